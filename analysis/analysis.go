@@ -133,7 +133,7 @@ func getResolvedMetricsWithoutSecrets(metrics []v1alpha1.Metric, args []v1alpha1
 	newArgs := make([]v1alpha1.Argument, 0)
 	for _, arg := range args {
 		newArg := arg.DeepCopy()
-		if newArg.ValueFrom != nil && newArg.ValueFrom.SecretKeyRef != nil {
+		if newArg.ValueFrom != nil && (newArg.ValueFrom.SecretKeyRef != nil || newArg.ValueFrom.SecretSourceRef != nil) {
 			newArg.ValueFrom = nil
 			newArg.Value = pointer.StringPtr("temp-for-secret")
 		}
@@ -172,7 +172,7 @@ func generateMetricTasks(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Metric) [
 		if analysisutil.MetricCompleted(run, metric.Name) {
 			continue
 		}
-		logCtx := logger.WithField("metric", metric.Name)
+		logCtx := logger.WithField(logutil.MetricKey, metric.Name)
 		lastMeasurement := analysisutil.LastMeasurement(run, metric.Name)
 		if lastMeasurement != nil && lastMeasurement.FinishedAt == nil {
 			now := timeutil.MetaNow()
@@ -258,6 +258,7 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 	for i, arg := range args {
 		//if secret specified in valueFrom, replace value with secret value
 		//error if arg has both value and valueFrom
+		var secretContent string
 		if arg.ValueFrom != nil && arg.ValueFrom.SecretKeyRef != nil {
 			name := arg.ValueFrom.SecretKeyRef.Name
 			secret, err := c.kubeclientset.CoreV1().Secrets(namespace).Get(context.TODO(), name, metav1.GetOptions{})
@@ -270,14 +271,21 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 				err := fmt.Errorf("key '%s' does not exist in secret '%s'", arg.ValueFrom.SecretKeyRef.Key, arg.ValueFrom.SecretKeyRef.Name)
 				return nil, nil, err
 			}
-			secretContent := string(secretContentBytes)
-			secretSet[secretContent] = true
-			resolvedArg := arg.DeepCopy()
-			resolvedArg.Value = &secretContent
-			args[i] = *resolvedArg
+			secretContent = string(secretContentBytes)
+		} else if arg.ValueFrom != nil && arg.ValueFrom.SecretSourceRef != nil {
+			var err error
+			secretContent, err = c.getSecretFromSource(context.TODO(), *arg.ValueFrom.SecretSourceRef, namespace)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to resolve arg '%s' from secret source: %w", arg.Name, err)
+			}
 		} else {
 			args[i] = arg
+			continue
 		}
+		secretSet[secretContent] = true
+		resolvedArg := arg.DeepCopy()
+		resolvedArg.Value = &secretContent
+		args[i] = *resolvedArg
 	}
 
 	// creates list of secret values from secretSet for RedactorFormatter
@@ -298,6 +306,30 @@ func (c *Controller) resolveArgs(tasks []metricTask, args []v1alpha1.Argument, n
 	return tasks, secrets, nil
 }
 
+// redactSecrets replaces the secret values in s
+func redactSecrets(s string, secrets []string) string {
+	for _, secret := range secrets {
+		if secret != "" {
+			s = strings.ReplaceAll(s, secret, "*****")
+		}
+	}
+	return s
+}
+
+// redactMeasurement replaces the secret values in the message, value and metadata of the measurement
+func redactMeasurement(measurement *v1alpha1.Measurement, secrets []string) {
+	measurement.Message = redactSecrets(measurement.Message, secrets)
+	measurement.Value = redactSecrets(measurement.Value, secrets)
+	redactMetadata(measurement.Metadata, secrets)
+}
+
+// redactMetadata replaces the secret values in the values of the metadata
+func redactMetadata(metadata map[string]string, secrets []string) {
+	for k, v := range metadata {
+		metadata[k] = redactSecrets(v, secrets)
+	}
+}
+
 // runMeasurements iterates a list of metric tasks, and runs, resumes, or terminates measurements
 func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTask, dryRunMetricsMap map[string]bool) error {
 	var wg sync.WaitGroup
@@ -318,16 +350,21 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 
 		go func(t metricTask) error {
 			defer wg.Done()
-			//redact secret values from logs
-			logger := logutil.WithRedactor(*logutil.WithAnalysisRun(run).WithField("metric", t.metric.Name), secrets)
-
 			resultsLock.Lock()
 			metricResult := analysisutil.GetResult(run, t.metric.Name)
 			resultsLock.Unlock()
 
+			// number the measurement taken, or resumed, so its log lines can be correlated
+			measurement := int32(1)
+			if metricResult != nil {
+				measurement += metricResult.Count + metricResult.Error
+			}
+			//redact secret values from logs
+			logger := logutil.WithRedactor(*logutil.WithMetric(run, t.metric.Name).WithField(logutil.MeasurementKey, measurement), secrets)
+
 			provider, err := c.newProvider(*logger, t.metric)
 			if err != nil {
-				log.Errorf("Error in getting provider :%v", err)
+				logger.Errorf("Error in getting provider :%v", err)
 				return err
 			}
 			if metricResult == nil {
@@ -392,12 +429,9 @@ func (c *Controller) runMeasurements(run *v1alpha1.AnalysisRun, tasks []metricTa
 				}
 			}
 
-			//redact secret values from measurement message
-			for _, secret := range secrets {
-				if secret != "" {
-					newMeasurement.Message = strings.ReplaceAll(newMeasurement.Message, secret, "*****")
-				}
-			}
+			//redact secret values from the measurement and the metric result, which end up in the status
+			redactMeasurement(&newMeasurement, secrets)
+			redactMetadata(metricResult.Metadata, secrets)
 
 			if t.incompleteMeasurement == nil {
 				metricResult.Measurements = append(metricResult.Measurements, newMeasurement)
@@ -458,8 +492,8 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 			runSummary.Count++
 		}
 		if result := analysisutil.GetResult(run, metric.Name); result != nil {
-			logger := logutil.WithAnalysisRun(run).WithField("metric", metric.Name)
-			metricStatus := assessMetricStatus(metric, *result, terminating)
+			logger := logutil.WithMetric(run, metric.Name)
+			metricStatus := assessMetricStatus(logger, metric, *result, terminating)
 			if result.Phase != metricStatus {
 				logger.Infof("Metric '%s' transitioned from %s -> %s", metric.Name, result.Phase, metricStatus)
 				if metricStatus.Completed() {
@@ -562,11 +596,10 @@ func (c *Controller) assessRunStatus(run *v1alpha1.AnalysisRun, metrics []v1alph
 // * current or latest measurement status
 // * parameters given by the metric (failureLimit, count, etc...)
 // * whether we are terminating (e.g. due to failing run, or termination request)
-func assessMetricStatus(metric v1alpha1.Metric, result v1alpha1.MetricResult, terminating bool) v1alpha1.AnalysisPhase {
+func assessMetricStatus(logger *log.Entry, metric v1alpha1.Metric, result v1alpha1.MetricResult, terminating bool) v1alpha1.AnalysisPhase {
 	if result.Phase.Completed() {
 		return result.Phase
 	}
-	logger := log.WithField("metric", metric.Name)
 	if len(result.Measurements) == 0 {
 		if terminating {
 			// we have yet to take a single measurement, but have already been instructed to stop
@@ -644,7 +677,7 @@ func calculateNextReconcileTime(run *v1alpha1.AnalysisRun, metrics []v1alpha1.Me
 			// NOTE: this also covers the case where metric.Count is reached
 			continue
 		}
-		logCtx := logutil.WithAnalysisRun(run).WithField("metric", metric.Name)
+		logCtx := logutil.WithMetric(run, metric.Name)
 		lastMeasurement := analysisutil.LastMeasurement(run, metric.Name)
 		if lastMeasurement == nil {
 			if metric.InitialDelay != "" {
@@ -728,7 +761,7 @@ func (c *Controller) garbageCollectMeasurements(run *v1alpha1.AnalysisRun, measu
 			if !ok {
 				continue
 			}
-			logger := logutil.WithAnalysisRun(run).WithField("metric", metric.Name)
+			logger := logutil.WithMetric(run, metric.Name)
 			provider, err := c.newProvider(*logger, metric)
 			if err != nil {
 				errors = append(errors, err)
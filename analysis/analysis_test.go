@@ -3,6 +3,7 @@ package analysis
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"testing"
@@ -533,8 +534,8 @@ func TestAssessMetricStatusNoMeasurements(t *testing.T) {
 	result := v1alpha1.MetricResult{
 		Measurements: nil,
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhasePending, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhasePending, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 }
 
 func TestAssessMetricStatusInFlightMeasurement(t *testing.T) {
@@ -557,8 +558,8 @@ func TestAssessMetricStatusInFlightMeasurement(t *testing.T) {
 			},
 		},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 }
 func TestAssessMetricStatusFailureLimit(t *testing.T) { // max failures
 	failureLimit := intstr.FromInt(2)
@@ -577,12 +578,12 @@ func TestAssessMetricStatusFailureLimit(t *testing.T) { // max failures
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 	newFailureLimit := intstr.FromInt(3)
 	metric.FailureLimit = &newFailureLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 }
 
 func TestAssessMetricStatusInconclusiveLimit(t *testing.T) {
@@ -602,12 +603,12 @@ func TestAssessMetricStatusInconclusiveLimit(t *testing.T) {
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 	newInconclusiveLimit := intstr.FromInt(3)
 	metric.InconclusiveLimit = &newInconclusiveLimit
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 }
 
 func TestAssessMetricStatusConsecutiveErrors(t *testing.T) {
@@ -624,11 +625,11 @@ func TestAssessMetricStatusConsecutiveErrors(t *testing.T) {
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseError, assessMetricStatus(metric, result, false))
-	assert.Equal(t, v1alpha1.AnalysisPhaseError, assessMetricStatus(metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 	result.ConsecutiveError = 4
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, true))
-	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
+	assert.Equal(t, v1alpha1.AnalysisPhaseRunning, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
 }
 
 func TestAssessMetricStatusCountReached(t *testing.T) {
@@ -647,10 +648,10 @@ func TestAssessMetricStatusCountReached(t *testing.T) {
 			FinishedAt: timePtr(metav1.NewTime(time.Now().Add(-60 * time.Second))),
 		}},
 	}
-	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
 	result.Successful = 5
 	result.Inconclusive = 5
-	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(metric, result, false))
+	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, assessMetricStatus(log.NewEntry(log.New()), metric, result, false))
 }
 
 func TestCalculateNextReconcileTimeInterval(t *testing.T) {
@@ -1432,6 +1433,93 @@ func TestSecretResolution(t *testing.T) {
 	assert.Contains(t, secretList, secretData)
 }
 
+func TestSecretSourceResolution(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	c.getSecretFromSource = func(ctx context.Context, ref v1alpha1.SecretSourceRef, namespace string) (string, error) {
+		assert.Equal(t, metav1.NamespaceDefault, namespace)
+		if ref.Vault.Path != "secret/data/prometheus" {
+			return "", fmt.Errorf("secret '%s' does not exist in Vault", ref.Vault.Path)
+		}
+		return "12345", nil
+	}
+
+	args := []v1alpha1.Argument{{
+		Name: "secret",
+		ValueFrom: &v1alpha1.ValueFrom{
+			SecretSourceRef: &v1alpha1.SecretSourceRef{
+				Vault: &v1alpha1.VaultSecretRef{Path: "secret/data/prometheus", Key: "token"},
+			},
+		},
+	}}
+	tasks := []metricTask{{
+		metric: v1alpha1.Metric{
+			Name:             "metric-name",
+			SuccessCondition: "{{args.secret}}",
+		},
+	}}
+	metricTaskList, secretList, err := c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.NoError(t, err)
+	assert.Equal(t, "12345", metricTaskList[0].metric.SuccessCondition)
+	assert.Contains(t, secretList, "12345")
+
+	args[0].ValueFrom.SecretSourceRef.Vault.Path = "secret/data/grafana"
+	_, _, err = c.resolveArgs(tasks, args, metav1.NamespaceDefault)
+	assert.EqualError(t, err, "failed to resolve arg 'secret' from secret source: secret 'secret/data/grafana' does not exist in Vault")
+}
+
+// TestSecretSourceRedaction verifies that the values of secrets from secret sources are redacted
+// from the status of the AnalysisRun
+func TestSecretSourceRedaction(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	c.getSecretFromSource = func(ctx context.Context, ref v1alpha1.SecretSourceRef, namespace string) (string, error) {
+		return "12345", nil
+	}
+	run := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.AnalysisRunSpec{
+			Args: []v1alpha1.Argument{{
+				Name: "token",
+				ValueFrom: &v1alpha1.ValueFrom{
+					SecretSourceRef: &v1alpha1.SecretSourceRef{
+						Vault: &v1alpha1.VaultSecretRef{Path: "secret/data/prometheus", Key: "token"},
+					},
+				},
+			}},
+			Metrics: []v1alpha1.Metric{{
+				Name:             "rate",
+				SuccessCondition: "result[0] > 0",
+				Provider: v1alpha1.MetricProvider{
+					Prometheus: &v1alpha1.PrometheusMetric{
+						Query: "rate{token=\"{{args.token}}\"}",
+					},
+				},
+			}},
+		},
+	}
+	measurement := newMeasurement(v1alpha1.AnalysisPhaseError)
+	measurement.Message = "query rate{token=\"12345\"} failed"
+	measurement.Value = "12345"
+	measurement.Metadata = map[string]string{"query": "rate{token=\"12345\"}"}
+	f.provider.On("Run", mock.Anything, mock.Anything, mock.Anything).Return(measurement)
+	f.provider.On("GetMetadata", mock.Anything, mock.Anything).Return(map[string]string{"ResolvedPrometheusQuery": "rate{token=\"12345\"}"}, nil)
+
+	newRun := c.reconcileAnalysisRun(run)
+	result := newRun.Status.MetricResults[0]
+	assert.Equal(t, map[string]string{"ResolvedPrometheusQuery": "rate{token=\"*****\"}"}, result.Metadata)
+	assert.Equal(t, "query rate{token=\"*****\"} failed", result.Measurements[0].Message)
+	assert.Equal(t, "*****", result.Measurements[0].Value)
+	assert.Equal(t, map[string]string{"query": "rate{token=\"*****\"}"}, result.Measurements[0].Metadata)
+	status, err := json.Marshal(newRun.Status)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(status), "12345")
+}
+
 // TestAssessMetricFailureInconclusiveOrError verifies that assessMetricFailureInconclusiveOrError returns the correct phases and messages
 // for Failed, Inconclusive, and Error metrics respectively
 func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
@@ -1446,7 +1534,7 @@ func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
 	expectedMsg := fmt.Sprintf("failed (%d) > failureLimit (%d)", result.Failed, 0)
 	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, phase)
 	assert.Equal(t, expectedMsg, msg)
-	assert.Equal(t, phase, assessMetricStatus(metric, result, true))
+	assert.Equal(t, phase, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 
 	result = v1alpha1.MetricResult{
 		Inconclusive: 1,
@@ -1458,7 +1546,7 @@ func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
 	expectedMsg = fmt.Sprintf("inconclusive (%d) > inconclusiveLimit (%d)", result.Inconclusive, 0)
 	assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, phase)
 	assert.Equal(t, expectedMsg, msg)
-	assert.Equal(t, phase, assessMetricStatus(metric, result, true))
+	assert.Equal(t, phase, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 
 	result = v1alpha1.MetricResult{
 		ConsecutiveError: 5, //default ConsecutiveErrorLimit for Metrics is 4
@@ -1470,7 +1558,7 @@ func TestAssessMetricFailureInconclusiveOrError(t *testing.T) {
 	expectedMsg = fmt.Sprintf("consecutiveErrors (%d) > consecutiveErrorLimit (%d)", result.ConsecutiveError, defaults.DefaultConsecutiveErrorLimit)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, phase)
 	assert.Equal(t, expectedMsg, msg)
-	assert.Equal(t, phase, assessMetricStatus(metric, result, true))
+	assert.Equal(t, phase, assessMetricStatus(log.NewEntry(log.New()), metric, result, true))
 }
 
 func StartAssessRunStatusErrorMessageAnalysisPhaseFail(t *testing.T, isDryRun bool) (v1alpha1.AnalysisPhase, string, *v1alpha1.RunSummary) {
@@ -1,13 +1,13 @@
 package analysis
 
 import (
+	"context"
 	"time"
 
 	log "github.com/sirupsen/logrus"
-	batchv1 "k8s.io/api/batch/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
-	batchinformers "k8s.io/client-go/informers/batch/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -19,8 +19,11 @@ import (
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
 	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/secretsources"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	informerutil "github.com/argoproj/argo-rollouts/utils/informer"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
@@ -36,12 +39,16 @@ type Controller struct {
 
 	analysisRunSynced cache.InformerSynced
 
-	jobInformer batchinformers.JobInformer
+	jobInformer informerutil.MetadataInformer
 
 	metricsServer *metrics.MetricsServer
 
 	newProvider func(logCtx log.Entry, metric v1alpha1.Metric) (metricproviders.Provider, error)
 
+	// getSecretFromSource returns the value of a secret of an external secret store, for an
+	// AnalysisRun of the given namespace
+	getSecretFromSource func(ctx context.Context, ref v1alpha1.SecretSourceRef, namespace string) (string, error)
+
 	// used for unit testing
 	enqueueAnalysis      func(obj interface{})
 	enqueueAnalysisAfter func(obj interface{}, duration time.Duration)
@@ -63,7 +70,7 @@ type ControllerConfig struct {
 	KubeClientSet        kubernetes.Interface
 	ArgoProjClientset    clientset.Interface
 	AnalysisRunInformer  informers.AnalysisRunInformer
-	JobInformer          batchinformers.JobInformer
+	JobInformer          informerutil.MetadataInformer
 	ResyncPeriod         time.Duration
 	AnalysisRunWorkQueue workqueue.RateLimitingInterface
 	MetricsServer        *metrics.MetricsServer
@@ -97,16 +104,14 @@ func NewController(cfg ControllerConfig) *Controller {
 		JobLister:  cfg.JobInformer.Lister(),
 	}
 	controller.newProvider = providerFactory.NewProvider
+	controller.getSecretFromSource = secretsources.NewSecretSourceFactory(controller.kubeclientset).GetSecret
 
 	cfg.JobInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj interface{}) {
-			controller.enqueueIfCompleted(obj)
-		},
 		UpdateFunc: func(oldObj, newObj interface{}) {
-			controller.enqueueIfCompleted(newObj)
+			controller.enqueueJobOwner(oldObj, newObj)
 		},
 		DeleteFunc: func(obj interface{}) {
-			controller.enqueueIfCompleted(obj)
+			controller.enqueueJobOwner(nil, obj)
 		},
 	})
 
@@ -115,6 +120,10 @@ func NewController(cfg ControllerConfig) *Controller {
 	cfg.AnalysisRunInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: controller.enqueueAnalysis,
 		UpdateFunc: func(old, new interface{}) {
+			if priority := controllerutil.UpdatePriority(old, new); priority != queue.PriorityNormal {
+				controllerutil.EnqueueWithPriority(new, priority, cfg.AnalysisRunWorkQueue)
+				return
+			}
 			controller.enqueueAnalysis(new)
 		},
 		DeleteFunc: controller.enqueueAnalysis,
@@ -168,16 +177,14 @@ func (c *Controller) syncHandler(key string) error {
 	return c.persistAnalysisRunStatus(run, newRun.Status)
 }
 
-func (c *Controller) enqueueIfCompleted(obj interface{}) {
-	job, ok := obj.(*batchv1.Job)
-	if !ok {
+// enqueueJobOwner enqueues the AnalysisRun of a job which changed. Only the metadata of jobs is
+// cached, so whether a job completed is only known once its AnalysisRun is reconciled. Resyncs of
+// unchanged jobs are ignored.
+func (c *Controller) enqueueJobOwner(oldObj, newObj interface{}) {
+	oldJob, oldOK := oldObj.(*metav1.PartialObjectMetadata)
+	newJob, newOK := newObj.(*metav1.PartialObjectMetadata)
+	if oldOK && newOK && oldJob.ResourceVersion == newJob.ResourceVersion {
 		return
 	}
-	for _, condition := range job.Status.Conditions {
-		switch condition.Type {
-		case batchv1.JobFailed, batchv1.JobComplete:
-			controllerutil.EnqueueParentObject(job, register.AnalysisRunKind, c.enqueueAnalysis)
-			return
-		}
-	}
+	controllerutil.EnqueueParentObject(newObj, register.AnalysisRunKind, c.enqueueAnalysis)
 }
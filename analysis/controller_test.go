@@ -19,6 +19,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	kubeinformers "k8s.io/client-go/informers"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
 	core "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
@@ -29,6 +30,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	informerutil "github.com/argoproj/argo-rollouts/utils/informer"
 	"github.com/argoproj/argo-rollouts/utils/record"
 )
 
@@ -103,7 +105,7 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		KubeClientSet:        f.kubeclient,
 		ArgoProjClientset:    f.client,
 		AnalysisRunInformer:  i.Argoproj().V1alpha1().AnalysisRuns(),
-		JobInformer:          k8sI.Batch().V1().Jobs(),
+		JobInformer:          informerutil.NewMetadataInformer(metadatafake.NewSimpleMetadataClient(runtime.NewScheme()), informerutil.JobsResource, metav1.NamespaceAll, resync(), nil),
 		ResyncPeriod:         resync(),
 		AnalysisRunWorkQueue: analysisRunWorkqueue,
 		MetricsServer:        metricsServer,
@@ -314,3 +316,36 @@ func TestNoReconcileForAnalysisRunWithDeletionTimestamp(t *testing.T) {
 
 	f.run(getKey(ar, t))
 }
+
+func TestEnqueueJobOwner(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	ar := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+		},
+	}
+	job := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "job",
+			Namespace:       metav1.NamespaceDefault,
+			ResourceVersion: "1",
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ar, v1alpha1.SchemeGroupVersion.WithKind("AnalysisRun"))},
+		},
+	}
+
+	// resync of an unchanged job
+	c.enqueueJobOwner(job, job)
+	assert.Empty(t, f.enqueuedObjects)
+
+	updatedJob := job.DeepCopy()
+	updatedJob.ResourceVersion = "2"
+	c.enqueueJobOwner(job, updatedJob)
+	assert.Equal(t, 1, f.enqueuedObjects["default/foo"])
+
+	c.enqueueJobOwner(nil, cache.DeletedFinalStateUnknown{Key: "default/job", Obj: updatedJob})
+	assert.Equal(t, 2, f.enqueuedObjects["default/foo"])
+}
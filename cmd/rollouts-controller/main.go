@@ -14,7 +14,10 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	kubeinformers "k8s.io/client-go/informers"
+	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/metadata"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/azure"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
@@ -23,10 +26,15 @@ import (
 	"github.com/argoproj/argo-rollouts/controller"
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	jobprovider "github.com/argoproj/argo-rollouts/metricproviders/job"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-rollouts/pkg/signals"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	awsutil "github.com/argoproj/argo-rollouts/utils/aws"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/impersonation"
+	"github.com/argoproj/argo-rollouts/utils/informer"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
@@ -51,6 +59,8 @@ func newCommand() *cobra.Command {
 		klogLevel            int
 		metricsPort          int
 		healthzPort          int
+		alertReceiverPort    int
+		debugPort            int
 		instanceID           string
 		qps                  float32
 		burst                int
@@ -67,8 +77,14 @@ func newCommand() *cobra.Command {
 		albIngressClasses    []string
 		nginxIngressClasses  []string
 		awsVerifyTargetGroup bool
+		awsOpts              awsutil.ClientOptions
 		namespaced           bool
 		printVersion         bool
+		reduceCacheMemory    bool
+		impersonateWrites    bool
+
+		maxProgressingRollouts int32
+		progressingBudgetLabel string
 	)
 	electOpts := controller.NewLeaderElectionOptions()
 	var command = cobra.Command{
@@ -90,10 +106,14 @@ func newCommand() *cobra.Command {
 			stopCh := signals.SetupSignalHandler()
 
 			defaults.SetVerifyTargetGroup(awsVerifyTargetGroup)
+			awsOpts.RequestsCounter = metrics.MetricAWSRequestTotal
+			awsutil.ConfigureClients(awsOpts)
 			defaults.SetIstioAPIVersion(istioVersion)
 			defaults.SetAmbassadorAPIVersion(ambassadorVersion)
 			defaults.SetSMIAPIVersion(trafficSplitVersion)
 			defaults.SetAppMeshCRDVersion(appmeshCRDVersion)
+			defaults.SetMaxProgressingRollouts(maxProgressingRollouts)
+			defaults.SetProgressingBudgetLabel(progressingBudgetLabel)
 
 			config, err := clientConfig.ClientConfig()
 			checkError(err)
@@ -113,6 +133,8 @@ func newCommand() *cobra.Command {
 			checkError(err)
 			dynamicClient, err := dynamic.NewForConfig(config)
 			checkError(err)
+			metadataClient, err := metadata.NewForConfig(config)
+			checkError(err)
 			discoveryClient, err := discovery.NewDiscoveryClientForConfig(config)
 			checkError(err)
 			smiClient, err := smiclientset.NewForConfig(config)
@@ -125,13 +147,23 @@ func newCommand() *cobra.Command {
 			instanceIDTweakListFunc := func(options *metav1.ListOptions) {
 				options.LabelSelector = instanceIDSelector.String()
 			}
-			jobInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(
+			jobTweakListFunc := func(options *metav1.ListOptions) {
+				options.LabelSelector = jobprovider.AnalysisRunUIDLabelKey
+			}
+			// the controller only reads the status of jobs it is resuming measurements of, which it
+			// fetches from the API server, so only the metadata of jobs is cached
+			jobInformer := informer.NewMetadataInformer(metadataClient, informer.JobsResource, namespace, resyncDuration, jobTweakListFunc)
+			// revision snapshots are the only ControllerRevisions the controller is interested in
+			controllerRevisionInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(
 				kubeClient,
 				resyncDuration,
 				kubeinformers.WithNamespace(namespace),
 				kubeinformers.WithTweakListOptions(func(options *metav1.ListOptions) {
-					options.LabelSelector = jobprovider.AnalysisRunUIDLabelKey
+					options.LabelSelector = v1alpha1.RevisionSnapshotLabelKey
 				}))
+			if reduceCacheMemory {
+				informer.RegisterTransformingInformers(kubeInformerFactory, namespace)
+			}
 			// We need three dynamic informer factories:
 			// 1. The first is the dynamic informer for rollouts, analysisruns, analysistemplates, experiments
 			dynamicInformerFactory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, resyncDuration, namespace, instanceIDTweakListFunc)
@@ -161,6 +193,21 @@ func newCommand() *cobra.Command {
 			ingressWrapper, err := ingressutil.NewIngressWrapper(mode, kubeClient, kubeInformerFactory)
 			checkError(err)
 
+			// namespaces are cluster scoped, so they are only watched when the controller is not namespaced
+			var namespaceInformer coreinformers.NamespaceInformer
+			var namespaceLister corev1listers.NamespaceLister
+			if !namespaced {
+				namespaceInformer = kubeInformerFactory.Core().V1().Namespaces()
+				namespaceLister = namespaceInformer.Lister()
+			}
+
+			var impersonator *impersonation.Impersonator
+			if impersonateWrites {
+				// the ServiceAccount can only be configured on the namespaces when the controller may
+				// watch them, i.e. when it is not namespaced
+				impersonator = impersonation.NewImpersonator(config, namespaceLister)
+			}
+
 			cm := controller.NewManager(
 				namespace,
 				kubeClient,
@@ -169,9 +216,10 @@ func newCommand() *cobra.Command {
 				smiClient,
 				discoveryClient,
 				kubeInformerFactory.Apps().V1().ReplicaSets(),
+				controllerRevisionInformerFactory.Apps().V1().ControllerRevisions(),
 				kubeInformerFactory.Core().V1().Services(),
 				ingressWrapper,
-				jobInformerFactory.Batch().V1().Jobs(),
+				jobInformer,
 				tolerantinformer.NewTolerantRolloutInformer(dynamicInformerFactory),
 				tolerantinformer.NewTolerantExperimentInformer(dynamicInformerFactory),
 				tolerantinformer.NewTolerantAnalysisRunInformer(dynamicInformerFactory),
@@ -182,13 +230,17 @@ func newCommand() *cobra.Command {
 				istioDynamicInformerFactory.ForResource(istioutil.GetIstioDestinationRuleGVR()).Informer(),
 				configMapInformer,
 				secretInformer,
+				namespaceInformer,
 				resyncDuration,
 				instanceID,
 				metricsPort,
 				healthzPort,
+				alertReceiverPort,
+				debugPort,
 				k8sRequestProvider,
 				nginxIngressClasses,
-				albIngressClasses)
+				albIngressClasses,
+				impersonator)
 			// notice that there is no need to run Start methods in a separate goroutine. (i.e. go kubeInformerFactory.Start(stopCh)
 			// Start method is non-blocking and runs all registered informers in a dedicated goroutine.
 			dynamicInformerFactory.Start(stopCh)
@@ -197,7 +249,8 @@ func newCommand() *cobra.Command {
 			}
 			kubeInformerFactory.Start(stopCh)
 			controllerNamespaceInformerFactory.Start(stopCh)
-			jobInformerFactory.Start(stopCh)
+			go jobInformer.Informer().Run(stopCh)
+			controllerRevisionInformerFactory.Start(stopCh)
 
 			// Check if Istio installed on cluster before starting dynamicInformerFactory
 			if istioutil.DoesIstioExist(istioPrimaryDynamicClient, namespace) {
@@ -222,6 +275,8 @@ func newCommand() *cobra.Command {
 	command.Flags().IntVar(&klogLevel, "kloglevel", 0, "Set the klog logging level")
 	command.Flags().IntVar(&metricsPort, "metricsport", controller.DefaultMetricsPort, "Set the port the metrics endpoint should be exposed over")
 	command.Flags().IntVar(&healthzPort, "healthzPort", controller.DefaultHealthzPort, "Set the port the healthz endpoint should be exposed over")
+	command.Flags().IntVar(&alertReceiverPort, "alert-receiver-port", 0, "Set the port the Alertmanager webhook receiver should be exposed over. The webhooks are authenticated with the token of the argo-rollouts-alert-receiver Secret. 0 disables the receiver")
+	command.Flags().IntVar(&debugPort, "debug-port", 0, "Set the port the debug endpoints (workqueues, informers, leader election, analysis runs, traffic routing caches and pprof) should be exposed over on 127.0.0.1. 0 disables the endpoints")
 	command.Flags().StringVar(&instanceID, "instance-id", "", "Indicates which argo rollout objects the controller should operate on")
 	command.Flags().Float32Var(&qps, "qps", defaults.DefaultQPS, "Maximum QPS (queries per second) to the K8s API server")
 	command.Flags().IntVar(&burst, "burst", defaults.DefaultBurst, "Maximum burst for throttle.")
@@ -240,7 +295,15 @@ func newCommand() *cobra.Command {
 	command.Flags().BoolVar(&awsVerifyTargetGroup, "alb-verify-weight", false, "Verify ALB target group weights before progressing through steps (requires AWS privileges)")
 	command.Flags().MarkDeprecated("alb-verify-weight", "Use --aws-verify-target-group instead")
 	command.Flags().BoolVar(&awsVerifyTargetGroup, "aws-verify-target-group", false, "Verify ALB target group before progressing through steps (requires AWS privileges)")
+	command.Flags().Float64Var(&awsOpts.QPS, "aws-qps", defaults.DefaultAwsQPS, "Maximum QPS (queries per second) to each AWS API. 0 disables the client side throttling")
+	command.Flags().IntVar(&awsOpts.Burst, "aws-burst", defaults.DefaultAwsBurst, "Maximum burst of queries to each AWS API")
+	command.Flags().DurationVar(&awsOpts.CacheTTL, "aws-cache-ttl", defaults.DefaultAwsCacheTTL, "Duration during which AWS load balancers and target groups are cached. 0 disables the cache")
+	command.Flags().IntVar(&awsOpts.MaxRetries, "aws-max-retries", defaults.DefaultAwsMaxRetries, "Maximum number of retries of a throttled call to an AWS API")
+	command.Flags().Int32Var(&maxProgressingRollouts, "max-progressing-rollouts", 0, "Maximum number of canary rollouts which may progress past their first step at the same time. Other rollouts are queued. 0 disables the limit")
+	command.Flags().StringVar(&progressingBudgetLabel, "progressing-budget-label", "", "Label of the rollouts whose values each get their own budget of max progressing rollouts. Rollouts without the label are not limited. Defaults to a single budget for all rollouts")
 	command.Flags().BoolVar(&printVersion, "version", false, "Print version")
+	command.Flags().BoolVar(&impersonateWrites, "impersonate-traffic-writes", false, "Mutate traffic routing objects and services while impersonating the ServiceAccount set by the "+annotations.ImpersonateServiceAccountAnnotation+" annotation of the rollout or its namespace")
+	command.Flags().BoolVar(&reduceCacheMemory, "reduce-cache-memory", true, "Strip fields the controller never reads (e.g. managedFields) from cached ReplicaSets and Services to reduce memory usage")
 	command.Flags().BoolVar(&electOpts.LeaderElect, "leader-elect", controller.DefaultLeaderElect, "If true, controller will perform leader election between instances to ensure no more than one instance of controller operates at a time")
 	command.Flags().DurationVar(&electOpts.LeaderElectionLeaseDuration, "leader-election-lease-duration", controller.DefaultLeaderElectionLeaseDuration, "The duration that non-leader candidates will wait after observing a leadership renewal until attempting to acquire leadership of a led but unrenewed leader slot. This is effectively the maximum duration that a leader can be stopped before it is replaced by another candidate. This is only applicable if leader election is enabled.")
 	command.Flags().DurationVar(&electOpts.LeaderElectionRenewDeadline, "leader-election-renew-deadline", controller.DefaultLeaderElectionRenewDeadline, "The interval between attempts by the acting master to renew a leadership slot before it stops leading. This must be less than or equal to the lease duration. This is only applicable if leader election is enabled.")
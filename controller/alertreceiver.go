@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+const (
+	// AlertmanagerWebhookPath is the endpoint receiving the webhooks of Alertmanager
+	AlertmanagerWebhookPath = "/alertmanager"
+	// AlertReceiverSecret is the Secret, in the namespace of the controller, holding the bearer token
+	// the webhooks of Alertmanager are authenticated with
+	AlertReceiverSecret = "argo-rollouts-alert-receiver"
+	// AlertReceiverTokenKey is the key of the bearer token in the AlertReceiverSecret
+	AlertReceiverTokenKey = "token"
+
+	alertStatusFiring = "firing"
+	// alertNamespaceLabel is the label of the alerts which restricts them to the rollouts of a
+	// namespace. The alerts without it are ignored.
+	alertNamespaceLabel = "namespace"
+)
+
+// alertmanagerWebhook is the payload of an Alertmanager webhook
+type alertmanagerWebhook struct {
+	Version string              `json:"version"`
+	Status  string              `json:"status"`
+	Alerts  []alertmanagerAlert `json:"alerts"`
+}
+
+// alertmanagerAlert is an alert of an Alertmanager webhook
+type alertmanagerAlert struct {
+	Status      string            `json:"status"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    time.Time         `json:"startsAt"`
+}
+
+// alertReceiver records the firing alerts of Alertmanager webhooks in the status of the rollouts
+// whose alert rules select them, for the rollout controller to pause or abort their update
+type alertReceiver struct {
+	rolloutsLister    listers.RolloutLister
+	secretLister      corev1listers.SecretNamespaceLister
+	argoprojclientset clientset.Interface
+}
+
+// NewAlertReceiverServer returns the server receiving the webhooks of Alertmanager. The webhooks are
+// authenticated with the bearer token of the AlertReceiverSecret read from the secret lister.
+func NewAlertReceiverServer(addr string, rolloutsLister listers.RolloutLister, secretLister corev1listers.SecretNamespaceLister, argoprojclientset clientset.Interface) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(AlertmanagerWebhookPath, &alertReceiver{
+		rolloutsLister:    rolloutsLister,
+		secretLister:      secretLister,
+		argoprojclientset: argoprojclientset,
+	})
+
+	return &http.Server{
+		Addr:    addr,
+		Handler: mux,
+	}
+}
+
+func (h *alertReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if !h.authenticate(req) {
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	var webhook alertmanagerWebhook
+	if err := json.NewDecoder(req.Body).Decode(&webhook); err != nil {
+		http.Error(w, fmt.Sprintf("invalid webhook payload: %v", err), http.StatusBadRequest)
+		return
+	}
+	rollouts, err := h.rolloutsLister.List(labels.Everything())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	// the alert is recorded in as many rollouts as possible, and Alertmanager retries the webhook
+	// when it failed to be recorded in any of them
+	var lastErr error
+	for _, alert := range webhook.Alerts {
+		if alert.Status != alertStatusFiring {
+			continue
+		}
+		for _, ro := range rollouts {
+			if err := h.receiveAlert(req.Context(), ro, alert); err != nil {
+				lastErr = err
+			}
+		}
+	}
+	if lastErr != nil {
+		http.Error(w, lastErr.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// authenticate returns whether the request carries the bearer token of the AlertReceiverSecret. No
+// request is authenticated while the Secret or its token is missing.
+func (h *alertReceiver) authenticate(req *http.Request) bool {
+	secret, err := h.secretLister.Get(AlertReceiverSecret)
+	if err != nil {
+		log.Warnf("Failed to get the alert receiver token from Secret '%s': %v", AlertReceiverSecret, err)
+		return false
+	}
+	token := secret.Data[AlertReceiverTokenKey]
+	if len(token) == 0 {
+		log.Warnf("Secret '%s' has no alert receiver token in its '%s' key", AlertReceiverSecret, AlertReceiverTokenKey)
+		return false
+	}
+	auth := req.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(auth, "Bearer ")), token) == 1
+}
+
+// receiveAlert records the alert in the status of the rollout, when the alert is labeled with the
+// namespace of the rollout, the rollout is updating and one of its alert rules selects the alert
+func (h *alertReceiver) receiveAlert(ctx context.Context, ro *v1alpha1.Rollout, alert alertmanagerAlert) error {
+	if alert.Labels[alertNamespaceLabel] != ro.Namespace {
+		return nil
+	}
+	if rolloututil.IsFullyPromoted(ro) || ro.Status.Abort {
+		return nil
+	}
+	action := selectAlert(ro.Spec.Alerts, alert.Labels)
+	if action == "" {
+		return nil
+	}
+	if pending := ro.Status.Alert; pending != nil && (pending.Action == action || pending.Action == v1alpha1.AlertActionAbort) {
+		return nil
+	}
+	if action == v1alpha1.AlertActionPause && pausedByAlert(ro) {
+		return nil
+	}
+
+	status := v1alpha1.RolloutAlertStatus{
+		Name:     alert.Labels["alertname"],
+		Action:   action,
+		Summary:  alertSummary(alert),
+		StartsAt: metav1.NewTime(alert.StartsAt),
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"alert": status,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	logutil.WithRollout(ro).Infof("Received firing alert '%s' (action: %s)", status.Name, action)
+	_, err = h.argoprojclientset.ArgoprojV1alpha1().Rollouts(ro.Namespace).Patch(ctx, ro.Name, types.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	if err != nil {
+		logutil.WithRollout(ro).Warnf("Failed to record alert '%s': %v", status.Name, err)
+	}
+	return err
+}
+
+// selectAlert returns the action of the alert rules which select the alert, with Abort taking
+// precedence over Pause, or an empty action when no rule selects the alert
+func selectAlert(rules []v1alpha1.RolloutAlertRule, alertLabels map[string]string) v1alpha1.AlertAction {
+	var action v1alpha1.AlertAction
+	for i := range rules {
+		selector, err := metav1.LabelSelectorAsSelector(&rules[i].Selector)
+		if err != nil || selector.Empty() || !selector.Matches(labels.Set(alertLabels)) {
+			continue
+		}
+		if rules[i].Action == v1alpha1.AlertActionPause {
+			if action == "" {
+				action = v1alpha1.AlertActionPause
+			}
+			continue
+		}
+		return v1alpha1.AlertActionAbort
+	}
+	return action
+}
+
+// pausedByAlert returns whether the rollout is already paused by a firing alert
+func pausedByAlert(ro *v1alpha1.Rollout) bool {
+	for _, cond := range ro.Status.PauseConditions {
+		if cond.Reason == v1alpha1.PauseReasonAlert {
+			return true
+		}
+	}
+	return false
+}
+
+// alertSummary returns the summary of the alert, or its description when it has no summary
+func alertSummary(alert alertmanagerAlert) string {
+	if summary := alert.Annotations["summary"]; summary != "" {
+		return summary
+	}
+	return alert.Annotations["description"]
+}
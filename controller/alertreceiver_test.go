@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+const testAlertReceiverToken = "s3cr3t"
+
+// newAlertReceiverSecretLister returns a lister of the Secrets of the controller namespace, with the
+// alert receiver Secret holding the token if it is not empty
+func newAlertReceiverSecretLister(token string) corev1listers.SecretNamespaceLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	if token != "" {
+		_ = indexer.Add(&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: AlertReceiverSecret, Namespace: defaults.Namespace()},
+			Data:       map[string][]byte{AlertReceiverTokenKey: []byte(token)},
+		})
+	}
+	return corev1listers.NewSecretLister(indexer).Secrets(defaults.Namespace())
+}
+
+func newAlertRollout(name string, action v1alpha1.AlertAction) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Alerts: []v1alpha1.RolloutAlertRule{{
+				Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+				Action:   action,
+			}},
+		},
+		Status: v1alpha1.RolloutStatus{
+			StableRS:       "stable",
+			CurrentPodHash: "canary",
+		},
+	}
+}
+
+// postAlertmanagerWebhook posts the payload to the alert receiver of the rollouts, and returns the
+// response and the rollouts whose status was patched
+func postAlertmanagerWebhook(t *testing.T, method string, payload []byte, rollouts ...*v1alpha1.Rollout) (*httptest.ResponseRecorder, map[string]v1alpha1.RolloutStatus) {
+	t.Helper()
+	return postAuthenticatedAlertmanagerWebhook(t, testAlertReceiverToken, "Bearer "+testAlertReceiverToken, method, payload, rollouts...)
+}
+
+// postAuthenticatedAlertmanagerWebhook posts the payload with the authorization header to the alert
+// receiver whose Secret holds the token
+func postAuthenticatedAlertmanagerWebhook(t *testing.T, token, authorization, method string, payload []byte, rollouts ...*v1alpha1.Rollout) (*httptest.ResponseRecorder, map[string]v1alpha1.RolloutStatus) {
+	t.Helper()
+	var objs []runtime.Object
+	for _, ro := range rollouts {
+		objs = append(objs, ro)
+	}
+	client := fake.NewSimpleClientset(objs...)
+	i := informers.NewSharedInformerFactory(client, 0)
+	for _, ro := range rollouts {
+		i.Argoproj().V1alpha1().Rollouts().Informer().GetIndexer().Add(ro)
+	}
+	server := NewAlertReceiverServer("localhost:8070", i.Argoproj().V1alpha1().Rollouts().Lister(), newAlertReceiverSecretLister(token), client)
+
+	req, err := http.NewRequest(method, AlertmanagerWebhookPath, bytes.NewReader(payload))
+	assert.NoError(t, err)
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	rr := httptest.NewRecorder()
+	server.Handler.ServeHTTP(rr, req)
+
+	patched := map[string]v1alpha1.RolloutStatus{}
+	for _, action := range client.Actions() {
+		patch, ok := action.(k8stesting.PatchAction)
+		if !ok {
+			continue
+		}
+		assert.Equal(t, "status", patch.GetSubresource())
+		var ro v1alpha1.Rollout
+		assert.NoError(t, json.Unmarshal(patch.GetPatch(), &ro))
+		patched[patch.GetName()] = ro.Status
+	}
+	return rr, patched
+}
+
+func TestAlertReceiver(t *testing.T) {
+	payload, err := os.ReadFile("testdata/alertmanager-webhook.json")
+	assert.NoError(t, err)
+
+	t.Run("abort", func(t *testing.T) {
+		rr, patched := postAlertmanagerWebhook(t, http.MethodPost, payload, newAlertRollout("guestbook", ""))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		if assert.Contains(t, patched, "guestbook") {
+			alert := patched["guestbook"].Alert
+			assert.Equal(t, "HighErrorRate", alert.Name)
+			assert.Equal(t, v1alpha1.AlertActionAbort, alert.Action)
+			assert.Equal(t, "Error rate of guestbook is above 5%", alert.Summary)
+			assert.Equal(t, "2022-03-01T12:00:00Z", alert.StartsAt.UTC().Format("2006-01-02T15:04:05Z"))
+		}
+	})
+	t.Run("pause", func(t *testing.T) {
+		_, patched := postAlertmanagerWebhook(t, http.MethodPost, payload, newAlertRollout("guestbook", v1alpha1.AlertActionPause))
+		if assert.Contains(t, patched, "guestbook") {
+			assert.Equal(t, v1alpha1.AlertActionPause, patched["guestbook"].Alert.Action)
+		}
+	})
+	t.Run("already paused by alert", func(t *testing.T) {
+		ro := newAlertRollout("guestbook", v1alpha1.AlertActionPause)
+		ro.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonAlert}}
+		_, patched := postAlertmanagerWebhook(t, http.MethodPost, payload, ro)
+		assert.Empty(t, patched)
+	})
+	t.Run("not selected", func(t *testing.T) {
+		ro := newAlertRollout("other", "")
+		ro.Spec.Alerts[0].Selector.MatchLabels["app"] = "other"
+		_, patched := postAlertmanagerWebhook(t, http.MethodPost, payload, ro, newAlertRollout("guestbook", ""))
+		assert.NotContains(t, patched, "other")
+		assert.Contains(t, patched, "guestbook")
+	})
+	t.Run("other namespace", func(t *testing.T) {
+		ro := newAlertRollout("guestbook", "")
+		ro.Namespace = "other"
+		_, patched := postAlertmanagerWebhook(t, http.MethodPost, payload, ro)
+		assert.Empty(t, patched)
+	})
+	t.Run("no namespace label", func(t *testing.T) {
+		var webhook map[string]interface{}
+		assert.NoError(t, json.Unmarshal(payload, &webhook))
+		for _, alert := range webhook["alerts"].([]interface{}) {
+			delete(alert.(map[string]interface{})["labels"].(map[string]interface{}), "namespace")
+		}
+		withoutNamespace, err := json.Marshal(webhook)
+		assert.NoError(t, err)
+		rr, patched := postAlertmanagerWebhook(t, http.MethodPost, withoutNamespace, newAlertRollout("guestbook", ""))
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Empty(t, patched)
+	})
+	t.Run("not updating", func(t *testing.T) {
+		promoted := newAlertRollout("promoted", "")
+		promoted.Status.StableRS = promoted.Status.CurrentPodHash
+		aborted := newAlertRollout("aborted", "")
+		aborted.Status.Abort = true
+		_, patched := postAlertmanagerWebhook(t, http.MethodPost, payload, promoted, aborted)
+		assert.Empty(t, patched)
+	})
+	t.Run("invalid payload", func(t *testing.T) {
+		rr, _ := postAlertmanagerWebhook(t, http.MethodPost, []byte("{"))
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+	t.Run("method not allowed", func(t *testing.T) {
+		rr, _ := postAlertmanagerWebhook(t, http.MethodGet, nil)
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
+
+func TestAlertReceiverAuthentication(t *testing.T) {
+	payload, err := os.ReadFile("testdata/alertmanager-webhook.json")
+	assert.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+	}{
+		{name: "missing token", token: testAlertReceiverToken},
+		{name: "wrong token", token: testAlertReceiverToken, authorization: "Bearer wrong"},
+		{name: "not a bearer token", token: testAlertReceiverToken, authorization: "Basic " + testAlertReceiverToken},
+		{name: "missing Secret", authorization: "Bearer " + testAlertReceiverToken},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rr, patched := postAuthenticatedAlertmanagerWebhook(t, test.token, test.authorization, http.MethodPost, payload, newAlertRollout("guestbook", ""))
+			assert.Equal(t, http.StatusUnauthorized, rr.Code)
+			assert.Equal(t, "Bearer", rr.Header().Get("WWW-Authenticate"))
+			assert.Empty(t, patched)
+		})
+	}
+}
+
+func TestSelectAlert(t *testing.T) {
+	alertLabels := map[string]string{"app": "guestbook", "severity": "critical"}
+	pause := v1alpha1.RolloutAlertRule{
+		Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+		Action:   v1alpha1.AlertActionPause,
+	}
+	abort := v1alpha1.RolloutAlertRule{
+		Selector: metav1.LabelSelector{MatchLabels: map[string]string{"severity": "critical"}},
+	}
+	assert.Equal(t, v1alpha1.AlertActionPause, selectAlert([]v1alpha1.RolloutAlertRule{pause}, alertLabels))
+	assert.Equal(t, v1alpha1.AlertActionAbort, selectAlert([]v1alpha1.RolloutAlertRule{pause, abort}, alertLabels))
+	assert.Equal(t, v1alpha1.AlertAction(""), selectAlert([]v1alpha1.RolloutAlertRule{{}}, alertLabels))
+}
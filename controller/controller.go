@@ -23,10 +23,10 @@ import (
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	appsinformers "k8s.io/client-go/informers/apps/v1"
-	batchinformers "k8s.io/client-go/informers/batch/v1"
 	coreinformers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
@@ -43,6 +43,8 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout"
 	"github.com/argoproj/argo-rollouts/service"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/impersonation"
+	informerutil "github.com/argoproj/argo-rollouts/utils/informer"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
@@ -87,6 +89,12 @@ const (
 
 	defaultLeaderElectionLeaseLockName = "argo-rollouts-controller-lock"
 	listenAddr                         = "0.0.0.0:%d"
+	// debugListenAddr is the address of the debug server, which is only reachable from within the pod
+	// since its endpoints are not authenticated
+	debugListenAddr = "127.0.0.1:%d"
+	// debugServerShutdownTimeout is how long the debug server waits for its requests to complete
+	// when the controller stops
+	debugServerShutdownTimeout = 5 * time.Second
 )
 
 type LeaderElectionOptions struct {
@@ -112,6 +120,9 @@ type Manager struct {
 	metricsServer           *metrics.MetricsServer
 	secondaryMetricsServer  *metrics.MetricsServer
 	healthzServer           *http.Server
+	alertReceiverServer     *http.Server
+	debugServer             *http.Server
+	leaderElection          *LeaderElectionState
 	rolloutController       *rollout.Controller
 	experimentController    *experiments.Controller
 	analysisController      *analysis.Controller
@@ -128,8 +139,10 @@ type Manager struct {
 	ingressSynced                 cache.InformerSynced
 	jobSynced                     cache.InformerSynced
 	replicasSetSynced             cache.InformerSynced
+	controllerRevisionSynced      cache.InformerSynced
 	configMapSynced               cache.InformerSynced
 	secretSynced                  cache.InformerSynced
+	namespaceSynced               cache.InformerSynced
 
 	rolloutWorkqueue     workqueue.RateLimitingInterface
 	serviceWorkqueue     workqueue.RateLimitingInterface
@@ -153,9 +166,10 @@ func NewManager(
 	smiclientset smiclientset.Interface,
 	discoveryClient discovery.DiscoveryInterface,
 	replicaSetInformer appsinformers.ReplicaSetInformer,
+	controllerRevisionInformer appsinformers.ControllerRevisionInformer,
 	servicesInformer coreinformers.ServiceInformer,
 	ingressWrap *ingressutil.IngressWrap,
-	jobInformer batchinformers.JobInformer,
+	jobInformer informerutil.MetadataInformer,
 	rolloutsInformer informers.RolloutInformer,
 	experimentsInformer informers.ExperimentInformer,
 	analysisRunInformer informers.AnalysisRunInformer,
@@ -166,13 +180,17 @@ func NewManager(
 	istioDestinationRuleInformer cache.SharedIndexInformer,
 	configMapInformer coreinformers.ConfigMapInformer,
 	secretInformer coreinformers.SecretInformer,
+	namespaceInformer coreinformers.NamespaceInformer,
 	resyncPeriod time.Duration,
 	instanceID string,
 	metricsPort int,
 	healthzPort int,
+	alertReceiverPort int,
+	debugPort int,
 	k8sRequestProvider *metrics.K8sRequestsCountProvider,
 	nginxIngressClasses []string,
 	albIngressClasses []string,
+	impersonator *impersonation.Impersonator,
 ) *Manager {
 
 	utilruntime.Must(rolloutscheme.AddToScheme(scheme.Scheme))
@@ -191,9 +209,17 @@ func NewManager(
 
 	healthzServer := NewHealthzServer(fmt.Sprintf(listenAddr, healthzPort))
 
-	rolloutWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts")
-	experimentWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Experiments")
-	analysisRunWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns")
+	// the alert receiver is only started when its port is set
+	var alertReceiverServer *http.Server
+	if alertReceiverPort > 0 {
+		alertReceiverServer = NewAlertReceiverServer(fmt.Sprintf(listenAddr, alertReceiverPort), rolloutsInformer.Lister(), secretInformer.Lister().Secrets(defaults.Namespace()), argoprojclientset)
+	}
+
+	// Rollouts, Experiments and AnalysisRuns use priority queues so user-initiated actions are
+	// processed ahead of resyncs, and no single namespace can monopolize the workers
+	rolloutWorkqueue := queue.NewPriorityRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts", metricsServer)
+	experimentWorkqueue := queue.NewPriorityRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Experiments", metricsServer)
+	analysisRunWorkqueue := queue.NewPriorityRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "AnalysisRuns", metricsServer)
 	serviceWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services")
 	ingressWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Ingresses")
 
@@ -215,6 +241,19 @@ func NewManager(
 		}),
 	)
 
+	// the impersonator is only set when it is enabled, so that the controllers never hold a typed nil
+	var rolloutImpersonator rollout.Impersonator
+	var serviceImpersonator service.Impersonator
+	if impersonator != nil {
+		rolloutImpersonator = impersonator
+		serviceImpersonator = impersonator
+	}
+	// namespaces are only watched by the cluster-wide controllers
+	var namespaceLister corelisters.NamespaceLister
+	if namespaceInformer != nil {
+		namespaceLister = namespaceInformer.Lister()
+	}
+
 	rolloutController := rollout.NewController(rollout.ControllerConfig{
 		Namespace:                       namespace,
 		KubeClientSet:                   kubeclientset,
@@ -230,7 +269,10 @@ func NewManager(
 		IstioVirtualServiceInformer:     istioVirtualServiceInformer,
 		IstioDestinationRuleInformer:    istioDestinationRuleInformer,
 		ReplicaSetInformer:              replicaSetInformer,
+		ControllerRevisionInformer:      controllerRevisionInformer,
 		ServicesInformer:                servicesInformer,
+		ConfigMapInformer:               configMapInformer,
+		NamespaceInformer:               namespaceInformer,
 		IngressWrapper:                  ingressWrap,
 		RolloutsInformer:                rolloutsInformer,
 		ResyncPeriod:                    resyncPeriod,
@@ -239,6 +281,7 @@ func NewManager(
 		IngressWorkQueue:                ingressWorkqueue,
 		MetricsServer:                   metricsServer,
 		Recorder:                        recorder,
+		Impersonator:                    rolloutImpersonator,
 	})
 
 	experimentController := experiments.NewController(experiments.ControllerConfig{
@@ -250,6 +293,7 @@ func NewManager(
 		AnalysisTemplateInformer:        analysisTemplateInformer,
 		ClusterAnalysisTemplateInformer: clusterAnalysisTemplateInformer,
 		ServiceInformer:                 servicesInformer,
+		NamespaceLister:                 namespaceLister,
 		ResyncPeriod:                    resyncPeriod,
 		RolloutWorkQueue:                rolloutWorkqueue,
 		ExperimentWorkQueue:             experimentWorkqueue,
@@ -277,6 +321,7 @@ func NewManager(
 		ServiceWorkqueue:  serviceWorkqueue,
 		ResyncPeriod:      resyncPeriod,
 		MetricsServer:     metricsServer,
+		Impersonator:      serviceImpersonator,
 	})
 
 	ingressController := ingress.NewController(ingress.ControllerConfig{
@@ -293,9 +338,47 @@ func NewManager(
 		NGINXClasses: nginxIngressClasses,
 	})
 
+	// the debug server exposes the internal state and pprof, so it is only started when its port is set
+	leaderElection := &LeaderElectionState{}
+	var debugServer *http.Server
+	if debugPort > 0 {
+		debugServer = NewDebugServer(DebugServerConfig{
+			Addr: fmt.Sprintf(debugListenAddr, debugPort),
+			Workqueues: map[string]workqueue.RateLimitingInterface{
+				"Rollouts":     rolloutWorkqueue,
+				"Experiments":  experimentWorkqueue,
+				"AnalysisRuns": analysisRunWorkqueue,
+				"Services":     serviceWorkqueue,
+				"Ingresses":    ingressWorkqueue,
+			},
+			Informers: map[string]cache.InformerSynced{
+				"Rollouts":                 rolloutsInformer.Informer().HasSynced,
+				"Experiments":              experimentsInformer.Informer().HasSynced,
+				"AnalysisRuns":             analysisRunInformer.Informer().HasSynced,
+				"AnalysisTemplates":        analysisTemplateInformer.Informer().HasSynced,
+				"ClusterAnalysisTemplates": clusterAnalysisTemplateInformer.Informer().HasSynced,
+				"ReplicaSets":              replicaSetInformer.Informer().HasSynced,
+				"ControllerRevisions":      controllerRevisionInformer.Informer().HasSynced,
+				"Services":                 servicesInformer.Informer().HasSynced,
+				"Ingresses":                ingressWrap.HasSynced,
+				"Jobs":                     jobInformer.Informer().HasSynced,
+				"ConfigMaps":               configMapInformer.Informer().HasSynced,
+				"Secrets":                  secretInformer.Informer().HasSynced,
+				"VirtualServices":          istioVirtualServiceInformer.HasSynced,
+				"DestinationRules":         istioDestinationRuleInformer.HasSynced,
+			},
+			AnalysisRunLister: analysisRunInformer.Lister(),
+			IstioController:   rolloutController.IstioController,
+			LeaderElection:    leaderElection,
+		})
+	}
+
 	cm := &Manager{
 		metricsServer:                 metricsServer,
 		healthzServer:                 healthzServer,
+		alertReceiverServer:           alertReceiverServer,
+		debugServer:                   debugServer,
+		leaderElection:                leaderElection,
 		rolloutSynced:                 rolloutsInformer.Informer().HasSynced,
 		serviceSynced:                 servicesInformer.Informer().HasSynced,
 		ingressSynced:                 ingressWrap.HasSynced,
@@ -305,6 +388,7 @@ func NewManager(
 		analysisTemplateSynced:        analysisTemplateInformer.Informer().HasSynced,
 		clusterAnalysisTemplateSynced: clusterAnalysisTemplateInformer.Informer().HasSynced,
 		replicasSetSynced:             replicaSetInformer.Informer().HasSynced,
+		controllerRevisionSynced:      controllerRevisionInformer.Informer().HasSynced,
 		configMapSynced:               configMapInformer.Informer().HasSynced,
 		secretSynced:                  secretInformer.Informer().HasSynced,
 		rolloutWorkqueue:              rolloutWorkqueue,
@@ -323,6 +407,11 @@ func NewManager(
 		kubeClientSet:                 kubeclientset,
 	}
 
+	// namespaces are only watched when the controller is not namespaced
+	if namespaceInformer != nil {
+		cm.namespaceSynced = namespaceInformer.Informer().HasSynced
+	}
+
 	return cm
 }
 
@@ -339,12 +428,16 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 
 	// Wait for the caches to be synced before starting workers
 	log.Info("Waiting for controller's informer caches to sync")
-	if ok := cache.WaitForCacheSync(stopCh, c.serviceSynced, c.ingressSynced, c.jobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.configMapSynced, c.secretSynced); !ok {
+	if ok := cache.WaitForCacheSync(stopCh, c.serviceSynced, c.ingressSynced, c.jobSynced, c.rolloutSynced, c.experimentSynced, c.analysisRunSynced, c.analysisTemplateSynced, c.replicasSetSynced, c.controllerRevisionSynced, c.configMapSynced, c.secretSynced); !ok {
 		return fmt.Errorf("failed to wait for caches to sync")
 	}
 	// only wait for cluster scoped informers to sync if we are running in cluster-wide mode
 	if c.namespace == metav1.NamespaceAll {
-		if ok := cache.WaitForCacheSync(stopCh, c.clusterAnalysisTemplateSynced); !ok {
+		clusterSynced := []cache.InformerSynced{c.clusterAnalysisTemplateSynced}
+		if c.namespaceSynced != nil {
+			clusterSynced = append(clusterSynced, c.namespaceSynced)
+		}
+		if ok := cache.WaitForCacheSync(stopCh, clusterSynced...); !ok {
 			return fmt.Errorf("failed to wait for cluster-scoped caches to sync")
 		}
 	}
@@ -354,6 +447,7 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 
 	if !electOpts.LeaderElect {
 		log.Info("Leader election is turned off. Running in single-instance mode")
+		c.leaderElection.setLeading(true)
 		go c.startLeading(ctx, rolloutThreadiness, serviceThreadiness, ingressThreadiness, experimentThreadiness, analysisThreadiness)
 	} else {
 		// id used to distinguish between multiple controller manager instances
@@ -369,6 +463,7 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 		// add a uniquifier so that two processes on the same host don't accidentally both become active
 		id = id + "_" + string(uuid.NewUUID())
 		log.Infof("Leaderelection get id %s", id)
+		c.leaderElection.setIdentity(true, id)
 		go leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
 			Lock: &resourcelock.LeaseLock{
 				LeaseMeta: metav1.ObjectMeta{Name: defaultLeaderElectionLeaseLockName, Namespace: electOpts.LeaderElectionNamespace}, Client: c.kubeClientSet.CoordinationV1(),
@@ -380,6 +475,7 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 			RetryPeriod:     electOpts.LeaderElectionRetryPeriod,
 			Callbacks: leaderelection.LeaderCallbacks{
 				OnStartedLeading: func(ctx context.Context) {
+					c.leaderElection.setLeading(true)
 					if c.secondaryMetricsServer != nil {
 						log.Warnln("Shutdown Secondary Metrics Server")
 						c.secondaryMetricsServer.Shutdown(ctx)
@@ -388,9 +484,11 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 				},
 				OnStoppedLeading: func() {
 					log.Infof("Stopped leading controller: %s", id)
+					c.leaderElection.setLeading(false)
 					return
 				},
 				OnNewLeader: func(identity string) {
+					c.leaderElection.setLeader(identity)
 					if identity == id {
 						return
 					}
@@ -424,8 +522,38 @@ func (c *Manager) Run(rolloutThreadiness, serviceThreadiness, ingressThreadiness
 		}
 	}()
 
+	// every instance receives alerts, since the alerts are recorded in the rollouts for the leader
+	if c.alertReceiverServer != nil {
+		go func() {
+			log.Infof("Starting Alert Receiver Server at %s", c.alertReceiverServer.Addr)
+			err := c.alertReceiverServer.ListenAndServe()
+			if err != nil {
+				err = errors.Wrap(err, "Starting Alert Receiver Server")
+				log.Error(err)
+			}
+		}()
+	}
+
+	if c.debugServer != nil {
+		go func() {
+			log.Infof("Starting Debug Server at %s", c.debugServer.Addr)
+			err := c.debugServer.ListenAndServe()
+			if err != nil && err != http.ErrServerClosed {
+				err = errors.Wrap(err, "Starting Debug Server")
+				log.Error(err)
+			}
+		}()
+	}
+
 	<-stopCh
 	log.Info("Shutting down workers")
+	if c.debugServer != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), debugServerShutdownTimeout)
+		defer cancel()
+		if err := c.debugServer.Shutdown(ctx); err != nil {
+			log.Warnf("Failed to shut down the Debug Server: %v", err)
+		}
+	}
 
 	return nil
 }
@@ -23,6 +23,7 @@ import (
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
 
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
@@ -37,6 +38,7 @@ import (
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	rolloutController "github.com/argoproj/argo-rollouts/rollout"
 	"github.com/argoproj/argo-rollouts/service"
+	informerutil "github.com/argoproj/argo-rollouts/utils/informer"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	"github.com/argoproj/argo-rollouts/utils/queue"
@@ -89,11 +91,13 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		analysisRunSynced:             alwaysReady,
 		analysisTemplateSynced:        alwaysReady,
 		replicasSetSynced:             alwaysReady,
+		controllerRevisionSynced:      alwaysReady,
 		configMapSynced:               alwaysReady,
 		secretSynced:                  alwaysReady,
 		clusterAnalysisTemplateSynced: alwaysReady,
 
-		healthzServer: NewHealthzServer(fmt.Sprintf(listenAddr, 8080)),
+		healthzServer:  NewHealthzServer(fmt.Sprintf(listenAddr, 8080)),
+		leaderElection: &LeaderElectionState{},
 	}
 
 	metricsAddr := fmt.Sprintf(listenAddr, 8090)
@@ -134,7 +138,9 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
 		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
 		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
+		ControllerRevisionInformer:      k8sI.Apps().V1().ControllerRevisions(),
 		ServicesInformer:                k8sI.Core().V1().Services(),
+		ConfigMapInformer:               k8sI.Core().V1().ConfigMaps(),
 		IngressWrapper:                  ingressWrapper,
 		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
 		IstioPrimaryDynamicClient:       dynamicClient,
@@ -152,7 +158,7 @@ func (f *fixture) newManager(t *testing.T) *Manager {
 		KubeClientSet:        f.kubeclient,
 		ArgoProjClientset:    f.client,
 		AnalysisRunInformer:  i.Argoproj().V1alpha1().AnalysisRuns(),
-		JobInformer:          k8sI.Batch().V1().Jobs(),
+		JobInformer:          informerutil.NewMetadataInformer(metadatafake.NewSimpleMetadataClient(runtime.NewScheme()), informerutil.JobsResource, metav1.NamespaceAll, noResyncPeriodFunc(), nil),
 		ResyncPeriod:         noResyncPeriodFunc(),
 		AnalysisRunWorkQueue: analysisRunWorkqueue,
 		MetricsServer:        cm.metricsServer,
@@ -265,9 +271,10 @@ func TestNewManager(t *testing.T) {
 		smifake.NewSimpleClientset(),
 		&discoveryfake.FakeDiscovery{},
 		k8sI.Apps().V1().ReplicaSets(),
+		k8sI.Apps().V1().ControllerRevisions(),
 		k8sI.Core().V1().Services(),
 		ingressWrapper,
-		k8sI.Batch().V1().Jobs(),
+		informerutil.NewMetadataInformer(metadatafake.NewSimpleMetadataClient(runtime.NewScheme()), informerutil.JobsResource, metav1.NamespaceAll, noResyncPeriodFunc(), nil),
 		i.Argoproj().V1alpha1().Rollouts(),
 		i.Argoproj().V1alpha1().Experiments(),
 		i.Argoproj().V1alpha1().AnalysisRuns(),
@@ -278,16 +285,52 @@ func TestNewManager(t *testing.T) {
 		istioDestinationRuleInformer,
 		k8sI.Core().V1().ConfigMaps(),
 		k8sI.Core().V1().Secrets(),
+		k8sI.Core().V1().Namespaces(),
 		noResyncPeriodFunc(),
 		"test",
 		8090,
 		8080,
+		8070,
+		8060,
 		k8sRequestProvider,
 		nil,
 		nil,
+		nil,
 	)
 
 	assert.NotNil(t, cm)
+	assert.NotNil(t, cm.alertReceiverServer)
+	assert.NotNil(t, cm.debugServer)
+	assert.Equal(t, "127.0.0.1:8060", cm.debugServer.Addr)
+	assert.NotNil(t, cm.namespaceSynced)
+}
+
+func TestDebugServerShutdownOnStop(t *testing.T) {
+	f := newFixture(t)
+
+	cm := f.newManager(t)
+	cm.debugServer = NewDebugServer(DebugServerConfig{
+		Addr:           fmt.Sprintf(debugListenAddr, 8061),
+		LeaderElection: &LeaderElectionState{},
+	})
+	stopCh := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		assert.NoError(t, cm.Run(1, 1, 1, 1, 1, NewLeaderElectionOptions(), stopCh))
+	}()
+	time.Sleep(1 * time.Second)
+
+	resp, err := http.Get("http://127.0.0.1:8061" + DebugLeaderElectionPath)
+	if assert.NoError(t, err) {
+		resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+	}
+
+	close(stopCh)
+	<-done
+	_, err = http.Get("http://127.0.0.1:8061" + DebugLeaderElectionPath)
+	assert.Error(t, err)
 }
 
 func TestPrimaryController(t *testing.T) {
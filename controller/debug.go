@@ -0,0 +1,231 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/istio"
+	"github.com/argoproj/argo-rollouts/utils/queue"
+)
+
+const (
+	// DebugWorkqueuesPath is the endpoint listing the contents of the workqueues
+	DebugWorkqueuesPath = "/debug/workqueues"
+	// DebugInformersPath is the endpoint listing the sync status of the informers
+	DebugInformersPath = "/debug/informers"
+	// DebugLeaderElectionPath is the endpoint describing the leader election of the instance
+	DebugLeaderElectionPath = "/debug/leaderelection"
+	// DebugAnalysisRunsPath is the endpoint listing the in-flight measurements of the AnalysisRuns
+	DebugAnalysisRunsPath = "/debug/analysisruns"
+	// DebugTrafficRoutingPath is the endpoint describing the caches of the traffic routers
+	DebugTrafficRoutingPath = "/debug/trafficrouting"
+	// DebugPprofPath is the prefix of the pprof endpoints
+	DebugPprofPath = "/debug/pprof/"
+)
+
+// DebugServerConfig describes the internal state exposed by the debug server
+type DebugServerConfig struct {
+	Addr              string
+	Workqueues        map[string]workqueue.RateLimitingInterface
+	Informers         map[string]cache.InformerSynced
+	AnalysisRunLister listers.AnalysisRunLister
+	IstioController   *istio.IstioController
+	LeaderElection    *LeaderElectionState
+}
+
+// LeaderElectionState tracks the leader election of the controller instance
+type LeaderElectionState struct {
+	mutex        sync.RWMutex
+	status       leaderElectionStatus
+	transitionAt time.Time
+}
+
+type leaderElectionStatus struct {
+	Enabled  bool   `json:"enabled"`
+	Identity string `json:"identity,omitempty"`
+	Leader   string `json:"leader,omitempty"`
+	IsLeader bool   `json:"isLeader"`
+	// LastTransition is when the instance last started or stopped leading
+	LastTransition string `json:"lastTransition,omitempty"`
+}
+
+func (s *LeaderElectionState) setIdentity(enabled bool, identity string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.status.Enabled = enabled
+	s.status.Identity = identity
+}
+
+func (s *LeaderElectionState) setLeading(isLeader bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.status.IsLeader = isLeader
+	if isLeader {
+		s.status.Leader = s.status.Identity
+	}
+	s.transitionAt = time.Now()
+}
+
+func (s *LeaderElectionState) setLeader(identity string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.status.Leader = identity
+}
+
+func (s *LeaderElectionState) get() leaderElectionStatus {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	status := s.status
+	if !s.transitionAt.IsZero() {
+		status.LastTransition = s.transitionAt.UTC().Format(time.RFC3339)
+	}
+	return status
+}
+
+type workqueueState struct {
+	Name  string       `json:"name"`
+	Len   int          `json:"len"`
+	Items []queue.Item `json:"items,omitempty"`
+}
+
+type inFlightMeasurement struct {
+	AnalysisRun string `json:"analysisRun"`
+	Metric      string `json:"metric"`
+	Phase       string `json:"phase"`
+	StartedAt   string `json:"startedAt,omitempty"`
+	ResumeAt    string `json:"resumeAt,omitempty"`
+	// Measurements is the number of measurements of the metric so far
+	Measurements int32 `json:"measurements"`
+}
+
+// debugServer serves the internal state of the controller, for troubleshooting
+type debugServer struct {
+	DebugServerConfig
+}
+
+// NewDebugServer returns the server exposing the internal state of the controller and pprof
+func NewDebugServer(cfg DebugServerConfig) *http.Server {
+	s := &debugServer{DebugServerConfig: cfg}
+	mux := http.NewServeMux()
+	mux.HandleFunc(DebugWorkqueuesPath, s.serveWorkqueues)
+	mux.HandleFunc(DebugInformersPath, s.serveInformers)
+	mux.HandleFunc(DebugLeaderElectionPath, s.serveLeaderElection)
+	mux.HandleFunc(DebugAnalysisRunsPath, s.serveAnalysisRuns)
+	mux.HandleFunc(DebugTrafficRoutingPath, s.serveTrafficRouting)
+	mux.HandleFunc(DebugPprofPath, pprof.Index)
+	mux.HandleFunc(DebugPprofPath+"cmdline", pprof.Cmdline)
+	mux.HandleFunc(DebugPprofPath+"profile", pprof.Profile)
+	mux.HandleFunc(DebugPprofPath+"symbol", pprof.Symbol)
+	mux.HandleFunc(DebugPprofPath+"trace", pprof.Trace)
+
+	return &http.Server{
+		Addr:    cfg.Addr,
+		Handler: mux,
+	}
+}
+
+func writeDebugJSON(w http.ResponseWriter, obj interface{}) {
+	data, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(data)
+}
+
+func (s *debugServer) serveWorkqueues(w http.ResponseWriter, req *http.Request) {
+	states := []workqueueState{}
+	for name, q := range s.Workqueues {
+		state := workqueueState{Name: name, Len: q.Len()}
+		// only the priority queues can list their items
+		if pq, ok := q.(queue.PriorityRateLimitingInterface); ok {
+			state.Items = pq.Items()
+		}
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].Name < states[j].Name
+	})
+	writeDebugJSON(w, states)
+}
+
+func (s *debugServer) serveInformers(w http.ResponseWriter, req *http.Request) {
+	synced := map[string]bool{}
+	for name, hasSynced := range s.Informers {
+		synced[name] = hasSynced()
+	}
+	writeDebugJSON(w, synced)
+}
+
+func (s *debugServer) serveLeaderElection(w http.ResponseWriter, req *http.Request) {
+	writeDebugJSON(w, s.LeaderElection.get())
+}
+
+func (s *debugServer) serveAnalysisRuns(w http.ResponseWriter, req *http.Request) {
+	runs, err := s.AnalysisRunLister.List(labels.Everything())
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeDebugJSON(w, inFlightMeasurements(runs))
+}
+
+// inFlightMeasurements returns the measurements which were started but not completed, of the
+// AnalysisRuns which are still running
+func inFlightMeasurements(runs []*v1alpha1.AnalysisRun) []inFlightMeasurement {
+	measurements := []inFlightMeasurement{}
+	for _, run := range runs {
+		if run.Status.Phase.Completed() {
+			continue
+		}
+		for _, result := range run.Status.MetricResults {
+			if len(result.Measurements) == 0 {
+				continue
+			}
+			last := result.Measurements[len(result.Measurements)-1]
+			if last.Phase.Completed() {
+				continue
+			}
+			measurement := inFlightMeasurement{
+				AnalysisRun:  run.Namespace + "/" + run.Name,
+				Metric:       result.Name,
+				Phase:        string(last.Phase),
+				Measurements: result.Count + result.Error,
+			}
+			if last.StartedAt != nil {
+				measurement.StartedAt = last.StartedAt.UTC().Format(time.RFC3339)
+			}
+			if last.ResumeAt != nil {
+				measurement.ResumeAt = last.ResumeAt.UTC().Format(time.RFC3339)
+			}
+			measurements = append(measurements, measurement)
+		}
+	}
+	sort.Slice(measurements, func(i, j int) bool {
+		if measurements[i].AnalysisRun != measurements[j].AnalysisRun {
+			return measurements[i].AnalysisRun < measurements[j].AnalysisRun
+		}
+		return measurements[i].Metric < measurements[j].Metric
+	})
+	return measurements
+}
+
+func (s *debugServer) serveTrafficRouting(w http.ResponseWriter, req *http.Request) {
+	state := map[string]interface{}{}
+	if s.IstioController != nil {
+		state["istio"] = s.IstioController.CacheState()
+	}
+	writeDebugJSON(w, state)
+}
@@ -0,0 +1,151 @@
+package controller
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
+	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/queue"
+)
+
+// getDebugEndpoint requests the endpoint of the debug server, and decodes its JSON response
+func getDebugEndpoint(t *testing.T, server *http.Server, path string, obj interface{}) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, path, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	server.Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), obj))
+}
+
+func newDebugServer(runs ...*v1alpha1.AnalysisRun) *http.Server {
+	client := fake.NewSimpleClientset()
+	i := informers.NewSharedInformerFactory(client, 0)
+	for _, run := range runs {
+		i.Argoproj().V1alpha1().AnalysisRuns().Informer().GetIndexer().Add(run)
+	}
+	rolloutWorkqueue := queue.NewPriorityRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts", nil)
+	rolloutWorkqueue.AddWithPriority("default/guestbook", queue.PriorityHigh)
+	serviceWorkqueue := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Services")
+	serviceWorkqueue.Add("default/guestbook")
+
+	leaderElection := &LeaderElectionState{}
+	leaderElection.setIdentity(true, "controller-1")
+	leaderElection.setLeader("controller-2")
+
+	return NewDebugServer(DebugServerConfig{
+		Addr: "localhost:8060",
+		Workqueues: map[string]workqueue.RateLimitingInterface{
+			"Rollouts": rolloutWorkqueue,
+			"Services": serviceWorkqueue,
+		},
+		Informers: map[string]cache.InformerSynced{
+			"Rollouts": func() bool { return true },
+			"Services": func() bool { return false },
+		},
+		AnalysisRunLister: i.Argoproj().V1alpha1().AnalysisRuns().Lister(),
+		LeaderElection:    leaderElection,
+	})
+}
+
+func TestDebugWorkqueues(t *testing.T) {
+	var states []workqueueState
+	getDebugEndpoint(t, newDebugServer(), DebugWorkqueuesPath, &states)
+	if assert.Len(t, states, 2) {
+		assert.Equal(t, "Rollouts", states[0].Name)
+		assert.Equal(t, 1, states[0].Len)
+		if assert.Len(t, states[0].Items, 1) {
+			assert.Equal(t, "default/guestbook", states[0].Items[0].Key)
+			assert.Equal(t, "high", states[0].Items[0].Priority)
+		}
+		assert.Equal(t, workqueueState{Name: "Services", Len: 1}, states[1])
+	}
+}
+
+func TestDebugInformers(t *testing.T) {
+	var synced map[string]bool
+	getDebugEndpoint(t, newDebugServer(), DebugInformersPath, &synced)
+	assert.Equal(t, map[string]bool{"Rollouts": true, "Services": false}, synced)
+}
+
+func TestDebugLeaderElection(t *testing.T) {
+	var status leaderElectionStatus
+	getDebugEndpoint(t, newDebugServer(), DebugLeaderElectionPath, &status)
+	assert.Equal(t, leaderElectionStatus{Enabled: true, Identity: "controller-1", Leader: "controller-2"}, status)
+
+	state := &LeaderElectionState{}
+	state.setIdentity(true, "controller-1")
+	state.setLeading(true)
+	status = state.get()
+	assert.True(t, status.IsLeader)
+	assert.Equal(t, "controller-1", status.Leader)
+	assert.NotEmpty(t, status.LastTransition)
+}
+
+func TestDebugAnalysisRuns(t *testing.T) {
+	startedAt := metav1.NewTime(time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC))
+	running := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-1", Namespace: metav1.NamespaceDefault},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseRunning,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:  "job",
+				Count: 1,
+				Measurements: []v1alpha1.Measurement{
+					{Phase: v1alpha1.AnalysisPhaseSuccessful},
+					{Phase: v1alpha1.AnalysisPhaseRunning, StartedAt: &startedAt},
+				},
+			}, {
+				Name:         "success-rate",
+				Count:        1,
+				Measurements: []v1alpha1.Measurement{{Phase: v1alpha1.AnalysisPhaseSuccessful}},
+			}},
+		},
+	}
+	completed := &v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-0", Namespace: metav1.NamespaceDefault},
+		Status: v1alpha1.AnalysisRunStatus{
+			Phase: v1alpha1.AnalysisPhaseFailed,
+			MetricResults: []v1alpha1.MetricResult{{
+				Name:         "job",
+				Measurements: []v1alpha1.Measurement{{Phase: v1alpha1.AnalysisPhaseRunning}},
+			}},
+		},
+	}
+
+	var measurements []inFlightMeasurement
+	getDebugEndpoint(t, newDebugServer(running, completed), DebugAnalysisRunsPath, &measurements)
+	assert.Equal(t, []inFlightMeasurement{{
+		AnalysisRun:  "default/guestbook-1",
+		Metric:       "job",
+		Phase:        "Running",
+		StartedAt:    "2022-03-01T12:00:00Z",
+		Measurements: 1,
+	}}, measurements)
+}
+
+func TestDebugTrafficRouting(t *testing.T) {
+	var state map[string]interface{}
+	getDebugEndpoint(t, newDebugServer(), DebugTrafficRoutingPath, &state)
+	assert.Empty(t, state)
+}
+
+func TestDebugPprof(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, DebugPprofPath, nil)
+	assert.NoError(t, err)
+	rr := httptest.NewRecorder()
+	newDebugServer().Handler.ServeHTTP(rr, req)
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Contains(t, rr.Body.String(), "goroutine")
+}
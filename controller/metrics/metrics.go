@@ -14,10 +14,13 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	rolloutlister "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/queue"
 )
 
 type MetricsServer struct {
 	*http.Server
+	// workqueueMetricsProvider provides the workqueue metrics of the priority workqueues
+	workqueueMetricsProvider
 	reconcileRolloutHistogram *prometheus.HistogramVec
 	errorRolloutCounter       *prometheus.CounterVec
 
@@ -29,6 +32,8 @@ type MetricsServer struct {
 	successNotificationCounter    *prometheus.CounterVec
 	errorNotificationCounter      *prometheus.CounterVec
 	sendNotificationRunHistogram  *prometheus.HistogramVec
+	workqueuePriorityDepthGauge   *prometheus.GaugeVec
+	workqueuePriorityWaitHist     *prometheus.HistogramVec
 	k8sRequestsCounter            *K8sRequestsCountProvider
 }
 
@@ -81,12 +86,17 @@ func NewMetricsServer(cfg ServerConfig, isPrimary bool) *MetricsServer {
 	reg.MustRegister(MetricNotificationFailedTotal)
 	reg.MustRegister(MetricNotificationSend)
 	reg.MustRegister(MetricVersionGauge)
+	reg.MustRegister(MetricWorkqueuePriorityDepth)
+	reg.MustRegister(MetricWorkqueuePriorityWait)
+	reg.MustRegister(MetricAWSRequestTotal)
 
 	mux.Handle(MetricsPath, promhttp.HandlerFor(prometheus.Gatherers{
 		// contains app controller specific metrics
 		reg,
 		// contains process, golang and controller workqueues metrics
 		registry.DefaultGatherer,
+		// contains the workqueue metrics of the priority workqueues
+		workqueueRegistry,
 	}, promhttp.HandlerOpts{}))
 	return &MetricsServer{
 		Server: &http.Server{
@@ -104,6 +114,8 @@ func NewMetricsServer(cfg ServerConfig, isPrimary bool) *MetricsServer {
 		successNotificationCounter:    MetricNotificationSuccessTotal,
 		errorNotificationCounter:      MetricNotificationFailedTotal,
 		sendNotificationRunHistogram:  MetricNotificationSend,
+		workqueuePriorityDepthGauge:   MetricWorkqueuePriorityDepth,
+		workqueuePriorityWaitHist:     MetricWorkqueuePriorityWait,
 
 		k8sRequestsCounter: cfg.K8SRequestProvider,
 	}
@@ -136,6 +148,22 @@ func (m *MetricsServer) IncError(namespace, name string, kind string) {
 	}
 }
 
+// SetWorkqueuePriorityDepth sets the depth of a priority class of a priority workqueue
+func (m *MetricsServer) SetWorkqueuePriorityDepth(name string, priority queue.Priority, depth int) {
+	if m.workqueuePriorityDepthGauge == nil {
+		return
+	}
+	m.workqueuePriorityDepthGauge.WithLabelValues(name, priority.String()).Set(float64(depth))
+}
+
+// ObserveWorkqueuePriorityWait records how long an item waited in a priority class of a priority workqueue
+func (m *MetricsServer) ObserveWorkqueuePriorityWait(name string, priority queue.Priority, wait time.Duration) {
+	if m.workqueuePriorityWaitHist == nil {
+		return
+	}
+	m.workqueuePriorityWaitHist.WithLabelValues(name, priority.String()).Observe(wait.Seconds())
+}
+
 func boolFloat64(b bool) float64 {
 	if b {
 		return 1
@@ -11,10 +11,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informerfactory "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/queue"
 )
 
 func newFakeServerConfig(objs ...runtime.Object) ServerConfig {
@@ -102,3 +104,20 @@ func TestSecondaryMetricsServer(t *testing.T) {
 	metricsServ := NewMetricsServer(newFakeServerConfig(), false)
 	testHttpResponse(t, metricsServ.Handler, expectedResponse)
 }
+
+func TestPriorityWorkqueueMetrics(t *testing.T) {
+	expectedResponse := `workqueue_adds_total{name="PriorityRollouts"} 1
+workqueue_depth{name="PriorityRollouts"} 1
+workqueue_adds_total{name="Services"} 1
+workqueue_priority_depth{name="PriorityRollouts",priority="high"} 1`
+
+	metricsServ := NewMetricsServer(newFakeServerConfig(), true)
+	q := queue.NewPriorityRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "PriorityRollouts", metricsServ)
+	defer q.ShutDown()
+	q.AddWithPriority("default/guestbook", queue.PriorityHigh)
+	// the metrics of client-go workqueues are gathered together with the ones of priority workqueues
+	services := workqueue.NewNamed("Services")
+	defer services.ShutDown()
+	services.Add("default/guestbook")
+	testHttpResponse(t, metricsServ.Handler, expectedResponse)
+}
@@ -216,6 +216,37 @@ var (
 	)
 )
 
+// AWS client metrics
+var (
+	MetricAWSRequestTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "aws_request_total",
+			Help: "Number of calls to the AWS APIs, by service, operation and status (success, throttled or error).",
+		},
+		[]string{"service", "operation", "status"},
+	)
+)
+
+// Workqueue metrics
+var (
+	MetricWorkqueuePriorityDepth = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "workqueue_priority_depth",
+			Help: "Current depth of a priority workqueue per priority class.",
+		},
+		[]string{"name", "priority"},
+	)
+
+	MetricWorkqueuePriorityWait = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "workqueue_priority_wait_duration_seconds",
+			Help:    "How long in seconds an item stays in a priority workqueue before being requested, per priority class.",
+			Buckets: prometheus.ExponentialBuckets(0.001, 4, 10),
+		},
+		[]string{"name", "priority"},
+	)
+)
+
 // MetricVersionGauge version info
 var (
 	MetricVersionGauge = prometheus.NewGaugeFunc(
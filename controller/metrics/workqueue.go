@@ -0,0 +1,108 @@
+package metrics
+
+import (
+	"k8s.io/client-go/util/workqueue"
+	k8smetrics "k8s.io/component-base/metrics"
+	workqueuemetrics "k8s.io/component-base/metrics/prometheus/workqueue"
+)
+
+// The workqueue metrics of the priority workqueues. These queues are not client-go workqueues, so
+// they do not get their metrics from the provider registered by
+// k8s.io/component-base/metrics/prometheus/workqueue, which is not exported. The metrics are
+// defined as the ones of that provider, so the metrics of all the workqueues are gathered together.
+var (
+	workqueueDepth = k8smetrics.NewGaugeVec(&k8smetrics.GaugeOpts{
+		Subsystem: workqueuemetrics.WorkQueueSubsystem,
+		Name:      workqueuemetrics.DepthKey,
+		Help:      "Current depth of workqueue",
+	}, []string{"name"})
+
+	workqueueAdds = k8smetrics.NewCounterVec(&k8smetrics.CounterOpts{
+		Subsystem: workqueuemetrics.WorkQueueSubsystem,
+		Name:      workqueuemetrics.AddsKey,
+		Help:      "Total number of adds handled by workqueue",
+	}, []string{"name"})
+
+	workqueueLatency = k8smetrics.NewHistogramVec(&k8smetrics.HistogramOpts{
+		Subsystem: workqueuemetrics.WorkQueueSubsystem,
+		Name:      workqueuemetrics.QueueLatencyKey,
+		Help:      "How long in seconds an item stays in workqueue before being requested.",
+		Buckets:   k8smetrics.ExponentialBuckets(10e-9, 10, 10),
+	}, []string{"name"})
+
+	workqueueWorkDuration = k8smetrics.NewHistogramVec(&k8smetrics.HistogramOpts{
+		Subsystem: workqueuemetrics.WorkQueueSubsystem,
+		Name:      workqueuemetrics.WorkDurationKey,
+		Help:      "How long in seconds processing an item from workqueue takes.",
+		Buckets:   k8smetrics.ExponentialBuckets(10e-9, 10, 10),
+	}, []string{"name"})
+
+	workqueueUnfinished = k8smetrics.NewGaugeVec(&k8smetrics.GaugeOpts{
+		Subsystem: workqueuemetrics.WorkQueueSubsystem,
+		Name:      workqueuemetrics.UnfinishedWorkKey,
+		Help: "How many seconds of work has done that " +
+			"is in progress and hasn't been observed by work_duration. Large " +
+			"values indicate stuck threads. One can deduce the number of stuck " +
+			"threads by observing the rate at which this increases.",
+	}, []string{"name"})
+
+	workqueueLongestRunningProcessor = k8smetrics.NewGaugeVec(&k8smetrics.GaugeOpts{
+		Subsystem: workqueuemetrics.WorkQueueSubsystem,
+		Name:      workqueuemetrics.LongestRunningProcessorKey,
+		Help: "How many seconds has the longest running " +
+			"processor for workqueue been running.",
+	}, []string{"name"})
+
+	workqueueRetries = k8smetrics.NewCounterVec(&k8smetrics.CounterOpts{
+		Subsystem: workqueuemetrics.WorkQueueSubsystem,
+		Name:      workqueuemetrics.RetriesKey,
+		Help:      "Total number of retries handled by workqueue",
+	}, []string{"name"})
+
+	// workqueueRegistry holds the workqueue metrics of the priority workqueues. They cannot be
+	// registered with the legacy registry, which already holds metrics with the same names.
+	workqueueRegistry = k8smetrics.NewKubeRegistry()
+)
+
+func init() {
+	workqueueRegistry.MustRegister(
+		workqueueDepth,
+		workqueueAdds,
+		workqueueLatency,
+		workqueueWorkDuration,
+		workqueueUnfinished,
+		workqueueLongestRunningProcessor,
+		workqueueRetries,
+	)
+}
+
+// workqueueMetricsProvider provides the workqueue metrics of the priority workqueues
+type workqueueMetricsProvider struct{}
+
+func (workqueueMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return workqueueDepth.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return workqueueAdds.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return workqueueLatency.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return workqueueWorkDuration.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return workqueueUnfinished.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return workqueueLongestRunningProcessor.WithLabelValues(name)
+}
+
+func (workqueueMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return workqueueRetries.WithLabelValues(name)
+}
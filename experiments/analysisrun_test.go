@@ -196,6 +196,34 @@ func TestClusterAnalysisTemplateNotExists(t *testing.T) {
 	assert.Contains(t, message, "clusteranalysistemplate")
 }
 
+// TestClusterAnalysisTemplateDeniedByNamespacePolicy verifies we error the analysis if the namespace policy of
+// the ClusterAnalysisTemplate does not allow the namespace of the experiment
+func TestClusterAnalysisTemplateDeniedByNamespacePolicy(t *testing.T) {
+	templates := generateTemplates("bar")
+	aTemplates := generateClusterAnalysisTemplates("cluster-success-rate")
+	aTemplates[0].Spec.NamespacePolicy = &v1alpha1.NamespacePolicy{Namespaces: []string{"other"}}
+	e := newExperiment("foo", templates, "")
+	e.Spec.Analyses = []v1alpha1.ExperimentAnalysisTemplateRef{
+		{
+			Name:         "cluster-success-rate",
+			TemplateName: aTemplates[0].Name,
+			ClusterScope: true,
+		},
+	}
+	rs := templateToRS(e, templates[0], 1)
+
+	f := newFixture(t, e, rs, &aTemplates[0])
+	defer f.Close()
+
+	patchIdx := f.expectPatchExperimentAction(e)
+	f.run(getKey(e, t))
+
+	patchedEx := f.getPatchedExperimentAsObj(patchIdx)
+	assert.Equal(t, v1alpha1.AnalysisPhaseError, patchedEx.Status.AnalysisRuns[0].Phase)
+	expectedMsg := fmt.Sprintf("ClusterAnalysisTemplate verification failed for analysis 'cluster-success-rate': ClusterAnalysisTemplate 'cluster-success-rate' is not allowed in namespace '%s' by its namespace policy", e.Namespace)
+	assert.Equal(t, expectedMsg, patchedEx.Status.AnalysisRuns[0].Message)
+}
+
 // TestCreateAnalysisRunWhenAvailable ensures we create the AnalysisRun when we become available
 func TestCreateAnalysisRunWithArg(t *testing.T) {
 	templates := generateTemplates("bar")
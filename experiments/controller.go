@@ -31,6 +31,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/diff"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
@@ -52,6 +53,7 @@ type Controller struct {
 	clusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
 	analysisRunLister             listers.AnalysisRunLister
 	serviceLister                 listersv1.ServiceLister
+	namespaceLister               listersv1.NamespaceLister
 
 	replicaSetSynced              cache.InformerSynced
 	experimentSynced              cache.InformerSynced
@@ -88,6 +90,7 @@ type ControllerConfig struct {
 	AnalysisTemplateInformer        informers.AnalysisTemplateInformer
 	ClusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer
 	ServiceInformer                 informersv1.ServiceInformer
+	NamespaceLister                 listersv1.NamespaceLister
 	ResyncPeriod                    time.Duration
 	RolloutWorkQueue                workqueue.RateLimitingInterface
 	ExperimentWorkQueue             workqueue.RateLimitingInterface
@@ -113,6 +116,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		clusterAnalysisTemplateLister: cfg.ClusterAnalysisTemplateInformer.Lister(),
 		analysisRunLister:             cfg.AnalysisRunInformer.Lister(),
 		serviceLister:                 cfg.ServiceInformer.Lister(),
+		namespaceLister:               cfg.NamespaceLister,
 		metricsServer:                 cfg.MetricsServer,
 		rolloutWorkqueue:              cfg.RolloutWorkQueue,
 		experimentWorkqueue:           cfg.ExperimentWorkQueue,
@@ -138,6 +142,10 @@ func NewController(cfg ControllerConfig) *Controller {
 	cfg.ExperimentsInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: controller.enqueueExperiment,
 		UpdateFunc: func(old, new interface{}) {
+			if priority := controllerutil.UpdatePriority(old, new); priority != queue.PriorityNormal {
+				controllerutil.EnqueueWithPriority(new, priority, cfg.ExperimentWorkQueue)
+				return
+			}
 			controller.enqueueExperiment(new)
 		},
 		DeleteFunc: controller.enqueueExperiment,
@@ -297,6 +305,7 @@ func (ec *Controller) syncHandler(key string) error {
 		ec.clusterAnalysisTemplateLister,
 		ec.analysisRunLister,
 		ec.serviceLister,
+		ec.namespaceLister,
 		ec.recorder,
 		ec.resyncPeriod,
 		ec.enqueueExperimentAfter,
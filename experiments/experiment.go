@@ -44,6 +44,7 @@ type experimentContext struct {
 	analysisRunLister             rolloutslisters.AnalysisRunLister
 	replicaSetLister              appslisters.ReplicaSetLister
 	serviceLister                 v1.ServiceLister
+	namespaceLister               v1.NamespaceLister
 	recorder                      record.EventRecorder
 	enqueueExperimentAfter        func(obj interface{}, duration time.Duration)
 	resyncPeriod                  time.Duration
@@ -67,6 +68,7 @@ func newExperimentContext(
 	clusterAnalysisTemplateLister rolloutslisters.ClusterAnalysisTemplateLister,
 	analysisRunLister rolloutslisters.AnalysisRunLister,
 	serviceLister v1.ServiceLister,
+	namespaceLister v1.NamespaceLister,
 	recorder record.EventRecorder,
 	resyncPeriod time.Duration,
 	enqueueExperimentAfter func(obj interface{}, duration time.Duration),
@@ -83,11 +85,12 @@ func newExperimentContext(
 		clusterAnalysisTemplateLister: clusterAnalysisTemplateLister,
 		analysisRunLister:             analysisRunLister,
 		serviceLister:                 serviceLister,
+		namespaceLister:               namespaceLister,
 		recorder:                      recorder,
 		enqueueExperimentAfter:        enqueueExperimentAfter,
 		resyncPeriod:                  resyncPeriod,
 
-		log:           log.WithField(logutil.ExperimentKey, experiment.Name).WithField(logutil.NamespaceKey, experiment.Namespace),
+		log:           logutil.WithExperiment(experiment),
 		newStatus:     experiment.Status.DeepCopy(),
 		isTerminating: experimentutil.IsTerminating(experiment),
 	}
@@ -623,6 +626,9 @@ func (ec *experimentContext) newAnalysisRun(analysis v1alpha1.ExperimentAnalysis
 		if err != nil {
 			return nil, err
 		}
+		if err := analysisutil.VerifyClusterAnalysisTemplateAccess(ec.namespaceLister, clusterTemplate, ec.ex.Namespace); err != nil {
+			return nil, err
+		}
 		name := fmt.Sprintf("%s-%s", ec.ex.Name, analysis.Name)
 
 		clusterAnalysisTemplates := []*v1alpha1.ClusterAnalysisTemplate{clusterTemplate}
@@ -634,6 +640,7 @@ func (ec *experimentContext) newAnalysisRun(analysis v1alpha1.ExperimentAnalysis
 		if instanceID != "" {
 			run.Labels = map[string]string{v1alpha1.LabelKeyControllerInstanceID: ec.ex.Labels[v1alpha1.LabelKeyControllerInstanceID]}
 		}
+		logutil.InheritLogLevel(ec.ex, run)
 		run.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(ec.ex, controllerKind)}
 		return run, nil
 	} else {
@@ -652,6 +659,7 @@ func (ec *experimentContext) newAnalysisRun(analysis v1alpha1.ExperimentAnalysis
 		if instanceID != "" {
 			run.Labels = map[string]string{v1alpha1.LabelKeyControllerInstanceID: ec.ex.Labels[v1alpha1.LabelKeyControllerInstanceID]}
 		}
+		logutil.InheritLogLevel(ec.ex, run)
 		run.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(ec.ex, controllerKind)}
 		return run, nil
 	}
@@ -663,8 +671,12 @@ func (ec *experimentContext) verifyAnalysisTemplate(analysis v1alpha1.Experiment
 	return err
 }
 
-// verifyAnalysisTemplate verifies a ClusterAnalysisTemplate. For now, it simply means that it exists
+// verifyClusterAnalysisTemplate verifies a ClusterAnalysisTemplate, which means that it exists and that
+// its namespace policy allows the namespace of the experiment to use it
 func (ec *experimentContext) verifyClusterAnalysisTemplate(analysis v1alpha1.ExperimentAnalysisTemplateRef) error {
-	_, err := ec.clusterAnalysisTemplateLister.Get(analysis.TemplateName)
-	return err
+	template, err := ec.clusterAnalysisTemplateLister.Get(analysis.TemplateName)
+	if err != nil {
+		return err
+	}
+	return analysisutil.VerifyClusterAnalysisTemplateAccess(ec.namespaceLister, template, ec.ex.Namespace)
 }
@@ -48,6 +48,7 @@ func newTestContext(ex *v1alpha1.Experiment, objects ...runtime.Object) *experim
 	analysisTemplateLister := rolloutsI.Argoproj().V1alpha1().AnalysisTemplates().Lister()
 	clusterAnalysisTemplateLister := rolloutsI.Argoproj().V1alpha1().ClusterAnalysisTemplates().Lister()
 	serviceLister := k8sI.Core().V1().Services().Lister()
+	namespaceLister := k8sI.Core().V1().Namespaces().Lister()
 
 	return newExperimentContext(
 		ex,
@@ -60,6 +61,7 @@ func newTestContext(ex *v1alpha1.Experiment, objects ...runtime.Object) *experim
 		clusterAnalysisTemplateLister,
 		analysisRunLister,
 		serviceLister,
+		namespaceLister,
 		record.NewFakeEventRecorder(),
 		noResyncPeriodFunc(),
 		func(obj interface{}, duration time.Duration) {},
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	log "github.com/sirupsen/logrus"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -63,7 +62,7 @@ func (c *Controller) getReplicaSetsForExperiment(experiment *v1alpha1.Experiment
 			}
 			if templateDefined(templateName) {
 				templateToRS[templateName] = rs
-				logCtx := log.WithField(logutil.ExperimentKey, experiment.Name).WithField(logutil.NamespaceKey, experiment.Namespace)
+				logCtx := logutil.WithExperiment(experiment)
 				logCtx.Infof("Claimed ReplicaSet '%s' for template '%s'", rs.Name, templateName)
 			}
 		}
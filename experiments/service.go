@@ -8,7 +8,6 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
-	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -52,7 +51,7 @@ func GetServiceForExperiment(experiment *v1alpha1.Experiment, svc *corev1.Servic
 		}
 		if templateDefined(experiment, templateName) {
 			templateToService[templateName] = svc
-			logCtx := log.WithField(logutil.ExperimentKey, experiment.Name).WithField(logutil.NamespaceKey, experiment.Namespace)
+			logCtx := logutil.WithExperiment(experiment)
 			logCtx.Infof("Claimed Service '%s' for template '%s'", svc.Name, templateName)
 		}
 	}
@@ -5,12 +5,12 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	log "github.com/sirupsen/logrus"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	awsutil "github.com/argoproj/argo-rollouts/utils/aws"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
 	metricutil "github.com/argoproj/argo-rollouts/utils/metric"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
@@ -26,17 +26,25 @@ type CloudWatchClientAPI interface {
 }
 
 type CloudWatchClient struct {
-	client *cloudwatch.Client
+	client cloudwatch.GetMetricDataAPIClient
 }
 
+// Query calls GetMetricData through the layer shared by the AWS clients, which rate limits and
+// retries the calls
 func (c *CloudWatchClient) Query(interval time.Duration, query []types.MetricDataQuery) (*cloudwatch.GetMetricDataOutput, error) {
 	endTime := timeutil.Now()
 	startTime := endTime.Add(-interval)
-	return c.client.GetMetricData(context.TODO(), &cloudwatch.GetMetricDataInput{
-		StartTime:         &startTime,
-		EndTime:           &endTime,
-		MetricDataQueries: query,
+	var out *cloudwatch.GetMetricDataOutput
+	err := awsutil.Call(context.TODO(), awsutil.CloudWatchService, "GetMetricData", func(ctx context.Context) error {
+		var err error
+		out, err = c.client.GetMetricData(ctx, &cloudwatch.GetMetricDataInput{
+			StartTime:         &startTime,
+			EndTime:           &endTime,
+			MetricDataQueries: query,
+		})
+		return err
 	})
+	return out, err
 }
 
 // Provider contains all the required components to run a CloudWatch query
@@ -124,7 +132,7 @@ func NewCloudWatchProvider(api CloudWatchClientAPI, logCtx log.Entry) *Provider
 }
 
 func NewCloudWatchAPIClient(metric v1alpha1.Metric, opts ...func(*cloudwatch.Options)) (CloudWatchClientAPI, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO())
+	cfg, err := awsutil.LoadConfig(context.TODO())
 	if err != nil {
 		return nil, err
 	}
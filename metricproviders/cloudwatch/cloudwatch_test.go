@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/smithy-go"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -232,6 +234,25 @@ func TestNewCloudWatchAPIClient(t *testing.T) {
 	})
 }
 
+func TestQueryRetriesThrottledCalls(t *testing.T) {
+	response := &cloudwatch.GetMetricDataOutput{}
+	client := &mockGetMetricDataClient{
+		errs:     []error{&smithy.GenericAPIError{Code: "Throttling"}},
+		response: response,
+	}
+	c := &CloudWatchClient{client: client}
+	result, err := c.Query(5*time.Minute, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, response, result)
+	assert.Equal(t, 2, client.calls)
+
+	client = &mockGetMetricDataClient{errs: []error{&smithy.GenericAPIError{Code: "AccessDenied"}}}
+	c = &CloudWatchClient{client: client}
+	_, err = c.Query(5*time.Minute, nil)
+	assert.Error(t, err)
+	assert.Equal(t, 1, client.calls)
+}
+
 func TestConvertType(t *testing.T) {
 	period := intstr.FromInt(300)
 	tests := []struct {
@@ -1,6 +1,7 @@
 package cloudwatch
 
 import (
+	"context"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
@@ -18,3 +19,19 @@ func (m *mockAPI) Query(interval time.Duration, query []types.MetricDataQuery) (
 	}
 	return m.response, nil
 }
+
+type mockGetMetricDataClient struct {
+	errs     []error
+	response *cloudwatch.GetMetricDataOutput
+	calls    int
+}
+
+func (m *mockGetMetricDataClient) GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	m.calls++
+	if len(m.errs) > 0 {
+		err := m.errs[0]
+		m.errs = m.errs[1:]
+		return nil, err
+	}
+	return m.response, nil
+}
@@ -12,7 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
-	batchlisters "k8s.io/client-go/listers/batch/v1"
+	"k8s.io/client-go/metadata/metadatalister"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
@@ -39,11 +39,13 @@ var (
 
 type JobProvider struct {
 	kubeclientset kubernetes.Interface
-	jobLister     batchlisters.JobLister
-	logCtx        log.Entry
+	// jobLister only lists the metadata of jobs. Jobs are fetched from the API server when their
+	// status is needed.
+	jobLister metadatalister.Lister
+	logCtx    log.Entry
 }
 
-func NewJobProvider(logCtx log.Entry, kubeclientset kubernetes.Interface, jobLister batchlisters.JobLister) *JobProvider {
+func NewJobProvider(logCtx log.Entry, kubeclientset kubernetes.Interface, jobLister metadatalister.Lister) *JobProvider {
 	return &JobProvider{
 		kubeclientset: kubeclientset,
 		logCtx:        logCtx,
@@ -143,7 +145,7 @@ func (p *JobProvider) Resume(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric,
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
-	job, err := p.jobLister.Jobs(run.Namespace).Get(jobName)
+	job, err := p.kubeclientset.BatchV1().Jobs(run.Namespace).Get(context.TODO(), jobName, metav1.GetOptions{})
 	if err != nil {
 		return metricutil.MarkMeasurementError(measurement, err)
 	}
@@ -204,7 +206,7 @@ func (p *JobProvider) GarbageCollect(run *v1alpha1.AnalysisRun, metric v1alpha1.
 		AnalysisRunUIDLabelKey: string(run.UID),
 	})
 	selector := labels.SelectorFromSet(set)
-	jobs, err := p.jobLister.List(selector)
+	jobs, err := p.jobLister.Namespace(run.Namespace).List(selector)
 	if err != nil {
 		return err
 	}
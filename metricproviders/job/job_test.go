@@ -14,12 +14,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
-	kubeinformers "k8s.io/client-go/informers"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
 	kubetesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/informer"
 )
 
 var noResyncPeriodFunc = func() time.Duration { return 0 }
@@ -27,16 +28,25 @@ var noResyncPeriodFunc = func() time.Duration { return 0 }
 func newTestJobProvider(objects ...runtime.Object) *JobProvider {
 	logCtx := log.NewEntry(log.New())
 	kubeclient := k8sfake.NewSimpleClientset(objects...)
-	k8sI := kubeinformers.NewSharedInformerFactory(kubeclient, noResyncPeriodFunc())
-	jobInformer := k8sI.Batch().V1().Jobs().Informer()
+	var jobMetadata []runtime.Object
+	for _, obj := range objects {
+		job := obj.(*batchv1.Job)
+		jobMetadata = append(jobMetadata, &metav1.PartialObjectMetadata{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+			ObjectMeta: job.ObjectMeta,
+		})
+	}
+	scheme := runtime.NewScheme()
+	metav1.AddMetaToScheme(scheme)
+	metadataclient := metadatafake.NewSimpleMetadataClient(scheme, jobMetadata...)
+	jobInformer := informer.NewMetadataInformer(metadataclient, informer.JobsResource, metav1.NamespaceAll, noResyncPeriodFunc(), nil)
 
 	ctx, cancel := context.WithCancel(context.Background())
-	go jobInformer.Run(ctx.Done())
-	cache.WaitForCacheSync(ctx.Done(), jobInformer.HasSynced)
+	go jobInformer.Informer().Run(ctx.Done())
+	cache.WaitForCacheSync(ctx.Done(), jobInformer.Informer().HasSynced)
 	cancel()
 
-	jobLister := k8sI.Batch().V1().Jobs().Lister()
-	return NewJobProvider(*logCtx, kubeclient, jobLister)
+	return NewJobProvider(*logCtx, kubeclient, jobInformer.Lister())
 }
 
 func newRunWithJobMetric() *v1alpha1.AnalysisRun {
@@ -211,7 +221,7 @@ func TestResumeErrorJob(t *testing.T) {
 	run := newRunWithJobMetric()
 	measurement := newRunningMeasurement("job-which-does-not-exist")
 	measurement = p.Resume(run, run.Spec.Metrics[0], measurement)
-	assert.Equal(t, "job.batch \"job-which-does-not-exist\" not found", measurement.Message)
+	assert.Equal(t, "jobs.batch \"job-which-does-not-exist\" not found", measurement.Message)
 	assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
 	assert.NotNil(t, measurement.FinishedAt)
 }
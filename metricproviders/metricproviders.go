@@ -13,7 +13,7 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes"
-	batchlisters "k8s.io/client-go/listers/batch/v1"
+	"k8s.io/client-go/metadata/metadatalister"
 
 	"github.com/argoproj/argo-rollouts/metricproviders/job"
 	"github.com/argoproj/argo-rollouts/metricproviders/prometheus"
@@ -41,7 +41,7 @@ type Provider interface {
 
 type ProviderFactory struct {
 	KubeClient kubernetes.Interface
-	JobLister  batchlisters.JobLister
+	JobLister  metadatalister.Lister
 }
 
 type ProviderFactoryFunc func(logCtx log.Entry, metric v1alpha1.Metric) (Provider, error)
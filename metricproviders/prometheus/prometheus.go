@@ -2,16 +2,19 @@ package prometheus
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/prometheus/client_golang/api"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/evaluate"
@@ -26,8 +29,22 @@ const (
 	// metadata object.
 	ResolvedPrometheusQuery             = "ResolvedPrometheusQuery"
 	EnvVarArgoRolloutsPrometheusAddress = "ARGO_ROLLOUTS_PROMETHEUS_ADDRESS"
+	// FailedSeries is used as the key for storing the labels of the series which failed a per-series
+	// evaluation in the measurement metadata
+	FailedSeries = "FailedSeries"
+	// InconclusiveSeries is used as the key for storing the labels of the series which were
+	// inconclusive in a per-series evaluation in the measurement metadata
+	InconclusiveSeries = "InconclusiveSeries"
 )
 
+// seriesEvaluation is the result of evaluating the conditions against each series of a vector
+type seriesEvaluation struct {
+	value    string
+	phase    v1alpha1.AnalysisPhase
+	message  string
+	metadata map[string]string
+}
+
 // Provider contains all the required components to run a prometheus query
 type Provider struct {
 	api    v1.API
@@ -64,7 +81,19 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 	}
 
-	newValue, newStatus, err := p.processResponse(metric, response)
+	var newValue string
+	var newStatus v1alpha1.AnalysisPhase
+	if vector, ok := response.(model.Vector); ok && metric.Provider.Prometheus.PerSeries != nil {
+		var evaluation *seriesEvaluation
+		evaluation, err = p.evaluateSeries(metric, vector)
+		if err == nil {
+			newValue, newStatus = evaluation.value, evaluation.phase
+			newMeasurement.Message = evaluation.message
+			newMeasurement.Metadata = evaluation.metadata
+		}
+	} else {
+		newValue, newStatus, err = p.processResponse(metric, response)
+	}
 	if err != nil {
 		return metricutil.MarkMeasurementError(newMeasurement, err)
 
@@ -77,7 +106,10 @@ func (p *Provider) Run(run *v1alpha1.AnalysisRun, metric v1alpha1.Metric) v1alph
 		}
 		warningMetadata = warningMetadata[:len(warningMetadata)-2]
 		if warningMetadata != "" {
-			newMeasurement.Metadata = map[string]string{"warnings": warningMetadata}
+			if newMeasurement.Metadata == nil {
+				newMeasurement.Metadata = map[string]string{}
+			}
+			newMeasurement.Metadata["warnings"] = warningMetadata
 			p.logCtx.Warnf("Prometheus returned the following warnings: %s", warningMetadata)
 		}
 	}
@@ -114,26 +146,135 @@ func (p *Provider) processResponse(metric v1alpha1.Metric, response model.Value)
 		return valueStr, newStatus, err
 	case model.Vector:
 		results := make([]float64, 0, len(value))
-		valueStr := "["
 		for _, s := range value {
 			if s != nil {
-				valueStr = valueStr + s.Value.String() + ","
 				results = append(results, float64(s.Value))
 			}
 		}
-		// if we appended to the string, we should remove the last comma on the string
-		if len(valueStr) > 1 {
-			valueStr = valueStr[:len(valueStr)-1]
-		}
-		valueStr = valueStr + "]"
 		newStatus, err := evaluate.EvaluateResult(results, metric, p.logCtx)
-		return valueStr, newStatus, err
+		return vectorString(value), newStatus, err
 	//TODO(dthomson) add other response types
 	default:
 		return "", v1alpha1.AnalysisPhaseError, fmt.Errorf("Prometheus metric type not supported")
 	}
 }
 
+// evaluateSeries evaluates the conditions against each series of a vector result, with the labels of
+// the series available to the conditions, and aggregates the results with the per-series policy
+func (p *Provider) evaluateSeries(metric v1alpha1.Metric, vector model.Vector) (*seriesEvaluation, error) {
+	var failed, inconclusive []model.Metric
+	seriesCount := 0
+	for _, s := range vector {
+		if s == nil {
+			continue
+		}
+		seriesCount++
+		labels := make(map[string]string, len(s.Metric))
+		for name, value := range s.Metric {
+			labels[string(name)] = string(value)
+		}
+		phase, err := evaluate.EvaluateSeriesResult(float64(s.Value), labels, metric, p.logCtx)
+		if err != nil {
+			return nil, fmt.Errorf("series %s: %v", s.Metric, err)
+		}
+		switch phase {
+		case v1alpha1.AnalysisPhaseFailed:
+			failed = append(failed, s.Metric)
+		case v1alpha1.AnalysisPhaseInconclusive:
+			inconclusive = append(inconclusive, s.Metric)
+		}
+	}
+
+	evaluation := &seriesEvaluation{
+		value:    vectorString(vector),
+		metadata: map[string]string{},
+	}
+	if seriesCount == 0 {
+		evaluation.phase = v1alpha1.AnalysisPhaseInconclusive
+		evaluation.message = "Query returned no series"
+		return evaluation, nil
+	}
+	threshold, err := failureThreshold(metric.Provider.Prometheus.PerSeries, seriesCount)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(failed) >= threshold:
+		evaluation.phase = v1alpha1.AnalysisPhaseFailed
+	case len(failed)+len(inconclusive) >= threshold:
+		// the inconclusive series could fail the measurement
+		evaluation.phase = v1alpha1.AnalysisPhaseInconclusive
+	default:
+		evaluation.phase = v1alpha1.AnalysisPhaseSuccessful
+	}
+
+	var messages []string
+	if len(failed) > 0 {
+		messages = append(messages, fmt.Sprintf("%d of %d series failed: %s", len(failed), seriesCount, seriesString(failed)))
+		evaluation.metadata[FailedSeries] = seriesJSON(failed)
+	}
+	if len(inconclusive) > 0 {
+		messages = append(messages, fmt.Sprintf("%d of %d series inconclusive: %s", len(inconclusive), seriesCount, seriesString(inconclusive)))
+		evaluation.metadata[InconclusiveSeries] = seriesJSON(inconclusive)
+	}
+	evaluation.message = strings.Join(messages, "; ")
+	if len(evaluation.metadata) == 0 {
+		evaluation.metadata = nil
+	}
+	return evaluation, nil
+}
+
+// failureThreshold returns the number of failed series which fail the measurement
+func failureThreshold(perSeries *v1alpha1.PerSeriesEvaluation, seriesCount int) (int, error) {
+	switch perSeries.Policy {
+	case "", v1alpha1.PerSeriesPolicyAny:
+		return 1, nil
+	case v1alpha1.PerSeriesPolicyAll:
+		return seriesCount, nil
+	case v1alpha1.PerSeriesPolicyQuorum:
+		quorum := intstr.FromString("50%")
+		if perSeries.Quorum != nil {
+			quorum = *perSeries.Quorum
+		}
+		threshold, err := intstr.GetScaledValueFromIntOrPercent(&quorum, seriesCount, true)
+		if err != nil {
+			return 0, fmt.Errorf("invalid perSeries quorum: %v", err)
+		}
+		if threshold < 1 {
+			threshold = 1
+		}
+		return threshold, nil
+	default:
+		return 0, fmt.Errorf("unknown perSeries policy '%s'", perSeries.Policy)
+	}
+}
+
+func vectorString(vector model.Vector) string {
+	values := make([]string, 0, len(vector))
+	for _, s := range vector {
+		if s != nil {
+			values = append(values, s.Value.String())
+		}
+	}
+	return "[" + strings.Join(values, ",") + "]"
+}
+
+func seriesString(series []model.Metric) string {
+	labels := make([]string, 0, len(series))
+	for _, s := range series {
+		labels = append(labels, s.String())
+	}
+	return strings.Join(labels, ", ")
+}
+
+func seriesJSON(series []model.Metric) string {
+	seriesBytes, err := json.Marshal(series)
+	if err != nil {
+		return ""
+	}
+	return string(seriesBytes)
+}
+
 // NewPrometheusProvider Creates a new Prometheus client
 func NewPrometheusProvider(api v1.API, logCtx log.Entry) *Provider {
 	return &Provider{
@@ -12,6 +12,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func newScalar(f float64) model.Value {
@@ -393,6 +394,112 @@ func TestProcessVectorResponse(t *testing.T) {
 
 }
 
+func newSeries(pod string, f float64) *model.Sample {
+	return &model.Sample{
+		Metric:    model.Metric{"pod": model.LabelValue(pod)},
+		Value:     model.SampleValue(f),
+		Timestamp: model.Time(0),
+	}
+}
+
+func newPerSeriesMetric(perSeries *v1alpha1.PerSeriesEvaluation) v1alpha1.Metric {
+	return v1alpha1.Metric{
+		Name:             "error-rate",
+		SuccessCondition: "result < 0.05",
+		Provider: v1alpha1.MetricProvider{
+			Prometheus: &v1alpha1.PrometheusMetric{
+				Query:     "test",
+				PerSeries: perSeries,
+			},
+		},
+	}
+}
+
+func TestRunPerSeries(t *testing.T) {
+	e := log.Entry{}
+	vector := model.Vector{
+		newSeries("canary-0", 0.01),
+		newSeries("canary-1", 0.1),
+		newSeries("canary-2", 0.02),
+		newSeries("canary-3", 0.2),
+	}
+
+	t.Run("Any", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: vector}, e)
+		measurement := p.Run(newAnalysisRun(), newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{}))
+		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+		assert.Equal(t, "[0.01,0.1,0.02,0.2]", measurement.Value)
+		assert.Equal(t, `2 of 4 series failed: {pod="canary-1"}, {pod="canary-3"}`, measurement.Message)
+		assert.Equal(t, `[{"pod":"canary-1"},{"pod":"canary-3"}]`, measurement.Metadata[FailedSeries])
+	})
+
+	t.Run("All", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: vector}, e)
+		measurement := p.Run(newAnalysisRun(), newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{Policy: v1alpha1.PerSeriesPolicyAll}))
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+		assert.Equal(t, `2 of 4 series failed: {pod="canary-1"}, {pod="canary-3"}`, measurement.Message)
+	})
+
+	t.Run("Quorum", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: vector}, e)
+		measurement := p.Run(newAnalysisRun(), newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{Policy: v1alpha1.PerSeriesPolicyQuorum}))
+		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+
+		quorum := intstr.FromInt(3)
+		measurement = p.Run(newAnalysisRun(), newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{Policy: v1alpha1.PerSeriesPolicyQuorum, Quorum: &quorum}))
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	})
+
+	t.Run("Inconclusive", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: vector}, e)
+		metric := newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{})
+		metric.FailureCondition = "result > 0.15"
+		measurement := p.Run(newAnalysisRun(), metric)
+		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+		assert.Equal(t, `1 of 4 series failed: {pod="canary-3"}; 1 of 4 series inconclusive: {pod="canary-1"}`, measurement.Message)
+		assert.Equal(t, `[{"pod":"canary-1"}]`, measurement.Metadata[InconclusiveSeries])
+
+		quorum := intstr.FromInt(2)
+		measurement = p.Run(newAnalysisRun(), newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{Policy: v1alpha1.PerSeriesPolicyQuorum, Quorum: &quorum}))
+		assert.Equal(t, v1alpha1.AnalysisPhaseFailed, measurement.Phase)
+		metric.Provider.Prometheus.PerSeries = &v1alpha1.PerSeriesEvaluation{Policy: v1alpha1.PerSeriesPolicyQuorum, Quorum: &quorum}
+		measurement = p.Run(newAnalysisRun(), metric)
+		assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, measurement.Phase)
+	})
+
+	t.Run("Labels", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: vector}, e)
+		metric := newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{})
+		metric.SuccessCondition = `result < 0.05 || labels.pod in ["canary-1", "canary-3"]`
+		measurement := p.Run(newAnalysisRun(), metric)
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+		assert.Empty(t, measurement.Message)
+		assert.Nil(t, measurement.Metadata)
+	})
+
+	t.Run("NoSeries", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: model.Vector{}}, e)
+		measurement := p.Run(newAnalysisRun(), newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{}))
+		assert.Equal(t, v1alpha1.AnalysisPhaseInconclusive, measurement.Phase)
+		assert.Equal(t, "Query returned no series", measurement.Message)
+	})
+
+	t.Run("EvaluationError", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: vector}, e)
+		metric := newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{})
+		metric.SuccessCondition = "result.foo"
+		measurement := p.Run(newAnalysisRun(), metric)
+		assert.Equal(t, v1alpha1.AnalysisPhaseError, measurement.Phase)
+		assert.Contains(t, measurement.Message, `series {pod="canary-0"}`)
+	})
+
+	t.Run("Scalar", func(t *testing.T) {
+		p := NewPrometheusProvider(mockAPI{value: newScalar(0.01)}, e)
+		measurement := p.Run(newAnalysisRun(), newPerSeriesMetric(&v1alpha1.PerSeriesEvaluation{}))
+		assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, measurement.Phase)
+	})
+}
+
 func TestProcessInvalidResponse(t *testing.T) {
 	logCtx := log.WithField("test", "test")
 	p := Provider{
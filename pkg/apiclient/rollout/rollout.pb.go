@@ -707,29 +707,32 @@ func (m *VersionInfo) GetRolloutsVersion() string {
 }
 
 type RolloutInfo struct {
-	ObjectMeta           *v1.ObjectMeta         `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	Status               string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	Message              string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Icon                 string                 `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
-	Strategy             string                 `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
-	Step                 string                 `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
-	SetWeight            string                 `protobuf:"bytes,7,opt,name=setWeight,proto3" json:"setWeight,omitempty"`
-	ActualWeight         string                 `protobuf:"bytes,8,opt,name=actualWeight,proto3" json:"actualWeight,omitempty"`
-	Ready                int32                  `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
-	Current              int32                  `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
-	Desired              int32                  `protobuf:"varint,11,opt,name=desired,proto3" json:"desired,omitempty"`
-	Updated              int32                  `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
-	Available            int32                  `protobuf:"varint,13,opt,name=available,proto3" json:"available,omitempty"`
-	RestartedAt          string                 `protobuf:"bytes,14,opt,name=restartedAt,proto3" json:"restartedAt,omitempty"`
-	Generation           string                 `protobuf:"bytes,15,opt,name=generation,proto3" json:"generation,omitempty"`
-	ReplicaSets          []*ReplicaSetInfo      `protobuf:"bytes,16,rep,name=replicaSets,proto3" json:"replicaSets,omitempty"`
-	Experiments          []*ExperimentInfo      `protobuf:"bytes,17,rep,name=experiments,proto3" json:"experiments,omitempty"`
-	AnalysisRuns         []*AnalysisRunInfo     `protobuf:"bytes,18,rep,name=analysisRuns,proto3" json:"analysisRuns,omitempty"`
-	Containers           []*ContainerInfo       `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
-	Steps                []*v1alpha1.CanaryStep `protobuf:"bytes,20,rep,name=steps,proto3" json:"steps,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
-	XXX_unrecognized     []byte                 `json:"-"`
-	XXX_sizecache        int32                  `json:"-"`
+	ObjectMeta             *v1.ObjectMeta               `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	Status                 string                       `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Message                string                       `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Icon                   string                       `protobuf:"bytes,4,opt,name=icon,proto3" json:"icon,omitempty"`
+	Strategy               string                       `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Step                   string                       `protobuf:"bytes,6,opt,name=step,proto3" json:"step,omitempty"`
+	SetWeight              string                       `protobuf:"bytes,7,opt,name=setWeight,proto3" json:"setWeight,omitempty"`
+	ActualWeight           string                       `protobuf:"bytes,8,opt,name=actualWeight,proto3" json:"actualWeight,omitempty"`
+	Ready                  int32                        `protobuf:"varint,9,opt,name=ready,proto3" json:"ready,omitempty"`
+	Current                int32                        `protobuf:"varint,10,opt,name=current,proto3" json:"current,omitempty"`
+	Desired                int32                        `protobuf:"varint,11,opt,name=desired,proto3" json:"desired,omitempty"`
+	Updated                int32                        `protobuf:"varint,12,opt,name=updated,proto3" json:"updated,omitempty"`
+	Available              int32                        `protobuf:"varint,13,opt,name=available,proto3" json:"available,omitempty"`
+	RestartedAt            string                       `protobuf:"bytes,14,opt,name=restartedAt,proto3" json:"restartedAt,omitempty"`
+	Generation             string                       `protobuf:"bytes,15,opt,name=generation,proto3" json:"generation,omitempty"`
+	ReplicaSets            []*ReplicaSetInfo            `protobuf:"bytes,16,rep,name=replicaSets,proto3" json:"replicaSets,omitempty"`
+	Experiments            []*ExperimentInfo            `protobuf:"bytes,17,rep,name=experiments,proto3" json:"experiments,omitempty"`
+	AnalysisRuns           []*AnalysisRunInfo           `protobuf:"bytes,18,rep,name=analysisRuns,proto3" json:"analysisRuns,omitempty"`
+	Containers             []*ContainerInfo             `protobuf:"bytes,19,rep,name=containers,proto3" json:"containers,omitempty"`
+	Steps                  []*v1alpha1.CanaryStep       `protobuf:"bytes,20,rep,name=steps,proto3" json:"steps,omitempty"`
+	StepHistory            []*v1alpha1.CanaryStepRecord `protobuf:"bytes,21,rep,name=stepHistory,proto3" json:"stepHistory,omitempty"`
+	Progress               *v1alpha1.RolloutProgress    `protobuf:"bytes,22,opt,name=progress,proto3" json:"progress,omitempty"`
+	NextScheduledRestartAt string                       `protobuf:"bytes,23,opt,name=nextScheduledRestartAt,proto3" json:"nextScheduledRestartAt,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{}                     `json:"-"`
+	XXX_unrecognized       []byte                       `json:"-"`
+	XXX_sizecache          int32                        `json:"-"`
 }
 
 func (m *RolloutInfo) Reset()         { *m = RolloutInfo{} }
@@ -905,6 +908,27 @@ func (m *RolloutInfo) GetSteps() []*v1alpha1.CanaryStep {
 	return nil
 }
 
+func (m *RolloutInfo) GetStepHistory() []*v1alpha1.CanaryStepRecord {
+	if m != nil {
+		return m.StepHistory
+	}
+	return nil
+}
+
+func (m *RolloutInfo) GetProgress() *v1alpha1.RolloutProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func (m *RolloutInfo) GetNextScheduledRestartAt() string {
+	if m != nil {
+		return m.NextScheduledRestartAt
+	}
+	return ""
+}
+
 type ExperimentInfo struct {
 	ObjectMeta           *v1.ObjectMeta     `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
 	Icon                 string             `protobuf:"bytes,2,opt,name=icon,proto3" json:"icon,omitempty"`
@@ -1502,107 +1526,112 @@ func init() {
 }
 
 var fileDescriptor_99101d942e8912a7 = []byte{
-	// 1600 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0x1c, 0x45,
-	0x16, 0x57, 0x7b, 0x3c, 0xf6, 0xb8, 0xc6, 0x7f, 0xcb, 0x4e, 0xd2, 0x99, 0x64, 0x2d, 0x6f, 0x67,
-	0xa5, 0x75, 0xbc, 0xbb, 0xdd, 0x76, 0x36, 0x72, 0x36, 0xbb, 0xcb, 0xc1, 0x24, 0x96, 0x09, 0x0a,
-	0x60, 0xda, 0x82, 0x08, 0x24, 0x88, 0x6a, 0x7a, 0xca, 0xed, 0x4e, 0x7a, 0xba, 0x9a, 0xae, 0xea,
-	0x09, 0x23, 0x6b, 0x0e, 0x70, 0xe1, 0xc8, 0x81, 0x0f, 0x81, 0x38, 0x71, 0xe1, 0xc2, 0x81, 0x13,
-	0x12, 0xe2, 0x88, 0xc4, 0x17, 0x40, 0x11, 0x17, 0xbe, 0x00, 0x67, 0x54, 0xaf, 0xab, 0xab, 0xbb,
-	0xc7, 0xe3, 0xc4, 0x91, 0x0d, 0xe6, 0xd4, 0xf5, 0xde, 0xab, 0xf7, 0xde, 0xaf, 0xfa, 0xfd, 0xa9,
-	0x3f, 0xe8, 0x5a, 0xfc, 0xd8, 0x77, 0x48, 0x1c, 0x78, 0x61, 0x40, 0x23, 0xe1, 0x24, 0x2c, 0x0c,
-	0x59, 0xaa, 0xbf, 0x76, 0x9c, 0x30, 0xc1, 0xf0, 0xa4, 0x22, 0x5b, 0x57, 0x7d, 0xc6, 0xfc, 0x90,
-	0x4a, 0x05, 0x87, 0x44, 0x11, 0x13, 0x44, 0x04, 0x2c, 0xe2, 0xd9, 0xb4, 0xd6, 0x7d, 0x3f, 0x10,
-	0x07, 0x69, 0xdb, 0xf6, 0x58, 0xd7, 0x21, 0x89, 0xcf, 0xe2, 0x84, 0x3d, 0x82, 0xc1, 0xbf, 0x94,
-	0x3e, 0x77, 0x94, 0x37, 0xee, 0x68, 0x4e, 0x6f, 0x83, 0x84, 0xf1, 0x01, 0xd9, 0x70, 0x7c, 0x1a,
-	0xd1, 0x84, 0x08, 0xda, 0x51, 0xd6, 0x6e, 0x3e, 0xfe, 0x0f, 0xb7, 0x03, 0x26, 0xa7, 0x77, 0x89,
-	0x77, 0x10, 0x44, 0x34, 0xe9, 0x17, 0xfa, 0x5d, 0x2a, 0x88, 0xd3, 0x3b, 0xaa, 0x75, 0x45, 0x21,
-	0x04, 0xaa, 0x9d, 0xee, 0x3b, 0xb4, 0x1b, 0x8b, 0x7e, 0x26, 0xb4, 0xee, 0xa2, 0x79, 0x37, 0xf3,
-	0x7b, 0x2f, 0xda, 0x67, 0x6f, 0xa6, 0x34, 0xe9, 0x63, 0x8c, 0xc6, 0x23, 0xd2, 0xa5, 0xa6, 0xb1,
-	0x62, 0xac, 0x4e, 0xb9, 0x30, 0xc6, 0x57, 0xd1, 0x94, 0xfc, 0xf2, 0x98, 0x78, 0xd4, 0x1c, 0x03,
-	0x41, 0xc1, 0xb0, 0x6e, 0xa2, 0xa5, 0x92, 0x95, 0xfb, 0x01, 0x17, 0x99, 0xa5, 0x8a, 0x96, 0x31,
-	0xac, 0xf5, 0xa9, 0x81, 0xe6, 0xf6, 0xa8, 0xb8, 0xd7, 0x25, 0x3e, 0x75, 0xe9, 0x07, 0x29, 0xe5,
-	0x02, 0x9b, 0x28, 0xff, 0xb3, 0x6a, 0x7e, 0x4e, 0x4a, 0x5b, 0x1e, 0x8b, 0x04, 0x91, 0xab, 0xce,
-	0x11, 0x68, 0x06, 0x5e, 0x42, 0xf5, 0x40, 0xda, 0x31, 0x6b, 0x20, 0xc9, 0x08, 0x3c, 0x8f, 0x6a,
-	0x82, 0xf8, 0xe6, 0x38, 0xf0, 0xe4, 0xb0, 0x8a, 0xa8, 0x3e, 0x8c, 0xe8, 0x00, 0xe1, 0xb7, 0xa2,
-	0x0e, 0x53, 0x6b, 0x79, 0x3e, 0xa6, 0x16, 0x6a, 0x24, 0xb4, 0x17, 0xf0, 0x80, 0x45, 0x00, 0xa9,
-	0xe6, 0x6a, 0xba, 0xea, 0xa9, 0x36, 0xec, 0xe9, 0x1e, 0xba, 0xe0, 0x52, 0x2e, 0x48, 0x22, 0x86,
-	0x9c, 0xbd, 0xf8, 0xcf, 0x7f, 0x0f, 0x5d, 0xd8, 0x4d, 0x58, 0x97, 0x09, 0x7a, 0x5a, 0x53, 0x52,
-	0x63, 0x3f, 0x0d, 0x43, 0x80, 0xdb, 0x70, 0x61, 0x6c, 0xed, 0xa0, 0xc5, 0xad, 0x36, 0x3b, 0x03,
-	0x9c, 0x3b, 0x68, 0xd1, 0xa5, 0x22, 0xe9, 0x9f, 0xda, 0xd0, 0x43, 0xb4, 0xa0, 0x6c, 0x3c, 0x20,
-	0xc2, 0x3b, 0xd8, 0xee, 0xd1, 0x08, 0xcc, 0x88, 0x7e, 0xac, 0xcd, 0xc8, 0x31, 0xde, 0x44, 0xcd,
-	0xa4, 0x48, 0x4b, 0x30, 0xd4, 0xbc, 0xb1, 0x64, 0xe7, 0x95, 0x5c, 0x4a, 0x59, 0xb7, 0x3c, 0xd1,
-	0x7a, 0x88, 0x66, 0x5e, 0xcf, 0xbd, 0x49, 0xc6, 0xb3, 0xf3, 0x18, 0xaf, 0xa3, 0x45, 0xd2, 0x23,
-	0x41, 0x48, 0xda, 0x21, 0xd5, 0x7a, 0xdc, 0x1c, 0x5b, 0xa9, 0xad, 0x4e, 0xb9, 0xa3, 0x44, 0xd6,
-	0x1d, 0x34, 0x37, 0x54, 0x2f, 0x78, 0x1d, 0x35, 0xf2, 0x06, 0x60, 0x1a, 0x2b, 0xb5, 0x63, 0x81,
-	0xea, 0x59, 0xd6, 0x2d, 0xd4, 0x7c, 0x9b, 0x26, 0x32, 0xd7, 0x00, 0xe3, 0x2a, 0x9a, 0xcb, 0x45,
-	0x8a, 0xad, 0x90, 0x0e, 0xb3, 0xad, 0xcf, 0x27, 0x50, 0xb3, 0x64, 0x12, 0xef, 0x22, 0xc4, 0xda,
-	0x8f, 0xa8, 0x27, 0x5e, 0xa3, 0x82, 0x80, 0x52, 0xf3, 0xc6, 0xba, 0x9d, 0xf5, 0x1a, 0xbb, 0xdc,
-	0x6b, 0xec, 0xf8, 0xb1, 0x2f, 0x19, 0xdc, 0x96, 0xbd, 0xc6, 0xee, 0x6d, 0xd8, 0x6f, 0x68, 0x3d,
-	0xb7, 0x64, 0x03, 0x5f, 0x44, 0x13, 0x5c, 0x10, 0x91, 0x72, 0x15, 0x3c, 0x45, 0xc9, 0x4a, 0xea,
-	0x52, 0xce, 0x8b, 0x3a, 0xcd, 0x49, 0x19, 0xbe, 0xc0, 0x63, 0x91, 0x2a, 0x55, 0x18, 0xcb, 0xea,
-	0xe2, 0x42, 0x76, 0x32, 0xbf, 0xaf, 0x4a, 0x55, 0xd3, 0x72, 0x3e, 0x17, 0x34, 0x36, 0x27, 0xb2,
-	0xf9, 0x72, 0x2c, 0xa3, 0xc4, 0xa9, 0x78, 0x40, 0x03, 0xff, 0x40, 0x98, 0x93, 0x59, 0x94, 0x34,
-	0x03, 0x5b, 0x68, 0x9a, 0x78, 0x22, 0x25, 0xa1, 0x9a, 0xd0, 0x80, 0x09, 0x15, 0x9e, 0xec, 0x22,
-	0x09, 0x25, 0x9d, 0xbe, 0x39, 0xb5, 0x62, 0xac, 0xd6, 0xdd, 0x8c, 0x90, 0xa8, 0xbd, 0x34, 0x49,
-	0x68, 0x24, 0x4c, 0x04, 0xfc, 0x9c, 0x94, 0x92, 0x0e, 0xe5, 0x41, 0x42, 0x3b, 0x66, 0x33, 0x93,
-	0x28, 0x52, 0x4a, 0xd2, 0xb8, 0x23, 0xbb, 0xb0, 0x39, 0x9d, 0x49, 0x14, 0x29, 0x51, 0xea, 0x94,
-	0x30, 0x67, 0x40, 0x56, 0x30, 0xf0, 0x0a, 0x6a, 0x26, 0x59, 0x5f, 0xa0, 0x9d, 0x2d, 0x61, 0xce,
-	0x02, 0xc8, 0x32, 0x0b, 0x2f, 0x23, 0xa4, 0x3a, 0xbc, 0x0c, 0xf1, 0x1c, 0x4c, 0x28, 0x71, 0xf0,
-	0x6d, 0x69, 0x21, 0x0e, 0x03, 0x8f, 0xec, 0x51, 0xc1, 0xcd, 0x79, 0xc8, 0xa5, 0x4b, 0x45, 0x2e,
-	0x69, 0x99, 0xca, 0xfb, 0x62, 0xae, 0x54, 0xa5, 0x1f, 0xc6, 0x34, 0x09, 0xba, 0x34, 0x12, 0xdc,
-	0x5c, 0x18, 0x52, 0xdd, 0xd6, 0xb2, 0x4c, 0xb5, 0x34, 0x17, 0xff, 0x1f, 0x4d, 0x93, 0x88, 0x84,
-	0x7d, 0x1e, 0x70, 0x37, 0x8d, 0xb8, 0x89, 0x41, 0xd7, 0xd4, 0xba, 0x5b, 0x85, 0x10, 0x94, 0x2b,
-	0xb3, 0xf1, 0x26, 0x42, 0xba, 0x95, 0x73, 0x73, 0x11, 0x74, 0x2f, 0x6a, 0xdd, 0x3b, 0xb9, 0x08,
-	0x34, 0x4b, 0x33, 0xf1, 0xfb, 0xa8, 0x2e, 0x23, 0xcf, 0xcd, 0x25, 0x50, 0x79, 0xc5, 0x2e, 0xb6,
-	0x5b, 0x3b, 0xdf, 0x6e, 0x61, 0xf0, 0x30, 0xaf, 0x81, 0x22, 0x85, 0x35, 0x27, 0xdf, 0x6e, 0xed,
-	0x3b, 0x24, 0x22, 0x49, 0x7f, 0x4f, 0xd0, 0xd8, 0xcd, 0xcc, 0x5a, 0xdf, 0x8c, 0xa1, 0xd9, 0xea,
-	0xaa, 0x7f, 0x87, 0x62, 0xc9, 0x53, 0x7f, 0xac, 0x9a, 0xfa, 0x7a, 0x63, 0xa9, 0x41, 0x8e, 0x14,
-	0x1b, 0x4b, 0x51, 0x5c, 0xe3, 0xc7, 0x15, 0x57, 0xbd, 0x5a, 0x5c, 0x43, 0x29, 0x31, 0xf1, 0x02,
-	0x29, 0x31, 0x1c, 0xd7, 0xc9, 0x17, 0x89, 0xab, 0xf5, 0x6b, 0x0d, 0xcd, 0x56, 0xad, 0xff, 0x81,
-	0xcd, 0x26, 0xff, 0xaf, 0xb5, 0x63, 0xfe, 0xeb, 0xf8, 0xc8, 0xff, 0x2a, 0xab, 0xb2, 0x0e, 0xdb,
-	0x9f, 0xa2, 0x24, 0xdf, 0x83, 0xcc, 0x80, 0x66, 0xd3, 0x70, 0x15, 0x25, 0xf9, 0xc4, 0x13, 0x41,
-	0x8f, 0x42, 0xaf, 0x69, 0xb8, 0x8a, 0x92, 0x71, 0x88, 0xa5, 0x51, 0xfa, 0x04, 0x7a, 0x4c, 0xc3,
-	0xcd, 0xc9, 0xcc, 0x3b, 0xfc, 0x0d, 0xae, 0x3a, 0x8c, 0xa6, 0xab, 0x6d, 0x01, 0x0d, 0xb7, 0x85,
-	0x16, 0x6a, 0x08, 0xda, 0x8d, 0x43, 0x22, 0x28, 0x74, 0x9a, 0x29, 0x57, 0xd3, 0xf8, 0x9f, 0x68,
-	0x81, 0x7b, 0x24, 0xa4, 0x77, 0xd9, 0x93, 0xe8, 0x2e, 0x25, 0x9d, 0x30, 0x88, 0x28, 0x34, 0x9d,
-	0x29, 0xf7, 0xa8, 0x40, 0xa2, 0x86, 0xb3, 0x11, 0x37, 0x67, 0x60, 0x7f, 0x52, 0x14, 0xfe, 0x1b,
-	0x1a, 0x8f, 0x59, 0x87, 0x9b, 0xb3, 0x10, 0xe0, 0x79, 0x1d, 0xe0, 0x5d, 0xd6, 0x81, 0xc0, 0x82,
-	0x54, 0xfe, 0xd3, 0x38, 0x88, 0x7c, 0x68, 0x3b, 0x0d, 0x17, 0xc6, 0xc0, 0x63, 0x91, 0x6f, 0xce,
-	0x2b, 0x1e, 0x8b, 0x7c, 0xeb, 0x6b, 0x03, 0x4d, 0x2a, 0xcd, 0x73, 0x8e, 0xb8, 0x6e, 0xe9, 0x59,
-	0xb1, 0xa8, 0x96, 0x0e, 0x91, 0x80, 0x9e, 0xca, 0x21, 0xda, 0x10, 0x89, 0x8c, 0xb6, 0x6e, 0xa3,
-	0x99, 0x4a, 0xc7, 0x19, 0x79, 0x42, 0xd1, 0xe7, 0xcd, 0xb1, 0xd2, 0x79, 0xd3, 0xfa, 0xc4, 0x40,
-	0x93, 0xaf, 0xb2, 0xf6, 0xf9, 0x2f, 0xdb, 0xfa, 0x76, 0x0c, 0xcd, 0x0d, 0xd5, 0xe6, 0x9f, 0xb8,
-	0x75, 0x2d, 0x23, 0xc4, 0x53, 0xcf, 0xa3, 0x9c, 0xef, 0xa7, 0xa1, 0x0a, 0x48, 0x89, 0x23, 0xf5,
-	0xf6, 0x49, 0x10, 0xd2, 0x0e, 0x94, 0x60, 0xdd, 0x55, 0x94, 0xdc, 0xd3, 0x83, 0xc8, 0x63, 0x91,
-	0x17, 0xa6, 0x3c, 0x2f, 0xc4, 0xba, 0x5b, 0xe1, 0xc9, 0x48, 0xd1, 0x24, 0x61, 0x09, 0x14, 0x63,
-	0xdd, 0xcd, 0x08, 0x99, 0xee, 0x8f, 0x58, 0x5b, 0x96, 0x61, 0x35, 0xdd, 0x55, 0xf4, 0x5c, 0x90,
-	0xde, 0xf8, 0x65, 0x06, 0xcd, 0xaa, 0x93, 0xd2, 0x1e, 0x4d, 0x7a, 0x81, 0x47, 0x31, 0x47, 0xb3,
-	0x3b, 0x54, 0x94, 0x8f, 0x4f, 0x97, 0x47, 0x9d, 0xd3, 0xe0, 0xfe, 0xd3, 0x1a, 0x79, 0x84, 0xb3,
-	0xd6, 0x3f, 0xfe, 0xf1, 0xe7, 0xcf, 0xc6, 0xd6, 0xf0, 0x2a, 0x5c, 0x1a, 0x7b, 0x1b, 0xc5, 0xcd,
-	0xef, 0x50, 0x1f, 0x2a, 0x07, 0xd9, 0x78, 0xe0, 0x04, 0xd2, 0xc5, 0x00, 0xcd, 0xc3, 0x51, 0xf7,
-	0x54, 0x6e, 0x37, 0xc1, 0xed, 0x3a, 0xb6, 0x4f, 0xea, 0xd6, 0x79, 0x22, 0x7d, 0xae, 0x1b, 0xb8,
-	0x87, 0xe6, 0xe5, 0x19, 0xb5, 0x64, 0x8c, 0xe3, 0xbf, 0x8c, 0xf2, 0xa1, 0x6f, 0x7e, 0x2d, 0xf3,
-	0x38, 0xb1, 0x75, 0x1d, 0x60, 0x5c, 0xc3, 0x7f, 0x7d, 0x26, 0x0c, 0x58, 0xf6, 0x47, 0x06, 0x5a,
-	0x18, 0x5e, 0xf7, 0x73, 0x3d, 0xb7, 0x86, 0xc5, 0xc5, 0x25, 0xc1, 0x72, 0xc0, 0xf7, 0x75, 0xfc,
-	0xf7, 0xe7, 0xfa, 0xd6, 0x6b, 0x7f, 0x07, 0x4d, 0xef, 0x50, 0xa1, 0xcf, 0xee, 0xf8, 0xa2, 0x9d,
-	0x5d, 0xa7, 0xed, 0xfc, 0x3a, 0x6d, 0x6f, 0xcb, 0xeb, 0x74, 0xab, 0x38, 0xae, 0x54, 0xae, 0x0e,
-	0xd6, 0x65, 0x70, 0xb9, 0x88, 0x17, 0x72, 0x97, 0xc5, 0xbd, 0xe1, 0x4b, 0x43, 0xee, 0x8e, 0xe5,
-	0x4b, 0x20, 0x5e, 0x2e, 0x6d, 0xca, 0x23, 0x6e, 0x87, 0xad, 0xed, 0xd3, 0x9d, 0x70, 0x94, 0xb5,
-	0x3c, 0x15, 0x5a, 0xff, 0x38, 0x49, 0x2a, 0xa8, 0xc6, 0xf8, 0x5f, 0x63, 0x0d, 0x10, 0x57, 0xef,
-	0x9a, 0x25, 0xc4, 0x23, 0x2f, 0xa1, 0xe7, 0x82, 0x38, 0xce, 0x90, 0x48, 0xc4, 0x5f, 0x18, 0x68,
-	0xba, 0x7c, 0x7d, 0xc5, 0x57, 0x8b, 0xa3, 0xcb, 0xd1, 0x5b, 0xed, 0x59, 0xa1, 0xbd, 0x09, 0x68,
-	0xed, 0xd6, 0xf5, 0x93, 0xa0, 0x25, 0x12, 0x87, 0xc4, 0xfa, 0x5d, 0xf6, 0x1e, 0x92, 0x67, 0x35,
-	0xbc, 0x60, 0x14, 0x75, 0x34, 0xf4, 0x52, 0x72, 0x56, 0x50, 0x5d, 0x80, 0x7a, 0xbf, 0xb5, 0xf3,
-	0x6c, 0xa8, 0x8a, 0x3b, 0x70, 0x38, 0x15, 0xce, 0xa1, 0x3e, 0x82, 0x0f, 0x9c, 0x43, 0xd8, 0xf9,
-	0x5e, 0x5a, 0x5b, 0x1b, 0x38, 0x87, 0x82, 0xf8, 0x03, 0xb9, 0x90, 0xaf, 0x0c, 0xd4, 0x2c, 0xbd,
-	0xa3, 0xe0, 0x2b, 0x7a, 0x11, 0x47, 0x5f, 0x57, 0xce, 0x6a, 0x1d, 0x5b, 0xb0, 0x8e, 0xff, 0xb5,
-	0x36, 0x4f, 0xb8, 0x8e, 0x34, 0xea, 0x30, 0xe7, 0x30, 0xdf, 0x99, 0x06, 0x79, 0xae, 0x94, 0x5f,
-	0x28, 0x4a, 0xb9, 0x32, 0xe2, 0xe1, 0xe2, 0x5c, 0x72, 0x25, 0x91, 0x38, 0x24, 0xd6, 0x5d, 0x34,
-	0xa9, 0xae, 0xf3, 0xc7, 0x76, 0xa4, 0x62, 0x17, 0x28, 0x3d, 0x13, 0x58, 0x97, 0xc0, 0xdd, 0x02,
-	0x9e, 0xcb, 0xdd, 0xf5, 0x32, 0xe1, 0xcb, 0xdb, 0xdf, 0x3f, 0x5d, 0x36, 0x7e, 0x78, 0xba, 0x6c,
-	0xfc, 0xf4, 0x74, 0xd9, 0x78, 0xf7, 0xd6, 0x89, 0x1f, 0x2e, 0xab, 0xcf, 0xa4, 0xed, 0x09, 0x40,
-	0xf1, 0xef, 0xdf, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe8, 0x30, 0x8c, 0x5d, 0x46, 0x15, 0x00, 0x00,
+	// 1666 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x07, 0xb5, 0x5a, 0x69, 0x35, 0xab, 0xcf, 0x91, 0x2c, 0xd3, 0x6b, 0x57, 0x50, 0xe9, 0x02,
+	0x95, 0xd5, 0x96, 0x94, 0x5c, 0x43, 0xae, 0xfb, 0x71, 0x50, 0x6d, 0x41, 0x76, 0x61, 0xbb, 0x2a,
+	0x85, 0xd6, 0x68, 0x81, 0xd6, 0x98, 0xe5, 0x8e, 0x28, 0xda, 0x5c, 0x0e, 0xcb, 0x19, 0xae, 0xbd,
+	0x10, 0xf6, 0x90, 0x5c, 0x72, 0xcc, 0x21, 0x7f, 0x45, 0x4e, 0xb9, 0xe4, 0x12, 0x20, 0x39, 0x05,
+	0x08, 0x72, 0x0c, 0x90, 0x7f, 0x20, 0x30, 0x72, 0xc9, 0x3f, 0x90, 0x73, 0x30, 0x8f, 0xc3, 0x21,
+	0xb9, 0x5a, 0xd9, 0x32, 0xa4, 0x44, 0x39, 0x71, 0xde, 0x7b, 0xf3, 0xde, 0xfb, 0x0d, 0xdf, 0xc7,
+	0x7c, 0xa0, 0xeb, 0xf1, 0x73, 0xdf, 0x21, 0x71, 0xe0, 0x85, 0x01, 0x8d, 0x84, 0x93, 0xb0, 0x30,
+	0x64, 0xa9, 0xfe, 0xda, 0x71, 0xc2, 0x04, 0xc3, 0x93, 0x8a, 0x6c, 0x5d, 0xf3, 0x19, 0xf3, 0x43,
+	0x2a, 0x15, 0x1c, 0x12, 0x45, 0x4c, 0x10, 0x11, 0xb0, 0x88, 0x67, 0xd3, 0x5a, 0x0f, 0xfd, 0x40,
+	0x1c, 0xa6, 0x6d, 0xdb, 0x63, 0x5d, 0x87, 0x24, 0x3e, 0x8b, 0x13, 0xf6, 0x0c, 0x06, 0xbf, 0x53,
+	0xfa, 0xdc, 0x51, 0xde, 0xb8, 0xa3, 0x39, 0xbd, 0x4d, 0x12, 0xc6, 0x87, 0x64, 0xd3, 0xf1, 0x69,
+	0x44, 0x13, 0x22, 0x68, 0x47, 0x59, 0xbb, 0xf5, 0xfc, 0x0f, 0xdc, 0x0e, 0x98, 0x9c, 0xde, 0x25,
+	0xde, 0x61, 0x10, 0xd1, 0xa4, 0x5f, 0xe8, 0x77, 0xa9, 0x20, 0x4e, 0xef, 0xb8, 0xd6, 0x55, 0x85,
+	0x10, 0xa8, 0x76, 0x7a, 0xe0, 0xd0, 0x6e, 0x2c, 0xfa, 0x99, 0xd0, 0xba, 0x87, 0xe6, 0xdd, 0xcc,
+	0xef, 0x83, 0xe8, 0x80, 0xfd, 0x23, 0xa5, 0x49, 0x1f, 0x63, 0x34, 0x1e, 0x91, 0x2e, 0x35, 0x8d,
+	0x55, 0x63, 0x6d, 0xca, 0x85, 0x31, 0xbe, 0x86, 0xa6, 0xe4, 0x97, 0xc7, 0xc4, 0xa3, 0xe6, 0x18,
+	0x08, 0x0a, 0x86, 0x75, 0x0b, 0x2d, 0x95, 0xac, 0x3c, 0x0c, 0xb8, 0xc8, 0x2c, 0x55, 0xb4, 0x8c,
+	0x61, 0xad, 0xf7, 0x0d, 0x34, 0xb7, 0x4f, 0xc5, 0x83, 0x2e, 0xf1, 0xa9, 0x4b, 0xff, 0x9f, 0x52,
+	0x2e, 0xb0, 0x89, 0xf2, 0x3f, 0xab, 0xe6, 0xe7, 0xa4, 0xb4, 0xe5, 0xb1, 0x48, 0x10, 0xb9, 0xea,
+	0x1c, 0x81, 0x66, 0xe0, 0x25, 0x54, 0x0f, 0xa4, 0x1d, 0xb3, 0x06, 0x92, 0x8c, 0xc0, 0xf3, 0xa8,
+	0x26, 0x88, 0x6f, 0x8e, 0x03, 0x4f, 0x0e, 0xab, 0x88, 0xea, 0xc3, 0x88, 0x0e, 0x11, 0xfe, 0x67,
+	0xd4, 0x61, 0x6a, 0x2d, 0x6f, 0xc6, 0xd4, 0x42, 0x8d, 0x84, 0xf6, 0x02, 0x1e, 0xb0, 0x08, 0x20,
+	0xd5, 0x5c, 0x4d, 0x57, 0x3d, 0xd5, 0x86, 0x3d, 0x3d, 0x40, 0x97, 0x5c, 0xca, 0x05, 0x49, 0xc4,
+	0x90, 0xb3, 0xb7, 0xff, 0xf9, 0xff, 0x45, 0x97, 0xf6, 0x12, 0xd6, 0x65, 0x82, 0x9e, 0xd5, 0x94,
+	0xd4, 0x38, 0x48, 0xc3, 0x10, 0xe0, 0x36, 0x5c, 0x18, 0x5b, 0xbb, 0x68, 0x71, 0xbb, 0xcd, 0xce,
+	0x01, 0xe7, 0x2e, 0x5a, 0x74, 0xa9, 0x48, 0xfa, 0x67, 0x36, 0xf4, 0x14, 0x2d, 0x28, 0x1b, 0x4f,
+	0x88, 0xf0, 0x0e, 0x77, 0x7a, 0x34, 0x02, 0x33, 0xa2, 0x1f, 0x6b, 0x33, 0x72, 0x8c, 0xb7, 0x50,
+	0x33, 0x29, 0xd2, 0x12, 0x0c, 0x35, 0x6f, 0x2e, 0xd9, 0x8a, 0x67, 0x97, 0x52, 0xd6, 0x2d, 0x4f,
+	0xb4, 0x9e, 0xa2, 0x99, 0xc7, 0xb9, 0x37, 0xc9, 0x78, 0x7d, 0x1e, 0xe3, 0x0d, 0xb4, 0x48, 0x7a,
+	0x24, 0x08, 0x49, 0x3b, 0xa4, 0x5a, 0x8f, 0x9b, 0x63, 0xab, 0xb5, 0xb5, 0x29, 0x77, 0x94, 0xc8,
+	0xba, 0x8b, 0xe6, 0x86, 0xea, 0x05, 0x6f, 0xa0, 0x46, 0xde, 0x00, 0x4c, 0x63, 0xb5, 0x76, 0x22,
+	0x50, 0x3d, 0xcb, 0xba, 0x8d, 0x9a, 0xff, 0xa2, 0x89, 0xcc, 0x35, 0xc0, 0xb8, 0x86, 0xe6, 0x72,
+	0x91, 0x62, 0x2b, 0xa4, 0xc3, 0x6c, 0xeb, 0xd3, 0x06, 0x6a, 0x96, 0x4c, 0xe2, 0x3d, 0x84, 0x58,
+	0xfb, 0x19, 0xf5, 0xc4, 0x23, 0x2a, 0x08, 0x28, 0x35, 0x6f, 0x6e, 0xd8, 0x59, 0xaf, 0xb1, 0xcb,
+	0xbd, 0xc6, 0x8e, 0x9f, 0xfb, 0x92, 0xc1, 0x6d, 0xd9, 0x6b, 0xec, 0xde, 0xa6, 0xfd, 0x77, 0xad,
+	0xe7, 0x96, 0x6c, 0xe0, 0x65, 0x34, 0xc1, 0x05, 0x11, 0x29, 0x57, 0xc1, 0x53, 0x94, 0xac, 0xa4,
+	0x2e, 0xe5, 0xbc, 0xa8, 0xd3, 0x9c, 0x94, 0xe1, 0x0b, 0x3c, 0x16, 0xa9, 0x52, 0x85, 0xb1, 0xac,
+	0x2e, 0x2e, 0x64, 0x27, 0xf3, 0xfb, 0xaa, 0x54, 0x35, 0x2d, 0xe7, 0x73, 0x41, 0x63, 0x73, 0x22,
+	0x9b, 0x2f, 0xc7, 0x32, 0x4a, 0x9c, 0x8a, 0x27, 0x34, 0xf0, 0x0f, 0x85, 0x39, 0x99, 0x45, 0x49,
+	0x33, 0xb0, 0x85, 0xa6, 0x89, 0x27, 0x52, 0x12, 0xaa, 0x09, 0x0d, 0x98, 0x50, 0xe1, 0xc9, 0x2e,
+	0x92, 0x50, 0xd2, 0xe9, 0x9b, 0x53, 0xab, 0xc6, 0x5a, 0xdd, 0xcd, 0x08, 0x89, 0xda, 0x4b, 0x93,
+	0x84, 0x46, 0xc2, 0x44, 0xc0, 0xcf, 0x49, 0x29, 0xe9, 0x50, 0x1e, 0x24, 0xb4, 0x63, 0x36, 0x33,
+	0x89, 0x22, 0xa5, 0x24, 0x8d, 0x3b, 0xb2, 0x0b, 0x9b, 0xd3, 0x99, 0x44, 0x91, 0x12, 0xa5, 0x4e,
+	0x09, 0x73, 0x06, 0x64, 0x05, 0x03, 0xaf, 0xa2, 0x66, 0x92, 0xf5, 0x05, 0xda, 0xd9, 0x16, 0xe6,
+	0x2c, 0x80, 0x2c, 0xb3, 0xf0, 0x0a, 0x42, 0xaa, 0xc3, 0xcb, 0x10, 0xcf, 0xc1, 0x84, 0x12, 0x07,
+	0xdf, 0x91, 0x16, 0xe2, 0x30, 0xf0, 0xc8, 0x3e, 0x15, 0xdc, 0x9c, 0x87, 0x5c, 0xba, 0x5c, 0xe4,
+	0x92, 0x96, 0xa9, 0xbc, 0x2f, 0xe6, 0x4a, 0x55, 0xfa, 0x32, 0xa6, 0x49, 0xd0, 0xa5, 0x91, 0xe0,
+	0xe6, 0xc2, 0x90, 0xea, 0x8e, 0x96, 0x65, 0xaa, 0xa5, 0xb9, 0xf8, 0xcf, 0x68, 0x9a, 0x44, 0x24,
+	0xec, 0xf3, 0x80, 0xbb, 0x69, 0xc4, 0x4d, 0x0c, 0xba, 0xa6, 0xd6, 0xdd, 0x2e, 0x84, 0xa0, 0x5c,
+	0x99, 0x8d, 0xb7, 0x10, 0xd2, 0xad, 0x9c, 0x9b, 0x8b, 0xa0, 0xbb, 0xac, 0x75, 0xef, 0xe6, 0x22,
+	0xd0, 0x2c, 0xcd, 0xc4, 0xff, 0x43, 0x75, 0x19, 0x79, 0x6e, 0x2e, 0x81, 0xca, 0x7d, 0xbb, 0xd8,
+	0x6e, 0xed, 0x7c, 0xbb, 0x85, 0xc1, 0xd3, 0xbc, 0x06, 0x8a, 0x14, 0xd6, 0x9c, 0x7c, 0xbb, 0xb5,
+	0xef, 0x92, 0x88, 0x24, 0xfd, 0x7d, 0x41, 0x63, 0x37, 0x33, 0x8b, 0x63, 0xd4, 0x94, 0x83, 0xfb,
+	0x01, 0x17, 0x2c, 0xe9, 0x9b, 0x97, 0xc0, 0xcb, 0xe3, 0x73, 0xf3, 0x42, 0x3d, 0x96, 0x74, 0xdc,
+	0xb2, 0x0b, 0x1c, 0xa0, 0x46, 0x9c, 0x30, 0x3f, 0xa1, 0x9c, 0x9b, 0xcb, 0x50, 0x89, 0x8f, 0xce,
+	0xe6, 0x4e, 0x15, 0xfa, 0x9e, 0x32, 0xea, 0x6a, 0xf3, 0x78, 0x0b, 0x2d, 0x47, 0xf4, 0xa5, 0xd8,
+	0xf7, 0x0e, 0x69, 0x27, 0x0d, 0x69, 0x47, 0xed, 0x47, 0xdb, 0xc2, 0xbc, 0x0c, 0x49, 0x75, 0x82,
+	0xd4, 0xfa, 0x6c, 0x0c, 0xcd, 0x56, 0x53, 0xe1, 0x47, 0xe8, 0x20, 0x79, 0x3f, 0x18, 0xab, 0xf6,
+	0x03, 0xbd, 0xdb, 0xd6, 0xa0, 0x70, 0x34, 0x5d, 0xea, 0x38, 0xe3, 0x27, 0x75, 0x9c, 0x7a, 0xb5,
+	0xe3, 0x0c, 0xd5, 0xc9, 0xc4, 0x5b, 0xd4, 0xc9, 0x70, 0xb2, 0x4f, 0xbe, 0x4d, 0xb2, 0x5b, 0xdf,
+	0xd7, 0xd0, 0x6c, 0xd5, 0xfa, 0x4f, 0xd8, 0x81, 0xf3, 0xff, 0x5a, 0x3b, 0xe1, 0xbf, 0x8e, 0x8f,
+	0xfc, 0xaf, 0xed, 0x30, 0xfb, 0x7d, 0x0d, 0x57, 0x51, 0x92, 0xef, 0x41, 0x22, 0x43, 0x07, 0x6e,
+	0xb8, 0x8a, 0x92, 0x7c, 0xe2, 0x89, 0xa0, 0x47, 0xa1, 0x01, 0x37, 0x5c, 0x45, 0xc9, 0x38, 0xc4,
+	0xd2, 0x28, 0x7d, 0x01, 0x8d, 0xb7, 0xe1, 0xe6, 0x64, 0xe6, 0x1d, 0xfe, 0x06, 0x57, 0x6d, 0x57,
+	0xd3, 0xd5, 0x5e, 0x89, 0x86, 0x7b, 0x65, 0x0b, 0x35, 0x04, 0xed, 0xc6, 0x21, 0x11, 0x14, 0xda,
+	0xef, 0x94, 0xab, 0x69, 0xfc, 0x5b, 0xb4, 0xc0, 0x3d, 0x12, 0xd2, 0x7b, 0xec, 0x45, 0x74, 0x8f,
+	0x92, 0x4e, 0x18, 0x44, 0x14, 0x3a, 0xf1, 0x94, 0x7b, 0x5c, 0x20, 0x51, 0xc3, 0x81, 0x91, 0x9b,
+	0x33, 0xb0, 0x69, 0x2b, 0x0a, 0xff, 0x0a, 0x8d, 0xc7, 0xac, 0xc3, 0xcd, 0x59, 0x08, 0xf0, 0xbc,
+	0x0e, 0xf0, 0x1e, 0xeb, 0x40, 0x60, 0x41, 0x2a, 0xff, 0x69, 0x1c, 0x44, 0x3e, 0xf4, 0xe2, 0x86,
+	0x0b, 0x63, 0xe0, 0xb1, 0xc8, 0x37, 0xe7, 0x15, 0x8f, 0x45, 0xbe, 0xf5, 0x89, 0x81, 0x26, 0x95,
+	0xe6, 0x05, 0x47, 0x5c, 0xef, 0x73, 0x59, 0xb1, 0x64, 0x44, 0x16, 0x09, 0xa8, 0x72, 0x6e, 0xd6,
+	0xf3, 0x48, 0x64, 0xb4, 0x75, 0x07, 0xcd, 0x54, 0xda, 0xf0, 0xc8, 0x63, 0x9b, 0x3e, 0x84, 0x8f,
+	0x95, 0x0e, 0xe1, 0xd6, 0x7b, 0x06, 0x9a, 0xfc, 0x1b, 0x6b, 0x5f, 0xfc, 0xb2, 0xad, 0xcf, 0xc7,
+	0xd0, 0xdc, 0x50, 0x6d, 0xfe, 0x8c, 0x5b, 0xd7, 0x0a, 0x42, 0x3c, 0xf5, 0x3c, 0xca, 0xf9, 0x41,
+	0x1a, 0xaa, 0x80, 0x94, 0x38, 0x52, 0xef, 0x80, 0x04, 0x21, 0xed, 0x40, 0x09, 0xd6, 0x5d, 0x45,
+	0xc9, 0x83, 0x4e, 0x10, 0x79, 0x2c, 0xf2, 0xc2, 0x94, 0xe7, 0x85, 0x58, 0x77, 0x2b, 0x3c, 0x19,
+	0x29, 0x9a, 0x24, 0x2c, 0x81, 0x62, 0xac, 0xbb, 0x19, 0x21, 0xd3, 0xfd, 0x19, 0x6b, 0xcb, 0x32,
+	0xac, 0xa6, 0xbb, 0x8a, 0x9e, 0x0b, 0xd2, 0x9b, 0xdf, 0xcd, 0xa0, 0x59, 0xb5, 0xab, 0xec, 0xd3,
+	0xa4, 0x17, 0x78, 0x14, 0x73, 0x34, 0xbb, 0x4b, 0x45, 0xf9, 0x4c, 0x79, 0x65, 0xd4, 0xe1, 0x15,
+	0x2e, 0x85, 0xad, 0x91, 0xe7, 0x5a, 0x6b, 0xe3, 0xdd, 0xaf, 0xbf, 0xfd, 0x60, 0x6c, 0x1d, 0xaf,
+	0xc1, 0x4d, 0xba, 0xb7, 0x59, 0x5c, 0x87, 0x8f, 0xf4, 0x49, 0x7b, 0x90, 0x8d, 0x07, 0x4e, 0x20,
+	0x5d, 0x0c, 0xd0, 0x3c, 0x9c, 0xff, 0xcf, 0xe4, 0x76, 0x0b, 0xdc, 0x6e, 0x60, 0xfb, 0xb4, 0x6e,
+	0x9d, 0x17, 0xd2, 0xe7, 0x86, 0x81, 0x7b, 0x68, 0x5e, 0x1e, 0xdc, 0x4b, 0xc6, 0x38, 0xfe, 0xc5,
+	0x28, 0x1f, 0xfa, 0x3a, 0xdc, 0x32, 0x4f, 0x12, 0x5b, 0x37, 0x00, 0xc6, 0x75, 0xfc, 0xcb, 0xd7,
+	0xc2, 0x80, 0x65, 0xbf, 0x63, 0xa0, 0x85, 0xe1, 0x75, 0xbf, 0xd1, 0x73, 0x6b, 0x58, 0x5c, 0xdc,
+	0x9c, 0x2c, 0x07, 0x7c, 0xdf, 0xc0, 0xbf, 0x7e, 0xa3, 0x6f, 0xbd, 0xf6, 0x7f, 0xa3, 0xe9, 0x5d,
+	0x2a, 0xf4, 0x85, 0x06, 0x2f, 0xdb, 0xd9, 0x1b, 0x83, 0x9d, 0xbf, 0x31, 0xd8, 0x3b, 0xf2, 0x8d,
+	0xa1, 0x55, 0x9c, 0xe1, 0x2a, 0xf7, 0x29, 0xeb, 0x0a, 0xb8, 0x5c, 0xc4, 0x0b, 0xb9, 0x4b, 0xed,
+	0x08, 0x7f, 0x64, 0xc8, 0xdd, 0xb1, 0x7c, 0x33, 0xc6, 0x2b, 0x05, 0xf8, 0x51, 0x57, 0xe6, 0xd6,
+	0xce, 0xb9, 0x9c, 0x90, 0xf2, 0x54, 0x68, 0xfd, 0xe6, 0x34, 0xa9, 0xa0, 0x1a, 0xe3, 0x1f, 0x8d,
+	0x75, 0x40, 0x5c, 0xbd, 0x80, 0x97, 0x10, 0x8f, 0xbc, 0x99, 0x5f, 0x08, 0xe2, 0x38, 0x43, 0x22,
+	0x11, 0x7f, 0x68, 0xa0, 0xe9, 0xf2, 0x9d, 0x1e, 0x5f, 0x2b, 0x8e, 0x2e, 0xc7, 0xaf, 0xfa, 0xe7,
+	0x85, 0xf6, 0x16, 0xa0, 0xb5, 0x5b, 0x37, 0x4e, 0x83, 0x96, 0x48, 0x1c, 0x12, 0xeb, 0x17, 0xd9,
+	0x23, 0x51, 0x9e, 0xd5, 0xf0, 0xac, 0x53, 0xd4, 0xd1, 0xd0, 0xf3, 0xd1, 0x79, 0x41, 0x75, 0x01,
+	0xea, 0xc3, 0xd6, 0xee, 0xeb, 0xa1, 0x2a, 0xee, 0xc0, 0xe1, 0x54, 0x38, 0x47, 0xfa, 0x5e, 0x32,
+	0x70, 0x8e, 0x60, 0xe7, 0xfb, 0xcb, 0xfa, 0xfa, 0xc0, 0x39, 0x12, 0xc4, 0x1f, 0xc8, 0x85, 0x7c,
+	0x6c, 0xa0, 0x66, 0xe9, 0x71, 0x09, 0x5f, 0xd5, 0x8b, 0x38, 0xfe, 0xe4, 0x74, 0x5e, 0xeb, 0xd8,
+	0x86, 0x75, 0xfc, 0xa9, 0xb5, 0x75, 0xca, 0x75, 0xa4, 0x51, 0x87, 0x39, 0x47, 0xf9, 0xce, 0x34,
+	0xc8, 0x73, 0xa5, 0xfc, 0x6c, 0x53, 0xca, 0x95, 0x11, 0xaf, 0x39, 0x17, 0x92, 0x2b, 0x89, 0xc4,
+	0x21, 0xb1, 0xee, 0xa1, 0x49, 0xf5, 0xc6, 0x71, 0x62, 0x47, 0x2a, 0x76, 0x81, 0xd2, 0xdb, 0x89,
+	0x75, 0x19, 0xdc, 0x2d, 0xe0, 0xb9, 0xdc, 0x5d, 0x2f, 0x13, 0xfe, 0x75, 0xe7, 0xcb, 0x57, 0x2b,
+	0xc6, 0x57, 0xaf, 0x56, 0x8c, 0x6f, 0x5e, 0xad, 0x18, 0xff, 0xb9, 0x7d, 0xea, 0xd7, 0xdc, 0xea,
+	0xdb, 0x71, 0x7b, 0x02, 0x50, 0xfc, 0xfe, 0x87, 0x01, 0x00, 0x9a, 0x73, 0x80, 0x0b, 0x5b, 0x16,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2681,6 +2710,45 @@ func (m *RolloutInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.NextScheduledRestartAt) > 0 {
+		i -= len(m.NextScheduledRestartAt)
+		copy(dAtA[i:], m.NextScheduledRestartAt)
+		i = encodeVarintRollout(dAtA, i, uint64(len(m.NextScheduledRestartAt)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.Progress != nil {
+		{
+			size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRollout(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if len(m.StepHistory) > 0 {
+		for iNdEx := len(m.StepHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StepHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRollout(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
 	if len(m.Steps) > 0 {
 		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -3727,6 +3795,20 @@ func (m *RolloutInfo) Size() (n int) {
 			n += 2 + l + sovRollout(uint64(l))
 		}
 	}
+	if len(m.StepHistory) > 0 {
+		for _, e := range m.StepHistory {
+			l = e.Size()
+			n += 2 + l + sovRollout(uint64(l))
+		}
+	}
+	if m.Progress != nil {
+		l = m.Progress.Size()
+		n += 2 + l + sovRollout(uint64(l))
+	}
+	l = len(m.NextScheduledRestartAt)
+	if l > 0 {
+		n += 2 + l + sovRollout(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6016,6 +6098,108 @@ func (m *RolloutInfo) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StepHistory = append(m.StepHistory, &v1alpha1.CanaryStepRecord{})
+			if err := m.StepHistory[len(m.StepHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Progress == nil {
+				m.Progress = &v1alpha1.RolloutProgress{}
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledRestartAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollout
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollout
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollout
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextScheduledRestartAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollout(dAtA[iNdEx:])
@@ -71,6 +71,21 @@ type AnalysisTemplateSpec struct {
 	// +patchStrategy=merge
 	// +optional
 	MeasurementRetention []MeasurementRetention `json:"measurementRetention,omitempty" patchStrategy:"merge" patchMergeKey:"metricName" protobuf:"bytes,4,rep,name=measurementRetention"`
+	// NamespacePolicy restricts the namespaces whose Rollouts, Experiments and AnalysisRuns may use the template.
+	// It only applies to ClusterAnalysisTemplates, which every namespace may use when it is not set.
+	// +optional
+	NamespacePolicy *NamespacePolicy `json:"namespacePolicy,omitempty" protobuf:"bytes,5,opt,name=namespacePolicy"`
+}
+
+// NamespacePolicy selects the namespaces allowed to use a ClusterAnalysisTemplate, by name or by labels. A namespace
+// is allowed if it is listed in namespaces or matches the namespace selector.
+type NamespacePolicy struct {
+	// Namespaces are the names of the allowed namespaces
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty" protobuf:"bytes,1,rep,name=namespaces"`
+	// NamespaceSelector selects the allowed namespaces by their labels
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty" protobuf:"bytes,2,opt,name=namespaceSelector"`
 }
 
 // DurationString is a string representing a duration (e.g. 30s, 5m, 1h)
@@ -200,6 +215,38 @@ type PrometheusMetric struct {
 	Address string `json:"address,omitempty" protobuf:"bytes,1,opt,name=address"`
 	// Query is a raw prometheus query to perform
 	Query string `json:"query,omitempty" protobuf:"bytes,2,opt,name=query"`
+	// PerSeries evaluates the success and failure conditions against each series of a vector result,
+	// instead of against the whole vector
+	// +optional
+	PerSeries *PerSeriesEvaluation `json:"perSeries,omitempty" protobuf:"bytes,3,opt,name=perSeries"`
+}
+
+// PerSeriesPolicy is the policy which aggregates the results of the series of a vector result
+// into the result of the measurement
+type PerSeriesPolicy string
+
+const (
+	// PerSeriesPolicyAny fails the measurement when any series fails
+	PerSeriesPolicyAny PerSeriesPolicy = "Any"
+	// PerSeriesPolicyAll fails the measurement when all the series fail
+	PerSeriesPolicyAll PerSeriesPolicy = "All"
+	// PerSeriesPolicyQuorum fails the measurement when a quorum of the series fail
+	PerSeriesPolicyQuorum PerSeriesPolicy = "Quorum"
+)
+
+// PerSeriesEvaluation evaluates the success and failure conditions against each series of a vector
+// result. The keyword `result` is the value of the series and `labels` are its labels, e.g.
+// `result < 0.05`. The labels of the series which fail are reported in the measurement.
+type PerSeriesEvaluation struct {
+	// Policy is the policy which decides whether the failed series fail the measurement: Any
+	// (default), All or Quorum
+	// +kubebuilder:validation:Enum=Any;All;Quorum
+	// +optional
+	Policy PerSeriesPolicy `json:"policy,omitempty" protobuf:"bytes,1,opt,name=policy,casttype=PerSeriesPolicy"`
+	// Quorum is the number, or the percentage, of the series which fail the measurement with the
+	// Quorum policy (default: 50%)
+	// +optional
+	Quorum *intstrutil.IntOrString `json:"quorum,omitempty" protobuf:"bytes,2,opt,name=quorum"`
 }
 
 // WavefrontMetric defines the wavefront query to perform canary analysis
@@ -332,6 +379,10 @@ type ValueFrom struct {
 	//valueFrom
 	// +optional
 	FieldRef *FieldRef `json:"fieldRef,omitempty" protobuf:"bytes,2,opt,name=fieldRef"`
+	// SecretSourceRef is a reference to a secret stored in an external secret store. This field is one of the
+	// fields with valueFrom
+	// +optional
+	SecretSourceRef *SecretSourceRef `json:"secretSourceRef,omitempty" protobuf:"bytes,3,opt,name=secretSourceRef"`
 }
 
 type SecretKeyRef struct {
@@ -341,6 +392,26 @@ type SecretKeyRef struct {
 	Key string `json:"key" protobuf:"bytes,2,opt,name=key"`
 }
 
+// SecretSourceRef is a reference to a secret stored in an external secret store. Exactly one store must be set.
+type SecretSourceRef struct {
+	// Vault is a reference to a secret stored in Vault, or in a store implementing the Vault HTTP API
+	// +optional
+	Vault *VaultSecretRef `json:"vault,omitempty" protobuf:"bytes,1,opt,name=vault"`
+}
+
+// VaultSecretRef is a reference to a key of a secret stored in Vault
+type VaultSecretRef struct {
+	// Profile is the name of the secret, in the namespace of the controller, holding the address and token used
+	// to access Vault. Defaults to "vault".
+	// +optional
+	Profile string `json:"profile,omitempty" protobuf:"bytes,1,opt,name=profile"`
+	// Path is the path of the secret, e.g. secret/data/prometheus for a secret of a KV version 2 secrets engine
+	// mounted at secret
+	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
+	// Key is the key of the secret data to select from
+	Key string `json:"key" protobuf:"bytes,3,opt,name=key"`
+}
+
 // AnalysisRunStatus is the status for a AnalysisRun resource
 type AnalysisRunStatus struct {
 	// Phase is the status of the analysis run
@@ -28,6 +28,7 @@ import (
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	math "math"
 	math_bits "math/bits"
@@ -104,10 +105,38 @@ func (m *ALBTrafficRouting) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ALBTrafficRouting proto.InternalMessageInfo
 
+func (m *AdoptionStatus) Reset()      { *m = AdoptionStatus{} }
+func (*AdoptionStatus) ProtoMessage() {}
+func (*AdoptionStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{2}
+}
+func (m *AdoptionStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AdoptionStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *AdoptionStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdoptionStatus.Merge(m, src)
+}
+func (m *AdoptionStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *AdoptionStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdoptionStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdoptionStatus proto.InternalMessageInfo
+
 func (m *AmbassadorTrafficRouting) Reset()      { *m = AmbassadorTrafficRouting{} }
 func (*AmbassadorTrafficRouting) ProtoMessage() {}
 func (*AmbassadorTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{2}
+	return fileDescriptor_e0e705f843545fab, []int{3}
 }
 func (m *AmbassadorTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -135,7 +164,7 @@ var xxx_messageInfo_AmbassadorTrafficRouting proto.InternalMessageInfo
 func (m *AnalysisRun) Reset()      { *m = AnalysisRun{} }
 func (*AnalysisRun) ProtoMessage() {}
 func (*AnalysisRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{3}
+	return fileDescriptor_e0e705f843545fab, []int{4}
 }
 func (m *AnalysisRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -163,7 +192,7 @@ var xxx_messageInfo_AnalysisRun proto.InternalMessageInfo
 func (m *AnalysisRunArgument) Reset()      { *m = AnalysisRunArgument{} }
 func (*AnalysisRunArgument) ProtoMessage() {}
 func (*AnalysisRunArgument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{4}
+	return fileDescriptor_e0e705f843545fab, []int{5}
 }
 func (m *AnalysisRunArgument) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +220,7 @@ var xxx_messageInfo_AnalysisRunArgument proto.InternalMessageInfo
 func (m *AnalysisRunList) Reset()      { *m = AnalysisRunList{} }
 func (*AnalysisRunList) ProtoMessage() {}
 func (*AnalysisRunList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{5}
+	return fileDescriptor_e0e705f843545fab, []int{6}
 }
 func (m *AnalysisRunList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -219,7 +248,7 @@ var xxx_messageInfo_AnalysisRunList proto.InternalMessageInfo
 func (m *AnalysisRunSpec) Reset()      { *m = AnalysisRunSpec{} }
 func (*AnalysisRunSpec) ProtoMessage() {}
 func (*AnalysisRunSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{6}
+	return fileDescriptor_e0e705f843545fab, []int{7}
 }
 func (m *AnalysisRunSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -247,7 +276,7 @@ var xxx_messageInfo_AnalysisRunSpec proto.InternalMessageInfo
 func (m *AnalysisRunStatus) Reset()      { *m = AnalysisRunStatus{} }
 func (*AnalysisRunStatus) ProtoMessage() {}
 func (*AnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{7}
+	return fileDescriptor_e0e705f843545fab, []int{8}
 }
 func (m *AnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +304,7 @@ var xxx_messageInfo_AnalysisRunStatus proto.InternalMessageInfo
 func (m *AnalysisRunStrategy) Reset()      { *m = AnalysisRunStrategy{} }
 func (*AnalysisRunStrategy) ProtoMessage() {}
 func (*AnalysisRunStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{8}
+	return fileDescriptor_e0e705f843545fab, []int{9}
 }
 func (m *AnalysisRunStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,7 +332,7 @@ var xxx_messageInfo_AnalysisRunStrategy proto.InternalMessageInfo
 func (m *AnalysisTemplate) Reset()      { *m = AnalysisTemplate{} }
 func (*AnalysisTemplate) ProtoMessage() {}
 func (*AnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{9}
+	return fileDescriptor_e0e705f843545fab, []int{10}
 }
 func (m *AnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -331,7 +360,7 @@ var xxx_messageInfo_AnalysisTemplate proto.InternalMessageInfo
 func (m *AnalysisTemplateList) Reset()      { *m = AnalysisTemplateList{} }
 func (*AnalysisTemplateList) ProtoMessage() {}
 func (*AnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{10}
+	return fileDescriptor_e0e705f843545fab, []int{11}
 }
 func (m *AnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +388,7 @@ var xxx_messageInfo_AnalysisTemplateList proto.InternalMessageInfo
 func (m *AnalysisTemplateSpec) Reset()      { *m = AnalysisTemplateSpec{} }
 func (*AnalysisTemplateSpec) ProtoMessage() {}
 func (*AnalysisTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{11}
+	return fileDescriptor_e0e705f843545fab, []int{12}
 }
 func (m *AnalysisTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -387,7 +416,7 @@ var xxx_messageInfo_AnalysisTemplateSpec proto.InternalMessageInfo
 func (m *AntiAffinity) Reset()      { *m = AntiAffinity{} }
 func (*AntiAffinity) ProtoMessage() {}
 func (*AntiAffinity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{12}
+	return fileDescriptor_e0e705f843545fab, []int{13}
 }
 func (m *AntiAffinity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +444,7 @@ var xxx_messageInfo_AntiAffinity proto.InternalMessageInfo
 func (m *AppMeshTrafficRouting) Reset()      { *m = AppMeshTrafficRouting{} }
 func (*AppMeshTrafficRouting) ProtoMessage() {}
 func (*AppMeshTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{13}
+	return fileDescriptor_e0e705f843545fab, []int{14}
 }
 func (m *AppMeshTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -443,7 +472,7 @@ var xxx_messageInfo_AppMeshTrafficRouting proto.InternalMessageInfo
 func (m *AppMeshVirtualNodeGroup) Reset()      { *m = AppMeshVirtualNodeGroup{} }
 func (*AppMeshVirtualNodeGroup) ProtoMessage() {}
 func (*AppMeshVirtualNodeGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{14}
+	return fileDescriptor_e0e705f843545fab, []int{15}
 }
 func (m *AppMeshVirtualNodeGroup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -471,7 +500,7 @@ var xxx_messageInfo_AppMeshVirtualNodeGroup proto.InternalMessageInfo
 func (m *AppMeshVirtualNodeReference) Reset()      { *m = AppMeshVirtualNodeReference{} }
 func (*AppMeshVirtualNodeReference) ProtoMessage() {}
 func (*AppMeshVirtualNodeReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{15}
+	return fileDescriptor_e0e705f843545fab, []int{16}
 }
 func (m *AppMeshVirtualNodeReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +528,7 @@ var xxx_messageInfo_AppMeshVirtualNodeReference proto.InternalMessageInfo
 func (m *AppMeshVirtualService) Reset()      { *m = AppMeshVirtualService{} }
 func (*AppMeshVirtualService) ProtoMessage() {}
 func (*AppMeshVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{16}
+	return fileDescriptor_e0e705f843545fab, []int{17}
 }
 func (m *AppMeshVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -527,7 +556,7 @@ var xxx_messageInfo_AppMeshVirtualService proto.InternalMessageInfo
 func (m *Argument) Reset()      { *m = Argument{} }
 func (*Argument) ProtoMessage() {}
 func (*Argument) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{17}
+	return fileDescriptor_e0e705f843545fab, []int{18}
 }
 func (m *Argument) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +584,7 @@ var xxx_messageInfo_Argument proto.InternalMessageInfo
 func (m *ArgumentValueFrom) Reset()      { *m = ArgumentValueFrom{} }
 func (*ArgumentValueFrom) ProtoMessage() {}
 func (*ArgumentValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{18}
+	return fileDescriptor_e0e705f843545fab, []int{19}
 }
 func (m *ArgumentValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +612,7 @@ var xxx_messageInfo_ArgumentValueFrom proto.InternalMessageInfo
 func (m *AwsResourceRef) Reset()      { *m = AwsResourceRef{} }
 func (*AwsResourceRef) ProtoMessage() {}
 func (*AwsResourceRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{19}
+	return fileDescriptor_e0e705f843545fab, []int{20}
 }
 func (m *AwsResourceRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -611,7 +640,7 @@ var xxx_messageInfo_AwsResourceRef proto.InternalMessageInfo
 func (m *BlueGreenStatus) Reset()      { *m = BlueGreenStatus{} }
 func (*BlueGreenStatus) ProtoMessage() {}
 func (*BlueGreenStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{20}
+	return fileDescriptor_e0e705f843545fab, []int{21}
 }
 func (m *BlueGreenStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +668,7 @@ var xxx_messageInfo_BlueGreenStatus proto.InternalMessageInfo
 func (m *BlueGreenStrategy) Reset()      { *m = BlueGreenStrategy{} }
 func (*BlueGreenStrategy) ProtoMessage() {}
 func (*BlueGreenStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{21}
+	return fileDescriptor_e0e705f843545fab, []int{22}
 }
 func (m *BlueGreenStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,7 +696,7 @@ var xxx_messageInfo_BlueGreenStrategy proto.InternalMessageInfo
 func (m *CanaryStatus) Reset()      { *m = CanaryStatus{} }
 func (*CanaryStatus) ProtoMessage() {}
 func (*CanaryStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{22}
+	return fileDescriptor_e0e705f843545fab, []int{23}
 }
 func (m *CanaryStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +724,7 @@ var xxx_messageInfo_CanaryStatus proto.InternalMessageInfo
 func (m *CanaryStep) Reset()      { *m = CanaryStep{} }
 func (*CanaryStep) ProtoMessage() {}
 func (*CanaryStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{23}
+	return fileDescriptor_e0e705f843545fab, []int{24}
 }
 func (m *CanaryStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -720,10 +749,38 @@ func (m *CanaryStep) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CanaryStep proto.InternalMessageInfo
 
+func (m *CanaryStepRecord) Reset()      { *m = CanaryStepRecord{} }
+func (*CanaryStepRecord) ProtoMessage() {}
+func (*CanaryStepRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{25}
+}
+func (m *CanaryStepRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CanaryStepRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CanaryStepRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CanaryStepRecord.Merge(m, src)
+}
+func (m *CanaryStepRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *CanaryStepRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_CanaryStepRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CanaryStepRecord proto.InternalMessageInfo
+
 func (m *CanaryStrategy) Reset()      { *m = CanaryStrategy{} }
 func (*CanaryStrategy) ProtoMessage() {}
 func (*CanaryStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{24}
+	return fileDescriptor_e0e705f843545fab, []int{26}
 }
 func (m *CanaryStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -751,7 +808,7 @@ var xxx_messageInfo_CanaryStrategy proto.InternalMessageInfo
 func (m *CloudWatchMetric) Reset()      { *m = CloudWatchMetric{} }
 func (*CloudWatchMetric) ProtoMessage() {}
 func (*CloudWatchMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{25}
+	return fileDescriptor_e0e705f843545fab, []int{27}
 }
 func (m *CloudWatchMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,7 +836,7 @@ var xxx_messageInfo_CloudWatchMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricDataQuery) Reset()      { *m = CloudWatchMetricDataQuery{} }
 func (*CloudWatchMetricDataQuery) ProtoMessage() {}
 func (*CloudWatchMetricDataQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{26}
+	return fileDescriptor_e0e705f843545fab, []int{28}
 }
 func (m *CloudWatchMetricDataQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -807,7 +864,7 @@ var xxx_messageInfo_CloudWatchMetricDataQuery proto.InternalMessageInfo
 func (m *CloudWatchMetricStat) Reset()      { *m = CloudWatchMetricStat{} }
 func (*CloudWatchMetricStat) ProtoMessage() {}
 func (*CloudWatchMetricStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{27}
+	return fileDescriptor_e0e705f843545fab, []int{29}
 }
 func (m *CloudWatchMetricStat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -835,7 +892,7 @@ var xxx_messageInfo_CloudWatchMetricStat proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetric) Reset()      { *m = CloudWatchMetricStatMetric{} }
 func (*CloudWatchMetricStatMetric) ProtoMessage() {}
 func (*CloudWatchMetricStatMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{28}
+	return fileDescriptor_e0e705f843545fab, []int{30}
 }
 func (m *CloudWatchMetricStatMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -863,7 +920,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetric proto.InternalMessageInfo
 func (m *CloudWatchMetricStatMetricDimension) Reset()      { *m = CloudWatchMetricStatMetricDimension{} }
 func (*CloudWatchMetricStatMetricDimension) ProtoMessage() {}
 func (*CloudWatchMetricStatMetricDimension) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{29}
+	return fileDescriptor_e0e705f843545fab, []int{31}
 }
 func (m *CloudWatchMetricStatMetricDimension) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -891,7 +948,7 @@ var xxx_messageInfo_CloudWatchMetricStatMetricDimension proto.InternalMessageInf
 func (m *ClusterAnalysisTemplate) Reset()      { *m = ClusterAnalysisTemplate{} }
 func (*ClusterAnalysisTemplate) ProtoMessage() {}
 func (*ClusterAnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{30}
+	return fileDescriptor_e0e705f843545fab, []int{32}
 }
 func (m *ClusterAnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -919,7 +976,7 @@ var xxx_messageInfo_ClusterAnalysisTemplate proto.InternalMessageInfo
 func (m *ClusterAnalysisTemplateList) Reset()      { *m = ClusterAnalysisTemplateList{} }
 func (*ClusterAnalysisTemplateList) ProtoMessage() {}
 func (*ClusterAnalysisTemplateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{31}
+	return fileDescriptor_e0e705f843545fab, []int{33}
 }
 func (m *ClusterAnalysisTemplateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -947,7 +1004,7 @@ var xxx_messageInfo_ClusterAnalysisTemplateList proto.InternalMessageInfo
 func (m *DatadogMetric) Reset()      { *m = DatadogMetric{} }
 func (*DatadogMetric) ProtoMessage() {}
 func (*DatadogMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{32}
+	return fileDescriptor_e0e705f843545fab, []int{34}
 }
 func (m *DatadogMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -975,7 +1032,7 @@ var xxx_messageInfo_DatadogMetric proto.InternalMessageInfo
 func (m *DryRun) Reset()      { *m = DryRun{} }
 func (*DryRun) ProtoMessage() {}
 func (*DryRun) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{33}
+	return fileDescriptor_e0e705f843545fab, []int{35}
 }
 func (m *DryRun) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1060,7 @@ var xxx_messageInfo_DryRun proto.InternalMessageInfo
 func (m *Experiment) Reset()      { *m = Experiment{} }
 func (*Experiment) ProtoMessage() {}
 func (*Experiment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{34}
+	return fileDescriptor_e0e705f843545fab, []int{36}
 }
 func (m *Experiment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1088,7 @@ var xxx_messageInfo_Experiment proto.InternalMessageInfo
 func (m *ExperimentAnalysisRunStatus) Reset()      { *m = ExperimentAnalysisRunStatus{} }
 func (*ExperimentAnalysisRunStatus) ProtoMessage() {}
 func (*ExperimentAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{35}
+	return fileDescriptor_e0e705f843545fab, []int{37}
 }
 func (m *ExperimentAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1116,7 @@ var xxx_messageInfo_ExperimentAnalysisRunStatus proto.InternalMessageInfo
 func (m *ExperimentAnalysisTemplateRef) Reset()      { *m = ExperimentAnalysisTemplateRef{} }
 func (*ExperimentAnalysisTemplateRef) ProtoMessage() {}
 func (*ExperimentAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{36}
+	return fileDescriptor_e0e705f843545fab, []int{38}
 }
 func (m *ExperimentAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1087,7 +1144,7 @@ var xxx_messageInfo_ExperimentAnalysisTemplateRef proto.InternalMessageInfo
 func (m *ExperimentCondition) Reset()      { *m = ExperimentCondition{} }
 func (*ExperimentCondition) ProtoMessage() {}
 func (*ExperimentCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{37}
+	return fileDescriptor_e0e705f843545fab, []int{39}
 }
 func (m *ExperimentCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,7 +1172,7 @@ var xxx_messageInfo_ExperimentCondition proto.InternalMessageInfo
 func (m *ExperimentList) Reset()      { *m = ExperimentList{} }
 func (*ExperimentList) ProtoMessage() {}
 func (*ExperimentList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{38}
+	return fileDescriptor_e0e705f843545fab, []int{40}
 }
 func (m *ExperimentList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1200,7 @@ var xxx_messageInfo_ExperimentList proto.InternalMessageInfo
 func (m *ExperimentSpec) Reset()      { *m = ExperimentSpec{} }
 func (*ExperimentSpec) ProtoMessage() {}
 func (*ExperimentSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{39}
+	return fileDescriptor_e0e705f843545fab, []int{41}
 }
 func (m *ExperimentSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1228,7 @@ var xxx_messageInfo_ExperimentSpec proto.InternalMessageInfo
 func (m *ExperimentStatus) Reset()      { *m = ExperimentStatus{} }
 func (*ExperimentStatus) ProtoMessage() {}
 func (*ExperimentStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{40}
+	return fileDescriptor_e0e705f843545fab, []int{42}
 }
 func (m *ExperimentStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1199,7 +1256,7 @@ var xxx_messageInfo_ExperimentStatus proto.InternalMessageInfo
 func (m *FieldRef) Reset()      { *m = FieldRef{} }
 func (*FieldRef) ProtoMessage() {}
 func (*FieldRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{41}
+	return fileDescriptor_e0e705f843545fab, []int{43}
 }
 func (m *FieldRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1227,7 +1284,7 @@ var xxx_messageInfo_FieldRef proto.InternalMessageInfo
 func (m *GraphiteMetric) Reset()      { *m = GraphiteMetric{} }
 func (*GraphiteMetric) ProtoMessage() {}
 func (*GraphiteMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{42}
+	return fileDescriptor_e0e705f843545fab, []int{44}
 }
 func (m *GraphiteMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1252,10 +1309,66 @@ func (m *GraphiteMetric) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GraphiteMetric proto.InternalMessageInfo
 
+func (m *InconclusivePolicy) Reset()      { *m = InconclusivePolicy{} }
+func (*InconclusivePolicy) ProtoMessage() {}
+func (*InconclusivePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{45}
+}
+func (m *InconclusivePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InconclusivePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *InconclusivePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InconclusivePolicy.Merge(m, src)
+}
+func (m *InconclusivePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *InconclusivePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_InconclusivePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InconclusivePolicy proto.InternalMessageInfo
+
+func (m *InconclusiveResolution) Reset()      { *m = InconclusiveResolution{} }
+func (*InconclusiveResolution) ProtoMessage() {}
+func (*InconclusiveResolution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{46}
+}
+func (m *InconclusiveResolution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InconclusiveResolution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *InconclusiveResolution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InconclusiveResolution.Merge(m, src)
+}
+func (m *InconclusiveResolution) XXX_Size() int {
+	return m.Size()
+}
+func (m *InconclusiveResolution) XXX_DiscardUnknown() {
+	xxx_messageInfo_InconclusiveResolution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InconclusiveResolution proto.InternalMessageInfo
+
 func (m *IstioDestinationRule) Reset()      { *m = IstioDestinationRule{} }
 func (*IstioDestinationRule) ProtoMessage() {}
 func (*IstioDestinationRule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{43}
+	return fileDescriptor_e0e705f843545fab, []int{47}
 }
 func (m *IstioDestinationRule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1283,7 +1396,7 @@ var xxx_messageInfo_IstioDestinationRule proto.InternalMessageInfo
 func (m *IstioTrafficRouting) Reset()      { *m = IstioTrafficRouting{} }
 func (*IstioTrafficRouting) ProtoMessage() {}
 func (*IstioTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{44}
+	return fileDescriptor_e0e705f843545fab, []int{48}
 }
 func (m *IstioTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1311,7 +1424,7 @@ var xxx_messageInfo_IstioTrafficRouting proto.InternalMessageInfo
 func (m *IstioVirtualService) Reset()      { *m = IstioVirtualService{} }
 func (*IstioVirtualService) ProtoMessage() {}
 func (*IstioVirtualService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{45}
+	return fileDescriptor_e0e705f843545fab, []int{49}
 }
 func (m *IstioVirtualService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1339,7 +1452,7 @@ var xxx_messageInfo_IstioVirtualService proto.InternalMessageInfo
 func (m *JobMetric) Reset()      { *m = JobMetric{} }
 func (*JobMetric) ProtoMessage() {}
 func (*JobMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{46}
+	return fileDescriptor_e0e705f843545fab, []int{50}
 }
 func (m *JobMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1367,7 +1480,7 @@ var xxx_messageInfo_JobMetric proto.InternalMessageInfo
 func (m *KayentaMetric) Reset()      { *m = KayentaMetric{} }
 func (*KayentaMetric) ProtoMessage() {}
 func (*KayentaMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{47}
+	return fileDescriptor_e0e705f843545fab, []int{51}
 }
 func (m *KayentaMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1395,7 +1508,7 @@ var xxx_messageInfo_KayentaMetric proto.InternalMessageInfo
 func (m *KayentaScope) Reset()      { *m = KayentaScope{} }
 func (*KayentaScope) ProtoMessage() {}
 func (*KayentaScope) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{48}
+	return fileDescriptor_e0e705f843545fab, []int{52}
 }
 func (m *KayentaScope) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1423,7 +1536,7 @@ var xxx_messageInfo_KayentaScope proto.InternalMessageInfo
 func (m *KayentaThreshold) Reset()      { *m = KayentaThreshold{} }
 func (*KayentaThreshold) ProtoMessage() {}
 func (*KayentaThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{49}
+	return fileDescriptor_e0e705f843545fab, []int{53}
 }
 func (m *KayentaThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1564,7 @@ var xxx_messageInfo_KayentaThreshold proto.InternalMessageInfo
 func (m *Measurement) Reset()      { *m = Measurement{} }
 func (*Measurement) ProtoMessage() {}
 func (*Measurement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{50}
+	return fileDescriptor_e0e705f843545fab, []int{54}
 }
 func (m *Measurement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1479,7 +1592,7 @@ var xxx_messageInfo_Measurement proto.InternalMessageInfo
 func (m *MeasurementRetention) Reset()      { *m = MeasurementRetention{} }
 func (*MeasurementRetention) ProtoMessage() {}
 func (*MeasurementRetention) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{51}
+	return fileDescriptor_e0e705f843545fab, []int{55}
 }
 func (m *MeasurementRetention) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1507,7 +1620,7 @@ var xxx_messageInfo_MeasurementRetention proto.InternalMessageInfo
 func (m *Metric) Reset()      { *m = Metric{} }
 func (*Metric) ProtoMessage() {}
 func (*Metric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{52}
+	return fileDescriptor_e0e705f843545fab, []int{56}
 }
 func (m *Metric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1535,7 +1648,7 @@ var xxx_messageInfo_Metric proto.InternalMessageInfo
 func (m *MetricProvider) Reset()      { *m = MetricProvider{} }
 func (*MetricProvider) ProtoMessage() {}
 func (*MetricProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{53}
+	return fileDescriptor_e0e705f843545fab, []int{57}
 }
 func (m *MetricProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1563,7 +1676,7 @@ var xxx_messageInfo_MetricProvider proto.InternalMessageInfo
 func (m *MetricResult) Reset()      { *m = MetricResult{} }
 func (*MetricResult) ProtoMessage() {}
 func (*MetricResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{54}
+	return fileDescriptor_e0e705f843545fab, []int{58}
 }
 func (m *MetricResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1588,10 +1701,38 @@ func (m *MetricResult) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MetricResult proto.InternalMessageInfo
 
+func (m *NamespacePolicy) Reset()      { *m = NamespacePolicy{} }
+func (*NamespacePolicy) ProtoMessage() {}
+func (*NamespacePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{59}
+}
+func (m *NamespacePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NamespacePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *NamespacePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespacePolicy.Merge(m, src)
+}
+func (m *NamespacePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *NamespacePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespacePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespacePolicy proto.InternalMessageInfo
+
 func (m *NewRelicMetric) Reset()      { *m = NewRelicMetric{} }
 func (*NewRelicMetric) ProtoMessage() {}
 func (*NewRelicMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{55}
+	return fileDescriptor_e0e705f843545fab, []int{60}
 }
 func (m *NewRelicMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1619,7 +1760,7 @@ var xxx_messageInfo_NewRelicMetric proto.InternalMessageInfo
 func (m *NginxTrafficRouting) Reset()      { *m = NginxTrafficRouting{} }
 func (*NginxTrafficRouting) ProtoMessage() {}
 func (*NginxTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{56}
+	return fileDescriptor_e0e705f843545fab, []int{61}
 }
 func (m *NginxTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1647,7 +1788,7 @@ var xxx_messageInfo_NginxTrafficRouting proto.InternalMessageInfo
 func (m *ObjectRef) Reset()      { *m = ObjectRef{} }
 func (*ObjectRef) ProtoMessage() {}
 func (*ObjectRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{57}
+	return fileDescriptor_e0e705f843545fab, []int{62}
 }
 func (m *ObjectRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1675,7 +1816,7 @@ var xxx_messageInfo_ObjectRef proto.InternalMessageInfo
 func (m *PauseCondition) Reset()      { *m = PauseCondition{} }
 func (*PauseCondition) ProtoMessage() {}
 func (*PauseCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{58}
+	return fileDescriptor_e0e705f843545fab, []int{63}
 }
 func (m *PauseCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1700,10 +1841,38 @@ func (m *PauseCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PauseCondition proto.InternalMessageInfo
 
+func (m *PerSeriesEvaluation) Reset()      { *m = PerSeriesEvaluation{} }
+func (*PerSeriesEvaluation) ProtoMessage() {}
+func (*PerSeriesEvaluation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{64}
+}
+func (m *PerSeriesEvaluation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerSeriesEvaluation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PerSeriesEvaluation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerSeriesEvaluation.Merge(m, src)
+}
+func (m *PerSeriesEvaluation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerSeriesEvaluation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerSeriesEvaluation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerSeriesEvaluation proto.InternalMessageInfo
+
 func (m *PingPongSpec) Reset()      { *m = PingPongSpec{} }
 func (*PingPongSpec) ProtoMessage() {}
 func (*PingPongSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{59}
+	return fileDescriptor_e0e705f843545fab, []int{65}
 }
 func (m *PingPongSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1731,7 +1900,7 @@ var xxx_messageInfo_PingPongSpec proto.InternalMessageInfo
 func (m *PodTemplateMetadata) Reset()      { *m = PodTemplateMetadata{} }
 func (*PodTemplateMetadata) ProtoMessage() {}
 func (*PodTemplateMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{60}
+	return fileDescriptor_e0e705f843545fab, []int{66}
 }
 func (m *PodTemplateMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1761,7 +1930,7 @@ func (m *PreferredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*PreferredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*PreferredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{61}
+	return fileDescriptor_e0e705f843545fab, []int{67}
 }
 func (m *PreferredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1789,7 +1958,7 @@ var xxx_messageInfo_PreferredDuringSchedulingIgnoredDuringExecution proto.Intern
 func (m *PrometheusMetric) Reset()      { *m = PrometheusMetric{} }
 func (*PrometheusMetric) ProtoMessage() {}
 func (*PrometheusMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{62}
+	return fileDescriptor_e0e705f843545fab, []int{68}
 }
 func (m *PrometheusMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1819,7 +1988,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Reset() {
 }
 func (*RequiredDuringSchedulingIgnoredDuringExecution) ProtoMessage() {}
 func (*RequiredDuringSchedulingIgnoredDuringExecution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{63}
+	return fileDescriptor_e0e705f843545fab, []int{69}
 }
 func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1844,10 +2013,38 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RequiredDuringSchedulingIgnoredDuringExecution proto.InternalMessageInfo
 
+func (m *RestartSchedule) Reset()      { *m = RestartSchedule{} }
+func (*RestartSchedule) ProtoMessage() {}
+func (*RestartSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{70}
+}
+func (m *RestartSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RestartSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RestartSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestartSchedule.Merge(m, src)
+}
+func (m *RestartSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RestartSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestartSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestartSchedule proto.InternalMessageInfo
+
 func (m *Rollout) Reset()      { *m = Rollout{} }
 func (*Rollout) ProtoMessage() {}
 func (*Rollout) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{64}
+	return fileDescriptor_e0e705f843545fab, []int{71}
 }
 func (m *Rollout) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1872,15 +2069,15 @@ func (m *Rollout) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Rollout proto.InternalMessageInfo
 
-func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
-func (*RolloutAnalysis) ProtoMessage() {}
-func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{65}
+func (m *RolloutAdoption) Reset()      { *m = RolloutAdoption{} }
+func (*RolloutAdoption) ProtoMessage() {}
+func (*RolloutAdoption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{72}
 }
-func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
+func (m *RolloutAdoption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *RolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RolloutAdoption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1888,13 +2085,97 @@ func (m *RolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 	}
 	return b[:n], nil
 }
-func (m *RolloutAnalysis) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RolloutAnalysis.Merge(m, src)
+func (m *RolloutAdoption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAdoption.Merge(m, src)
 }
-func (m *RolloutAnalysis) XXX_Size() int {
+func (m *RolloutAdoption) XXX_Size() int {
 	return m.Size()
 }
-func (m *RolloutAnalysis) XXX_DiscardUnknown() {
+func (m *RolloutAdoption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutAdoption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutAdoption proto.InternalMessageInfo
+
+func (m *RolloutAlertRule) Reset()      { *m = RolloutAlertRule{} }
+func (*RolloutAlertRule) ProtoMessage() {}
+func (*RolloutAlertRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{73}
+}
+func (m *RolloutAlertRule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutAlertRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutAlertRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAlertRule.Merge(m, src)
+}
+func (m *RolloutAlertRule) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutAlertRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutAlertRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutAlertRule proto.InternalMessageInfo
+
+func (m *RolloutAlertStatus) Reset()      { *m = RolloutAlertStatus{} }
+func (*RolloutAlertStatus) ProtoMessage() {}
+func (*RolloutAlertStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{74}
+}
+func (m *RolloutAlertStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutAlertStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutAlertStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAlertStatus.Merge(m, src)
+}
+func (m *RolloutAlertStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutAlertStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutAlertStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutAlertStatus proto.InternalMessageInfo
+
+func (m *RolloutAnalysis) Reset()      { *m = RolloutAnalysis{} }
+func (*RolloutAnalysis) ProtoMessage() {}
+func (*RolloutAnalysis) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{75}
+}
+func (m *RolloutAnalysis) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutAnalysis) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutAnalysis) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutAnalysis.Merge(m, src)
+}
+func (m *RolloutAnalysis) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutAnalysis) XXX_DiscardUnknown() {
 	xxx_messageInfo_RolloutAnalysis.DiscardUnknown(m)
 }
 
@@ -1903,7 +2184,7 @@ var xxx_messageInfo_RolloutAnalysis proto.InternalMessageInfo
 func (m *RolloutAnalysisBackground) Reset()      { *m = RolloutAnalysisBackground{} }
 func (*RolloutAnalysisBackground) ProtoMessage() {}
 func (*RolloutAnalysisBackground) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{66}
+	return fileDescriptor_e0e705f843545fab, []int{76}
 }
 func (m *RolloutAnalysisBackground) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1931,7 +2212,7 @@ var xxx_messageInfo_RolloutAnalysisBackground proto.InternalMessageInfo
 func (m *RolloutAnalysisRunStatus) Reset()      { *m = RolloutAnalysisRunStatus{} }
 func (*RolloutAnalysisRunStatus) ProtoMessage() {}
 func (*RolloutAnalysisRunStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{67}
+	return fileDescriptor_e0e705f843545fab, []int{77}
 }
 func (m *RolloutAnalysisRunStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1959,7 +2240,7 @@ var xxx_messageInfo_RolloutAnalysisRunStatus proto.InternalMessageInfo
 func (m *RolloutAnalysisTemplate) Reset()      { *m = RolloutAnalysisTemplate{} }
 func (*RolloutAnalysisTemplate) ProtoMessage() {}
 func (*RolloutAnalysisTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{68}
+	return fileDescriptor_e0e705f843545fab, []int{78}
 }
 func (m *RolloutAnalysisTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1987,7 +2268,7 @@ var xxx_messageInfo_RolloutAnalysisTemplate proto.InternalMessageInfo
 func (m *RolloutCondition) Reset()      { *m = RolloutCondition{} }
 func (*RolloutCondition) ProtoMessage() {}
 func (*RolloutCondition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{69}
+	return fileDescriptor_e0e705f843545fab, []int{79}
 }
 func (m *RolloutCondition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2012,10 +2293,38 @@ func (m *RolloutCondition) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutCondition proto.InternalMessageInfo
 
+func (m *RolloutCrashGuard) Reset()      { *m = RolloutCrashGuard{} }
+func (*RolloutCrashGuard) ProtoMessage() {}
+func (*RolloutCrashGuard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{80}
+}
+func (m *RolloutCrashGuard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutCrashGuard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutCrashGuard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutCrashGuard.Merge(m, src)
+}
+func (m *RolloutCrashGuard) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutCrashGuard) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutCrashGuard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutCrashGuard proto.InternalMessageInfo
+
 func (m *RolloutExperimentStep) Reset()      { *m = RolloutExperimentStep{} }
 func (*RolloutExperimentStep) ProtoMessage() {}
 func (*RolloutExperimentStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{70}
+	return fileDescriptor_e0e705f843545fab, []int{81}
 }
 func (m *RolloutExperimentStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2045,7 +2354,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Reset() {
 }
 func (*RolloutExperimentStepAnalysisTemplateRef) ProtoMessage() {}
 func (*RolloutExperimentStepAnalysisTemplateRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{71}
+	return fileDescriptor_e0e705f843545fab, []int{82}
 }
 func (m *RolloutExperimentStepAnalysisTemplateRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2073,7 +2382,7 @@ var xxx_messageInfo_RolloutExperimentStepAnalysisTemplateRef proto.InternalMessa
 func (m *RolloutExperimentTemplate) Reset()      { *m = RolloutExperimentTemplate{} }
 func (*RolloutExperimentTemplate) ProtoMessage() {}
 func (*RolloutExperimentTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{72}
+	return fileDescriptor_e0e705f843545fab, []int{83}
 }
 func (m *RolloutExperimentTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2101,7 +2410,7 @@ var xxx_messageInfo_RolloutExperimentTemplate proto.InternalMessageInfo
 func (m *RolloutList) Reset()      { *m = RolloutList{} }
 func (*RolloutList) ProtoMessage() {}
 func (*RolloutList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{73}
+	return fileDescriptor_e0e705f843545fab, []int{84}
 }
 func (m *RolloutList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2129,7 +2438,7 @@ var xxx_messageInfo_RolloutList proto.InternalMessageInfo
 func (m *RolloutPause) Reset()      { *m = RolloutPause{} }
 func (*RolloutPause) ProtoMessage() {}
 func (*RolloutPause) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{74}
+	return fileDescriptor_e0e705f843545fab, []int{85}
 }
 func (m *RolloutPause) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2154,10 +2463,66 @@ func (m *RolloutPause) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RolloutPause proto.InternalMessageInfo
 
+func (m *RolloutProgress) Reset()      { *m = RolloutProgress{} }
+func (*RolloutProgress) ProtoMessage() {}
+func (*RolloutProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{86}
+}
+func (m *RolloutProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutProgress.Merge(m, src)
+}
+func (m *RolloutProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutProgress proto.InternalMessageInfo
+
+func (m *RolloutQueueStatus) Reset()      { *m = RolloutQueueStatus{} }
+func (*RolloutQueueStatus) ProtoMessage() {}
+func (*RolloutQueueStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{87}
+}
+func (m *RolloutQueueStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RolloutQueueStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RolloutQueueStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RolloutQueueStatus.Merge(m, src)
+}
+func (m *RolloutQueueStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RolloutQueueStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RolloutQueueStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RolloutQueueStatus proto.InternalMessageInfo
+
 func (m *RolloutSpec) Reset()      { *m = RolloutSpec{} }
 func (*RolloutSpec) ProtoMessage() {}
 func (*RolloutSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{75}
+	return fileDescriptor_e0e705f843545fab, []int{88}
 }
 func (m *RolloutSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2185,7 +2550,7 @@ var xxx_messageInfo_RolloutSpec proto.InternalMessageInfo
 func (m *RolloutStatus) Reset()      { *m = RolloutStatus{} }
 func (*RolloutStatus) ProtoMessage() {}
 func (*RolloutStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{76}
+	return fileDescriptor_e0e705f843545fab, []int{89}
 }
 func (m *RolloutStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2213,7 +2578,7 @@ var xxx_messageInfo_RolloutStatus proto.InternalMessageInfo
 func (m *RolloutStrategy) Reset()      { *m = RolloutStrategy{} }
 func (*RolloutStrategy) ProtoMessage() {}
 func (*RolloutStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{77}
+	return fileDescriptor_e0e705f843545fab, []int{90}
 }
 func (m *RolloutStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2241,7 +2606,7 @@ var xxx_messageInfo_RolloutStrategy proto.InternalMessageInfo
 func (m *RolloutTrafficRouting) Reset()      { *m = RolloutTrafficRouting{} }
 func (*RolloutTrafficRouting) ProtoMessage() {}
 func (*RolloutTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{78}
+	return fileDescriptor_e0e705f843545fab, []int{91}
 }
 func (m *RolloutTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2269,7 +2634,7 @@ var xxx_messageInfo_RolloutTrafficRouting proto.InternalMessageInfo
 func (m *RunSummary) Reset()      { *m = RunSummary{} }
 func (*RunSummary) ProtoMessage() {}
 func (*RunSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{79}
+	return fileDescriptor_e0e705f843545fab, []int{92}
 }
 func (m *RunSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2297,7 +2662,7 @@ var xxx_messageInfo_RunSummary proto.InternalMessageInfo
 func (m *SMITrafficRouting) Reset()      { *m = SMITrafficRouting{} }
 func (*SMITrafficRouting) ProtoMessage() {}
 func (*SMITrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{80}
+	return fileDescriptor_e0e705f843545fab, []int{93}
 }
 func (m *SMITrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2325,7 +2690,7 @@ var xxx_messageInfo_SMITrafficRouting proto.InternalMessageInfo
 func (m *ScopeDetail) Reset()      { *m = ScopeDetail{} }
 func (*ScopeDetail) ProtoMessage() {}
 func (*ScopeDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{81}
+	return fileDescriptor_e0e705f843545fab, []int{94}
 }
 func (m *ScopeDetail) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2353,7 +2718,7 @@ var xxx_messageInfo_ScopeDetail proto.InternalMessageInfo
 func (m *SecretKeyRef) Reset()      { *m = SecretKeyRef{} }
 func (*SecretKeyRef) ProtoMessage() {}
 func (*SecretKeyRef) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{82}
+	return fileDescriptor_e0e705f843545fab, []int{95}
 }
 func (m *SecretKeyRef) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2378,10 +2743,38 @@ func (m *SecretKeyRef) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SecretKeyRef proto.InternalMessageInfo
 
+func (m *SecretSourceRef) Reset()      { *m = SecretSourceRef{} }
+func (*SecretSourceRef) ProtoMessage() {}
+func (*SecretSourceRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{96}
+}
+func (m *SecretSourceRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretSourceRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *SecretSourceRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretSourceRef.Merge(m, src)
+}
+func (m *SecretSourceRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretSourceRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretSourceRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretSourceRef proto.InternalMessageInfo
+
 func (m *SetCanaryScale) Reset()      { *m = SetCanaryScale{} }
 func (*SetCanaryScale) ProtoMessage() {}
 func (*SetCanaryScale) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{83}
+	return fileDescriptor_e0e705f843545fab, []int{97}
 }
 func (m *SetCanaryScale) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2409,7 +2802,7 @@ var xxx_messageInfo_SetCanaryScale proto.InternalMessageInfo
 func (m *StickinessConfig) Reset()      { *m = StickinessConfig{} }
 func (*StickinessConfig) ProtoMessage() {}
 func (*StickinessConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{84}
+	return fileDescriptor_e0e705f843545fab, []int{98}
 }
 func (m *StickinessConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2437,7 +2830,7 @@ var xxx_messageInfo_StickinessConfig proto.InternalMessageInfo
 func (m *TLSRoute) Reset()      { *m = TLSRoute{} }
 func (*TLSRoute) ProtoMessage() {}
 func (*TLSRoute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{85}
+	return fileDescriptor_e0e705f843545fab, []int{99}
 }
 func (m *TLSRoute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2465,7 +2858,7 @@ var xxx_messageInfo_TLSRoute proto.InternalMessageInfo
 func (m *TemplateService) Reset()      { *m = TemplateService{} }
 func (*TemplateService) ProtoMessage() {}
 func (*TemplateService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{86}
+	return fileDescriptor_e0e705f843545fab, []int{100}
 }
 func (m *TemplateService) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2493,7 +2886,7 @@ var xxx_messageInfo_TemplateService proto.InternalMessageInfo
 func (m *TemplateSpec) Reset()      { *m = TemplateSpec{} }
 func (*TemplateSpec) ProtoMessage() {}
 func (*TemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{87}
+	return fileDescriptor_e0e705f843545fab, []int{101}
 }
 func (m *TemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2521,7 +2914,7 @@ var xxx_messageInfo_TemplateSpec proto.InternalMessageInfo
 func (m *TemplateStatus) Reset()      { *m = TemplateStatus{} }
 func (*TemplateStatus) ProtoMessage() {}
 func (*TemplateStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{88}
+	return fileDescriptor_e0e705f843545fab, []int{102}
 }
 func (m *TemplateStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2549,7 +2942,7 @@ var xxx_messageInfo_TemplateStatus proto.InternalMessageInfo
 func (m *TraefikTrafficRouting) Reset()      { *m = TraefikTrafficRouting{} }
 func (*TraefikTrafficRouting) ProtoMessage() {}
 func (*TraefikTrafficRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{89}
+	return fileDescriptor_e0e705f843545fab, []int{103}
 }
 func (m *TraefikTrafficRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2577,7 +2970,7 @@ var xxx_messageInfo_TraefikTrafficRouting proto.InternalMessageInfo
 func (m *TrafficWeights) Reset()      { *m = TrafficWeights{} }
 func (*TrafficWeights) ProtoMessage() {}
 func (*TrafficWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{90}
+	return fileDescriptor_e0e705f843545fab, []int{104}
 }
 func (m *TrafficWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2605,7 +2998,7 @@ var xxx_messageInfo_TrafficWeights proto.InternalMessageInfo
 func (m *ValueFrom) Reset()      { *m = ValueFrom{} }
 func (*ValueFrom) ProtoMessage() {}
 func (*ValueFrom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{91}
+	return fileDescriptor_e0e705f843545fab, []int{105}
 }
 func (m *ValueFrom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2630,10 +3023,38 @@ func (m *ValueFrom) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ValueFrom proto.InternalMessageInfo
 
+func (m *VaultSecretRef) Reset()      { *m = VaultSecretRef{} }
+func (*VaultSecretRef) ProtoMessage() {}
+func (*VaultSecretRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0e705f843545fab, []int{106}
+}
+func (m *VaultSecretRef) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultSecretRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VaultSecretRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultSecretRef.Merge(m, src)
+}
+func (m *VaultSecretRef) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultSecretRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultSecretRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultSecretRef proto.InternalMessageInfo
+
 func (m *WavefrontMetric) Reset()      { *m = WavefrontMetric{} }
 func (*WavefrontMetric) ProtoMessage() {}
 func (*WavefrontMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{92}
+	return fileDescriptor_e0e705f843545fab, []int{107}
 }
 func (m *WavefrontMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2661,7 +3082,7 @@ var xxx_messageInfo_WavefrontMetric proto.InternalMessageInfo
 func (m *WebMetric) Reset()      { *m = WebMetric{} }
 func (*WebMetric) ProtoMessage() {}
 func (*WebMetric) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{93}
+	return fileDescriptor_e0e705f843545fab, []int{108}
 }
 func (m *WebMetric) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2689,7 +3110,7 @@ var xxx_messageInfo_WebMetric proto.InternalMessageInfo
 func (m *WebMetricHeader) Reset()      { *m = WebMetricHeader{} }
 func (*WebMetricHeader) ProtoMessage() {}
 func (*WebMetricHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{94}
+	return fileDescriptor_e0e705f843545fab, []int{109}
 }
 func (m *WebMetricHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2717,7 +3138,7 @@ var xxx_messageInfo_WebMetricHeader proto.InternalMessageInfo
 func (m *WeightDestination) Reset()      { *m = WeightDestination{} }
 func (*WeightDestination) ProtoMessage() {}
 func (*WeightDestination) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e0e705f843545fab, []int{95}
+	return fileDescriptor_e0e705f843545fab, []int{110}
 }
 func (m *WeightDestination) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2745,6 +3166,7 @@ var xxx_messageInfo_WeightDestination proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*ALBStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ALBStatus")
 	proto.RegisterType((*ALBTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ALBTrafficRouting")
+	proto.RegisterType((*AdoptionStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AdoptionStatus")
 	proto.RegisterType((*AmbassadorTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AmbassadorTrafficRouting")
 	proto.RegisterType((*AnalysisRun)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRun")
 	proto.RegisterType((*AnalysisRunArgument)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.AnalysisRunArgument")
@@ -2767,6 +3189,7 @@ func init() {
 	proto.RegisterType((*BlueGreenStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.BlueGreenStrategy")
 	proto.RegisterType((*CanaryStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStatus")
 	proto.RegisterType((*CanaryStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStep")
+	proto.RegisterType((*CanaryStepRecord)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStepRecord")
 	proto.RegisterType((*CanaryStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CanaryStrategy")
 	proto.RegisterType((*CloudWatchMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CloudWatchMetric")
 	proto.RegisterType((*CloudWatchMetricDataQuery)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.CloudWatchMetricDataQuery")
@@ -2786,6 +3209,8 @@ func init() {
 	proto.RegisterType((*ExperimentStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ExperimentStatus")
 	proto.RegisterType((*FieldRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.FieldRef")
 	proto.RegisterType((*GraphiteMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.GraphiteMetric")
+	proto.RegisterType((*InconclusivePolicy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.InconclusivePolicy")
+	proto.RegisterType((*InconclusiveResolution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.InconclusiveResolution")
 	proto.RegisterType((*IstioDestinationRule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioDestinationRule")
 	proto.RegisterType((*IstioTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioTrafficRouting")
 	proto.RegisterType((*IstioVirtualService)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.IstioVirtualService")
@@ -2800,11 +3225,13 @@ func init() {
 	proto.RegisterType((*MetricProvider)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricProvider")
 	proto.RegisterType((*MetricResult)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricResult")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.MetricResult.MetadataEntry")
+	proto.RegisterType((*NamespacePolicy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NamespacePolicy")
 	proto.RegisterType((*NewRelicMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NewRelicMetric")
 	proto.RegisterType((*NginxTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.NginxTrafficRouting.AdditionalIngressAnnotationsEntry")
 	proto.RegisterType((*ObjectRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ObjectRef")
 	proto.RegisterType((*PauseCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PauseCondition")
+	proto.RegisterType((*PerSeriesEvaluation)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PerSeriesEvaluation")
 	proto.RegisterType((*PingPongSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PingPongSpec")
 	proto.RegisterType((*PodTemplateMetadata)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PodTemplateMetadata.AnnotationsEntry")
@@ -2812,17 +3239,24 @@ func init() {
 	proto.RegisterType((*PreferredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PreferredDuringSchedulingIgnoredDuringExecution")
 	proto.RegisterType((*PrometheusMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.PrometheusMetric")
 	proto.RegisterType((*RequiredDuringSchedulingIgnoredDuringExecution)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RequiredDuringSchedulingIgnoredDuringExecution")
+	proto.RegisterType((*RestartSchedule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RestartSchedule")
 	proto.RegisterType((*Rollout)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.Rollout")
+	proto.RegisterType((*RolloutAdoption)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAdoption")
+	proto.RegisterType((*RolloutAlertRule)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAlertRule")
+	proto.RegisterType((*RolloutAlertStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAlertStatus")
 	proto.RegisterType((*RolloutAnalysis)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysis")
 	proto.RegisterType((*RolloutAnalysisBackground)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisBackground")
 	proto.RegisterType((*RolloutAnalysisRunStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisRunStatus")
 	proto.RegisterType((*RolloutAnalysisTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutAnalysisTemplate")
 	proto.RegisterType((*RolloutCondition)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutCondition")
+	proto.RegisterType((*RolloutCrashGuard)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutCrashGuard")
 	proto.RegisterType((*RolloutExperimentStep)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStep")
 	proto.RegisterType((*RolloutExperimentStepAnalysisTemplateRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentStepAnalysisTemplateRef")
 	proto.RegisterType((*RolloutExperimentTemplate)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutExperimentTemplate")
 	proto.RegisterType((*RolloutList)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutList")
 	proto.RegisterType((*RolloutPause)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutPause")
+	proto.RegisterType((*RolloutProgress)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutProgress")
+	proto.RegisterType((*RolloutQueueStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutQueueStatus")
 	proto.RegisterType((*RolloutSpec)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutSpec")
 	proto.RegisterType((*RolloutStatus)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStatus")
 	proto.RegisterType((*RolloutStrategy)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.RolloutStrategy")
@@ -2831,6 +3265,7 @@ func init() {
 	proto.RegisterType((*SMITrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SMITrafficRouting")
 	proto.RegisterType((*ScopeDetail)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ScopeDetail")
 	proto.RegisterType((*SecretKeyRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretKeyRef")
+	proto.RegisterType((*SecretSourceRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SecretSourceRef")
 	proto.RegisterType((*SetCanaryScale)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.SetCanaryScale")
 	proto.RegisterType((*StickinessConfig)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.StickinessConfig")
 	proto.RegisterType((*TLSRoute)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TLSRoute")
@@ -2840,6 +3275,7 @@ func init() {
 	proto.RegisterType((*TraefikTrafficRouting)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TraefikTrafficRouting")
 	proto.RegisterType((*TrafficWeights)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.TrafficWeights")
 	proto.RegisterType((*ValueFrom)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.ValueFrom")
+	proto.RegisterType((*VaultSecretRef)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.VaultSecretRef")
 	proto.RegisterType((*WavefrontMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WavefrontMetric")
 	proto.RegisterType((*WebMetric)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetric")
 	proto.RegisterType((*WebMetricHeader)(nil), "github.com.argoproj.argo_rollouts.pkg.apis.rollouts.v1alpha1.WebMetricHeader")
@@ -2851,455 +3287,531 @@ func init() {
 }
 
 var fileDescriptor_e0e705f843545fab = []byte{
-	// 7167 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x5d, 0x8c, 0x24, 0xd7,
-	0x55, 0xb0, 0xab, 0x7b, 0x7a, 0xa6, 0xfb, 0xcc, 0xff, 0xdd, 0xd9, 0xec, 0x78, 0xec, 0xdd, 0x76,
-	0xca, 0x91, 0x3f, 0xe7, 0x23, 0x99, 0x4d, 0xfc, 0x03, 0x4e, 0x1c, 0x19, 0xba, 0x67, 0x76, 0xbd,
-	0xb3, 0x9e, 0x99, 0x9d, 0xbd, 0x3d, 0xbb, 0x9b, 0x38, 0x71, 0x48, 0x4d, 0xf7, 0x9d, 0x9e, 0xda,
-	0xe9, 0xae, 0xea, 0x54, 0x55, 0xcf, 0xee, 0x38, 0x56, 0x62, 0x13, 0xd9, 0x04, 0x94, 0x28, 0x86,
-	0x24, 0x42, 0x08, 0x05, 0x45, 0x28, 0x12, 0x88, 0xe4, 0x01, 0x45, 0x20, 0x5e, 0x22, 0x81, 0x48,
-	0x22, 0xc2, 0x03, 0x28, 0x20, 0x20, 0x09, 0x52, 0x1a, 0xdc, 0xe1, 0x05, 0x04, 0x42, 0x48, 0x41,
-	0x28, 0xfb, 0x84, 0xee, 0x6f, 0xdd, 0xaa, 0xae, 0x9e, 0xed, 0x9e, 0xae, 0xd9, 0x58, 0x90, 0xb7,
-	0xee, 0x7b, 0xce, 0x3d, 0xe7, 0xfe, 0x9e, 0x73, 0xee, 0xb9, 0xe7, 0x9e, 0x82, 0xf5, 0xba, 0x1d,
-	0xec, 0xb5, 0x77, 0x96, 0xab, 0x6e, 0xf3, 0xbc, 0xe5, 0xd5, 0xdd, 0x96, 0xe7, 0xde, 0x64, 0x3f,
-	0xde, 0xe9, 0xb9, 0x8d, 0x86, 0xdb, 0x0e, 0xfc, 0xf3, 0xad, 0xfd, 0xfa, 0x79, 0xab, 0x65, 0xfb,
-	0xe7, 0x55, 0xc9, 0xc1, 0xbb, 0xad, 0x46, 0x6b, 0xcf, 0x7a, 0xf7, 0xf9, 0x3a, 0x71, 0x88, 0x67,
-	0x05, 0xa4, 0xb6, 0xdc, 0xf2, 0xdc, 0xc0, 0x45, 0xef, 0x0b, 0xa9, 0x2d, 0x4b, 0x6a, 0xec, 0xc7,
-	0x2f, 0xca, 0xba, 0xcb, 0xad, 0xfd, 0xfa, 0x32, 0xa5, 0xb6, 0xac, 0x4a, 0x24, 0xb5, 0xa5, 0x77,
-	0x6a, 0x6d, 0xa9, 0xbb, 0x75, 0xf7, 0x3c, 0x23, 0xba, 0xd3, 0xde, 0x65, 0xff, 0xd8, 0x1f, 0xf6,
-	0x8b, 0x33, 0x5b, 0x7a, 0x78, 0xff, 0x29, 0x7f, 0xd9, 0x76, 0x69, 0xdb, 0xce, 0xef, 0x58, 0x41,
-	0x75, 0xef, 0xfc, 0x41, 0x4f, 0x8b, 0x96, 0x4c, 0x0d, 0xa9, 0xea, 0x7a, 0x24, 0x09, 0xe7, 0x89,
-	0x10, 0xa7, 0x69, 0x55, 0xf7, 0x6c, 0x87, 0x78, 0x87, 0x61, 0xaf, 0x9b, 0x24, 0xb0, 0x92, 0x6a,
-	0x9d, 0xef, 0x57, 0xcb, 0x6b, 0x3b, 0x81, 0xdd, 0x24, 0x3d, 0x15, 0x7e, 0xf6, 0x6e, 0x15, 0xfc,
-	0xea, 0x1e, 0x69, 0x5a, 0x3d, 0xf5, 0x1e, 0xef, 0x57, 0xaf, 0x1d, 0xd8, 0x8d, 0xf3, 0xb6, 0x13,
-	0xf8, 0x81, 0x17, 0xaf, 0x64, 0x7e, 0x33, 0x0b, 0x85, 0xd2, 0x7a, 0xb9, 0x12, 0x58, 0x41, 0xdb,
-	0x47, 0xaf, 0x19, 0x30, 0xd5, 0x70, 0xad, 0x5a, 0xd9, 0x6a, 0x58, 0x4e, 0x95, 0x78, 0x8b, 0xc6,
-	0x43, 0xc6, 0xa3, 0x93, 0x8f, 0xad, 0x2f, 0x8f, 0x32, 0x5f, 0xcb, 0xa5, 0x5b, 0x3e, 0x26, 0xbe,
-	0xdb, 0xf6, 0xaa, 0x04, 0x93, 0xdd, 0xf2, 0xc2, 0xb7, 0x3b, 0xc5, 0xfb, 0xba, 0x9d, 0xe2, 0xd4,
-	0xba, 0xc6, 0x09, 0x47, 0xf8, 0xa2, 0x2f, 0x18, 0x30, 0x5f, 0xb5, 0x1c, 0xcb, 0x3b, 0xdc, 0xb6,
-	0xbc, 0x3a, 0x09, 0x9e, 0xf5, 0xdc, 0x76, 0x6b, 0x31, 0x73, 0x02, 0xad, 0xb9, 0x5f, 0xb4, 0x66,
-	0x7e, 0x25, 0xce, 0x0e, 0xf7, 0xb6, 0x80, 0xb5, 0xcb, 0x0f, 0xac, 0x9d, 0x06, 0xd1, 0xdb, 0x95,
-	0x3d, 0xc9, 0x76, 0x55, 0xe2, 0xec, 0x70, 0x6f, 0x0b, 0xcc, 0x57, 0xb3, 0x30, 0x5f, 0x5a, 0x2f,
-	0x6f, 0x7b, 0xd6, 0xee, 0xae, 0x5d, 0xc5, 0x6e, 0x3b, 0xb0, 0x9d, 0x3a, 0x7a, 0x3b, 0x4c, 0xd8,
-	0x4e, 0xdd, 0x23, 0xbe, 0xcf, 0x26, 0xb2, 0x50, 0x9e, 0x15, 0x44, 0x27, 0xd6, 0x78, 0x31, 0x96,
-	0x70, 0xf4, 0x24, 0x4c, 0xfa, 0xc4, 0x3b, 0xb0, 0xab, 0x64, 0xcb, 0xf5, 0x02, 0x36, 0xd2, 0xb9,
-	0xf2, 0x29, 0x81, 0x3e, 0x59, 0x09, 0x41, 0x58, 0xc7, 0xa3, 0xd5, 0x3c, 0xd7, 0x0d, 0x04, 0x9c,
-	0x0d, 0x44, 0x21, 0xac, 0x86, 0x43, 0x10, 0xd6, 0xf1, 0xd0, 0xeb, 0x06, 0xcc, 0xf9, 0x81, 0x5d,
-	0xdd, 0xb7, 0x1d, 0xe2, 0xfb, 0x2b, 0xae, 0xb3, 0x6b, 0xd7, 0x17, 0x73, 0x6c, 0x14, 0x37, 0x47,
-	0x1b, 0xc5, 0x4a, 0x8c, 0x6a, 0x79, 0xa1, 0xdb, 0x29, 0xce, 0xc5, 0x4b, 0x71, 0x0f, 0x77, 0xb4,
-	0x0a, 0x73, 0x96, 0xe3, 0xb8, 0x81, 0x15, 0xd8, 0xae, 0xb3, 0xe5, 0x91, 0x5d, 0xfb, 0xf6, 0xe2,
-	0x18, 0xeb, 0xce, 0xa2, 0xe8, 0xce, 0x5c, 0x29, 0x06, 0xc7, 0x3d, 0x35, 0xcc, 0x55, 0x58, 0x2c,
-	0x35, 0x77, 0x2c, 0xdf, 0xb7, 0x6a, 0xae, 0x17, 0x9b, 0x8d, 0x47, 0x21, 0xdf, 0xb4, 0x5a, 0x2d,
-	0xdb, 0xa9, 0xd3, 0xe9, 0xc8, 0x3e, 0x5a, 0x28, 0x4f, 0x75, 0x3b, 0xc5, 0xfc, 0x86, 0x28, 0xc3,
-	0x0a, 0x6a, 0x7e, 0x3f, 0x03, 0x93, 0x25, 0xc7, 0x6a, 0x1c, 0xfa, 0xb6, 0x8f, 0xdb, 0x0e, 0xfa,
-	0x08, 0xe4, 0xa9, 0x74, 0xa9, 0x59, 0x81, 0x25, 0x76, 0xe4, 0xbb, 0x96, 0xf9, 0x66, 0x5f, 0xd6,
-	0x37, 0x7b, 0x38, 0x2e, 0x14, 0x7b, 0xf9, 0xe0, 0xdd, 0xcb, 0x57, 0x76, 0x6e, 0x92, 0x6a, 0xb0,
-	0x41, 0x02, 0xab, 0x8c, 0x44, 0x2f, 0x20, 0x2c, 0xc3, 0x8a, 0x2a, 0x72, 0x61, 0xcc, 0x6f, 0x91,
-	0xaa, 0xd8, 0x61, 0x1b, 0x23, 0xae, 0xe4, 0xb0, 0xe9, 0x95, 0x16, 0xa9, 0x96, 0xa7, 0x04, 0xeb,
-	0x31, 0xfa, 0x0f, 0x33, 0x46, 0xe8, 0x16, 0x8c, 0xfb, 0x4c, 0xe6, 0x88, 0xcd, 0x73, 0x25, 0x3d,
-	0x96, 0x8c, 0x6c, 0x79, 0x46, 0x30, 0x1d, 0xe7, 0xff, 0xb1, 0x60, 0x67, 0xfe, 0x83, 0x01, 0xa7,
-	0x34, 0xec, 0x92, 0x57, 0x6f, 0x37, 0x89, 0x13, 0xa0, 0x87, 0x60, 0xcc, 0xb1, 0x9a, 0x44, 0x6c,
-	0x14, 0xd5, 0xe4, 0x4d, 0xab, 0x49, 0x30, 0x83, 0xa0, 0x87, 0x21, 0x77, 0x60, 0x35, 0xda, 0x84,
-	0x0d, 0x52, 0xa1, 0x3c, 0x2d, 0x50, 0x72, 0xd7, 0x69, 0x21, 0xe6, 0x30, 0xf4, 0x12, 0x14, 0xd8,
-	0x8f, 0x8b, 0x9e, 0xdb, 0x4c, 0xa9, 0x6b, 0xa2, 0x85, 0xd7, 0x25, 0xd9, 0xf2, 0x74, 0xb7, 0x53,
-	0x2c, 0xa8, 0xbf, 0x38, 0x64, 0x68, 0xfe, 0xa3, 0x01, 0xb3, 0x5a, 0xe7, 0xd6, 0x6d, 0x3f, 0x40,
-	0x1f, 0xea, 0x59, 0x3c, 0xcb, 0x83, 0x2d, 0x1e, 0x5a, 0x9b, 0x2d, 0x9d, 0x39, 0xd1, 0xd3, 0xbc,
-	0x2c, 0xd1, 0x16, 0x8e, 0x03, 0x39, 0x3b, 0x20, 0x4d, 0x7f, 0x31, 0xf3, 0x50, 0xf6, 0xd1, 0xc9,
-	0xc7, 0xd6, 0x52, 0x9b, 0xc6, 0x70, 0x7c, 0xd7, 0x28, 0x7d, 0xcc, 0xd9, 0x98, 0x5f, 0x1b, 0x8b,
-	0xf4, 0x90, 0xae, 0x28, 0xe4, 0xc2, 0x44, 0x93, 0x04, 0x9e, 0x5d, 0xe5, 0xfb, 0x6a, 0xf2, 0xb1,
-	0xd5, 0xd1, 0x5a, 0xb1, 0xc1, 0x88, 0x85, 0xc2, 0x92, 0xff, 0xf7, 0xb1, 0xe4, 0x82, 0xf6, 0x60,
-	0xcc, 0xf2, 0xea, 0xb2, 0xcf, 0x17, 0xd3, 0x99, 0xdf, 0x70, 0xcd, 0x95, 0xbc, 0xba, 0x8f, 0x19,
-	0x07, 0x74, 0x1e, 0x0a, 0x01, 0xf1, 0x9a, 0xb6, 0x63, 0x05, 0x5c, 0xba, 0xe6, 0xcb, 0xf3, 0x02,
-	0xad, 0xb0, 0x2d, 0x01, 0x38, 0xc4, 0x41, 0x0d, 0x18, 0xaf, 0x79, 0x87, 0xb8, 0xed, 0x2c, 0x8e,
-	0xa5, 0x31, 0x14, 0xab, 0x8c, 0x56, 0xb8, 0x99, 0xf8, 0x7f, 0x2c, 0x78, 0xa0, 0x2f, 0x1b, 0xb0,
-	0xd0, 0x24, 0x96, 0xdf, 0xf6, 0x08, 0xed, 0x02, 0x26, 0x01, 0x71, 0xa8, 0x34, 0x5c, 0xcc, 0x31,
-	0xe6, 0x78, 0xd4, 0x79, 0xe8, 0xa5, 0x5c, 0x7e, 0x50, 0x34, 0x65, 0x21, 0x09, 0x8a, 0x13, 0x5b,
-	0x63, 0x7e, 0x7f, 0x0c, 0xe6, 0x7b, 0x24, 0x04, 0x7a, 0x02, 0x72, 0xad, 0x3d, 0xcb, 0x97, 0x5b,
-	0xfe, 0x9c, 0x5c, 0x6f, 0x5b, 0xb4, 0xf0, 0x4e, 0xa7, 0x38, 0x2d, 0xab, 0xb0, 0x02, 0xcc, 0x91,
-	0xa9, 0x4e, 0x6d, 0x12, 0xdf, 0xb7, 0xea, 0x52, 0x0e, 0x68, 0xcb, 0x84, 0x15, 0x63, 0x09, 0x47,
-	0xbf, 0x6c, 0xc0, 0x34, 0x5f, 0x32, 0x98, 0xf8, 0xed, 0x46, 0x40, 0x65, 0x1d, 0x1d, 0x96, 0xcb,
-	0x69, 0x2c, 0x4f, 0x4e, 0xb2, 0x7c, 0x5a, 0x70, 0x9f, 0xd6, 0x4b, 0x7d, 0x1c, 0xe5, 0x8b, 0x6e,
-	0x40, 0xc1, 0x0f, 0x2c, 0x2f, 0x20, 0xb5, 0x52, 0xc0, 0xb4, 0xda, 0xe4, 0x63, 0xff, 0x7f, 0x30,
-	0x21, 0xb0, 0x6d, 0x37, 0x09, 0x17, 0x38, 0x15, 0x49, 0x00, 0x87, 0xb4, 0xd0, 0x4b, 0x00, 0x5e,
-	0xdb, 0xa9, 0xb4, 0x9b, 0x4d, 0xcb, 0x3b, 0x14, 0x1a, 0xfc, 0xd2, 0x68, 0xdd, 0xc3, 0x8a, 0x5e,
-	0xa8, 0xb3, 0xc2, 0x32, 0xac, 0xf1, 0x43, 0xaf, 0x18, 0x30, 0xcd, 0x57, 0xa2, 0x6c, 0xc1, 0x78,
-	0xca, 0x2d, 0x98, 0xa7, 0x43, 0xbb, 0xaa, 0xb3, 0xc0, 0x51, 0x8e, 0xe6, 0xdf, 0x45, 0xf5, 0x49,
-	0x25, 0xa0, 0xd6, 0x75, 0xfd, 0x10, 0x7d, 0x10, 0xee, 0xf7, 0xdb, 0xd5, 0x2a, 0xf1, 0xfd, 0xdd,
-	0x76, 0x03, 0xb7, 0x9d, 0x4b, 0xb6, 0x1f, 0xb8, 0xde, 0xe1, 0xba, 0xdd, 0xb4, 0x03, 0xb6, 0xe2,
-	0x72, 0xe5, 0xb3, 0xdd, 0x4e, 0xf1, 0xfe, 0x4a, 0x3f, 0x24, 0xdc, 0xbf, 0x3e, 0xb2, 0xe0, 0x81,
-	0xb6, 0xd3, 0x9f, 0x3c, 0xb7, 0xde, 0x8a, 0xdd, 0x4e, 0xf1, 0x81, 0x6b, 0xfd, 0xd1, 0xf0, 0x51,
-	0x34, 0xcc, 0x7f, 0x35, 0x60, 0x4e, 0xf6, 0x6b, 0x9b, 0x34, 0x5b, 0x0d, 0x2a, 0x5d, 0x4e, 0xde,
-	0x10, 0x09, 0x22, 0x86, 0x08, 0x4e, 0x47, 0x9d, 0xc8, 0xf6, 0xf7, 0xb3, 0x46, 0xcc, 0x7f, 0x31,
-	0x60, 0x21, 0x8e, 0x7c, 0x0f, 0x94, 0xa7, 0x1f, 0x55, 0x9e, 0x9b, 0xe9, 0xf6, 0xb6, 0x8f, 0x06,
-	0x7d, 0x6d, 0xac, 0xb7, 0xaf, 0xff, 0xdb, 0xd5, 0x68, 0xa8, 0x15, 0xb3, 0x3f, 0x49, 0xad, 0x38,
-	0xf6, 0xa6, 0xd2, 0x8a, 0xbf, 0x37, 0x06, 0x53, 0x25, 0x27, 0xb0, 0x4b, 0xbb, 0xbb, 0xb6, 0x63,
-	0x07, 0x87, 0xe8, 0xd3, 0x19, 0x38, 0xdf, 0xf2, 0xc8, 0x2e, 0xf1, 0x3c, 0x52, 0x5b, 0x6d, 0x7b,
-	0xb6, 0x53, 0xaf, 0x54, 0xf7, 0x48, 0xad, 0xdd, 0xb0, 0x9d, 0xfa, 0x5a, 0xdd, 0x71, 0x55, 0xf1,
-	0x85, 0xdb, 0xa4, 0xda, 0x66, 0x5d, 0xe2, 0x9b, 0xa2, 0x39, 0x5a, 0x97, 0xb6, 0x86, 0x63, 0x5a,
-	0x7e, 0xbc, 0xdb, 0x29, 0x9e, 0x1f, 0xb2, 0x12, 0x1e, 0xb6, 0x6b, 0xe8, 0x53, 0x19, 0x58, 0xf6,
-	0xc8, 0x47, 0xdb, 0xf6, 0xe0, 0xa3, 0xc1, 0xa5, 0x56, 0x63, 0x44, 0xf5, 0x33, 0x14, 0xcf, 0xf2,
-	0x63, 0xdd, 0x4e, 0x71, 0xc8, 0x3a, 0x78, 0xc8, 0x7e, 0x99, 0xdf, 0xc8, 0xc0, 0xe9, 0x52, 0xab,
-	0xb5, 0x41, 0xfc, 0xbd, 0xd8, 0xa1, 0xf6, 0xb3, 0x06, 0xcc, 0x1c, 0xd8, 0x5e, 0xd0, 0xb6, 0x1a,
-	0xd2, 0x09, 0xc0, 0x97, 0x44, 0x65, 0xc4, 0xed, 0xcc, 0xb9, 0x5d, 0x8f, 0x90, 0x2e, 0xa3, 0x6e,
-	0xa7, 0x38, 0x13, 0x2d, 0xc3, 0x31, 0xf6, 0xe8, 0x37, 0x0c, 0x98, 0x13, 0x45, 0x9b, 0x6e, 0x8d,
-	0xe8, 0x9e, 0xa3, 0x6b, 0x69, 0xb6, 0x49, 0x11, 0xe7, 0x2e, 0x86, 0x78, 0x29, 0xee, 0x69, 0x84,
-	0xf9, 0xef, 0x19, 0x38, 0xd3, 0x87, 0x06, 0xfa, 0x5d, 0x03, 0x16, 0xb8, 0xbb, 0x49, 0x03, 0x61,
-	0xb2, 0x2b, 0x46, 0xf3, 0x03, 0x69, 0xb7, 0x1c, 0xd3, 0xbd, 0x40, 0x9c, 0x2a, 0x29, 0x2f, 0x52,
-	0xb1, 0xb1, 0x92, 0xc0, 0x1a, 0x27, 0x36, 0x88, 0xb5, 0x94, 0x3b, 0xa0, 0x62, 0x2d, 0xcd, 0xdc,
-	0x93, 0x96, 0x56, 0x12, 0x58, 0xe3, 0xc4, 0x06, 0x99, 0x3f, 0x0f, 0x0f, 0x1c, 0x41, 0xee, 0xee,
-	0x27, 0x7e, 0xf3, 0x05, 0xb5, 0xea, 0xa3, 0x6b, 0x6e, 0x00, 0x67, 0x81, 0x09, 0xe3, 0x9e, 0xdb,
-	0x0e, 0x08, 0xd7, 0x6e, 0x85, 0x32, 0x50, 0x3d, 0x81, 0x59, 0x09, 0x16, 0x10, 0xf3, 0x1b, 0x06,
-	0xe4, 0x87, 0xf0, 0x3f, 0x14, 0xa3, 0xfe, 0x87, 0x42, 0x8f, 0xef, 0x21, 0xe8, 0xf5, 0x3d, 0x3c,
-	0x3b, 0xda, 0x6c, 0x0c, 0xe2, 0x73, 0xf8, 0x0f, 0x03, 0xe6, 0x7b, 0x7c, 0x14, 0x68, 0x0f, 0x16,
-	0x5a, 0x6e, 0x4d, 0xda, 0x17, 0x97, 0x2c, 0x7f, 0x8f, 0xc1, 0x44, 0xf7, 0x9e, 0xa0, 0x33, 0xb9,
-	0x95, 0x00, 0xbf, 0xd3, 0x29, 0x2e, 0x2a, 0x22, 0x31, 0x04, 0x9c, 0x48, 0x11, 0xb5, 0x20, 0xbf,
-	0x6b, 0x93, 0x46, 0x2d, 0x5c, 0x82, 0x23, 0x5a, 0x12, 0x17, 0x05, 0x35, 0xee, 0x9e, 0x93, 0xff,
-	0xb0, 0xe2, 0x62, 0x5e, 0x85, 0x99, 0xa8, 0xb3, 0x76, 0x80, 0xc9, 0x3b, 0x0b, 0x59, 0xcb, 0x73,
-	0xc4, 0xd4, 0x4d, 0x0a, 0x84, 0x6c, 0x09, 0x6f, 0x62, 0x5a, 0x6e, 0xfe, 0x78, 0x0c, 0x66, 0xcb,
-	0x8d, 0x36, 0x79, 0xd6, 0x23, 0x44, 0x9e, 0x4f, 0x4b, 0x30, 0xdb, 0xf2, 0xc8, 0x81, 0x4d, 0x6e,
-	0x55, 0x48, 0x83, 0x54, 0x03, 0xd7, 0x13, 0xf4, 0xcf, 0x88, 0xea, 0xb3, 0x5b, 0x51, 0x30, 0x8e,
-	0xe3, 0xa3, 0x67, 0x60, 0xc6, 0xaa, 0x06, 0xf6, 0x01, 0x51, 0x14, 0x78, 0x03, 0xde, 0x22, 0x28,
-	0xcc, 0x94, 0x22, 0x50, 0x1c, 0xc3, 0x46, 0x1f, 0x82, 0x45, 0xbf, 0x6a, 0x35, 0xc8, 0xb5, 0x96,
-	0x60, 0xb5, 0xb2, 0x47, 0xaa, 0xfb, 0x5b, 0xae, 0xed, 0x04, 0xc2, 0x1b, 0xf1, 0x90, 0xa0, 0xb4,
-	0x58, 0xe9, 0x83, 0x87, 0xfb, 0x52, 0x40, 0x7f, 0x62, 0xc0, 0xd9, 0x96, 0x47, 0xb6, 0x3c, 0xb7,
-	0xe9, 0x52, 0x35, 0xd3, 0x73, 0x44, 0x17, 0x47, 0xd5, 0xeb, 0x23, 0xea, 0x53, 0x5e, 0xd2, 0xeb,
-	0x22, 0x7c, 0x6b, 0xb7, 0x53, 0x3c, 0xbb, 0x75, 0x54, 0x03, 0xf0, 0xd1, 0xed, 0x43, 0x7f, 0x66,
-	0xc0, 0xb9, 0x96, 0xeb, 0x07, 0x47, 0x74, 0x21, 0x77, 0xa2, 0x5d, 0x30, 0xbb, 0x9d, 0xe2, 0xb9,
-	0xad, 0x23, 0x5b, 0x80, 0xef, 0xd2, 0x42, 0xb3, 0x3b, 0x09, 0xf3, 0xda, 0xda, 0x13, 0xe7, 0xd7,
-	0xa7, 0x61, 0x5a, 0x2e, 0x86, 0x50, 0xad, 0x17, 0x42, 0x7f, 0x43, 0x49, 0x07, 0xe2, 0x28, 0x2e,
-	0x5d, 0x77, 0x6a, 0x29, 0xf2, 0xda, 0xb1, 0x75, 0xb7, 0x15, 0x81, 0xe2, 0x18, 0x36, 0x5a, 0x83,
-	0x53, 0xa2, 0x04, 0x93, 0x56, 0xc3, 0xae, 0x5a, 0x2b, 0x6e, 0x5b, 0x2c, 0xb9, 0x5c, 0xf9, 0x4c,
-	0xb7, 0x53, 0x3c, 0xb5, 0xd5, 0x0b, 0xc6, 0x49, 0x75, 0xd0, 0x3a, 0x2c, 0x58, 0xed, 0xc0, 0x55,
-	0xfd, 0xbf, 0xe0, 0x50, 0x4d, 0x51, 0x63, 0x4b, 0x2b, 0xcf, 0x55, 0x4a, 0x29, 0x01, 0x8e, 0x13,
-	0x6b, 0xa1, 0xad, 0x18, 0xb5, 0x0a, 0xa9, 0xba, 0x4e, 0x8d, 0xcf, 0x72, 0x2e, 0xb4, 0xc2, 0x4b,
-	0x09, 0x38, 0x38, 0xb1, 0x26, 0x6a, 0xc0, 0x4c, 0xd3, 0xba, 0x7d, 0xcd, 0xb1, 0x0e, 0x2c, 0xbb,
-	0x41, 0x99, 0x08, 0x1f, 0x46, 0xff, 0x83, 0x75, 0x3b, 0xb0, 0x1b, 0xcb, 0xfc, 0x3a, 0x6f, 0x79,
-	0xcd, 0x09, 0xae, 0x78, 0x95, 0x80, 0x5a, 0x6b, 0xdc, 0x38, 0xda, 0x88, 0xd0, 0xc2, 0x31, 0xda,
-	0xe8, 0x0a, 0x9c, 0x66, 0xdb, 0x71, 0xd5, 0xbd, 0xe5, 0xac, 0x92, 0x86, 0x75, 0x28, 0x3b, 0x30,
-	0xc1, 0x3a, 0x70, 0x7f, 0xb7, 0x53, 0x3c, 0x5d, 0x49, 0x42, 0xc0, 0xc9, 0xf5, 0x90, 0x05, 0x0f,
-	0x44, 0x01, 0x98, 0x1c, 0xd8, 0xbe, 0xed, 0x3a, 0xdc, 0x13, 0x91, 0x0f, 0x3d, 0x11, 0x95, 0xfe,
-	0x68, 0xf8, 0x28, 0x1a, 0xe8, 0xb7, 0x0c, 0x58, 0x48, 0xda, 0x86, 0x8b, 0x85, 0x34, 0x2e, 0x2b,
-	0x62, 0x5b, 0x8b, 0xaf, 0x88, 0x44, 0xa1, 0x90, 0xd8, 0x08, 0xf4, 0xb2, 0x01, 0x53, 0x96, 0x76,
-	0x8a, 0x5a, 0x04, 0xd6, 0xaa, 0xcb, 0xa3, 0x9e, 0xe5, 0x43, 0x8a, 0xe5, 0xb9, 0x6e, 0xa7, 0x18,
-	0x39, 0xa9, 0xe1, 0x08, 0x47, 0xf4, 0xdb, 0x06, 0x9c, 0x4e, 0xdc, 0xe3, 0x8b, 0x93, 0x27, 0x31,
-	0x42, 0x6c, 0x91, 0x24, 0xcb, 0x9c, 0xe4, 0x66, 0xa0, 0xd7, 0x0d, 0xa5, 0xca, 0x36, 0xa4, 0x37,
-	0x65, 0x8a, 0x35, 0xed, 0xea, 0x88, 0x07, 0xc7, 0xd0, 0x20, 0x90, 0x84, 0xcb, 0xa7, 0x34, 0xcd,
-	0x28, 0x0b, 0x71, 0x9c, 0x3d, 0xfa, 0x8c, 0x21, 0x55, 0xa3, 0x6a, 0xd1, 0xf4, 0x49, 0xb5, 0x08,
-	0x85, 0x9a, 0x56, 0x35, 0x28, 0xc6, 0x1c, 0x7d, 0x18, 0x96, 0xac, 0x1d, 0xd7, 0x0b, 0x12, 0x37,
-	0xdf, 0xe2, 0x0c, 0xdb, 0x46, 0xe7, 0xba, 0x9d, 0xe2, 0x52, 0xa9, 0x2f, 0x16, 0x3e, 0x82, 0x82,
-	0xf9, 0xd5, 0x1c, 0x4c, 0x71, 0x23, 0x5f, 0xa8, 0xae, 0xaf, 0x1b, 0xf0, 0x60, 0xb5, 0xed, 0x79,
-	0xc4, 0x09, 0x2a, 0x01, 0x69, 0xf5, 0x2a, 0x2e, 0xe3, 0x44, 0x15, 0xd7, 0x43, 0xdd, 0x4e, 0xf1,
-	0xc1, 0x95, 0x23, 0xf8, 0xe3, 0x23, 0x5b, 0x87, 0xfe, 0xca, 0x00, 0x53, 0x20, 0x94, 0xad, 0xea,
-	0x7e, 0xdd, 0x73, 0xdb, 0x4e, 0xad, 0xb7, 0x13, 0x99, 0x13, 0xed, 0xc4, 0x23, 0xdd, 0x4e, 0xd1,
-	0x5c, 0xb9, 0x6b, 0x2b, 0xf0, 0x00, 0x2d, 0x45, 0xcf, 0xc2, 0xbc, 0xc0, 0xba, 0x70, 0xbb, 0x45,
-	0x3c, 0x9b, 0x9a, 0xd3, 0xe2, 0x3e, 0x3d, 0x0c, 0x51, 0x88, 0x23, 0xe0, 0xde, 0x3a, 0xc8, 0x87,
-	0x89, 0x5b, 0xc4, 0xae, 0xef, 0x05, 0xd2, 0x7c, 0x1a, 0x31, 0x2e, 0x41, 0x1c, 0xf8, 0x6f, 0x70,
-	0x9a, 0xe5, 0xc9, 0x6e, 0xa7, 0x38, 0x21, 0xfe, 0x60, 0xc9, 0x09, 0x6d, 0xc2, 0x0c, 0x3f, 0x82,
-	0x6d, 0xd9, 0x4e, 0x7d, 0xcb, 0x75, 0xf8, 0x6d, 0x7e, 0xa1, 0xfc, 0x88, 0x54, 0xf8, 0x95, 0x08,
-	0xf4, 0x4e, 0xa7, 0x38, 0x25, 0x7f, 0x6f, 0x1f, 0xb6, 0x08, 0x8e, 0xd5, 0x36, 0xff, 0x60, 0x0c,
-	0x40, 0x2e, 0x57, 0xd2, 0x42, 0x3f, 0x03, 0x05, 0x9f, 0x04, 0x9c, 0xab, 0x70, 0x9e, 0xf3, 0x3b,
-	0x09, 0x59, 0x88, 0x43, 0x38, 0xda, 0x87, 0x5c, 0xcb, 0x6a, 0xfb, 0x44, 0x4c, 0xfe, 0xe5, 0x54,
-	0x26, 0x7f, 0x8b, 0x52, 0xe4, 0x67, 0x2e, 0xf6, 0x13, 0x73, 0x1e, 0xe8, 0x93, 0x06, 0x00, 0x89,
-	0x4e, 0xd8, 0xc8, 0xbe, 0x0f, 0xc1, 0x32, 0x9c, 0x53, 0x3a, 0x06, 0xe5, 0x99, 0x6e, 0xa7, 0x08,
-	0xda, 0xd4, 0x6b, 0x6c, 0xd1, 0x2d, 0xc8, 0x5b, 0x52, 0xe6, 0x8f, 0x9d, 0x84, 0xcc, 0x67, 0x47,
-	0x21, 0xb5, 0x68, 0x15, 0x33, 0xf4, 0x29, 0x03, 0x66, 0x7c, 0x12, 0x88, 0xa9, 0xa2, 0x92, 0x47,
-	0x18, 0xbc, 0x23, 0x2e, 0xba, 0x4a, 0x84, 0x26, 0x97, 0xa0, 0xd1, 0x32, 0x1c, 0xe3, 0x6b, 0xfe,
-	0xcd, 0x14, 0xcc, 0xc8, 0x25, 0x13, 0xda, 0xb0, 0xdc, 0x85, 0xd1, 0xc7, 0x86, 0x5d, 0xd1, 0x81,
-	0x38, 0x8a, 0x4b, 0x2b, 0xf3, 0x45, 0x19, 0x35, 0x61, 0x55, 0xe5, 0x8a, 0x0e, 0xc4, 0x51, 0x5c,
-	0xd4, 0x84, 0x9c, 0x1f, 0x90, 0x96, 0xbc, 0xf1, 0x1b, 0xf1, 0x42, 0x2a, 0xdc, 0x09, 0xa1, 0x4f,
-	0x9f, 0xfe, 0xf3, 0x31, 0xe7, 0xc2, 0xbc, 0x70, 0x41, 0xc4, 0x31, 0x27, 0x96, 0x41, 0x3a, 0x2b,
-	0x31, 0xea, 0xf3, 0xe3, 0xb3, 0x11, 0x2d, 0xc3, 0x31, 0xf6, 0x09, 0x66, 0x6d, 0xee, 0x04, 0xcd,
-	0xda, 0xe7, 0x21, 0xdf, 0xb4, 0x6e, 0x57, 0xda, 0x5e, 0xfd, 0xf8, 0xe6, 0xb3, 0x08, 0xc6, 0xe1,
-	0x54, 0xb0, 0xa2, 0x87, 0x5e, 0x31, 0xb4, 0xcd, 0x35, 0xc1, 0x88, 0xdf, 0x48, 0x77, 0x73, 0x29,
-	0xad, 0xd0, 0x77, 0x9b, 0xf5, 0x18, 0x99, 0xf9, 0x7b, 0x6e, 0x64, 0x52, 0x83, 0x89, 0x6f, 0x10,
-	0x65, 0x30, 0x15, 0x4e, 0xd4, 0x60, 0x5a, 0x89, 0x30, 0xc3, 0x31, 0xe6, 0xac, 0x3d, 0x7c, 0xcf,
-	0xa9, 0xf6, 0xc0, 0x89, 0xb6, 0xa7, 0x12, 0x61, 0x86, 0x63, 0xcc, 0xfb, 0x9f, 0xac, 0x26, 0x4f,
-	0xe6, 0x64, 0x35, 0x95, 0xc2, 0xc9, 0xea, 0x68, 0xa3, 0x73, 0x7a, 0x54, 0xa3, 0x13, 0x5d, 0x06,
-	0x54, 0x3b, 0x74, 0xac, 0xa6, 0x5d, 0x15, 0xc2, 0x92, 0x29, 0x88, 0x19, 0x76, 0xf2, 0x5e, 0x12,
-	0x82, 0x0c, 0xad, 0xf6, 0x60, 0xe0, 0x84, 0x5a, 0x28, 0x80, 0x7c, 0x4b, 0xda, 0x16, 0xb3, 0x69,
-	0xac, 0x7e, 0x69, 0x6b, 0xf0, 0x4b, 0x61, 0xba, 0xf1, 0x64, 0x09, 0x56, 0x9c, 0xcc, 0xff, 0x32,
-	0x60, 0x6e, 0xa5, 0xe1, 0xb6, 0x6b, 0x37, 0xac, 0xa0, 0xba, 0xc7, 0x6f, 0x30, 0xd1, 0x33, 0x90,
-	0xb7, 0x9d, 0x80, 0x78, 0x07, 0x56, 0x43, 0x68, 0x14, 0x53, 0x5e, 0xf2, 0xae, 0x89, 0xf2, 0x3b,
-	0x9d, 0xe2, 0xcc, 0x6a, 0xdb, 0x63, 0xa1, 0x81, 0x5c, 0xbe, 0x60, 0x55, 0x07, 0x7d, 0xc9, 0x80,
-	0x79, 0x7e, 0x07, 0xba, 0x6a, 0x05, 0xd6, 0xd5, 0x36, 0xf1, 0x6c, 0x22, 0x6f, 0x41, 0x47, 0x14,
-	0x2d, 0xf1, 0xb6, 0x4a, 0x06, 0x87, 0xa1, 0x11, 0xb9, 0x11, 0xe7, 0x8c, 0x7b, 0x1b, 0x63, 0x7e,
-	0x2e, 0x0b, 0xf7, 0xf7, 0xa5, 0x85, 0x96, 0x20, 0x63, 0xd7, 0x44, 0xd7, 0x41, 0xd0, 0xcd, 0xac,
-	0xd5, 0x70, 0xc6, 0xae, 0xa1, 0x65, 0x66, 0x0f, 0x79, 0xc4, 0xf7, 0xe5, 0x85, 0x58, 0x41, 0x99,
-	0x2e, 0xa2, 0x14, 0x6b, 0x18, 0xa8, 0x08, 0xb9, 0x86, 0xb5, 0x43, 0x1a, 0xc2, 0xd6, 0x65, 0x16,
-	0xd6, 0x3a, 0x2d, 0xc0, 0xbc, 0x1c, 0xfd, 0x92, 0x01, 0xc0, 0x1b, 0x48, 0x2d, 0x65, 0xa1, 0xd7,
-	0x70, 0xba, 0xc3, 0x44, 0x29, 0xf3, 0x56, 0x86, 0xff, 0xb1, 0xc6, 0x15, 0x6d, 0xc3, 0x38, 0x35,
-	0xb6, 0xdc, 0xda, 0xb1, 0xd5, 0x18, 0xbb, 0x00, 0xd8, 0x62, 0x34, 0xb0, 0xa0, 0x45, 0xc7, 0xca,
-	0x23, 0x41, 0xdb, 0x73, 0xe8, 0xd0, 0x32, 0xc5, 0x95, 0xe7, 0xad, 0xc0, 0xaa, 0x14, 0x6b, 0x18,
-	0xe6, 0x1f, 0x67, 0x60, 0x21, 0xa9, 0xe9, 0x54, 0x3f, 0x8c, 0xf3, 0xd6, 0x8a, 0x63, 0xdb, 0xfb,
-	0xd3, 0x1f, 0x1f, 0x71, 0x9d, 0xaf, 0x2e, 0xbd, 0x45, 0xc0, 0x91, 0xe0, 0x8b, 0xde, 0xaf, 0x46,
-	0x28, 0x73, 0xcc, 0x11, 0x52, 0x94, 0x63, 0xa3, 0xf4, 0x10, 0x8c, 0xf9, 0x74, 0xe6, 0xb3, 0x51,
-	0xe7, 0x3a, 0x9b, 0x23, 0x06, 0xa1, 0x18, 0x6d, 0xc7, 0x0e, 0x44, 0xbc, 0xae, 0xc2, 0xb8, 0xe6,
-	0xd8, 0x01, 0x66, 0x10, 0xf3, 0x0b, 0x19, 0x58, 0xea, 0xdf, 0x29, 0xf4, 0x05, 0x03, 0xa0, 0x46,
-	0x4d, 0x69, 0xba, 0x24, 0x65, 0xf8, 0x83, 0x75, 0x52, 0x63, 0xb8, 0x2a, 0x39, 0x85, 0xb1, 0x30,
-	0xaa, 0xc8, 0xc7, 0x5a, 0x43, 0xd0, 0x63, 0x72, 0xe9, 0x6f, 0x5a, 0x4d, 0x69, 0x80, 0xaa, 0x3a,
-	0x1b, 0x0a, 0x82, 0x35, 0x2c, 0x7a, 0x56, 0x72, 0xac, 0x26, 0xf1, 0x5b, 0x96, 0x0a, 0xc8, 0x66,
-	0x67, 0xa5, 0x4d, 0x59, 0x88, 0x43, 0xb8, 0xd9, 0x80, 0x87, 0x07, 0x68, 0x67, 0x4a, 0xc1, 0xb1,
-	0xe6, 0x7f, 0x1a, 0x70, 0x66, 0xa5, 0xd1, 0xf6, 0x03, 0xe2, 0xfd, 0x9f, 0x09, 0x2d, 0xfa, 0x6f,
-	0x03, 0x1e, 0xe8, 0xd3, 0xe7, 0x7b, 0x10, 0x61, 0xf4, 0x62, 0x34, 0xc2, 0xe8, 0xda, 0xa8, 0x4b,
-	0x3a, 0xb1, 0x1f, 0x7d, 0x02, 0x8d, 0x02, 0x98, 0xa6, 0x52, 0xab, 0xe6, 0xd6, 0x53, 0xd2, 0x9b,
-	0x0f, 0x43, 0xee, 0xa3, 0x54, 0xff, 0xc4, 0xd7, 0x18, 0x53, 0x4a, 0x98, 0xc3, 0xcc, 0xf7, 0x81,
-	0x08, 0xc7, 0x89, 0x6d, 0x1e, 0x63, 0x90, 0xcd, 0x63, 0xfe, 0x7d, 0x06, 0xb4, 0x33, 0xf6, 0x3d,
-	0x58, 0x94, 0x4e, 0x64, 0x51, 0x8e, 0x78, 0x6a, 0xd6, 0x3c, 0x06, 0xfd, 0xe2, 0xee, 0x0f, 0x62,
-	0x71, 0xf7, 0x9b, 0xa9, 0x71, 0x3c, 0x3a, 0xec, 0xfe, 0xbb, 0x06, 0x3c, 0x10, 0x22, 0xf7, 0xba,
-	0xbf, 0xee, 0x2e, 0x61, 0x9e, 0x84, 0x49, 0x2b, 0xac, 0x26, 0xd6, 0x80, 0x7a, 0x6a, 0xa2, 0x51,
-	0xc4, 0x3a, 0x5e, 0x18, 0xe5, 0x9b, 0x3d, 0x66, 0x94, 0xef, 0xd8, 0xd1, 0x51, 0xbe, 0xe6, 0x8f,
-	0x32, 0x70, 0xb6, 0xb7, 0x67, 0x72, 0x6f, 0x0c, 0x76, 0x3b, 0xfc, 0x14, 0x4c, 0x05, 0xa2, 0x82,
-	0x26, 0xe9, 0xd5, 0x43, 0xa9, 0x6d, 0x0d, 0x86, 0x23, 0x98, 0xb4, 0x66, 0x95, 0xef, 0xca, 0x4a,
-	0xd5, 0x6d, 0xc9, 0x18, 0x71, 0x55, 0x73, 0x45, 0x83, 0xe1, 0x08, 0xa6, 0x8a, 0xbe, 0x1b, 0x3b,
-	0xf1, 0xe8, 0xbb, 0x0a, 0x9c, 0x96, 0xf1, 0x46, 0x17, 0x5d, 0x6f, 0xc5, 0x6d, 0xb6, 0x1a, 0x44,
-	0x44, 0x89, 0xd3, 0xc6, 0x9e, 0x15, 0x55, 0x4e, 0xe3, 0x24, 0x24, 0x9c, 0x5c, 0xd7, 0xfc, 0x6e,
-	0x16, 0x4e, 0x85, 0xc3, 0xbe, 0xe2, 0x3a, 0x35, 0x9b, 0x45, 0x6d, 0x3d, 0x0d, 0x63, 0xc1, 0x61,
-	0x4b, 0x0e, 0xf6, 0xff, 0x93, 0xcd, 0xd9, 0x3e, 0x6c, 0xd1, 0xd9, 0x3e, 0x93, 0x50, 0x85, 0x39,
-	0x20, 0x59, 0x25, 0xb4, 0xae, 0x76, 0x07, 0x9f, 0x81, 0x27, 0xa2, 0xab, 0xf9, 0x4e, 0xa7, 0x98,
-	0xf0, 0x4e, 0x70, 0x59, 0x51, 0x8a, 0xae, 0x79, 0x74, 0x13, 0x66, 0x1a, 0x96, 0x1f, 0x5c, 0x6b,
-	0xd5, 0xac, 0x80, 0x6c, 0xdb, 0x4d, 0x22, 0xf6, 0xdc, 0x30, 0xa1, 0xd7, 0xea, 0xc6, 0x74, 0x3d,
-	0x42, 0x09, 0xc7, 0x28, 0xa3, 0x03, 0x40, 0xb4, 0x64, 0xdb, 0xb3, 0x1c, 0x9f, 0xf7, 0x8a, 0xf2,
-	0x1b, 0x3e, 0xd4, 0x5b, 0x1d, 0xcb, 0xd6, 0x7b, 0xa8, 0xe1, 0x04, 0x0e, 0xe8, 0x11, 0x18, 0xf7,
-	0x88, 0xe5, 0x8b, 0xc9, 0x2c, 0x84, 0xfb, 0x1f, 0xb3, 0x52, 0x2c, 0xa0, 0xfa, 0x86, 0x1a, 0xbf,
-	0xcb, 0x86, 0xfa, 0x81, 0x01, 0x33, 0xe1, 0x34, 0xdd, 0x03, 0x25, 0xd9, 0x8c, 0x2a, 0xc9, 0x4b,
-	0x69, 0x89, 0xc4, 0x3e, 0x7a, 0xf1, 0xcf, 0xc7, 0xf5, 0xfe, 0xb1, 0xd0, 0xdb, 0x8f, 0x41, 0x41,
-	0xee, 0x6a, 0x69, 0x7d, 0x8e, 0x78, 0xba, 0x8d, 0xd8, 0x25, 0xda, 0x93, 0x11, 0xc1, 0x04, 0x87,
-	0xfc, 0xa8, 0x5a, 0xae, 0x09, 0x95, 0x2b, 0x96, 0xbd, 0x52, 0xcb, 0x52, 0x15, 0x27, 0xa9, 0x65,
-	0x59, 0x07, 0x5d, 0x83, 0x33, 0x2d, 0xcf, 0x65, 0xcf, 0x08, 0x57, 0x89, 0x55, 0x6b, 0xd8, 0x0e,
-	0x91, 0x2e, 0x04, 0x7e, 0x61, 0xff, 0x40, 0xb7, 0x53, 0x3c, 0xb3, 0x95, 0x8c, 0x82, 0xfb, 0xd5,
-	0x8d, 0x3e, 0x7d, 0x19, 0x1b, 0xe0, 0xe9, 0xcb, 0xaf, 0x28, 0x47, 0x1d, 0xf1, 0xc5, 0x03, 0x94,
-	0x0f, 0xa6, 0x35, 0x95, 0x09, 0x62, 0x3d, 0x5c, 0x52, 0x25, 0xc1, 0x14, 0x2b, 0xf6, 0xfd, 0xbd,
-	0x41, 0xe3, 0xc7, 0xf4, 0x06, 0x85, 0x11, 0xcc, 0x13, 0x3f, 0xc9, 0x08, 0xe6, 0xfc, 0x9b, 0x2a,
-	0x82, 0xf9, 0xd5, 0x1c, 0xcc, 0xc5, 0x2d, 0x90, 0x93, 0x7f, 0xd6, 0xf3, 0xeb, 0x06, 0xcc, 0xc9,
-	0xdd, 0xc3, 0x79, 0x12, 0xe9, 0xe7, 0x5f, 0x4f, 0x69, 0xd3, 0x72, 0x5b, 0x4a, 0x3d, 0x3c, 0xdd,
-	0x8e, 0x71, 0xc3, 0x3d, 0xfc, 0xd1, 0x0b, 0x30, 0xa9, 0xdc, 0xe1, 0xc7, 0x7a, 0xe3, 0x33, 0xcb,
-	0xac, 0xa8, 0x90, 0x04, 0xd6, 0xe9, 0xa1, 0x57, 0x0d, 0x80, 0xaa, 0x54, 0x73, 0x72, 0x77, 0x5d,
-	0x4d, 0x6b, 0x77, 0x29, 0x05, 0x1a, 0x1a, 0xcb, 0xaa, 0xc8, 0xc7, 0x1a, 0x63, 0xf4, 0x39, 0xe6,
-	0x08, 0x57, 0xd6, 0x1d, 0xdd, 0x4f, 0xd9, 0xd1, 0x83, 0x4e, 0x8f, 0x30, 0x4c, 0x43, 0x53, 0x4a,
-	0x03, 0xf9, 0x38, 0xd2, 0x08, 0xf3, 0x69, 0x50, 0x61, 0x82, 0x54, 0x6c, 0xb1, 0x40, 0xc1, 0x2d,
-	0x2b, 0xd8, 0x13, 0x4b, 0x50, 0x89, 0xad, 0x8b, 0x12, 0x80, 0x43, 0x1c, 0xf3, 0x23, 0x30, 0xf3,
-	0xac, 0x67, 0xb5, 0xf6, 0x6c, 0xe6, 0x70, 0xa6, 0xe7, 0xa4, 0xb7, 0xc3, 0x84, 0x55, 0xab, 0x25,
-	0x3d, 0xdb, 0x2e, 0xf1, 0x62, 0x2c, 0xe1, 0x83, 0x1d, 0x89, 0xbe, 0x69, 0xc0, 0xc2, 0x9a, 0x1f,
-	0xd8, 0xee, 0x2a, 0xf1, 0x03, 0x2a, 0x2b, 0xe9, 0x8e, 0x6a, 0x37, 0x06, 0x09, 0x63, 0x5d, 0x85,
-	0x39, 0x71, 0x2b, 0xd6, 0xde, 0xf1, 0x49, 0xa0, 0x19, 0xa7, 0x6a, 0x71, 0xae, 0xc4, 0xe0, 0xb8,
-	0xa7, 0x06, 0xa5, 0x22, 0xae, 0xc7, 0x42, 0x2a, 0xd9, 0x28, 0x95, 0x4a, 0x0c, 0x8e, 0x7b, 0x6a,
-	0x98, 0xdf, 0xc9, 0xc2, 0x29, 0xd6, 0x8d, 0x58, 0x08, 0xfa, 0x67, 0xfa, 0x85, 0xa0, 0x8f, 0xb8,
-	0x3e, 0x19, 0xaf, 0x63, 0x04, 0xa0, 0xff, 0x9a, 0x01, 0xb3, 0xb5, 0xe8, 0x48, 0xa7, 0xe3, 0x73,
-	0x48, 0x9a, 0x43, 0x1e, 0xee, 0x12, 0x2b, 0xc4, 0x71, 0xfe, 0xe8, 0xf3, 0x06, 0xcc, 0x46, 0x9b,
-	0x29, 0x45, 0xd6, 0x09, 0x0c, 0x92, 0x8a, 0x4f, 0x8d, 0x96, 0xfb, 0x38, 0xde, 0x04, 0xf3, 0x6f,
-	0x0d, 0x31, 0xa5, 0x27, 0x11, 0x5f, 0x8d, 0x6e, 0x41, 0x21, 0x68, 0xf8, 0xbc, 0x50, 0xf4, 0x76,
-	0xc4, 0x63, 0xce, 0xf6, 0x7a, 0x85, 0x91, 0xd3, 0x2c, 0x11, 0x51, 0x42, 0x2d, 0x2a, 0xc9, 0xcb,
-	0xfc, 0x8a, 0x01, 0x85, 0xcb, 0xee, 0x8e, 0xd8, 0xce, 0x1f, 0x4e, 0xc1, 0x89, 0xa0, 0x6c, 0x0d,
-	0x75, 0xff, 0x14, 0x9a, 0xaf, 0xcf, 0x44, 0x5c, 0x08, 0x0f, 0x6a, 0xb4, 0x97, 0x59, 0xba, 0x13,
-	0x4a, 0xea, 0xb2, 0xbb, 0xd3, 0xd7, 0x43, 0xf5, 0x3b, 0x39, 0x98, 0x7e, 0xce, 0x3a, 0x24, 0x4e,
-	0x60, 0x0d, 0x2f, 0x80, 0xe8, 0xa9, 0xbc, 0xc5, 0xc2, 0x2d, 0x35, 0xfb, 0x31, 0x3c, 0x95, 0x87,
-	0x20, 0xac, 0xe3, 0x85, 0x72, 0x85, 0x67, 0x5f, 0x48, 0x92, 0x08, 0x2b, 0x31, 0x38, 0xee, 0xa9,
-	0x81, 0x2e, 0x03, 0x12, 0x6f, 0xc9, 0x4a, 0xd5, 0xaa, 0xdb, 0x76, 0xb8, 0x64, 0xe1, 0x07, 0x76,
-	0x75, 0x90, 0xd9, 0xe8, 0xc1, 0xc0, 0x09, 0xb5, 0xd0, 0x87, 0x60, 0xb1, 0xca, 0x28, 0x0b, 0xb3,
-	0x56, 0xa7, 0xc8, 0x8f, 0x36, 0x2a, 0xd4, 0x79, 0xa5, 0x0f, 0x1e, 0xee, 0x4b, 0x81, 0xb6, 0xd4,
-	0x0f, 0x5c, 0xcf, 0xaa, 0x13, 0x9d, 0xee, 0x78, 0xb4, 0xa5, 0x95, 0x1e, 0x0c, 0x9c, 0x50, 0x0b,
-	0x7d, 0x02, 0x0a, 0xc1, 0x9e, 0x47, 0xfc, 0x3d, 0xb7, 0x51, 0x13, 0x17, 0xd2, 0x23, 0x7a, 0x71,
-	0xc4, 0xec, 0x6f, 0x4b, 0xaa, 0xda, 0xf2, 0x96, 0x45, 0x38, 0xe4, 0x89, 0x3c, 0x18, 0xf7, 0xab,
-	0x6e, 0x8b, 0xf8, 0xc2, 0x1c, 0xbc, 0x9c, 0x0a, 0x77, 0xe6, 0x95, 0xd0, 0xfc, 0x47, 0x8c, 0x03,
-	0x16, 0x9c, 0xcc, 0x6f, 0x65, 0x60, 0x4a, 0x47, 0x1c, 0x40, 0x44, 0x7c, 0xd2, 0x80, 0xa9, 0xaa,
-	0xeb, 0x04, 0x9e, 0xdb, 0xe0, 0xbe, 0x11, 0xbe, 0x41, 0x46, 0x4c, 0x51, 0xc0, 0x48, 0xad, 0x92,
-	0xc0, 0xb2, 0x1b, 0x9a, 0x9b, 0x45, 0x63, 0x83, 0x23, 0x4c, 0xd1, 0xa7, 0x0d, 0x98, 0x0d, 0x23,
-	0x75, 0x42, 0x27, 0x4d, 0xaa, 0x0d, 0x51, 0x12, 0xf7, 0x42, 0x94, 0x13, 0x8e, 0xb3, 0x36, 0x77,
-	0x60, 0x2e, 0x3e, 0xdb, 0x74, 0x28, 0x5b, 0x96, 0xd8, 0xeb, 0xd9, 0x70, 0x28, 0xb7, 0x2c, 0xdf,
-	0xc7, 0x0c, 0x82, 0xde, 0x01, 0xf9, 0xa6, 0xe5, 0xd5, 0x6d, 0xc7, 0x6a, 0xb0, 0x51, 0xcc, 0x6a,
-	0x02, 0x49, 0x94, 0x63, 0x85, 0x61, 0xfe, 0x70, 0x0c, 0x26, 0x35, 0x2b, 0xfe, 0xe4, 0x2d, 0xf2,
-	0xc8, 0xf3, 0xf6, 0x6c, 0x8a, 0xcf, 0xdb, 0x9f, 0x07, 0xd8, 0xb5, 0x1d, 0xdb, 0xdf, 0x3b, 0xe6,
-	0xc3, 0x79, 0x76, 0x99, 0x77, 0x51, 0x51, 0xc0, 0x1a, 0xb5, 0xf0, 0xc6, 0x24, 0x77, 0x44, 0x3a,
-	0x91, 0x57, 0x0d, 0x4d, 0x79, 0x8c, 0xa7, 0x71, 0x43, 0xac, 0x4d, 0xcc, 0xb2, 0x54, 0x26, 0x17,
-	0x9c, 0xc0, 0x3b, 0x3c, 0x52, 0xc7, 0x6c, 0x43, 0xde, 0x23, 0x7e, 0xbb, 0x49, 0xcf, 0x16, 0x13,
-	0x43, 0x0f, 0x03, 0xbb, 0x5d, 0xc7, 0xa2, 0x3e, 0x56, 0x94, 0x96, 0x9e, 0x86, 0xe9, 0x48, 0x13,
-	0xd0, 0x1c, 0x64, 0xf7, 0xc9, 0x21, 0x5f, 0x27, 0x98, 0xfe, 0x44, 0x0b, 0x91, 0x7b, 0x25, 0x31,
-	0x2c, 0xef, 0xcd, 0x3c, 0x65, 0x98, 0x2e, 0x24, 0x1e, 0x15, 0x8f, 0xe3, 0xf6, 0xa7, 0x73, 0xd1,
-	0xd0, 0x5e, 0xce, 0xab, 0xb9, 0xe0, 0x31, 0x14, 0x1c, 0x66, 0xfe, 0x68, 0x1c, 0xc4, 0xa5, 0xe7,
-	0x00, 0xc2, 0x47, 0xbf, 0xeb, 0xc8, 0x1c, 0xe3, 0xae, 0xe3, 0x32, 0x4c, 0xd9, 0x8e, 0x1d, 0xd8,
-	0x56, 0x83, 0xb9, 0x01, 0x84, 0x72, 0x94, 0xe1, 0x94, 0x53, 0x6b, 0x1a, 0x2c, 0x81, 0x4e, 0xa4,
-	0x2e, 0xba, 0x0a, 0x39, 0xa6, 0x3d, 0xc4, 0x02, 0x1e, 0xfe, 0x66, 0x96, 0x5d, 0xca, 0xf3, 0x37,
-	0x16, 0x9c, 0x12, 0xb3, 0xe8, 0x79, 0xea, 0x00, 0x75, 0x50, 0x13, 0xeb, 0x38, 0xb4, 0xe8, 0x63,
-	0x70, 0xdc, 0x53, 0x83, 0x52, 0xd9, 0xb5, 0xec, 0x46, 0xdb, 0x23, 0x21, 0x95, 0xf1, 0x28, 0x95,
-	0x8b, 0x31, 0x38, 0xee, 0xa9, 0x81, 0x76, 0x61, 0x4a, 0x94, 0xf1, 0xc8, 0x98, 0x89, 0x63, 0xf6,
-	0x92, 0x45, 0x40, 0x5d, 0xd4, 0x28, 0xe1, 0x08, 0x5d, 0xd4, 0x86, 0x79, 0xdb, 0xa9, 0xba, 0x4e,
-	0xb5, 0xd1, 0xf6, 0xed, 0x03, 0x12, 0x3e, 0x70, 0x38, 0x0e, 0xb3, 0xd3, 0xdd, 0x4e, 0x71, 0x7e,
-	0x2d, 0x4e, 0x0e, 0xf7, 0x72, 0x40, 0xaf, 0x18, 0x70, 0xba, 0xea, 0x3a, 0x3e, 0x7b, 0x8b, 0x7b,
-	0x40, 0x2e, 0x78, 0x9e, 0xeb, 0x71, 0xde, 0x85, 0x63, 0xf2, 0x66, 0xde, 0xa7, 0x95, 0x24, 0x92,
-	0x38, 0x99, 0x13, 0x7a, 0x11, 0xf2, 0x2d, 0xcf, 0x3d, 0xb0, 0x6b, 0xc4, 0x13, 0x51, 0x56, 0xeb,
-	0x69, 0xe4, 0x06, 0xd8, 0x12, 0x34, 0x43, 0xd1, 0x23, 0x4b, 0xb0, 0xe2, 0x67, 0x7e, 0x35, 0x0f,
-	0x33, 0x51, 0x74, 0xf4, 0x71, 0x80, 0x96, 0xe7, 0x36, 0x49, 0xb0, 0x47, 0x54, 0xa0, 0xfa, 0xe6,
-	0xa8, 0x4f, 0xd0, 0x25, 0x3d, 0x19, 0xe7, 0x40, 0xc5, 0x45, 0x58, 0x8a, 0x35, 0x8e, 0xc8, 0x83,
-	0x89, 0x7d, 0xae, 0x44, 0x85, 0x4d, 0xf1, 0x5c, 0x2a, 0x16, 0x90, 0xe0, 0xcc, 0x22, 0xac, 0x45,
-	0x11, 0x96, 0x8c, 0xd0, 0x0e, 0x64, 0x6f, 0x91, 0x9d, 0x74, 0x9e, 0x75, 0xde, 0x20, 0xe2, 0x6c,
-	0x52, 0x9e, 0xe8, 0x76, 0x8a, 0xd9, 0x1b, 0x64, 0x07, 0x53, 0xe2, 0xb4, 0x5f, 0x35, 0x7e, 0x63,
-	0x2b, 0x44, 0xc5, 0x88, 0xfd, 0x8a, 0x5c, 0xff, 0xf2, 0x7e, 0x89, 0x22, 0x2c, 0x19, 0xa1, 0x17,
-	0xa1, 0x70, 0xcb, 0x3a, 0x20, 0xbb, 0x9e, 0xeb, 0x04, 0x22, 0xb8, 0x66, 0xc4, 0xd8, 0xe5, 0x1b,
-	0x92, 0x9c, 0xe0, 0xcb, 0xd4, 0xbb, 0x2a, 0xc4, 0x21, 0x3b, 0x74, 0x00, 0x79, 0x87, 0xdc, 0xc2,
-	0xa4, 0x61, 0x57, 0x45, 0xd8, 0xe8, 0x88, 0xcb, 0x7a, 0x53, 0x50, 0x13, 0x9c, 0x99, 0xde, 0x93,
-	0x65, 0x58, 0xf1, 0xa2, 0x73, 0x79, 0xd3, 0xdd, 0x11, 0x82, 0x6a, 0xc4, 0xb9, 0x54, 0xe7, 0x4c,
-	0x3e, 0x97, 0x97, 0xdd, 0x1d, 0x4c, 0x89, 0xd3, 0x3d, 0x52, 0x55, 0x91, 0x1d, 0x42, 0x4c, 0x6d,
-	0xa6, 0x1b, 0xd1, 0xc2, 0xf7, 0x48, 0x58, 0x8a, 0x35, 0x8e, 0x74, 0x6c, 0xeb, 0xc2, 0xad, 0x25,
-	0x04, 0xd5, 0x88, 0x63, 0x1b, 0x75, 0x92, 0xf1, 0xb1, 0x95, 0x65, 0x58, 0xf1, 0x32, 0xbf, 0x32,
-	0x0e, 0x53, 0x7a, 0x2e, 0xa4, 0x01, 0x74, 0xb5, 0xb2, 0x4f, 0x33, 0xc3, 0xd8, 0xa7, 0xf4, 0x78,
-	0xa1, 0x79, 0xa5, 0xa5, 0x87, 0x61, 0x2d, 0x35, 0xf3, 0x2c, 0x3c, 0x5e, 0x68, 0x85, 0x3e, 0x8e,
-	0x30, 0x1d, 0xe2, 0xa2, 0x9a, 0x1a, 0x39, 0xdc, 0x0c, 0xc8, 0x45, 0x8d, 0x9c, 0x88, 0x62, 0x7f,
-	0x0c, 0x20, 0xcc, 0x09, 0x24, 0x6e, 0x2b, 0x94, 0xf5, 0xa4, 0xe5, 0x2a, 0xd2, 0xb0, 0xd0, 0x23,
-	0x30, 0x4e, 0x15, 0x25, 0xa9, 0x89, 0x57, 0x84, 0xea, 0x0c, 0x77, 0x91, 0x95, 0x62, 0x01, 0x45,
-	0x4f, 0x51, 0x9b, 0x26, 0x54, 0x6f, 0xe2, 0x71, 0xe0, 0x42, 0x68, 0xd3, 0x84, 0x30, 0x1c, 0xc1,
-	0xa4, 0x4d, 0x27, 0x54, 0x1b, 0xb1, 0x95, 0xa4, 0x35, 0x9d, 0xa9, 0x28, 0xcc, 0x61, 0xcc, 0xa7,
-	0x10, 0xd3, 0x5e, 0x4c, 0x59, 0xe5, 0x34, 0x9f, 0x42, 0x0c, 0x8e, 0x7b, 0x6a, 0xd0, 0xce, 0x88,
-	0x8b, 0x96, 0x49, 0x1e, 0x8f, 0xd7, 0xe7, 0x8a, 0xe4, 0x35, 0xdd, 0x32, 0x9f, 0x62, 0x53, 0xff,
-	0xfe, 0xf4, 0xf2, 0x7a, 0x0d, 0x6e, 0x9a, 0x8f, 0x66, 0x44, 0x7f, 0x04, 0x66, 0xa2, 0x32, 0x8b,
-	0x2e, 0xa8, 0x96, 0xe7, 0xee, 0xda, 0x0d, 0x12, 0xf7, 0xfd, 0x6c, 0xf1, 0x62, 0x2c, 0xe1, 0x83,
-	0x39, 0x9f, 0xff, 0x22, 0x0b, 0xa7, 0x36, 0xeb, 0xb6, 0x73, 0x3b, 0xe6, 0xb5, 0x4d, 0xca, 0xb7,
-	0x69, 0x0c, 0x9b, 0x6f, 0x33, 0x7c, 0xa4, 0x21, 0x12, 0x9a, 0x26, 0x3f, 0xd2, 0x90, 0xd9, 0x4e,
-	0xa3, 0xb8, 0xe8, 0x07, 0x06, 0x3c, 0x68, 0xd5, 0xb8, 0x15, 0x69, 0x35, 0x44, 0x69, 0xc8, 0x54,
-	0xee, 0x68, 0x7f, 0x44, 0x9d, 0xd0, 0xdb, 0xf9, 0xe5, 0xd2, 0x11, 0x5c, 0xf9, 0x8c, 0xbf, 0x4d,
-	0xf4, 0xe0, 0xc1, 0xa3, 0x50, 0xf1, 0x91, 0xcd, 0x5f, 0xba, 0x02, 0x6f, 0xbd, 0x2b, 0xa3, 0xa1,
-	0x56, 0xcb, 0x27, 0x0d, 0x28, 0x70, 0xa7, 0x24, 0x26, 0xbb, 0x54, 0x54, 0x58, 0x2d, 0xfb, 0x3a,
-	0xf1, 0x7c, 0x99, 0x08, 0x48, 0x3b, 0x68, 0x95, 0xb6, 0xd6, 0x04, 0x04, 0x6b, 0x58, 0x54, 0x18,
-	0xef, 0xdb, 0x4e, 0x4d, 0x4c, 0x93, 0x12, 0xc6, 0xcf, 0xd9, 0x4e, 0x0d, 0x33, 0x88, 0x12, 0xd7,
-	0xd9, 0xbe, 0x59, 0x39, 0xbe, 0x6c, 0xc0, 0x0c, 0x7b, 0x83, 0x15, 0x1e, 0x01, 0x9e, 0x54, 0x51,
-	0x08, 0xbc, 0x19, 0x67, 0xa3, 0x51, 0x08, 0x77, 0x3a, 0xc5, 0x49, 0xfe, 0x6a, 0x2b, 0x1a, 0x94,
-	0xf0, 0x41, 0xe1, 0x37, 0x60, 0xb1, 0x12, 0x99, 0xa1, 0x8f, 0xb5, 0xca, 0x4b, 0x56, 0x91, 0x44,
-	0x70, 0x48, 0xcf, 0x7c, 0x09, 0xa6, 0xf4, 0x10, 0x73, 0xf4, 0x24, 0x4c, 0xb6, 0x6c, 0xa7, 0x1e,
-	0x7d, 0x8a, 0xa4, 0x3c, 0xa5, 0x5b, 0x21, 0x08, 0xeb, 0x78, 0xac, 0x9a, 0x1b, 0x56, 0x8b, 0x39,
-	0x58, 0xb7, 0x5c, 0xbd, 0x5a, 0xf8, 0xc7, 0xfc, 0xc3, 0x2c, 0x9c, 0x4a, 0x78, 0xca, 0x80, 0x5e,
-	0x35, 0x60, 0x9c, 0xc5, 0x55, 0xcb, 0x38, 0x83, 0x17, 0x52, 0x7f, 0x2e, 0xb1, 0xcc, 0xc2, 0xb7,
-	0xc5, 0x3a, 0x56, 0xe2, 0x93, 0x17, 0x62, 0xc1, 0x1c, 0xfd, 0xa6, 0x01, 0x93, 0x96, 0xb6, 0xd5,
-	0x78, 0xe8, 0xc5, 0x4e, 0xfa, 0x8d, 0xe9, 0xd9, 0x59, 0x5a, 0xc8, 0x58, 0xb8, 0x91, 0xf4, 0xb6,
-	0x2c, 0xbd, 0x07, 0x26, 0xb5, 0x2e, 0x0c, 0xb3, 0x43, 0x96, 0x9e, 0x81, 0xb9, 0x91, 0x76, 0xd8,
-	0x07, 0x60, 0xd8, 0xbc, 0x56, 0x54, 0x61, 0xdd, 0xd2, 0x1f, 0x46, 0xaa, 0x11, 0x17, 0x2f, 0x23,
-	0x05, 0xd4, 0xdc, 0x81, 0xb9, 0xf8, 0x21, 0x27, 0xf5, 0x9b, 0xc6, 0x77, 0xc1, 0x90, 0x99, 0xa8,
-	0xcc, 0xbf, 0xcc, 0xc0, 0x84, 0x78, 0x0f, 0x75, 0x0f, 0xa2, 0x2d, 0xf7, 0x23, 0x57, 0x25, 0x6b,
-	0xa9, 0x3c, 0xe3, 0xea, 0x1b, 0x6a, 0xe9, 0xc7, 0x42, 0x2d, 0x9f, 0x4b, 0x87, 0xdd, 0xd1, 0x71,
-	0x96, 0x5f, 0x1e, 0x83, 0xd9, 0xd8, 0xfb, 0x32, 0x6a, 0xaa, 0xf4, 0x84, 0x17, 0x5d, 0x4b, 0xf5,
-	0x09, 0x9b, 0x8a, 0x04, 0x3e, 0x3a, 0xd2, 0xc8, 0x8f, 0x24, 0xfc, 0xbb, 0x9a, 0x5a, 0xae, 0xe0,
-	0x9f, 0xe6, 0xfe, 0x1b, 0x36, 0x72, 0xe6, 0x9f, 0x0d, 0xb8, 0xbf, 0xef, 0x33, 0x44, 0x96, 0xaf,
-	0xc1, 0x8b, 0x42, 0xc5, 0x86, 0x4c, 0xf9, 0x59, 0xb1, 0xba, 0xb7, 0x88, 0x3f, 0xb1, 0x8f, 0xb3,
-	0x47, 0x4f, 0xc0, 0x14, 0x53, 0xad, 0x54, 0xa6, 0x04, 0xa4, 0x25, 0x1c, 0xb5, 0xcc, 0x65, 0x57,
-	0xd1, 0xca, 0x71, 0x04, 0xcb, 0xfc, 0x92, 0x01, 0x8b, 0xfd, 0x5e, 0xef, 0x0f, 0x70, 0x30, 0xfc,
-	0xb9, 0x58, 0x38, 0x68, 0xb1, 0x27, 0x1c, 0x34, 0x76, 0x34, 0x94, 0x91, 0x9f, 0xda, 0xa9, 0x2c,
-	0x7b, 0x97, 0x68, 0xc7, 0xcf, 0x18, 0x70, 0xa6, 0xcf, 0x6e, 0xea, 0x09, 0x0b, 0x36, 0x8e, 0x1d,
-	0x16, 0x9c, 0x19, 0x34, 0x2c, 0xd8, 0xfc, 0xeb, 0x2c, 0xcc, 0x89, 0xf6, 0x84, 0xf6, 0xd5, 0x53,
-	0x91, 0xa0, 0xda, 0xb7, 0xc5, 0x82, 0x6a, 0x17, 0xe2, 0xf8, 0x3f, 0x8d, 0xa8, 0x7d, 0x73, 0x45,
-	0xd4, 0xfe, 0x38, 0x03, 0xa7, 0x13, 0x93, 0x0a, 0xa0, 0x4f, 0x25, 0xa8, 0x86, 0x1b, 0x29, 0x67,
-	0x2f, 0x18, 0x50, 0x39, 0x8c, 0x1a, 0x86, 0xfa, 0x79, 0x3d, 0xfc, 0x93, 0x8b, 0xfa, 0xdd, 0x13,
-	0xc8, 0xc3, 0x30, 0x64, 0x24, 0xa8, 0xf9, 0xab, 0x59, 0x78, 0x74, 0x50, 0x42, 0x6f, 0xd2, 0x97,
-	0x02, 0x7e, 0xe4, 0xa5, 0xc0, 0x3d, 0x52, 0xdb, 0x27, 0xf2, 0x68, 0xe0, 0x2b, 0x59, 0xa5, 0xf6,
-	0x7a, 0xd7, 0xe7, 0x40, 0xb7, 0x7a, 0x13, 0xd4, 0xb4, 0x93, 0xa9, 0x06, 0x43, 0x51, 0x38, 0x51,
-	0xe1, 0xc5, 0x77, 0x3a, 0xc5, 0x79, 0x91, 0x7e, 0xac, 0x42, 0x02, 0x51, 0x88, 0x65, 0x25, 0xf4,
-	0x28, 0xe4, 0x3d, 0x0e, 0x95, 0xb1, 0xd1, 0xe2, 0x6a, 0x94, 0x97, 0x61, 0x05, 0x45, 0x9f, 0xd0,
-	0x6c, 0xe1, 0xb1, 0x93, 0x7a, 0xd7, 0x7e, 0xd4, 0x8d, 0xef, 0x0b, 0x90, 0xf7, 0x65, 0xd2, 0x40,
-	0xee, 0x96, 0x7f, 0x7c, 0xc0, 0x90, 0x7b, 0x7a, 0x74, 0x92, 0x19, 0x04, 0x79, 0xff, 0x54, 0x7e,
-	0x41, 0x45, 0x12, 0x99, 0xea, 0xd4, 0xc2, 0x7d, 0x8c, 0x90, 0x70, 0x62, 0xf9, 0xae, 0x01, 0x93,
-	0x62, 0xb6, 0xee, 0xc1, 0x2b, 0x80, 0x9b, 0xd1, 0x57, 0x00, 0x17, 0x52, 0x91, 0x1d, 0x7d, 0x9e,
-	0x00, 0xdc, 0x84, 0x29, 0x3d, 0xaf, 0x0c, 0x7a, 0x5e, 0x93, 0x7d, 0xc6, 0x28, 0xf9, 0x2b, 0xa4,
-	0x74, 0x0c, 0xe5, 0xa2, 0xf9, 0xc5, 0xbc, 0x1a, 0x45, 0xe6, 0x87, 0xd0, 0xd7, 0xa0, 0x71, 0xe4,
-	0x1a, 0xd4, 0x97, 0x40, 0x26, 0xfd, 0x25, 0x70, 0x15, 0xf2, 0x52, 0x40, 0x09, 0x35, 0xfe, 0xb0,
-	0x1e, 0xbb, 0x46, 0x6d, 0x01, 0x4a, 0x4c, 0x5b, 0xb8, 0xec, 0xa8, 0xa5, 0xe6, 0x50, 0x09, 0x4e,
-	0x45, 0x06, 0xbd, 0x08, 0x93, 0xb7, 0x5c, 0x6f, 0xbf, 0xe1, 0x5a, 0x2c, 0x1d, 0x28, 0xa4, 0x71,
-	0xc1, 0xa2, 0x1c, 0x5e, 0x3c, 0x44, 0xfa, 0x46, 0x48, 0x1f, 0xeb, 0xcc, 0x50, 0x09, 0x66, 0x9b,
-	0xb6, 0x83, 0x89, 0x55, 0x53, 0xc1, 0xfe, 0x63, 0x3c, 0x5f, 0xa1, 0x34, 0x72, 0x37, 0xa2, 0x60,
-	0x1c, 0xc7, 0x47, 0x1f, 0x83, 0xbc, 0x2f, 0x72, 0xd7, 0xa4, 0x73, 0x15, 0xa6, 0xce, 0x8c, 0x9c,
-	0x68, 0x38, 0x76, 0xb2, 0x04, 0x2b, 0x86, 0x68, 0x1d, 0x16, 0x3c, 0x91, 0x1d, 0x22, 0xf2, 0x31,
-	0x01, 0xbe, 0x3f, 0x59, 0x5a, 0x3c, 0x9c, 0x00, 0xc7, 0x89, 0xb5, 0xa8, 0x15, 0xc3, 0x12, 0x24,
-	0xf1, 0x3b, 0x01, 0xcd, 0x8d, 0xce, 0x16, 0x7c, 0x0d, 0x0b, 0xe8, 0x51, 0x8f, 0x47, 0xf2, 0x23,
-	0x3c, 0x1e, 0xa9, 0xc0, 0xe9, 0x38, 0x88, 0xe5, 0xb0, 0x60, 0x69, 0x33, 0x34, 0xed, 0xb1, 0x95,
-	0x84, 0x84, 0x93, 0xeb, 0xa2, 0x1b, 0x50, 0xf0, 0x08, 0x3b, 0x5f, 0x94, 0xe4, 0xe5, 0xfb, 0xd0,
-	0x61, 0x46, 0x58, 0x12, 0xc0, 0x21, 0x2d, 0x3a, 0xef, 0x56, 0x34, 0x65, 0xdf, 0xd5, 0x14, 0x3f,
-	0x87, 0x24, 0xe6, 0xbe, 0x4f, 0x6e, 0x19, 0xf3, 0x8d, 0x19, 0x98, 0x8e, 0xf8, 0x16, 0xd0, 0xc3,
-	0x90, 0x63, 0x49, 0x3d, 0x98, 0x78, 0xc8, 0x87, 0x22, 0x8c, 0x0f, 0x0e, 0x87, 0xa1, 0xcf, 0x1a,
-	0x30, 0xdb, 0x8a, 0x78, 0x61, 0xa5, 0xe4, 0x1c, 0xf1, 0x9e, 0x2f, 0xea, 0xda, 0xd5, 0x92, 0xdd,
-	0x46, 0x99, 0xe1, 0x38, 0x77, 0xba, 0x01, 0x45, 0xe4, 0x5d, 0x83, 0x78, 0x0c, 0x5b, 0xd8, 0x38,
-	0x8a, 0xc4, 0x4a, 0x14, 0x8c, 0xe3, 0xf8, 0x74, 0x86, 0x59, 0xef, 0x46, 0xf9, 0x4e, 0x4a, 0x49,
-	0x12, 0xc0, 0x21, 0x2d, 0xf4, 0x0c, 0xcc, 0x88, 0x4c, 0x6d, 0x5b, 0x6e, 0xed, 0x92, 0xe5, 0xef,
-	0x09, 0xe3, 0x5e, 0x1d, 0x46, 0x56, 0x22, 0x50, 0x1c, 0xc3, 0x66, 0x7d, 0x0b, 0xd3, 0xe1, 0x31,
-	0x02, 0xe3, 0xd1, 0x5c, 0xc0, 0x2b, 0x51, 0x30, 0x8e, 0xe3, 0xa3, 0x77, 0x68, 0x72, 0x9f, 0xdf,
-	0xd3, 0x29, 0x69, 0x90, 0x20, 0xfb, 0x4b, 0x30, 0xdb, 0x66, 0x67, 0xa1, 0x9a, 0x04, 0x8a, 0xfd,
-	0xa8, 0x18, 0x5e, 0x8b, 0x82, 0x71, 0x1c, 0x1f, 0x3d, 0x0d, 0xd3, 0x1e, 0x95, 0x6e, 0x8a, 0x00,
-	0xbf, 0xbc, 0x53, 0x77, 0x33, 0x58, 0x07, 0xe2, 0x28, 0x2e, 0x7a, 0x16, 0xe6, 0xc3, 0x74, 0x4f,
-	0x92, 0x00, 0xbf, 0xcd, 0x53, 0x99, 0x4c, 0x4a, 0x71, 0x04, 0xdc, 0x5b, 0x07, 0xfd, 0x02, 0xcc,
-	0x69, 0x23, 0xb1, 0xe6, 0xd4, 0xc8, 0x6d, 0x91, 0x92, 0x87, 0xa5, 0x6d, 0x5f, 0x89, 0xc1, 0x70,
-	0x0f, 0x36, 0x7a, 0x2f, 0xcc, 0x54, 0xdd, 0x46, 0x83, 0xc9, 0x38, 0x9e, 0x87, 0x96, 0xe7, 0xde,
-	0xe1, 0x59, 0x8a, 0x22, 0x10, 0x1c, 0xc3, 0x44, 0x97, 0x01, 0xb9, 0x3b, 0x3e, 0xf1, 0x0e, 0x48,
-	0xed, 0x59, 0xfe, 0xe5, 0x45, 0xaa, 0xe2, 0xa7, 0xa3, 0x71, 0xbf, 0x57, 0x7a, 0x30, 0x70, 0x42,
-	0x2d, 0x96, 0x08, 0x45, 0x7b, 0x83, 0x33, 0x93, 0xc6, 0x37, 0x43, 0xe2, 0x27, 0xf7, 0xbb, 0x3e,
-	0xc0, 0xf1, 0x60, 0x9c, 0x87, 0x61, 0xa7, 0x93, 0x84, 0x47, 0x4f, 0x49, 0x19, 0xea, 0x08, 0x5e,
-	0x8a, 0x05, 0x27, 0xf4, 0x71, 0x28, 0xec, 0xc8, 0xfc, 0xc4, 0x8b, 0x73, 0x69, 0xe8, 0xc5, 0x58,
-	0xaa, 0xed, 0xf0, 0x64, 0xaa, 0x00, 0x38, 0x64, 0x89, 0x1e, 0x81, 0xc9, 0x4b, 0x5b, 0x25, 0xb5,
-	0x0a, 0xe7, 0xd9, 0xec, 0x8f, 0xd1, 0x2a, 0x58, 0x07, 0xd0, 0x1d, 0xa6, 0xec, 0x25, 0xc4, 0xa6,
-	0x38, 0xd4, 0xb7, 0xbd, 0xe6, 0x0f, 0xc5, 0x66, 0xd7, 0x91, 0xb8, 0xb2, 0x78, 0x2a, 0x86, 0x2d,
-	0xca, 0xb1, 0xc2, 0x40, 0x2f, 0xc0, 0xa4, 0xd0, 0x17, 0x4c, 0x36, 0x2d, 0x1c, 0xef, 0x7d, 0x17,
-	0x0e, 0x49, 0x60, 0x9d, 0x1e, 0xbb, 0x65, 0x62, 0x69, 0x5b, 0xc9, 0xc5, 0x76, 0xa3, 0xb1, 0x78,
-	0x9a, 0xc9, 0xcd, 0xf0, 0x96, 0x29, 0x04, 0x61, 0x1d, 0x0f, 0x3d, 0x2e, 0x23, 0x27, 0xde, 0x12,
-	0xb9, 0x76, 0x53, 0x91, 0x13, 0xca, 0xca, 0xed, 0x13, 0xd8, 0x7b, 0xe6, 0x2e, 0x21, 0x0b, 0x3b,
-	0xb0, 0x24, 0x4d, 0xac, 0xde, 0x4d, 0xb2, 0xb8, 0x18, 0xf1, 0x12, 0x2c, 0xdd, 0xe8, 0x8b, 0x89,
-	0x8f, 0xa0, 0x82, 0x76, 0x20, 0x6b, 0x35, 0x76, 0x16, 0xef, 0x4f, 0xc3, 0x56, 0x54, 0x5f, 0x52,
-	0xe5, 0xc1, 0x38, 0xa5, 0xf5, 0x32, 0xa6, 0xc4, 0xcd, 0x57, 0x32, 0xca, 0x2b, 0xaf, 0x92, 0x13,
-	0xbe, 0xa4, 0xaf, 0x6a, 0x23, 0x8d, 0x2f, 0x05, 0xf6, 0x24, 0xf1, 0xe6, 0x0a, 0x29, 0x71, 0x4d,
-	0xb7, 0xd4, 0x3e, 0x4e, 0x25, 0xf3, 0x44, 0x34, 0xf1, 0x22, 0x3f, 0xcd, 0x45, 0x77, 0xb1, 0xf9,
-	0xbd, 0x71, 0xe5, 0x84, 0x8a, 0x85, 0x02, 0x78, 0x90, 0xb3, 0xfd, 0xc0, 0x76, 0x53, 0x7c, 0xb6,
-	0x15, 0xcb, 0x58, 0xc8, 0x02, 0x58, 0x19, 0x00, 0x73, 0x56, 0x94, 0xa7, 0x53, 0xb7, 0x9d, 0xdb,
-	0xa2, 0xfb, 0x57, 0x53, 0xbf, 0xe3, 0xe7, 0x3c, 0x19, 0x00, 0x73, 0x56, 0xe8, 0x26, 0x5f, 0x69,
-	0xe9, 0x7c, 0x15, 0x32, 0xfe, 0xb1, 0xd7, 0xe8, 0x8a, 0xa3, 0xbc, 0xfc, 0xa6, 0x2d, 0x6c, 0x98,
-	0x11, 0x79, 0x55, 0x36, 0xd6, 0x92, 0x78, 0x55, 0x36, 0xd6, 0x30, 0x65, 0x82, 0x5e, 0x33, 0x00,
-	0x2c, 0xf5, 0xd5, 0xd3, 0x74, 0x32, 0xde, 0xf7, 0xfb, 0x8a, 0x2a, 0x8f, 0x39, 0x0b, 0xa1, 0x58,
-	0xe3, 0x8c, 0x5e, 0x84, 0x09, 0x8b, 0x7f, 0xaf, 0x43, 0x84, 0xf3, 0xa5, 0xf3, 0x11, 0x9a, 0x58,
-	0x0b, 0x58, 0x1c, 0xa3, 0x00, 0x61, 0xc9, 0x90, 0xf2, 0x0e, 0x3c, 0x8b, 0xec, 0xda, 0xfb, 0x22,
-	0xae, 0xaf, 0x32, 0x72, 0xda, 0x5d, 0x4a, 0x2c, 0x89, 0xb7, 0x00, 0x61, 0xc9, 0xd0, 0xfc, 0x37,
-	0x03, 0xb4, 0x4f, 0xe4, 0x85, 0x81, 0x5e, 0xc6, 0xc0, 0x81, 0x5e, 0x99, 0x21, 0x03, 0xbd, 0xb2,
-	0x43, 0x05, 0x7a, 0x8d, 0x0d, 0x1f, 0xe8, 0x95, 0xeb, 0x1f, 0xe8, 0x65, 0xbe, 0x6e, 0xc0, 0x7c,
-	0xcf, 0x9a, 0x8c, 0x7f, 0x8a, 0xd8, 0x18, 0xf0, 0x53, 0xc4, 0xab, 0x30, 0x27, 0x52, 0x97, 0x56,
-	0x5a, 0x0d, 0x3b, 0xf1, 0x85, 0xeb, 0x76, 0x0c, 0x8e, 0x7b, 0x6a, 0x98, 0x7f, 0x6a, 0xc0, 0xa4,
-	0xf6, 0x20, 0x87, 0xf6, 0x83, 0x3d, 0x5c, 0x12, 0xcd, 0x08, 0xb3, 0xb6, 0x32, 0xf7, 0x2a, 0x87,
-	0x71, 0x4f, 0x7f, 0x5d, 0x4b, 0x93, 0x17, 0x7a, 0xfa, 0x69, 0x29, 0x16, 0x50, 0x9e, 0x00, 0x8d,
-	0xf0, 0xcf, 0x4c, 0x67, 0xf5, 0x04, 0x68, 0xa4, 0x85, 0x19, 0x84, 0xb1, 0xa3, 0xba, 0x5c, 0xc4,
-	0x00, 0x6a, 0x49, 0x62, 0x2d, 0x7a, 0x62, 0x63, 0x30, 0x74, 0x16, 0xb2, 0xc4, 0xa9, 0x89, 0x83,
-	0x87, 0xfa, 0x04, 0xc9, 0x05, 0xa7, 0x86, 0x69, 0xb9, 0x79, 0x05, 0xa6, 0x2a, 0xa4, 0xea, 0x91,
-	0xe0, 0x39, 0x72, 0x38, 0xf0, 0x37, 0x4d, 0xf6, 0xc9, 0x61, 0xfc, 0x9b, 0x26, 0xb4, 0x3a, 0x2d,
-	0x37, 0x7f, 0xdf, 0x80, 0x58, 0xce, 0x5e, 0xcd, 0xeb, 0x67, 0xf4, 0xf3, 0xfa, 0x45, 0xfc, 0x53,
-	0x99, 0x23, 0xfd, 0x53, 0x97, 0x01, 0x35, 0xad, 0xa0, 0xba, 0x17, 0xc9, 0x50, 0x2d, 0xce, 0x7c,
-	0xe1, 0xf3, 0xbf, 0x1e, 0x0c, 0x9c, 0x50, 0xcb, 0x7c, 0xd9, 0x80, 0x9e, 0xaf, 0x44, 0x53, 0x4b,
-	0x85, 0x88, 0xcf, 0x45, 0xf0, 0xa3, 0xb0, 0xb2, 0x54, 0xe4, 0x57, 0x22, 0x24, 0x9c, 0x9e, 0x97,
-	0xa4, 0xc7, 0x4d, 0xfa, 0x2f, 0xf8, 0x43, 0x29, 0x75, 0x5e, 0x5a, 0x8d, 0x82, 0x71, 0x1c, 0xdf,
-	0xbc, 0x0e, 0x79, 0xf9, 0x9a, 0x94, 0x3d, 0xc9, 0x92, 0x27, 0x70, 0xfd, 0x49, 0x16, 0x3d, 0x80,
-	0x33, 0x08, 0x1d, 0x26, 0xdf, 0xb1, 0x2f, 0xb9, 0x7e, 0x20, 0x9f, 0xc0, 0x72, 0x3f, 0xdb, 0xe6,
-	0x1a, 0x2b, 0xc3, 0x0a, 0x6a, 0xce, 0xc3, 0xac, 0x72, 0xa0, 0x89, 0xe8, 0xa0, 0x6f, 0x65, 0x61,
-	0x2a, 0xf2, 0xed, 0xbf, 0xbb, 0x4f, 0xf6, 0xe0, 0xd3, 0x92, 0xe0, 0x08, 0xcb, 0x0e, 0xe9, 0x08,
-	0xd3, 0x3d, 0x8f, 0x63, 0x27, 0xeb, 0x79, 0xcc, 0xa5, 0xe3, 0x79, 0x0c, 0x60, 0x42, 0x7c, 0x17,
-	0x5d, 0xa8, 0x9e, 0x8d, 0x94, 0x52, 0x41, 0x88, 0x37, 0xd5, 0x4c, 0xf0, 0x4b, 0x01, 0x26, 0x59,
-	0x99, 0x5f, 0xcf, 0xc1, 0x4c, 0x34, 0x39, 0xc4, 0x00, 0x33, 0xf9, 0x8e, 0x9e, 0x99, 0x1c, 0xd2,
-	0x11, 0x90, 0x1d, 0xd5, 0x11, 0x30, 0x36, 0xaa, 0x23, 0x20, 0x77, 0x0c, 0x47, 0x40, 0xef, 0x31,
-	0x7e, 0x7c, 0xe0, 0x63, 0xfc, 0xfb, 0xd4, 0x2d, 0xf6, 0x44, 0xe4, 0xda, 0x27, 0xbc, 0xc5, 0x46,
-	0xd1, 0x69, 0x58, 0x71, 0x6b, 0x89, 0xd1, 0x00, 0xf9, 0xbb, 0x1c, 0x78, 0xbc, 0xc4, 0x4b, 0xe7,
-	0xe1, 0x7d, 0x8d, 0x6f, 0x19, 0xe2, 0xc2, 0x39, 0xfc, 0xf4, 0x3f, 0x53, 0x7e, 0x10, 0x55, 0x9c,
-	0x95, 0x10, 0x84, 0x75, 0x3c, 0xf6, 0x79, 0xaa, 0xe8, 0xf7, 0xb8, 0x98, 0x5f, 0x45, 0xff, 0x3c,
-	0x55, 0xec, 0xfb, 0x5d, 0x71, 0x7c, 0xf3, 0x63, 0x70, 0x3a, 0xd1, 0xcc, 0x61, 0xe7, 0x3e, 0x26,
-	0x97, 0x49, 0x4d, 0x20, 0x68, 0xcd, 0x88, 0xe5, 0x0e, 0x5c, 0xba, 0xd1, 0x17, 0x13, 0x1f, 0x41,
-	0xc5, 0xfc, 0x5a, 0x16, 0x66, 0xa2, 0xdf, 0x36, 0x40, 0xb7, 0xd4, 0xa1, 0x28, 0x95, 0xf3, 0x18,
-	0x27, 0xab, 0xe5, 0x66, 0xe8, 0xeb, 0xe1, 0xe0, 0x5f, 0xc3, 0xdf, 0x51, 0x89, 0x22, 0x4e, 0x8e,
-	0xb1, 0x70, 0x2d, 0x08, 0x76, 0xec, 0xf3, 0x05, 0x61, 0x0c, 0xb1, 0xb8, 0x36, 0x4f, 0x9d, 0x7b,
-	0x18, 0x15, 0xac, 0x58, 0x61, 0x8d, 0x2d, 0xd5, 0x2d, 0x07, 0xc4, 0xb3, 0x77, 0x6d, 0xf5, 0x5d,
-	0x26, 0x26, 0xb9, 0xaf, 0x8b, 0x32, 0xac, 0xa0, 0xe6, 0xcb, 0x19, 0x08, 0xbf, 0x42, 0xc7, 0xd2,
-	0xa2, 0xfb, 0x9a, 0xcd, 0x22, 0xa6, 0xed, 0xf2, 0xa8, 0xdf, 0x1e, 0x08, 0x29, 0x8a, 0x08, 0x23,
-	0xad, 0x04, 0x47, 0x38, 0xfe, 0x04, 0xbe, 0x3e, 0x67, 0xc1, 0x6c, 0xec, 0x85, 0x53, 0xea, 0x61,
-	0x9c, 0x5f, 0xcc, 0x42, 0x41, 0xbd, 0x11, 0x43, 0xef, 0x61, 0xb9, 0x85, 0xf7, 0x5c, 0x99, 0xf1,
-	0xf9, 0xad, 0x5a, 0x06, 0xe0, 0x3d, 0xb7, 0x76, 0xa7, 0x53, 0x9c, 0x55, 0xc8, 0xbc, 0x08, 0x8b,
-	0x0a, 0xd4, 0x42, 0x6c, 0x7b, 0x8d, 0xb8, 0x85, 0x78, 0x0d, 0xaf, 0x63, 0x5a, 0x8e, 0x6e, 0xc3,
-	0xc4, 0x1e, 0xb1, 0x6a, 0xc4, 0x93, 0x01, 0x1b, 0x1b, 0x29, 0xbd, 0x6b, 0xbb, 0xc4, 0xa8, 0x86,
-	0xc3, 0xc0, 0xff, 0xfb, 0x58, 0xb2, 0xa3, 0x5a, 0x72, 0xc7, 0xad, 0x1d, 0xc6, 0x33, 0x06, 0x97,
-	0xdd, 0xda, 0x21, 0x66, 0x10, 0xf4, 0x0c, 0xcc, 0x04, 0x76, 0x93, 0xb8, 0xed, 0x40, 0xff, 0xc6,
-	0x57, 0x36, 0xf4, 0xd8, 0x6f, 0x47, 0xa0, 0x38, 0x86, 0x4d, 0xb5, 0xec, 0x4d, 0xdf, 0x75, 0x58,
-	0x1a, 0xa0, 0xf1, 0xa8, 0x7b, 0xef, 0x72, 0xe5, 0xca, 0x26, 0xcb, 0x02, 0xa4, 0x30, 0x28, 0xb6,
-	0xcd, 0x1e, 0xa2, 0x78, 0x44, 0x5c, 0x98, 0xcd, 0x85, 0xcf, 0x85, 0x79, 0x39, 0x56, 0x18, 0xe6,
-	0x35, 0x98, 0x8d, 0x75, 0x55, 0xda, 0xe2, 0x46, 0xb2, 0x2d, 0x3e, 0x58, 0x7a, 0xde, 0x3f, 0x32,
-	0x60, 0xbe, 0x67, 0xf3, 0x0e, 0x1a, 0x5f, 0x1c, 0x57, 0x23, 0x99, 0xe3, 0xab, 0x91, 0xec, 0x70,
-	0x6a, 0xa4, 0xbc, 0xfc, 0xed, 0x37, 0xce, 0xdd, 0xf7, 0x9d, 0x37, 0xce, 0xdd, 0xf7, 0xbd, 0x37,
-	0xce, 0xdd, 0xf7, 0x72, 0xf7, 0x9c, 0xf1, 0xed, 0xee, 0x39, 0xe3, 0x3b, 0xdd, 0x73, 0xc6, 0xf7,
-	0xba, 0xe7, 0x8c, 0x7f, 0xea, 0x9e, 0x33, 0x5e, 0xff, 0xe1, 0xb9, 0xfb, 0x9e, 0xcf, 0xcb, 0x65,
-	0xf2, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x41, 0xfc, 0x5a, 0xcc, 0x8a, 0x00, 0x00,
+	// 8374 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x6d, 0x6c, 0x24, 0xc9,
+	0x75, 0xd8, 0xf5, 0x0c, 0x87, 0x1c, 0x16, 0xb9, 0xfc, 0xa8, 0xdd, 0xbd, 0x9d, 0xe3, 0xdd, 0x2e,
+	0x57, 0x7d, 0x86, 0x72, 0x4e, 0x24, 0xae, 0xb5, 0x3a, 0x25, 0xb2, 0x4f, 0xb9, 0x64, 0x86, 0xdc,
+	0xbd, 0xe3, 0x1d, 0xb9, 0x3b, 0xfb, 0x86, 0xbb, 0x2b, 0x9d, 0x2c, 0xc5, 0xcd, 0x99, 0xe2, 0xb0,
+	0x77, 0x7b, 0xba, 0xe7, 0xba, 0x7b, 0xb8, 0xcb, 0xd3, 0x41, 0x92, 0x23, 0x48, 0x71, 0x02, 0x09,
+	0x56, 0x6c, 0x0b, 0x49, 0x10, 0x24, 0x30, 0x02, 0x23, 0x71, 0x2c, 0xff, 0x08, 0x0c, 0x07, 0xfe,
+	0x11, 0x03, 0x0e, 0x62, 0x19, 0x91, 0x7f, 0x24, 0x50, 0x80, 0x24, 0x92, 0x93, 0x98, 0x89, 0xe8,
+	0xfc, 0x49, 0x90, 0xc0, 0x48, 0xe0, 0x20, 0xf0, 0xfe, 0x0a, 0xea, 0xb3, 0xab, 0xba, 0x7b, 0xb8,
+	0x33, 0x9c, 0xe6, 0x5a, 0x88, 0xf5, 0x8b, 0x9c, 0xf7, 0x5e, 0xbd, 0x57, 0xd5, 0x5d, 0x55, 0xef,
+	0xd5, 0xab, 0xf7, 0x5e, 0xa3, 0xad, 0xae, 0x1b, 0xef, 0x0f, 0x76, 0xd7, 0xda, 0x41, 0xef, 0x9a,
+	0x13, 0x76, 0x83, 0x7e, 0x18, 0x3c, 0x60, 0xff, 0x7c, 0x38, 0x0c, 0x3c, 0x2f, 0x18, 0xc4, 0xd1,
+	0xb5, 0xfe, 0xc3, 0xee, 0x35, 0xa7, 0xef, 0x46, 0xd7, 0x14, 0xe4, 0xe0, 0x23, 0x8e, 0xd7, 0xdf,
+	0x77, 0x3e, 0x72, 0xad, 0x4b, 0x7c, 0x12, 0x3a, 0x31, 0xe9, 0xac, 0xf5, 0xc3, 0x20, 0x0e, 0xf0,
+	0x27, 0x12, 0x6e, 0x6b, 0x92, 0x1b, 0xfb, 0xe7, 0xaf, 0xc8, 0xb6, 0x6b, 0xfd, 0x87, 0xdd, 0x35,
+	0xca, 0x6d, 0x4d, 0x41, 0x24, 0xb7, 0x95, 0x0f, 0x6b, 0x7d, 0xe9, 0x06, 0xdd, 0xe0, 0x1a, 0x63,
+	0xba, 0x3b, 0xd8, 0x63, 0xbf, 0xd8, 0x0f, 0xf6, 0x1f, 0x17, 0xb6, 0xf2, 0xf2, 0xc3, 0x8f, 0x47,
+	0x6b, 0x6e, 0x40, 0xfb, 0x76, 0x6d, 0xd7, 0x89, 0xdb, 0xfb, 0xd7, 0x0e, 0x32, 0x3d, 0x5a, 0xb1,
+	0x35, 0xa2, 0x76, 0x10, 0x92, 0x3c, 0x9a, 0x57, 0x13, 0x9a, 0x9e, 0xd3, 0xde, 0x77, 0x7d, 0x12,
+	0x1e, 0x26, 0xa3, 0xee, 0x91, 0xd8, 0xc9, 0x6b, 0x75, 0x6d, 0x58, 0xab, 0x70, 0xe0, 0xc7, 0x6e,
+	0x8f, 0x64, 0x1a, 0xfc, 0xf9, 0xa7, 0x35, 0x88, 0xda, 0xfb, 0xa4, 0xe7, 0x64, 0xda, 0x7d, 0x74,
+	0x58, 0xbb, 0x41, 0xec, 0x7a, 0xd7, 0x5c, 0x3f, 0x8e, 0xe2, 0x30, 0xdd, 0xc8, 0xfe, 0x56, 0x19,
+	0xcd, 0xd6, 0xb7, 0x1a, 0xad, 0xd8, 0x89, 0x07, 0x11, 0xfe, 0x8a, 0x85, 0xe6, 0xbd, 0xc0, 0xe9,
+	0x34, 0x1c, 0xcf, 0xf1, 0xdb, 0x24, 0xac, 0x59, 0x57, 0xad, 0x57, 0xe6, 0xae, 0x6f, 0xad, 0x4d,
+	0xf2, 0xbe, 0xd6, 0xea, 0x8f, 0x22, 0x20, 0x51, 0x30, 0x08, 0xdb, 0x04, 0xc8, 0x5e, 0xe3, 0xc2,
+	0xb7, 0x8f, 0x56, 0x9f, 0x3b, 0x3e, 0x5a, 0x9d, 0xdf, 0xd2, 0x24, 0x81, 0x21, 0x17, 0x7f, 0xc3,
+	0x42, 0xcb, 0x6d, 0xc7, 0x77, 0xc2, 0xc3, 0x1d, 0x27, 0xec, 0x92, 0xf8, 0x8d, 0x30, 0x18, 0xf4,
+	0x6b, 0xa5, 0x33, 0xe8, 0xcd, 0x0b, 0xa2, 0x37, 0xcb, 0xeb, 0x69, 0x71, 0x90, 0xed, 0x01, 0xeb,
+	0x57, 0x14, 0x3b, 0xbb, 0x1e, 0xd1, 0xfb, 0x55, 0x3e, 0xcb, 0x7e, 0xb5, 0xd2, 0xe2, 0x20, 0xdb,
+	0x03, 0xfb, 0xcb, 0x65, 0xb4, 0x5c, 0xdf, 0x6a, 0xec, 0x84, 0xce, 0xde, 0x9e, 0xdb, 0x86, 0x60,
+	0x10, 0xbb, 0x7e, 0x17, 0xff, 0x28, 0x9a, 0x71, 0xfd, 0x6e, 0x48, 0xa2, 0x88, 0xbd, 0xc8, 0xd9,
+	0xc6, 0xa2, 0x60, 0x3a, 0xb3, 0xc9, 0xc1, 0x20, 0xf1, 0xf8, 0x63, 0x68, 0x2e, 0x22, 0xe1, 0x81,
+	0xdb, 0x26, 0xcd, 0x20, 0x8c, 0xd9, 0x93, 0xae, 0x34, 0xce, 0x0b, 0xf2, 0xb9, 0x56, 0x82, 0x02,
+	0x9d, 0x8e, 0x36, 0x0b, 0x83, 0x20, 0x16, 0x78, 0xf6, 0x20, 0x66, 0x93, 0x66, 0x90, 0xa0, 0x40,
+	0xa7, 0xc3, 0x5f, 0xb7, 0xd0, 0x52, 0x14, 0xbb, 0xed, 0x87, 0xae, 0x4f, 0xa2, 0x68, 0x3d, 0xf0,
+	0xf7, 0xdc, 0x6e, 0xad, 0xc2, 0x9e, 0xe2, 0xad, 0xc9, 0x9e, 0x62, 0x2b, 0xc5, 0xb5, 0x71, 0xe1,
+	0xf8, 0x68, 0x75, 0x29, 0x0d, 0x85, 0x8c, 0x74, 0xbc, 0x81, 0x96, 0x1c, 0xdf, 0x0f, 0x62, 0x27,
+	0x76, 0x03, 0xbf, 0x19, 0x92, 0x3d, 0xf7, 0x71, 0x6d, 0x8a, 0x0d, 0xa7, 0x26, 0x86, 0xb3, 0x54,
+	0x4f, 0xe1, 0x21, 0xd3, 0xc2, 0xfe, 0x27, 0x25, 0xb4, 0x50, 0xef, 0x04, 0x7d, 0x0a, 0x12, 0x6b,
+	0xea, 0x75, 0xb4, 0xd0, 0x21, 0x7d, 0x2f, 0x38, 0xec, 0x11, 0x3f, 0xbe, 0xe5, 0xf4, 0x88, 0x78,
+	0x17, 0xcf, 0x0b, 0xb6, 0x0b, 0x1b, 0x06, 0x16, 0x52, 0xd4, 0xb4, 0x7d, 0x48, 0xfa, 0x9e, 0xdb,
+	0x76, 0x5a, 0x84, 0xb7, 0x2f, 0x99, 0xed, 0xc1, 0xc0, 0x42, 0x8a, 0x1a, 0xd7, 0xd1, 0x62, 0x3f,
+	0xe8, 0xec, 0x90, 0x5e, 0xdf, 0x73, 0x62, 0xf2, 0xa6, 0x13, 0xed, 0x8b, 0xd7, 0x74, 0x49, 0x30,
+	0x58, 0x6c, 0x9a, 0x68, 0x48, 0xd3, 0xe3, 0x4f, 0xa3, 0x59, 0x87, 0x0e, 0x8a, 0x74, 0xea, 0x31,
+	0x7b, 0x28, 0x73, 0xd7, 0xff, 0xec, 0x1a, 0xdf, 0x6d, 0xd6, 0xf4, 0xdd, 0x26, 0x79, 0x31, 0x74,
+	0x33, 0x5c, 0x3b, 0xf8, 0xc8, 0xda, 0x8e, 0xdb, 0x23, 0x8d, 0x65, 0x21, 0x68, 0xb6, 0x2e, 0x99,
+	0x40, 0xc2, 0xcf, 0xde, 0x40, 0xb5, 0x7a, 0x6f, 0xd7, 0x89, 0x22, 0xa7, 0x13, 0x84, 0xa9, 0x09,
+	0xfc, 0x0a, 0xaa, 0xf6, 0x9c, 0x7e, 0xdf, 0xf5, 0xbb, 0x74, 0x06, 0x97, 0x5f, 0x99, 0x6d, 0xcc,
+	0x1f, 0x1f, 0xad, 0x56, 0xb7, 0x05, 0x0c, 0x14, 0xd6, 0xfe, 0xbd, 0x12, 0x9a, 0xab, 0xfb, 0x8e,
+	0x77, 0x18, 0xb9, 0x11, 0x0c, 0x7c, 0xfc, 0x53, 0xa8, 0x4a, 0xfb, 0xd0, 0x71, 0x62, 0x47, 0x6c,
+	0x62, 0x3f, 0x36, 0x5a, 0x8f, 0x6f, 0xef, 0x3e, 0x20, 0xed, 0x78, 0x9b, 0xc4, 0x4e, 0x03, 0x8b,
+	0x7e, 0xa3, 0x04, 0x06, 0x8a, 0x2b, 0x0e, 0xd0, 0x54, 0xd4, 0x27, 0x6d, 0xb1, 0x29, 0x6d, 0x4f,
+	0xb8, 0xf8, 0x93, 0xae, 0xb7, 0xfa, 0xa4, 0xdd, 0x98, 0x17, 0xa2, 0xa7, 0xe8, 0x2f, 0x60, 0x82,
+	0xf0, 0x23, 0x34, 0x1d, 0xb1, 0x29, 0x25, 0xf6, 0x9b, 0xdb, 0xc5, 0x89, 0x64, 0x6c, 0x1b, 0x0b,
+	0x42, 0xe8, 0x34, 0xff, 0x0d, 0x42, 0x9c, 0xfd, 0x1f, 0x2c, 0x74, 0x5e, 0xa3, 0xae, 0x87, 0xdd,
+	0x01, 0x9d, 0x9d, 0xf8, 0x2a, 0x9a, 0xf2, 0x93, 0xf9, 0xac, 0xba, 0xcc, 0x66, 0x21, 0xc3, 0xe0,
+	0x97, 0x51, 0xe5, 0xc0, 0xf1, 0x06, 0x72, 0xca, 0x9e, 0x13, 0x24, 0x95, 0x7b, 0x14, 0x08, 0x1c,
+	0x87, 0xdf, 0x47, 0xb3, 0xec, 0x9f, 0x9b, 0x61, 0xd0, 0x2b, 0x68, 0x68, 0xa2, 0x87, 0xf7, 0x24,
+	0xdb, 0xc6, 0x39, 0x3a, 0xfd, 0xd4, 0x4f, 0x48, 0x04, 0xda, 0xff, 0xd9, 0x42, 0x8b, 0xda, 0xe0,
+	0xb6, 0xdc, 0x28, 0xc6, 0x3f, 0x99, 0x99, 0x3c, 0x6b, 0xa3, 0x4d, 0x1e, 0xda, 0x9a, 0x4d, 0x9d,
+	0x25, 0x31, 0xd2, 0xaa, 0x84, 0x68, 0x13, 0xc7, 0x47, 0x15, 0x37, 0x26, 0xbd, 0xa8, 0x56, 0xba,
+	0x5a, 0x7e, 0x65, 0xee, 0xfa, 0x66, 0x61, 0xaf, 0x31, 0x79, 0xbe, 0x9b, 0x94, 0x3f, 0x70, 0x31,
+	0xf6, 0xaf, 0x4d, 0x19, 0x23, 0xa4, 0x33, 0x0a, 0x07, 0x68, 0xa6, 0x47, 0xe2, 0xd0, 0x6d, 0xf3,
+	0x75, 0x35, 0x77, 0x7d, 0x63, 0xb2, 0x5e, 0x6c, 0x33, 0x66, 0x89, 0x7e, 0xe1, 0xbf, 0x23, 0x90,
+	0x52, 0xf0, 0x3e, 0x9a, 0x72, 0xc2, 0xae, 0x1c, 0xf3, 0xcd, 0x62, 0xde, 0x6f, 0x32, 0xe7, 0xea,
+	0x61, 0x37, 0x02, 0x26, 0x01, 0x5f, 0x43, 0xb3, 0x31, 0x09, 0x7b, 0xae, 0xef, 0xc4, 0x5c, 0x21,
+	0x55, 0x93, 0x0d, 0x68, 0x47, 0x22, 0x20, 0xa1, 0xc1, 0x1e, 0x9a, 0xee, 0x84, 0x87, 0x30, 0xf0,
+	0x6b, 0x53, 0x45, 0x3c, 0x8a, 0x0d, 0xc6, 0x2b, 0x59, 0x4c, 0xfc, 0x37, 0x08, 0x19, 0xf8, 0x97,
+	0x2c, 0x74, 0xa1, 0x47, 0x9c, 0x68, 0x10, 0x12, 0x3a, 0x04, 0x20, 0x31, 0xf1, 0xa9, 0xb6, 0xa8,
+	0x55, 0x98, 0x70, 0x98, 0xf4, 0x3d, 0x64, 0x39, 0x37, 0x5e, 0x12, 0x5d, 0xb9, 0x90, 0x87, 0x85,
+	0xdc, 0xde, 0xd8, 0xbf, 0x37, 0x85, 0x96, 0x33, 0x3b, 0x04, 0x7e, 0x15, 0x55, 0xfa, 0xfb, 0x4e,
+	0x24, 0x97, 0xfc, 0x15, 0x39, 0xdf, 0x9a, 0x14, 0xf8, 0xe4, 0x68, 0xf5, 0x9c, 0x6c, 0xc2, 0x00,
+	0xc0, 0x89, 0xa9, 0x19, 0xd2, 0x23, 0x51, 0xe4, 0x74, 0xe5, 0x3e, 0xa0, 0x4d, 0x13, 0x06, 0x06,
+	0x89, 0xc7, 0x7f, 0xcd, 0x42, 0xe7, 0xf8, 0x94, 0x01, 0x12, 0x0d, 0xbc, 0x98, 0xee, 0x75, 0xf4,
+	0xb1, 0xbc, 0x55, 0xc4, 0xf4, 0xe4, 0x2c, 0x1b, 0x17, 0x85, 0xf4, 0x73, 0x3a, 0x34, 0x02, 0x53,
+	0x2e, 0xbe, 0x8f, 0x66, 0xa3, 0xd8, 0x09, 0x4f, 0xab, 0xf3, 0xd8, 0x86, 0xd3, 0x92, 0x0c, 0x20,
+	0xe1, 0x85, 0xdf, 0x47, 0x28, 0x1c, 0xf8, 0xad, 0x41, 0xaf, 0xe7, 0x84, 0x87, 0xc2, 0xe8, 0x79,
+	0x73, 0xb2, 0xe1, 0x81, 0xe2, 0x97, 0xe8, 0xac, 0x04, 0x06, 0x9a, 0x3c, 0xfc, 0xd3, 0x16, 0x3a,
+	0xc7, 0x67, 0xa2, 0xec, 0xc1, 0x74, 0xc1, 0x3d, 0x58, 0xa6, 0x8f, 0x76, 0x43, 0x17, 0x01, 0xa6,
+	0x44, 0xfb, 0xdf, 0x99, 0xfa, 0xa4, 0x15, 0x87, 0x4e, 0x4c, 0xba, 0x87, 0xf8, 0xd3, 0xe8, 0x85,
+	0x68, 0xd0, 0x6e, 0x93, 0x28, 0xda, 0x1b, 0x78, 0x30, 0xf0, 0xdf, 0x74, 0xa3, 0x38, 0x08, 0x0f,
+	0xb7, 0xdc, 0x9e, 0x1b, 0xb3, 0x19, 0x57, 0x69, 0x5c, 0x3e, 0x3e, 0x5a, 0x7d, 0xa1, 0x35, 0x8c,
+	0x08, 0x86, 0xb7, 0xc7, 0x0e, 0x7a, 0x71, 0xe0, 0x0f, 0x67, 0xcf, 0x0d, 0xde, 0xd5, 0xe3, 0xa3,
+	0xd5, 0x17, 0xef, 0x0e, 0x27, 0x83, 0x93, 0x78, 0xd8, 0xff, 0xdd, 0x42, 0x4b, 0x72, 0x5c, 0xd2,
+	0x7e, 0x7a, 0x06, 0x86, 0x48, 0x6c, 0x18, 0x22, 0x50, 0x8c, 0x3a, 0x91, 0xfd, 0x1f, 0x66, 0x8d,
+	0xd8, 0xff, 0xcd, 0x42, 0x17, 0xd2, 0xc4, 0xcf, 0x40, 0x79, 0x46, 0xa6, 0xf2, 0xbc, 0x55, 0xec,
+	0x68, 0x87, 0x68, 0xd0, 0x6f, 0x54, 0xb2, 0x63, 0xfd, 0xff, 0x5d, 0x8d, 0x26, 0x5a, 0xb1, 0xfc,
+	0x27, 0xa9, 0x15, 0xa7, 0x7e, 0x90, 0xb4, 0x22, 0xfe, 0xaa, 0x85, 0x16, 0xa9, 0x61, 0x1b, 0xf5,
+	0x1d, 0x7a, 0x00, 0xf6, 0xdc, 0xb6, 0xdc, 0xc1, 0x27, 0xb4, 0xff, 0x6f, 0x99, 0x4c, 0x1b, 0xe7,
+	0xe9, 0xb9, 0x2c, 0x05, 0x84, 0xb4, 0x68, 0xfb, 0x97, 0xa7, 0xd0, 0x7c, 0xdd, 0x8f, 0xdd, 0xfa,
+	0xde, 0x9e, 0xeb, 0xbb, 0xf1, 0x21, 0xfe, 0x6a, 0x09, 0x5d, 0xeb, 0x87, 0x64, 0x8f, 0x84, 0x21,
+	0xe9, 0x6c, 0x0c, 0x42, 0xd7, 0xef, 0xb6, 0xda, 0xfb, 0xa4, 0x33, 0xf0, 0x5c, 0xbf, 0xbb, 0xd9,
+	0xf5, 0x03, 0x05, 0xbe, 0xf1, 0x98, 0xb4, 0x07, 0xec, 0x09, 0xf3, 0x35, 0xda, 0x9b, 0xac, 0xff,
+	0xcd, 0xf1, 0x84, 0x36, 0x3e, 0x7a, 0x7c, 0xb4, 0x7a, 0x6d, 0xcc, 0x46, 0x30, 0xee, 0xd0, 0xf0,
+	0xcf, 0x94, 0xd0, 0x5a, 0x48, 0xde, 0x1d, 0xb8, 0xa3, 0x3f, 0x0d, 0xbe, 0x89, 0x7a, 0x13, 0x6a,
+	0xc3, 0xb1, 0x64, 0x36, 0xae, 0x1f, 0x1f, 0xad, 0x8e, 0xd9, 0x06, 0xc6, 0x1c, 0x97, 0xfd, 0xdb,
+	0x25, 0x74, 0xb1, 0xde, 0xef, 0x6f, 0x93, 0x68, 0x3f, 0x75, 0xc6, 0xfe, 0x59, 0x0b, 0x2d, 0x1c,
+	0xb8, 0x61, 0x3c, 0x70, 0x3c, 0xe9, 0xc6, 0xe1, 0x53, 0xa2, 0x35, 0xe1, 0xee, 0xc2, 0xa5, 0xdd,
+	0x33, 0x58, 0x37, 0x30, 0xf5, 0x58, 0x98, 0x30, 0x48, 0x89, 0xc7, 0x7f, 0xcb, 0x42, 0x4b, 0x02,
+	0x74, 0x2b, 0xe8, 0x10, 0xdd, 0xf7, 0x77, 0xb7, 0xc8, 0x3e, 0x29, 0xe6, 0xdc, 0x49, 0x94, 0x86,
+	0x42, 0xa6, 0x13, 0xf6, 0xff, 0x2c, 0xa1, 0x4b, 0x43, 0x78, 0xe0, 0x7f, 0x64, 0xa1, 0x0b, 0xdc,
+	0x61, 0xa8, 0xa1, 0x80, 0xec, 0x89, 0xa7, 0xf9, 0xa9, 0xa2, 0x7b, 0x0e, 0x74, 0x2d, 0x10, 0xbf,
+	0x4d, 0x1a, 0x35, 0xba, 0x8b, 0xad, 0xe7, 0x88, 0x86, 0xdc, 0x0e, 0xb1, 0x9e, 0x72, 0x17, 0x62,
+	0xaa, 0xa7, 0xa5, 0x67, 0xd2, 0xd3, 0x56, 0x8e, 0x68, 0xc8, 0xed, 0x90, 0xfd, 0x97, 0xd0, 0x8b,
+	0x27, 0xb0, 0x7b, 0xba, 0x03, 0xc2, 0xfe, 0x0c, 0xba, 0x68, 0x32, 0x90, 0x73, 0xec, 0xa9, 0x4d,
+	0xb1, 0x8d, 0xa6, 0xc3, 0x60, 0x10, 0x13, 0xae, 0x6c, 0x67, 0x1b, 0x88, 0xaa, 0x2d, 0x60, 0x10,
+	0x10, 0x18, 0xfb, 0xb7, 0x2d, 0x54, 0x1d, 0xc3, 0x1d, 0xb2, 0x6a, 0xba, 0x43, 0x66, 0x33, 0xae,
+	0x90, 0x38, 0xeb, 0x0a, 0x79, 0x63, 0xb2, 0xb7, 0x31, 0x8a, 0x0b, 0xe4, 0x0f, 0x2d, 0xb4, 0x9c,
+	0x71, 0x99, 0xe0, 0x7d, 0x74, 0x21, 0xe5, 0x07, 0x64, 0x38, 0x31, 0xbc, 0x57, 0xe9, 0x9b, 0x6c,
+	0xe6, 0xe0, 0x9f, 0x1c, 0xad, 0xd6, 0x14, 0x93, 0x14, 0x01, 0xe4, 0x72, 0xc4, 0x7d, 0x54, 0xdd,
+	0x73, 0x89, 0xd7, 0x49, 0xa6, 0xe0, 0x84, 0x86, 0xcd, 0x4d, 0xc1, 0x8d, 0x7b, 0x0b, 0xe5, 0x2f,
+	0x50, 0x52, 0xec, 0x3b, 0x68, 0xc1, 0x74, 0xb7, 0x8f, 0xf0, 0xf2, 0x2e, 0xa3, 0xb2, 0x13, 0xfa,
+	0xe2, 0xd5, 0xcd, 0x09, 0x82, 0x72, 0x1d, 0x6e, 0x01, 0x85, 0xdb, 0x7f, 0x3c, 0x85, 0x16, 0x1b,
+	0xde, 0x80, 0xbc, 0x11, 0x12, 0x22, 0x8f, 0xcb, 0xd4, 0xf5, 0x1a, 0x92, 0x03, 0x97, 0x3c, 0x6a,
+	0x11, 0x8f, 0xb4, 0xe3, 0x20, 0xac, 0x59, 0x29, 0xd7, 0xab, 0x89, 0x86, 0x34, 0x3d, 0xf5, 0xfe,
+	0x3a, 0xed, 0xd8, 0x3d, 0x20, 0x8a, 0x43, 0xca, 0xfb, 0x5b, 0x37, 0xb0, 0x90, 0xa2, 0xc6, 0x3f,
+	0x89, 0x6a, 0x51, 0xdb, 0xf1, 0xc8, 0xdd, 0xbe, 0x10, 0xb5, 0xbe, 0x4f, 0xda, 0x0f, 0x9b, 0x81,
+	0xeb, 0xc7, 0xc2, 0x39, 0x72, 0x55, 0x70, 0xaa, 0xb5, 0x86, 0xd0, 0xc1, 0x50, 0x0e, 0xf8, 0xb7,
+	0x2c, 0x74, 0xb9, 0x1f, 0x92, 0x66, 0x18, 0xf4, 0x02, 0xaa, 0x66, 0x32, 0x1e, 0x03, 0x71, 0x72,
+	0xbe, 0x37, 0xa1, 0x3e, 0xe5, 0x90, 0xac, 0xc7, 0xf2, 0x03, 0xc7, 0x47, 0xab, 0x97, 0x9b, 0x27,
+	0x75, 0x00, 0x4e, 0xee, 0x1f, 0xfe, 0x17, 0x16, 0xba, 0xd2, 0x0f, 0xa2, 0xf8, 0x84, 0x21, 0x54,
+	0xce, 0x74, 0x08, 0xf6, 0xf1, 0xd1, 0xea, 0x95, 0xe6, 0x89, 0x3d, 0x80, 0xa7, 0xf4, 0xd0, 0xfe,
+	0x4f, 0xf3, 0x68, 0x59, 0x9b, 0x7b, 0xe2, 0x38, 0xfd, 0x1a, 0x3a, 0x27, 0x27, 0x43, 0xa2, 0xd6,
+	0x67, 0x13, 0xf7, 0x47, 0x5d, 0x47, 0x82, 0x49, 0x4b, 0xe7, 0x9d, 0x9a, 0x8a, 0xbc, 0x75, 0x6a,
+	0xde, 0x35, 0x0d, 0x2c, 0xa4, 0xa8, 0xf1, 0x26, 0x3a, 0x2f, 0x20, 0xe2, 0x7a, 0x62, 0x3d, 0x18,
+	0x88, 0x29, 0x57, 0x69, 0x5c, 0x3a, 0x3e, 0x5a, 0x3d, 0xdf, 0xcc, 0xa2, 0x21, 0xaf, 0x0d, 0xde,
+	0x42, 0x17, 0x9c, 0x41, 0x1c, 0xa8, 0xf1, 0xdf, 0xf0, 0xa9, 0xa6, 0xe8, 0xb0, 0xa9, 0x55, 0xe5,
+	0x2a, 0xa5, 0x9e, 0x83, 0x87, 0xdc, 0x56, 0xb8, 0x99, 0xe2, 0xd6, 0x22, 0xed, 0xc0, 0xef, 0xf0,
+	0xb7, 0x5c, 0x49, 0x0e, 0x05, 0xf5, 0x1c, 0x1a, 0xc8, 0x6d, 0x89, 0x3d, 0xb4, 0xd0, 0x73, 0x1e,
+	0xdf, 0xf5, 0x9d, 0x03, 0xc7, 0xf5, 0xa8, 0x90, 0xda, 0xf4, 0x53, 0xce, 0xf9, 0xf4, 0x42, 0x76,
+	0x8d, 0x5f, 0xc8, 0xae, 0x6d, 0xfa, 0xf1, 0xed, 0xb0, 0x15, 0x53, 0x6b, 0x8d, 0x1b, 0x47, 0xdb,
+	0x06, 0x2f, 0x48, 0xf1, 0xc6, 0xb7, 0xd1, 0x45, 0xb6, 0x1c, 0x37, 0x82, 0x47, 0xfe, 0x06, 0xf1,
+	0x9c, 0x43, 0x39, 0x80, 0x19, 0x36, 0x80, 0x17, 0x8e, 0x8f, 0x56, 0x2f, 0xb6, 0xf2, 0x08, 0x20,
+	0xbf, 0x1d, 0x75, 0x8c, 0x98, 0x08, 0x20, 0x07, 0x6e, 0xe4, 0x06, 0x3e, 0x77, 0x8c, 0x54, 0x13,
+	0xc7, 0x48, 0x6b, 0x38, 0x19, 0x9c, 0xc4, 0x03, 0xff, 0x5d, 0x0b, 0x5d, 0xc8, 0x5b, 0x86, 0xb5,
+	0xd9, 0x22, 0xce, 0x4e, 0xa9, 0xa5, 0xc5, 0x67, 0x44, 0xee, 0xa6, 0x90, 0xdb, 0x09, 0xfc, 0x45,
+	0x0b, 0xcd, 0x3b, 0xda, 0x29, 0xaa, 0x86, 0xae, 0x5a, 0x93, 0xbb, 0x1c, 0xf5, 0x73, 0x59, 0x63,
+	0x89, 0x5e, 0x77, 0xeb, 0x10, 0x30, 0x24, 0xe2, 0xbf, 0x6f, 0xa1, 0x8b, 0xb9, 0x6b, 0xbc, 0x36,
+	0x77, 0x16, 0x4f, 0x88, 0x4d, 0x92, 0xfc, 0x3d, 0x27, 0xbf, 0x1b, 0xf4, 0xc2, 0x56, 0xaa, 0xa6,
+	0x6d, 0xe9, 0xdc, 0x99, 0x67, 0x5d, 0xbb, 0x33, 0xe1, 0xc1, 0x31, 0x31, 0x08, 0x24, 0x63, 0x7e,
+	0xf8, 0x6d, 0x9a, 0xd2, 0x20, 0x2d, 0x1e, 0x7f, 0xcd, 0x92, 0xaa, 0x51, 0xf5, 0xe8, 0xdc, 0x59,
+	0xf5, 0x08, 0x27, 0x9a, 0x56, 0x75, 0x28, 0x25, 0x1c, 0x7f, 0x16, 0xad, 0x38, 0xbb, 0x41, 0x18,
+	0xe7, 0x2e, 0xbe, 0xda, 0x02, 0x5b, 0x46, 0x57, 0x8e, 0x8f, 0x56, 0x57, 0xea, 0x43, 0xa9, 0xe0,
+	0x04, 0x0e, 0xf8, 0xb1, 0xda, 0x51, 0x9b, 0x41, 0x87, 0x7a, 0x9f, 0x9a, 0x34, 0x96, 0xa5, 0xb6,
+	0xc8, 0xc6, 0xfc, 0xe1, 0xa1, 0x7b, 0x8d, 0x08, 0x1a, 0x59, 0x03, 0xe7, 0xd1, 0x8d, 0xc7, 0x31,
+	0xf1, 0xe9, 0xaa, 0x34, 0x36, 0x60, 0x9d, 0x1b, 0xe4, 0x89, 0xb0, 0x7f, 0x7d, 0x1a, 0xcd, 0xf3,
+	0xe3, 0x85, 0x50, 0x9a, 0xbf, 0x69, 0xa1, 0x97, 0xda, 0x83, 0x30, 0x24, 0x7e, 0xdc, 0x8a, 0x49,
+	0x3f, 0xab, 0x32, 0xad, 0x33, 0x55, 0x99, 0x57, 0x8f, 0x8f, 0x56, 0x5f, 0x5a, 0x3f, 0x41, 0x3e,
+	0x9c, 0xd8, 0x3b, 0xfc, 0xaf, 0x2d, 0x64, 0x0b, 0x82, 0x86, 0xd3, 0x7e, 0xd8, 0x0d, 0x83, 0x81,
+	0xdf, 0xc9, 0x0e, 0xa2, 0x74, 0xa6, 0x83, 0xf8, 0xe0, 0xf1, 0xd1, 0xaa, 0xbd, 0xfe, 0xd4, 0x5e,
+	0xc0, 0x08, 0x3d, 0xc5, 0x6f, 0xa0, 0x65, 0x41, 0x75, 0xe3, 0x71, 0x9f, 0x84, 0x6e, 0x8f, 0x08,
+	0x55, 0x3b, 0xab, 0x85, 0xb7, 0xa4, 0x09, 0x20, 0xdb, 0x06, 0x47, 0x68, 0xe6, 0x11, 0x71, 0xbb,
+	0xfb, 0xb1, 0x34, 0xdc, 0x26, 0x8c, 0x69, 0x11, 0xae, 0x86, 0xfb, 0x9c, 0x67, 0x63, 0x8e, 0xfa,
+	0x34, 0xc5, 0x0f, 0x90, 0x92, 0xf0, 0x2d, 0xb4, 0xc0, 0x0f, 0x7f, 0x4d, 0xd7, 0xef, 0x36, 0x03,
+	0x9f, 0x47, 0x82, 0xcc, 0x36, 0x3e, 0x28, 0x4d, 0x8d, 0x96, 0x81, 0x7d, 0x72, 0xb4, 0x3a, 0x2f,
+	0xff, 0xdf, 0x39, 0xec, 0x13, 0x48, 0xb5, 0xc6, 0x5f, 0xb6, 0xd0, 0x5c, 0x14, 0x93, 0xbe, 0xf0,
+	0xcd, 0xd7, 0xa6, 0x8b, 0xf0, 0x14, 0xcb, 0xf9, 0x4f, 0xfa, 0x40, 0xda, 0x41, 0xd8, 0xd1, 0x62,
+	0x63, 0x12, 0x51, 0xa0, 0xcb, 0xb5, 0xbf, 0x5a, 0x41, 0x28, 0x69, 0x86, 0xff, 0x1c, 0x9a, 0x8d,
+	0x48, 0xcc, 0x47, 0x2f, 0x6e, 0x33, 0xf8, 0x25, 0x91, 0x04, 0x42, 0x82, 0xc7, 0x0f, 0x51, 0xa5,
+	0xef, 0x0c, 0x22, 0x52, 0x2b, 0x15, 0xa1, 0x8b, 0xc4, 0x24, 0x6c, 0x52, 0x8e, 0xfc, 0xd4, 0xc9,
+	0xfe, 0x05, 0x2e, 0x03, 0x7f, 0xc9, 0x42, 0x88, 0x98, 0x13, 0x67, 0x62, 0xef, 0x8f, 0x10, 0x99,
+	0xcc, 0x2d, 0xfa, 0x0c, 0x1a, 0x0b, 0xf4, 0x12, 0x23, 0x81, 0x81, 0x26, 0x16, 0x3f, 0x42, 0x55,
+	0x47, 0x6a, 0xbd, 0xa9, 0xb3, 0xd0, 0x7a, 0xec, 0x30, 0x28, 0x7f, 0x81, 0x12, 0x86, 0x7f, 0xc6,
+	0x42, 0x0b, 0x11, 0x89, 0xc5, 0xab, 0xa2, 0x7b, 0x6f, 0xad, 0x52, 0xc4, 0xe4, 0x6f, 0x19, 0x3c,
+	0xb9, 0x0e, 0x31, 0x61, 0x90, 0x92, 0x8b, 0xdf, 0x41, 0xd5, 0x0e, 0x71, 0x3a, 0x9e, 0xeb, 0x9f,
+	0xde, 0x88, 0x64, 0xc3, 0xdc, 0x10, 0x5c, 0x40, 0xf1, 0xb3, 0xff, 0x63, 0x09, 0x2d, 0xa5, 0x67,
+	0x31, 0x0d, 0xd0, 0x70, 0xfd, 0x0e, 0x79, 0x2c, 0x27, 0xa4, 0xba, 0xfe, 0xa0, 0x40, 0xe0, 0x38,
+	0x1a, 0xfe, 0x93, 0x5c, 0x85, 0x96, 0x4e, 0x1f, 0xfe, 0x93, 0x7b, 0x1d, 0xfa, 0x0e, 0x42, 0xd4,
+	0x08, 0x8a, 0xf6, 0x19, 0xf7, 0xf2, 0xd8, 0xdc, 0xd9, 0x94, 0xba, 0xa9, 0x38, 0x80, 0xc6, 0x0d,
+	0xbf, 0x8e, 0x66, 0x82, 0x41, 0xdc, 0x0e, 0x7a, 0x44, 0x84, 0x72, 0xfd, 0x88, 0xbc, 0x58, 0xb9,
+	0xcd, 0xc1, 0x4f, 0x54, 0xdc, 0x1f, 0x7d, 0x26, 0x02, 0x08, 0xb2, 0x91, 0x7e, 0x71, 0x5d, 0x39,
+	0xf9, 0xe2, 0xda, 0xfe, 0xdd, 0x73, 0x68, 0x41, 0x72, 0x4a, 0xce, 0x5f, 0xdc, 0xfd, 0x36, 0xe4,
+	0xfc, 0xb5, 0xae, 0x23, 0xc1, 0xa4, 0xa5, 0x8d, 0xf9, 0xb6, 0x66, 0x1e, 0xbf, 0x54, 0xe3, 0x96,
+	0x8e, 0x04, 0x93, 0x16, 0xf7, 0x50, 0x85, 0x6e, 0x44, 0xf2, 0xf2, 0xfc, 0xcd, 0xa2, 0xb6, 0xbe,
+	0x64, 0x7e, 0xd0, 0x5f, 0x11, 0x70, 0x29, 0xcc, 0x83, 0x1c, 0x1b, 0x4e, 0xe5, 0xda, 0x54, 0x81,
+	0x7b, 0x88, 0xe9, 0xaf, 0xe6, 0xeb, 0xc8, 0x84, 0x41, 0x4a, 0x7c, 0xce, 0x91, 0xac, 0x72, 0x86,
+	0x47, 0xb2, 0x77, 0x68, 0x94, 0xda, 0xe3, 0xd6, 0x20, 0xec, 0x4e, 0xb8, 0x6a, 0xb7, 0x05, 0x17,
+	0x50, 0xfc, 0xe8, 0x7d, 0x7d, 0xb2, 0x2d, 0xce, 0x30, 0xe6, 0xf7, 0x8b, 0xdd, 0x16, 0x95, 0x5d,
+	0x31, 0x74, 0x83, 0xcc, 0x1c, 0x90, 0xaa, 0xcf, 0xfc, 0x80, 0x44, 0x8d, 0x7d, 0xbe, 0x40, 0x94,
+	0xb1, 0x3f, 0x7b, 0xa6, 0xc6, 0xfe, 0xba, 0x21, 0x0c, 0x52, 0xc2, 0x59, 0x7f, 0xf8, 0x9a, 0x53,
+	0xfd, 0x41, 0x67, 0xda, 0x9f, 0x96, 0x21, 0x0c, 0x52, 0xc2, 0x87, 0x7b, 0x05, 0xe6, 0xce, 0xc6,
+	0x2b, 0x30, 0x5f, 0x80, 0x57, 0xe0, 0xe4, 0x03, 0xd3, 0xb9, 0x89, 0x0f, 0x4c, 0x6f, 0x21, 0xdc,
+	0x39, 0xf4, 0x9d, 0x9e, 0xdb, 0x16, 0x9b, 0x25, 0x53, 0xed, 0x0b, 0xcc, 0x6b, 0xb4, 0x22, 0x36,
+	0x32, 0xbc, 0x91, 0xa1, 0x80, 0x9c, 0x56, 0x38, 0x46, 0xd5, 0xbe, 0xb4, 0x4e, 0x17, 0x8b, 0x98,
+	0xfd, 0xd2, 0x5a, 0xe5, 0xf1, 0x15, 0x74, 0xe1, 0x49, 0x08, 0x28, 0x49, 0x78, 0x80, 0x30, 0x9f,
+	0x77, 0xc6, 0x89, 0x6f, 0xe9, 0x34, 0x27, 0xbe, 0xe7, 0xe9, 0x60, 0xd7, 0x33, 0xcc, 0x20, 0x47,
+	0x80, 0xfd, 0x7f, 0x2c, 0xb4, 0xb4, 0xee, 0x05, 0x83, 0xce, 0x7d, 0xfa, 0x93, 0xc7, 0x20, 0xe0,
+	0xd7, 0x51, 0xd5, 0xf5, 0x63, 0x12, 0x1e, 0x38, 0x9e, 0x50, 0x64, 0xb6, 0x0c, 0xd3, 0xd8, 0x14,
+	0xf0, 0x27, 0x34, 0x98, 0x79, 0x10, 0x3a, 0x3c, 0xf8, 0x99, 0x6e, 0x6b, 0xa0, 0xda, 0xe0, 0x5f,
+	0xb4, 0xd0, 0x32, 0x8f, 0x62, 0xd8, 0x70, 0x62, 0xe7, 0xce, 0x80, 0x84, 0x2e, 0x91, 0x71, 0x0c,
+	0x13, 0xee, 0x68, 0xe9, 0xbe, 0x4a, 0x01, 0x87, 0xc9, 0xe9, 0x67, 0x3b, 0x2d, 0x19, 0xb2, 0x9d,
+	0xb1, 0x7f, 0xbe, 0x8c, 0x5e, 0x18, 0xca, 0x0b, 0xaf, 0xa0, 0x92, 0xdb, 0x11, 0x43, 0x47, 0x82,
+	0x6f, 0x69, 0xb3, 0x03, 0x25, 0xb7, 0x83, 0xd7, 0x98, 0x01, 0x1d, 0x92, 0x28, 0x92, 0x77, 0xc8,
+	0xb3, 0xca, 0xd6, 0x15, 0x50, 0xd0, 0x28, 0xe8, 0x45, 0x90, 0xe7, 0xec, 0x12, 0x4f, 0x1c, 0xd2,
+	0x98, 0x49, 0xbe, 0x45, 0x01, 0xc0, 0xe1, 0xf8, 0xaf, 0x5a, 0x08, 0xf1, 0x0e, 0xd2, 0x23, 0x9e,
+	0x50, 0xa7, 0x50, 0xec, 0x63, 0xa2, 0x9c, 0x79, 0x2f, 0x93, 0xdf, 0xa0, 0x49, 0xc5, 0x3b, 0x68,
+	0x9a, 0x5a, 0xe7, 0x41, 0xe7, 0xd4, 0xda, 0x93, 0xdd, 0x99, 0x35, 0x19, 0x0f, 0x10, 0xbc, 0xe8,
+	0xb3, 0x0a, 0x49, 0x3c, 0x08, 0x7d, 0xfa, 0x68, 0x99, 0xbe, 0xac, 0xf2, 0x5e, 0x80, 0x82, 0x82,
+	0x46, 0x61, 0xff, 0x46, 0x09, 0x5d, 0xc8, 0xeb, 0x3a, 0x55, 0x4b, 0xd3, 0xbc, 0xb7, 0xc2, 0xdf,
+	0xf0, 0xc9, 0xe2, 0x9f, 0x0f, 0xff, 0x2f, 0x09, 0x5b, 0xe1, 0xbf, 0x41, 0xc8, 0xc5, 0x9f, 0x54,
+	0x4f, 0xa8, 0x74, 0xca, 0x27, 0xa4, 0x38, 0xa7, 0x9e, 0xd2, 0x55, 0x34, 0x15, 0xd1, 0x37, 0x5f,
+	0x36, 0xef, 0xa3, 0xd8, 0x3b, 0x62, 0x18, 0x4a, 0x31, 0xf0, 0xdd, 0xb8, 0x36, 0x65, 0x52, 0xdc,
+	0xf5, 0xdd, 0x18, 0x18, 0xc6, 0xfe, 0x46, 0x09, 0xad, 0x0c, 0x1f, 0x14, 0xcd, 0x65, 0x41, 0x1d,
+	0x7a, 0xf6, 0xa2, 0x53, 0x52, 0x06, 0x30, 0x39, 0x67, 0xf5, 0x0c, 0x37, 0xa4, 0xa4, 0x24, 0x9a,
+	0x4d, 0x81, 0x22, 0xd0, 0x3a, 0x82, 0xaf, 0xcb, 0xa9, 0xaf, 0x25, 0x3b, 0xa8, 0x36, 0xdb, 0x0a,
+	0x03, 0x1a, 0x15, 0x3d, 0x5c, 0xab, 0xe0, 0x18, 0xf1, 0xcc, 0xd8, 0xe1, 0x5a, 0x85, 0xd0, 0x40,
+	0x82, 0xb7, 0x3d, 0xf4, 0xf2, 0x08, 0xfd, 0x2c, 0x28, 0xbc, 0xdd, 0xfe, 0x5f, 0x16, 0xba, 0xb4,
+	0xee, 0x0d, 0xa2, 0x98, 0x84, 0x7f, 0x6a, 0x82, 0x03, 0xff, 0xaf, 0x85, 0x5e, 0x1c, 0x32, 0xe6,
+	0x67, 0x10, 0x23, 0xf8, 0x9e, 0x19, 0x23, 0x78, 0x77, 0xd2, 0x29, 0x9d, 0x3b, 0x8e, 0x21, 0xa1,
+	0x82, 0x31, 0x3a, 0x47, 0x77, 0xad, 0x4e, 0xd0, 0x2d, 0x48, 0x6f, 0xbe, 0x8c, 0x2a, 0xef, 0x52,
+	0xfd, 0x93, 0x9e, 0x63, 0x4c, 0x29, 0x01, 0xc7, 0xd9, 0x9f, 0x40, 0x22, 0xa0, 0x2e, 0xb5, 0x78,
+	0xac, 0x51, 0x16, 0x8f, 0xfd, 0xef, 0x4b, 0x48, 0x73, 0xca, 0x3c, 0x83, 0x49, 0xe9, 0x1b, 0x93,
+	0x72, 0x42, 0x37, 0x8b, 0xe6, 0x62, 0x1a, 0x96, 0x39, 0x73, 0x90, 0xca, 0x9c, 0xb9, 0x55, 0x98,
+	0xc4, 0x93, 0x13, 0x67, 0xbe, 0x6b, 0xa1, 0x17, 0x13, 0xe2, 0xac, 0xdf, 0xf6, 0xe9, 0x3b, 0xcc,
+	0xc7, 0xd0, 0x9c, 0x93, 0x34, 0x13, 0x73, 0x40, 0xb9, 0x1e, 0x35, 0x8e, 0xa0, 0xd3, 0x25, 0x71,
+	0xfa, 0xe5, 0x53, 0xc6, 0xe9, 0x4f, 0x3d, 0xc5, 0xdd, 0xf1, 0x47, 0x25, 0x74, 0x39, 0x3b, 0x32,
+	0xb9, 0x36, 0x46, 0x0b, 0xa8, 0xf8, 0x38, 0x9a, 0x8f, 0x45, 0x03, 0x6d, 0xa7, 0x57, 0xd9, 0xa1,
+	0x3b, 0x1a, 0x0e, 0x0c, 0x4a, 0xda, 0xb2, 0xcd, 0x57, 0x65, 0xab, 0x1d, 0xf4, 0x65, 0x96, 0x87,
+	0x6a, 0xb9, 0xae, 0xe1, 0xc0, 0xa0, 0x54, 0xf1, 0xb3, 0x53, 0x67, 0x1e, 0x3f, 0xdb, 0x42, 0x17,
+	0x65, 0x88, 0xde, 0xcd, 0x20, 0x5c, 0x0f, 0x7a, 0x7d, 0x8f, 0x88, 0x3c, 0x0f, 0xda, 0xd9, 0xcb,
+	0xa2, 0xc9, 0x45, 0xc8, 0x23, 0x82, 0xfc, 0xb6, 0xf6, 0x77, 0xcb, 0xe8, 0x7c, 0xf2, 0xd8, 0xd7,
+	0x03, 0xbf, 0xe3, 0x52, 0x38, 0x7e, 0x0d, 0x4d, 0xc5, 0x87, 0x7d, 0xf9, 0xb0, 0xff, 0x8c, 0xec,
+	0x0e, 0x75, 0x8f, 0x3f, 0x39, 0x5a, 0xbd, 0x94, 0xd3, 0x84, 0xa2, 0x80, 0x35, 0xc2, 0x5b, 0x6a,
+	0x75, 0xf0, 0x37, 0xf0, 0xaa, 0x39, 0x9b, 0x9f, 0x1c, 0xad, 0xe6, 0x24, 0x47, 0xaf, 0x29, 0x4e,
+	0xe6, 0x9c, 0xc7, 0x0f, 0xd0, 0x82, 0xe7, 0x44, 0xf1, 0xdd, 0x7e, 0xc7, 0x89, 0x09, 0xf5, 0xd0,
+	0x9d, 0xc2, 0xa7, 0xa7, 0x82, 0x0c, 0xb6, 0x0c, 0x4e, 0x90, 0xe2, 0x8c, 0x0f, 0x10, 0xa6, 0x90,
+	0x9d, 0xd0, 0xf1, 0x23, 0x3e, 0x2a, 0x57, 0xb8, 0xfa, 0xc6, 0x93, 0xa7, 0x4e, 0x83, 0x5b, 0x19,
+	0x6e, 0x90, 0x23, 0x01, 0x7f, 0x10, 0x4d, 0x87, 0xc4, 0x89, 0xc4, 0xcb, 0x9c, 0x4d, 0xd6, 0x3f,
+	0x30, 0x28, 0x08, 0xac, 0xbe, 0xa0, 0xa6, 0x9f, 0xb2, 0xa0, 0x7e, 0xdf, 0x42, 0x0b, 0xc9, 0x6b,
+	0x7a, 0x06, 0x4a, 0xb2, 0x67, 0x2a, 0xc9, 0x37, 0x8b, 0xda, 0x12, 0x87, 0xe8, 0xc5, 0x7f, 0x39,
+	0xad, 0x8f, 0x8f, 0x05, 0xcf, 0x7f, 0x0e, 0xcd, 0xca, 0x55, 0x2d, 0xad, 0xcf, 0x09, 0x0f, 0xd5,
+	0x86, 0x5d, 0xa2, 0x25, 0x7d, 0x09, 0x21, 0x90, 0xc8, 0xa3, 0x6a, 0xb9, 0x23, 0x54, 0x6e, 0xad,
+	0x64, 0xaa, 0x65, 0xa9, 0x8a, 0xf3, 0xd4, 0xb2, 0x6c, 0x83, 0xef, 0xa2, 0x4b, 0xfd, 0x30, 0x60,
+	0xb9, 0xd3, 0xd2, 0xd7, 0x2e, 0x3d, 0x17, 0x3c, 0xc6, 0xe5, 0xc5, 0xe3, 0xa3, 0xd5, 0x4b, 0xcd,
+	0x7c, 0x12, 0x18, 0xd6, 0xd6, 0x4c, 0x5e, 0x9b, 0x1a, 0x21, 0x79, 0xed, 0xaf, 0x2b, 0xff, 0x20,
+	0x89, 0x44, 0x0a, 0xd9, 0xa7, 0x8b, 0x7a, 0x95, 0x39, 0xdb, 0x7a, 0x32, 0xa5, 0xea, 0x42, 0x28,
+	0x28, 0xf1, 0xc3, 0x9d, 0x50, 0xd3, 0xa7, 0x74, 0x42, 0x25, 0x39, 0x08, 0x33, 0x7f, 0x92, 0x39,
+	0x08, 0xd5, 0x1f, 0xa8, 0xcc, 0xbc, 0x2f, 0x57, 0xd0, 0x52, 0xda, 0x02, 0x39, 0xfb, 0xc4, 0xbc,
+	0x9f, 0xb3, 0xd0, 0x92, 0x5c, 0x3d, 0x5c, 0x26, 0x91, 0xd7, 0x0b, 0x5b, 0x05, 0x2d, 0x5a, 0x6e,
+	0x4b, 0xa9, 0x6c, 0xfb, 0x9d, 0x94, 0x34, 0xc8, 0xc8, 0xc7, 0x9f, 0x41, 0x73, 0xca, 0x0b, 0x7f,
+	0xaa, 0x2c, 0xbd, 0x45, 0x66, 0x45, 0x25, 0x2c, 0x40, 0xe7, 0x47, 0x2f, 0x92, 0x51, 0x5b, 0xaa,
+	0x39, 0xb9, 0xba, 0xee, 0x14, 0xb5, 0xba, 0x94, 0x02, 0x4d, 0x8c, 0x65, 0x05, 0x8a, 0x40, 0x13,
+	0x8c, 0x7f, 0x9e, 0xf9, 0xdf, 0x95, 0x75, 0x17, 0x89, 0x1b, 0xed, 0x4f, 0x15, 0xbd, 0xce, 0x93,
+	0xe0, 0x04, 0x65, 0x4a, 0x69, 0xa8, 0x08, 0x8c, 0x4e, 0xd8, 0xaf, 0x21, 0x15, 0x59, 0x4b, 0xb7,
+	0x2d, 0x16, 0x5b, 0xdb, 0x74, 0xe2, 0x7d, 0x31, 0x05, 0xd5, 0xb6, 0x75, 0x53, 0x22, 0x20, 0xa1,
+	0xb1, 0x7f, 0x0a, 0x2d, 0xbc, 0x11, 0x3a, 0xfd, 0x7d, 0x37, 0x26, 0xe2, 0x9c, 0xf4, 0xa3, 0x68,
+	0xc6, 0xe9, 0x74, 0xf2, 0x6a, 0x55, 0xd4, 0x39, 0x18, 0x24, 0x7e, 0xb4, 0x23, 0xd1, 0x6f, 0x58,
+	0x08, 0x6f, 0xfa, 0xed, 0xc0, 0xa7, 0xf6, 0x9f, 0x7b, 0x20, 0x52, 0x66, 0xb8, 0xea, 0x0e, 0x07,
+	0x7e, 0x24, 0x6e, 0x3c, 0x35, 0xd5, 0x4d, 0xa1, 0x20, 0xb0, 0xf8, 0x13, 0x68, 0xda, 0x69, 0x6b,
+	0xda, 0x41, 0xde, 0x1c, 0x4e, 0xd7, 0xdb, 0x42, 0x37, 0x18, 0xdc, 0x39, 0x14, 0x44, 0x1b, 0xfc,
+	0x1a, 0x9a, 0x89, 0xdd, 0x1e, 0x09, 0x06, 0xd2, 0x81, 0xf3, 0x01, 0x39, 0x98, 0x1d, 0x0e, 0xce,
+	0xd1, 0x2d, 0xb2, 0x05, 0x75, 0xdb, 0x3c, 0xaf, 0xf3, 0x06, 0x12, 0x05, 0x1e, 0x4f, 0x68, 0x49,
+	0x1d, 0x07, 0xac, 0x11, 0x8f, 0x03, 0x93, 0x0d, 0x26, 0x79, 0x64, 0xe5, 0x13, 0x1f, 0xd9, 0x67,
+	0xa9, 0x63, 0x2f, 0x0a, 0xbc, 0x83, 0x53, 0xa6, 0xcc, 0x26, 0xa9, 0xab, 0x8a, 0x0b, 0x68, 0x1c,
+	0xed, 0x6f, 0x59, 0xe8, 0xc2, 0x66, 0x14, 0xbb, 0xc1, 0x06, 0x89, 0x62, 0xaa, 0xfd, 0x68, 0x27,
+	0x07, 0xde, 0x28, 0xb1, 0xfc, 0x1b, 0x68, 0x49, 0x5c, 0xaf, 0x0e, 0x76, 0x23, 0xa3, 0x8a, 0x86,
+	0xda, 0x6e, 0xd6, 0x53, 0x78, 0xc8, 0xb4, 0xa0, 0x5c, 0xc4, 0x3d, 0x6b, 0xc2, 0xa5, 0x6c, 0x72,
+	0x69, 0xa5, 0xf0, 0x90, 0x69, 0x61, 0x7f, 0xa7, 0x8c, 0xce, 0xb3, 0x61, 0xa4, 0xf2, 0x70, 0xbe,
+	0x36, 0x2c, 0x0f, 0x67, 0xc2, 0x1d, 0x87, 0xc9, 0x3a, 0x45, 0x16, 0xce, 0xdf, 0xb4, 0xd0, 0x62,
+	0xc7, 0x7c, 0xd2, 0xc5, 0x78, 0x91, 0xf2, 0xde, 0x21, 0x8f, 0xf9, 0x4b, 0x01, 0x21, 0x2d, 0x1f,
+	0xff, 0x82, 0x85, 0x16, 0xcd, 0x6e, 0x4a, 0x25, 0x74, 0x06, 0x0f, 0x49, 0x05, 0xe9, 0x9b, 0xf0,
+	0x08, 0xd2, 0x5d, 0xb0, 0xff, 0xad, 0x25, 0x5e, 0xe9, 0x59, 0x24, 0x99, 0xe0, 0x47, 0x68, 0x36,
+	0xf6, 0x22, 0x0e, 0xac, 0x95, 0x8b, 0x38, 0xb8, 0xee, 0x6c, 0xb5, 0x18, 0x3b, 0xcd, 0xb6, 0x14,
+	0x90, 0x08, 0x12, 0x59, 0xf6, 0x37, 0x2d, 0x34, 0xfb, 0x56, 0xb0, 0x2b, 0x36, 0xe8, 0xcf, 0x16,
+	0xe0, 0x16, 0x52, 0xd6, 0xa3, 0xba, 0xc8, 0x4c, 0x0e, 0x24, 0xaf, 0x1b, 0x4e, 0xa1, 0x97, 0x34,
+	0xde, 0x6b, 0xac, 0x6a, 0x17, 0x65, 0xf5, 0x56, 0xb0, 0x3b, 0xd4, 0xe7, 0xf8, 0x0f, 0x2a, 0xe8,
+	0xdc, 0xdb, 0xce, 0x21, 0xf1, 0x63, 0x67, 0x7c, 0x95, 0x42, 0x37, 0xd6, 0x3e, 0x8b, 0x39, 0xd7,
+	0xb6, 0xc9, 0x64, 0x63, 0x4d, 0x50, 0xa0, 0xd3, 0x25, 0xfb, 0x0a, 0x2f, 0x22, 0x94, 0xb7, 0x23,
+	0xac, 0xa7, 0xf0, 0x90, 0x69, 0x41, 0x2f, 0x2a, 0x45, 0x7e, 0x6f, 0xbd, 0xdd, 0x0e, 0x06, 0xa2,
+	0x4a, 0x10, 0x77, 0xc1, 0xa8, 0xa3, 0xe9, 0x76, 0x86, 0x02, 0x72, 0x5a, 0xd1, 0x7c, 0x8f, 0x36,
+	0xe3, 0x2c, 0x94, 0x8b, 0xce, 0x91, 0x1f, 0x56, 0x55, 0xbe, 0xc7, 0xfa, 0x10, 0x3a, 0x18, 0xca,
+	0x81, 0xf6, 0x34, 0x8a, 0x83, 0xd0, 0xe9, 0x12, 0x9d, 0xef, 0xb4, 0xd9, 0xd3, 0x56, 0x86, 0x02,
+	0x72, 0x5a, 0xe1, 0x2f, 0xa0, 0xd9, 0x78, 0x3f, 0x24, 0xd1, 0x7e, 0xe0, 0x75, 0x6a, 0x33, 0x45,
+	0xf8, 0xe5, 0xc4, 0xdb, 0xdf, 0x91, 0x5c, 0xb5, 0xe9, 0x2d, 0x41, 0x90, 0xc8, 0xc4, 0x21, 0x9a,
+	0x8e, 0xa8, 0x53, 0x28, 0xaa, 0x55, 0x8b, 0x38, 0x7c, 0x0a, 0xe9, 0xcc, 0xcf, 0xa4, 0x79, 0x04,
+	0x99, 0x04, 0x10, 0x92, 0xec, 0xdf, 0x29, 0xa1, 0x79, 0x9d, 0x70, 0x84, 0x2d, 0xe2, 0x4b, 0x16,
+	0x9a, 0x6f, 0x07, 0x7e, 0x1c, 0x06, 0x1e, 0x6b, 0x22, 0x16, 0xc8, 0x84, 0x65, 0x63, 0x18, 0xab,
+	0x0d, 0x12, 0x3b, 0xae, 0xa7, 0x39, 0xce, 0x34, 0x31, 0x60, 0x08, 0x65, 0x99, 0xcf, 0x49, 0xb0,
+	0x5e, 0xe2, 0x76, 0x2b, 0xb4, 0x23, 0x6a, 0xc7, 0xbd, 0x61, 0x4a, 0x82, 0xb4, 0x68, 0x7b, 0x17,
+	0x2d, 0xa5, 0xdf, 0x36, 0x7d, 0x94, 0x7d, 0x47, 0xac, 0xf5, 0x72, 0xf2, 0x28, 0x9b, 0x4e, 0x14,
+	0x01, 0xc3, 0xe0, 0x0f, 0xd1, 0x40, 0x9d, 0xb0, 0xeb, 0xfa, 0x8e, 0xc7, 0x9e, 0x62, 0x59, 0xdb,
+	0x90, 0x04, 0x1c, 0x14, 0x85, 0xfd, 0x07, 0x53, 0x68, 0x4e, 0x3b, 0x97, 0x9d, 0xfd, 0x19, 0xcb,
+	0x28, 0x39, 0x52, 0x2e, 0xb0, 0xe4, 0x88, 0x19, 0x63, 0x37, 0x55, 0x68, 0x8c, 0x9d, 0xba, 0x03,
+	0xab, 0x9c, 0x50, 0xe2, 0xe9, 0xcb, 0x96, 0xa6, 0x3c, 0xa6, 0x8b, 0xb8, 0xf3, 0xd7, 0x5e, 0xcc,
+	0x9a, 0x54, 0x26, 0x37, 0xfc, 0x38, 0x3c, 0x3c, 0x51, 0xc7, 0xec, 0xa0, 0x6a, 0x48, 0xa2, 0x41,
+	0x8f, 0x9e, 0x16, 0x67, 0xc6, 0x7e, 0x0c, 0x2c, 0x4c, 0x03, 0x44, 0x7b, 0x50, 0x9c, 0x56, 0x5e,
+	0x43, 0xe7, 0x8c, 0x2e, 0xe0, 0x25, 0x54, 0x7e, 0x48, 0x0e, 0xf9, 0x3c, 0x01, 0xfa, 0x2f, 0xbe,
+	0x60, 0xdc, 0x14, 0x8a, 0xc7, 0xf2, 0x13, 0xa5, 0x8f, 0x5b, 0x76, 0x80, 0x72, 0x0f, 0xff, 0xa7,
+	0xb9, 0xc8, 0xa1, 0xef, 0xc2, 0xd3, 0xaa, 0x99, 0xa8, 0x77, 0xc1, 0x83, 0x71, 0x38, 0xce, 0xfe,
+	0xa3, 0x69, 0x24, 0xae, 0xb1, 0x47, 0xd8, 0x7c, 0xf4, 0xdb, 0xab, 0xd2, 0x29, 0x6e, 0xaf, 0xde,
+	0x42, 0xf3, 0xae, 0xef, 0xc6, 0xae, 0xe3, 0x31, 0xc7, 0x4e, 0xad, 0x6c, 0x44, 0x76, 0xcf, 0x6f,
+	0x6a, 0xb8, 0x1c, 0x3e, 0x46, 0x5b, 0x7c, 0x07, 0x55, 0x98, 0xf6, 0xa8, 0x4d, 0x3d, 0xc5, 0xfa,
+	0x18, 0x76, 0xd7, 0xce, 0xc2, 0x2c, 0x78, 0xa2, 0x19, 0xe7, 0xc4, 0x2c, 0x7a, 0x5e, 0xce, 0x45,
+	0x1d, 0xbd, 0x6b, 0x15, 0x53, 0x7f, 0xb7, 0x52, 0x78, 0xc8, 0xb4, 0xa0, 0x5c, 0xf6, 0x1c, 0xd7,
+	0x1b, 0x84, 0x24, 0xe1, 0x32, 0x6d, 0x72, 0xb9, 0x99, 0xc2, 0x43, 0xa6, 0x05, 0xde, 0x43, 0xf3,
+	0x02, 0xc6, 0x43, 0xac, 0x66, 0x4e, 0x39, 0x4a, 0x16, 0x4a, 0x77, 0x53, 0xe3, 0x04, 0x06, 0x5f,
+	0x3c, 0x40, 0xcb, 0xae, 0x76, 0xd8, 0x4b, 0xb2, 0xbc, 0x4e, 0x23, 0xec, 0x22, 0x0d, 0xae, 0xd9,
+	0x4c, 0xb3, 0x83, 0xac, 0x04, 0x1a, 0xc8, 0x78, 0xb1, 0x1d, 0xf8, 0x11, 0x2b, 0x48, 0x70, 0x40,
+	0x6e, 0x84, 0x61, 0x10, 0x72, 0xd9, 0xb3, 0xa7, 0x94, 0xcd, 0xfc, 0x89, 0xeb, 0x79, 0x2c, 0x21,
+	0x5f, 0x12, 0x7e, 0x0f, 0x55, 0xfb, 0x61, 0x70, 0xe0, 0x76, 0x48, 0x28, 0xc2, 0xf5, 0xb6, 0x8a,
+	0xa8, 0xd7, 0xd2, 0x14, 0x3c, 0x93, 0xad, 0x47, 0x42, 0x40, 0xc9, 0xb3, 0x7f, 0xb5, 0x8a, 0x16,
+	0x4c, 0x72, 0xfc, 0x79, 0x84, 0xfa, 0x61, 0xd0, 0x23, 0xf1, 0x3e, 0x51, 0x39, 0x33, 0xb7, 0x26,
+	0xad, 0xc3, 0x21, 0xf9, 0xc9, 0xc8, 0x15, 0xba, 0x5d, 0x24, 0x50, 0xd0, 0x24, 0xe2, 0x10, 0xcd,
+	0x3c, 0xe4, 0x4a, 0x54, 0xd8, 0x14, 0x6f, 0x17, 0x62, 0x01, 0x09, 0xc9, 0x2c, 0xd9, 0x43, 0x80,
+	0x40, 0x0a, 0xc2, 0xbb, 0xa8, 0xfc, 0x88, 0xec, 0x16, 0x93, 0xdb, 0x7e, 0x9f, 0x88, 0xb3, 0x49,
+	0x63, 0x86, 0xa6, 0x62, 0xdf, 0x27, 0xbb, 0x40, 0x99, 0xd3, 0x71, 0x75, 0xf8, 0x1d, 0x7c, 0x6d,
+	0xaa, 0x88, 0x71, 0x19, 0x17, 0xfa, 0x7c, 0x5c, 0x02, 0x04, 0x52, 0x10, 0x7e, 0x0f, 0xcd, 0x3e,
+	0x72, 0x0e, 0xc8, 0x5e, 0x18, 0xf8, 0x71, 0x31, 0x25, 0x61, 0xee, 0x4b, 0x76, 0x42, 0x2e, 0x53,
+	0xef, 0x0a, 0x08, 0x89, 0x38, 0x7c, 0x80, 0xaa, 0x3e, 0xcd, 0x99, 0xf5, 0xdc, 0x76, 0x6d, 0xba,
+	0x88, 0x69, 0x7d, 0x4b, 0x70, 0x13, 0x92, 0x99, 0xde, 0x93, 0x30, 0x50, 0xb2, 0xe8, 0xbb, 0x7c,
+	0x10, 0xec, 0xd6, 0x66, 0x8a, 0x78, 0x97, 0x6f, 0x05, 0xc6, 0xbb, 0x7c, 0x2b, 0xd8, 0x05, 0xca,
+	0x9c, 0xae, 0x91, 0xb6, 0x8a, 0xd5, 0xa9, 0x55, 0x8b, 0x58, 0x23, 0xe9, 0xd8, 0x1f, 0xbe, 0x46,
+	0x12, 0x28, 0x68, 0x12, 0xe9, 0xb3, 0xed, 0x0a, 0x47, 0x65, 0x6d, 0xb6, 0x88, 0x67, 0x6b, 0xba,
+	0x3d, 0xf9, 0xb3, 0x95, 0x30, 0x50, 0xb2, 0xec, 0x6f, 0x4e, 0xa3, 0x79, 0xbd, 0x3e, 0xdd, 0x08,
+	0xba, 0x5a, 0xd9, 0xa7, 0xa5, 0x71, 0xec, 0x53, 0x7a, 0xbc, 0xd0, 0xee, 0x19, 0xa4, 0x87, 0x61,
+	0xb3, 0x30, 0xf3, 0x2c, 0x39, 0x5e, 0x68, 0xc0, 0x08, 0x0c, 0xa1, 0x63, 0x84, 0x1e, 0x50, 0x23,
+	0x87, 0x9b, 0x01, 0x15, 0xd3, 0xc8, 0x31, 0x14, 0xfb, 0x75, 0x84, 0x92, 0x3a, 0x6d, 0xe2, 0xfe,
+	0x49, 0x59, 0x4f, 0x5a, 0xfd, 0x38, 0x8d, 0x8a, 0xfa, 0x39, 0xa9, 0xa2, 0x24, 0x1d, 0x91, 0x4a,
+	0xad, 0xce, 0x70, 0x37, 0x19, 0x14, 0x04, 0x96, 0x46, 0x1f, 0xe8, 0xea, 0x4d, 0x64, 0x48, 0x5f,
+	0x48, 0x6c, 0x9a, 0x04, 0x07, 0x06, 0x25, 0xed, 0x3a, 0x09, 0xc3, 0x20, 0xac, 0xcd, 0x9a, 0x5d,
+	0x67, 0x2a, 0x0a, 0x38, 0x8e, 0xf9, 0x14, 0x52, 0xda, 0x8b, 0x29, 0xab, 0x8a, 0xe6, 0x53, 0x48,
+	0xe1, 0x21, 0xd3, 0x82, 0x0e, 0x46, 0x5c, 0x9d, 0xcd, 0xf1, 0x08, 0xcb, 0x21, 0x97, 0x5e, 0x5f,
+	0xd1, 0x2d, 0xf3, 0xf9, 0xab, 0xe5, 0xc9, 0xc3, 0x28, 0xf5, 0x59, 0x3b, 0xba, 0x69, 0x3e, 0x99,
+	0x11, 0xfd, 0xcf, 0x2c, 0x94, 0xae, 0x96, 0x45, 0xe3, 0x4c, 0x55, 0xc8, 0x9f, 0xac, 0x1e, 0xcc,
+	0x56, 0xba, 0x22, 0x8c, 0x40, 0xa3, 0xc0, 0x8f, 0xd1, 0xb2, 0xfa, 0x65, 0x14, 0xdb, 0x98, 0xbb,
+	0xfe, 0xd1, 0x11, 0xef, 0xdd, 0x69, 0xe8, 0xae, 0x6c, 0xca, 0x4d, 0xa3, 0x5b, 0x69, 0x8e, 0x90,
+	0x15, 0x42, 0x2f, 0x43, 0xcc, 0x1d, 0x97, 0x2e, 0x87, 0x7e, 0x18, 0xec, 0xb9, 0x1e, 0x49, 0x7b,
+	0xae, 0x9a, 0x1c, 0x0c, 0x12, 0x3f, 0xda, 0x65, 0xc8, 0xef, 0x96, 0xd1, 0xf9, 0x5b, 0x5d, 0xd7,
+	0x7f, 0x9c, 0xf2, 0x39, 0xe7, 0x15, 0xbd, 0xb6, 0xc6, 0x2d, 0x7a, 0x9d, 0xe4, 0x2a, 0x89, 0xaa,
+	0xe2, 0xf9, 0xb9, 0x4a, 0x02, 0x09, 0x26, 0x2d, 0xfe, 0x7d, 0x0b, 0xbd, 0xe4, 0x74, 0xb8, 0x0d,
+	0xec, 0x78, 0x02, 0x9a, 0x08, 0x95, 0xfb, 0x51, 0x34, 0xa1, 0x46, 0xcb, 0x0e, 0x7e, 0xad, 0x7e,
+	0x82, 0x54, 0x3e, 0x5f, 0xe5, 0x3d, 0xc9, 0x4b, 0x27, 0x91, 0xc2, 0x89, 0xdd, 0x5f, 0xb9, 0x8d,
+	0x3e, 0xf0, 0x54, 0x41, 0x63, 0xcd, 0xf5, 0x2f, 0x59, 0x68, 0x96, 0xbb, 0x54, 0xe9, 0xcd, 0xdb,
+	0x75, 0x84, 0x9c, 0xbe, 0x7b, 0x8f, 0x84, 0x91, 0xac, 0xe5, 0xa6, 0x1d, 0x13, 0xeb, 0xcd, 0x4d,
+	0x81, 0x01, 0x8d, 0x8a, 0xaa, 0x92, 0x87, 0xae, 0xdf, 0xa9, 0x95, 0x4c, 0x55, 0xf2, 0xb6, 0xeb,
+	0x77, 0x80, 0x61, 0x94, 0xb2, 0x29, 0x0f, 0x2d, 0xac, 0xf4, 0x4b, 0x16, 0x5a, 0x60, 0x49, 0xa4,
+	0xc9, 0x01, 0xe6, 0x63, 0x2a, 0x2a, 0x86, 0x77, 0xe3, 0xb2, 0x19, 0x15, 0xf3, 0xe4, 0x68, 0x75,
+	0x8e, 0xb5, 0x48, 0x05, 0xc9, 0xc8, 0xec, 0x42, 0x16, 0xbb, 0x33, 0x69, 0x76, 0x21, 0x05, 0x41,
+	0xc2, 0xcf, 0xfe, 0x87, 0x16, 0x3a, 0xdf, 0x24, 0x61, 0x8b, 0x05, 0xf8, 0xdf, 0xa0, 0x0f, 0x91,
+	0x3b, 0x6e, 0x7f, 0x1c, 0x4d, 0xf7, 0x79, 0xf9, 0x3e, 0xcb, 0xb8, 0x9f, 0x9b, 0xe6, 0x9b, 0xc7,
+	0x13, 0x5a, 0x80, 0x40, 0x36, 0xe3, 0x20, 0x10, 0x0d, 0x68, 0x54, 0xfc, 0xbb, 0x83, 0x20, 0x1c,
+	0xf4, 0x4e, 0x1d, 0xf3, 0xcd, 0x9c, 0xfc, 0x77, 0x18, 0x0f, 0x10, 0xbc, 0xec, 0xf7, 0xd1, 0xbc,
+	0x9e, 0x12, 0x42, 0x1d, 0xd2, 0x34, 0x0d, 0xc4, 0x4c, 0x1d, 0x54, 0x0e, 0xe9, 0x66, 0x82, 0x02,
+	0x9d, 0x8e, 0x35, 0x0b, 0x92, 0x66, 0x29, 0x3f, 0x76, 0x33, 0xd0, 0x9b, 0x25, 0x3f, 0xec, 0x5f,
+	0x2f, 0xa3, 0xf3, 0x39, 0xa9, 0x47, 0xd4, 0x6f, 0x33, 0xcd, 0x12, 0x12, 0x64, 0x80, 0xce, 0x67,
+	0x0a, 0x4f, 0x6f, 0xe2, 0xbb, 0xa6, 0x58, 0x70, 0x4a, 0x4b, 0x71, 0x20, 0x08, 0xe1, 0xf8, 0xef,
+	0x58, 0xf4, 0xe2, 0x33, 0xd9, 0x13, 0x78, 0xcc, 0xd2, 0x6e, 0xf1, 0x9d, 0xc9, 0x6c, 0x01, 0xda,
+	0xe5, 0x6a, 0xb2, 0xe2, 0xf5, 0xbe, 0xac, 0xfc, 0x38, 0x9a, 0xd3, 0x86, 0x30, 0xce, 0x52, 0x5e,
+	0x79, 0x1d, 0x2d, 0x4d, 0xb4, 0x15, 0x7c, 0x0a, 0x8d, 0x5b, 0x43, 0x91, 0xda, 0x05, 0x8f, 0xf4,
+	0x14, 0x74, 0xf5, 0xc4, 0x45, 0x0e, 0xba, 0xc0, 0xda, 0xc7, 0x16, 0x5a, 0x4a, 0x1f, 0x26, 0x8b,
+	0xbe, 0xa3, 0xc7, 0x9f, 0x47, 0xb3, 0x7d, 0xb9, 0xca, 0xc4, 0x91, 0x70, 0xd2, 0xfc, 0xb9, 0xec,
+	0x5a, 0xe7, 0x07, 0x27, 0x85, 0x80, 0x44, 0xa4, 0xfd, 0x63, 0x68, 0xcc, 0xb2, 0x8b, 0x76, 0x0f,
+	0x2d, 0x02, 0x61, 0xdb, 0x8b, 0x20, 0x25, 0xd4, 0xa9, 0x1c, 0x89, 0xff, 0xc5, 0x53, 0x51, 0x66,
+	0x8e, 0xa4, 0x81, 0x6a, 0xa4, 0x51, 0xc7, 0x6e, 0x8f, 0xbc, 0x13, 0xf8, 0x72, 0x75, 0x2a, 0xea,
+	0x1d, 0x01, 0x07, 0x45, 0x61, 0xff, 0xab, 0x12, 0x9a, 0x11, 0xf9, 0x9a, 0xcf, 0x20, 0x2c, 0xfb,
+	0xa1, 0x71, 0x03, 0xb7, 0x59, 0x48, 0x9a, 0xe9, 0xd0, 0x98, 0xec, 0x28, 0x15, 0x93, 0xfd, 0x76,
+	0x31, 0xe2, 0x4e, 0x0e, 0xc8, 0xbe, 0x83, 0x16, 0x05, 0xa1, 0xfc, 0x48, 0xc7, 0xa4, 0x9f, 0xe7,
+	0xb0, 0x7f, 0xd5, 0x42, 0x4b, 0x92, 0xa7, 0x47, 0xc2, 0x98, 0xdd, 0x53, 0x3b, 0xa8, 0x1a, 0xe9,
+	0x15, 0xdf, 0x4e, 0x69, 0x42, 0x26, 0x13, 0x49, 0x40, 0x40, 0xb1, 0xa5, 0xda, 0xd6, 0x88, 0xe9,
+	0xb8, 0x9c, 0x89, 0xe9, 0x98, 0x63, 0xfd, 0x31, 0x83, 0x39, 0xec, 0xff, 0x6d, 0x21, 0xac, 0x77,
+	0x77, 0x8c, 0x48, 0xf4, 0xd3, 0xc8, 0xa3, 0x5b, 0x46, 0x24, 0x0a, 0x8d, 0x97, 0xcd, 0x2d, 0x43,
+	0x16, 0x07, 0x97, 0x78, 0xfc, 0x49, 0x54, 0x65, 0x2b, 0x2b, 0x3a, 0xd5, 0x1d, 0x45, 0xf2, 0xac,
+	0x04, 0x0f, 0x50, 0xdc, 0xec, 0xdf, 0xaa, 0x24, 0xef, 0x5d, 0x26, 0x35, 0x7f, 0xc5, 0xca, 0x86,
+	0x9f, 0xde, 0x2d, 0x34, 0xb3, 0x5a, 0x65, 0x8a, 0x9c, 0x1c, 0x89, 0x1a, 0x19, 0x25, 0x9d, 0xef,
+	0x14, 0xf6, 0x35, 0x88, 0x1f, 0x56, 0x77, 0x1e, 0xb7, 0xba, 0xf3, 0xdf, 0xb6, 0x10, 0x76, 0x33,
+	0x21, 0x63, 0xc2, 0x9b, 0xd7, 0x9c, 0x30, 0xc0, 0x24, 0xc3, 0x97, 0xa7, 0xe4, 0x66, 0xe1, 0x90,
+	0xd3, 0x07, 0xfb, 0xbf, 0x5a, 0xe8, 0x85, 0xa1, 0x89, 0xfb, 0xac, 0x3a, 0x57, 0x68, 0x62, 0x6b,
+	0x56, 0x11, 0x3e, 0xc8, 0xb4, 0x48, 0x75, 0x41, 0x9b, 0x42, 0x40, 0x5a, 0x3c, 0x7e, 0x15, 0xcd,
+	0xb3, 0xe5, 0x47, 0x95, 0x6a, 0x4c, 0xfa, 0xe2, 0x46, 0x8a, 0xdd, 0x4d, 0xb4, 0x34, 0x38, 0x18,
+	0x54, 0xf6, 0x2f, 0x5a, 0xa8, 0x36, 0xac, 0x62, 0xd2, 0x08, 0x7b, 0xd4, 0x5f, 0x48, 0x65, 0x32,
+	0xac, 0x66, 0x32, 0x19, 0x52, 0x3e, 0x30, 0x41, 0xae, 0xbb, 0x9f, 0xca, 0x4f, 0x09, 0xd4, 0xff,
+	0x9a, 0x85, 0x2e, 0x0d, 0x59, 0xe8, 0x99, 0x8c, 0x16, 0xeb, 0xd4, 0x19, 0x2d, 0xa5, 0x51, 0x33,
+	0x5a, 0xec, 0x7f, 0x53, 0x56, 0xfa, 0x27, 0x39, 0x8a, 0x7d, 0xdc, 0xc8, 0x07, 0xf9, 0x91, 0x54,
+	0x3e, 0xc8, 0x85, 0x34, 0xfd, 0x0f, 0x93, 0x41, 0x7e, 0xb0, 0x92, 0x41, 0x7e, 0xae, 0x84, 0x96,
+	0xe5, 0x3b, 0x0a, 0x9d, 0x68, 0xff, 0x8d, 0x81, 0x13, 0x76, 0xf0, 0x47, 0xd0, 0x5c, 0xcf, 0x79,
+	0x2c, 0xcc, 0x4f, 0x19, 0xbf, 0xca, 0x22, 0x98, 0xb7, 0x13, 0x30, 0xe8, 0x34, 0xb4, 0x0a, 0x67,
+	0xcf, 0x79, 0xcc, 0x78, 0x6c, 0x05, 0x41, 0x9f, 0x6e, 0x19, 0xb7, 0xf7, 0xf6, 0xc4, 0x62, 0x64,
+	0x45, 0xe0, 0xb6, 0xb3, 0x68, 0xc8, 0x6b, 0x23, 0x58, 0x6d, 0xf6, 0x9c, 0x2e, 0x69, 0x0e, 0x3c,
+	0x4f, 0xb2, 0x2a, 0x1b, 0xac, 0xd2, 0x68, 0xc8, 0x6b, 0x43, 0xf7, 0x86, 0x9e, 0xf3, 0xf8, 0xf6,
+	0xed, 0xed, 0xb7, 0x5d, 0x4f, 0x16, 0xf2, 0x14, 0x7b, 0xc3, 0xb6, 0x06, 0x07, 0x83, 0xca, 0xfe,
+	0xe3, 0x12, 0xba, 0x98, 0x5b, 0x55, 0x8a, 0x16, 0x70, 0xca, 0xa8, 0xf2, 0xfb, 0x05, 0x97, 0xaf,
+	0x1a, 0x51, 0x99, 0x4f, 0x9a, 0x56, 0xf2, 0x0b, 0x7a, 0x3a, 0x07, 0x57, 0xcd, 0x7b, 0x67, 0x50,
+	0x88, 0x6b, 0xcc, 0xcc, 0x0e, 0xfb, 0x6f, 0x94, 0xd1, 0x2b, 0xa3, 0x32, 0xfa, 0x01, 0xcd, 0xfc,
+	0x8b, 0x8c, 0xcc, 0xbf, 0x67, 0x64, 0x66, 0x9d, 0x49, 0x12, 0xe0, 0x37, 0xcb, 0xe8, 0x85, 0xcc,
+	0xcb, 0x50, 0x3a, 0x68, 0x94, 0x98, 0x8e, 0x19, 0x7a, 0x02, 0x93, 0xd5, 0xb6, 0xb5, 0xaa, 0x58,
+	0x2d, 0x0e, 0xa6, 0x55, 0xb1, 0x92, 0x8f, 0x0a, 0x0a, 0x20, 0xc8, 0x46, 0xf4, 0xa3, 0x7c, 0xe2,
+	0x13, 0x83, 0x32, 0x22, 0x5c, 0x04, 0xc6, 0x70, 0x18, 0x28, 0x2c, 0xfe, 0x82, 0x76, 0x64, 0x9d,
+	0x3a, 0xab, 0xf2, 0x38, 0x27, 0xc5, 0xfb, 0x7c, 0x46, 0x3b, 0x87, 0x55, 0x4e, 0x7f, 0x0e, 0x9b,
+	0x1f, 0x72, 0x06, 0xb3, 0x95, 0x33, 0x85, 0xdf, 0x30, 0xa1, 0x1c, 0x47, 0xca, 0x77, 0x2d, 0x34,
+	0x27, 0xde, 0xd6, 0x33, 0xc8, 0xea, 0x7b, 0x60, 0x66, 0xf5, 0xdd, 0x28, 0x64, 0xef, 0x18, 0x92,
+	0xd2, 0xf7, 0x00, 0xcd, 0xeb, 0x85, 0x05, 0x59, 0xf1, 0x3a, 0xb9, 0xf7, 0x59, 0x13, 0x15, 0xaf,
+	0x13, 0x5c, 0x92, 0x7d, 0xd1, 0xfe, 0x95, 0x92, 0x3a, 0xc1, 0xc9, 0x9c, 0x3a, 0x76, 0x49, 0x42,
+	0xc2, 0x36, 0xf1, 0xa5, 0x2f, 0x2b, 0xb9, 0x24, 0xe1, 0x60, 0x90, 0x78, 0x1a, 0x7c, 0x72, 0x89,
+	0x44, 0xb1, 0xdb, 0x73, 0x62, 0xd2, 0x49, 0x96, 0xd2, 0x29, 0x5d, 0xce, 0x2c, 0xb5, 0xef, 0x46,
+	0x3e, 0x3b, 0x18, 0x26, 0x07, 0xff, 0x45, 0xf6, 0x1d, 0x4e, 0x20, 0x4e, 0xe7, 0xd0, 0xcc, 0x14,
+	0x3c, 0x2f, 0xbe, 0xc1, 0xa9, 0xa3, 0x20, 0x4d, 0x3b, 0x4e, 0x72, 0xf6, 0xb7, 0x92, 0x33, 0xfe,
+	0x9d, 0x01, 0x19, 0x88, 0x7c, 0x29, 0xea, 0x7a, 0xea, 0x07, 0xdc, 0x70, 0x11, 0x0f, 0x2c, 0x89,
+	0x57, 0x11, 0x70, 0x50, 0x14, 0xd4, 0xac, 0xd9, 0x1d, 0x74, 0xba, 0x24, 0x96, 0xe5, 0x6c, 0xa4,
+	0x59, 0xd3, 0x60, 0x50, 0x10, 0x58, 0x7a, 0x6a, 0x7f, 0x97, 0x0a, 0x39, 0x5d, 0xcc, 0xa2, 0xea,
+	0xc1, 0x1d, 0xc1, 0x03, 0x14, 0x37, 0xfb, 0x0f, 0xe7, 0xd5, 0xca, 0x61, 0x2e, 0x71, 0x7d, 0xdf,
+	0xb1, 0x4e, 0xdc, 0x77, 0xf4, 0x65, 0x5f, 0x2a, 0x7e, 0xd9, 0xdf, 0x41, 0x55, 0xa9, 0x94, 0xc4,
+	0x90, 0x5f, 0xd6, 0xd8, 0xaf, 0x51, 0xa3, 0x78, 0xed, 0xc0, 0xd8, 0xac, 0x98, 0x17, 0x2c, 0x71,
+	0xf4, 0x09, 0x28, 0x28, 0x36, 0xf8, 0x3d, 0x34, 0xf7, 0x28, 0x08, 0x1f, 0x7a, 0x81, 0xc3, 0xbe,
+	0x82, 0x80, 0x8a, 0x08, 0xa9, 0x50, 0x97, 0x44, 0xdc, 0x48, 0xbc, 0x9f, 0xf0, 0x07, 0x5d, 0x18,
+	0xfd, 0x4a, 0x41, 0xcf, 0xf5, 0x8d, 0x89, 0xc9, 0x2d, 0x32, 0x75, 0xda, 0xdb, 0x36, 0xd1, 0x90,
+	0xa6, 0xc7, 0x9f, 0xa3, 0xae, 0x1b, 0x5e, 0xf6, 0xb0, 0x98, 0xe0, 0x17, 0xf9, 0xde, 0x05, 0x53,
+	0xdd, 0xbb, 0xc3, 0x21, 0xa0, 0x04, 0xd2, 0xfa, 0xf0, 0xa1, 0x28, 0x2c, 0x66, 0x7c, 0xd2, 0x8d,
+	0xef, 0xc9, 0xac, 0x1a, 0x38, 0xe4, 0xe0, 0x21, 0xb7, 0x15, 0x4d, 0x62, 0x95, 0xf0, 0x96, 0xef,
+	0xf4, 0xa3, 0xfd, 0x20, 0xe6, 0xec, 0x16, 0x92, 0x24, 0x56, 0xc8, 0x23, 0x80, 0xfc, 0x76, 0x74,
+	0x21, 0xb1, 0x32, 0xab, 0x3c, 0xac, 0x40, 0xbb, 0x89, 0x67, 0xbb, 0x26, 0xad, 0xf8, 0xc3, 0xfe,
+	0x9e, 0x94, 0x51, 0x5c, 0x9d, 0x20, 0xa3, 0xb8, 0x85, 0x2e, 0xa6, 0x51, 0xac, 0x9e, 0x5a, 0x6d,
+	0xde, 0x34, 0x41, 0x9a, 0x79, 0x44, 0x90, 0xdf, 0x96, 0x46, 0x2a, 0x87, 0xfc, 0x8c, 0x51, 0x97,
+	0xf1, 0x7b, 0x63, 0x47, 0x2a, 0x83, 0x64, 0x00, 0x09, 0x2f, 0x16, 0x66, 0x1e, 0x9a, 0x0e, 0xf6,
+	0xda, 0x52, 0x21, 0x13, 0xca, 0x64, 0xca, 0x37, 0xdd, 0x14, 0x10, 0xd2, 0xa2, 0xe9, 0xbc, 0x76,
+	0xcc, 0x4a, 0xec, 0x77, 0x0a, 0xfc, 0xe8, 0xae, 0x98, 0xdb, 0xc3, 0xca, 0x2e, 0xd2, 0x82, 0xb8,
+	0xc2, 0x4b, 0x5d, 0x3b, 0x57, 0xc8, 0x33, 0x30, 0x5d, 0xdf, 0x42, 0xb0, 0xf8, 0x05, 0x4a, 0x18,
+	0x2d, 0x97, 0xe2, 0x78, 0x84, 0x9e, 0x31, 0x17, 0x8b, 0x28, 0x9d, 0x9c, 0xf6, 0x8e, 0x27, 0x2b,
+	0x80, 0x81, 0x22, 0x10, 0xd2, 0xf0, 0x17, 0x10, 0x6a, 0xab, 0xe3, 0x6e, 0x6d, 0xb9, 0x88, 0x2f,
+	0x01, 0x67, 0x4e, 0xd1, 0x22, 0xd8, 0x4b, 0xfd, 0x06, 0x4d, 0xa4, 0xfd, 0x8f, 0x2f, 0xa2, 0x73,
+	0xc6, 0x45, 0x02, 0xbd, 0xc6, 0x62, 0x15, 0x06, 0x99, 0xc2, 0xa9, 0x26, 0x86, 0x10, 0x5f, 0x1d,
+	0x1c, 0x47, 0xeb, 0x9f, 0x2e, 0xf6, 0x8d, 0xbb, 0x70, 0x69, 0x7f, 0x4d, 0x18, 0x2b, 0x66, 0x5e,
+	0xb0, 0x6b, 0x5f, 0x8d, 0x31, 0x85, 0x41, 0x5a, 0x3a, 0xdd, 0xd2, 0x45, 0xf6, 0x86, 0x47, 0x42,
+	0x46, 0x2d, 0x4e, 0x4a, 0x8a, 0xc5, 0xba, 0x89, 0x86, 0x34, 0x3d, 0x5d, 0xe2, 0x6c, 0x74, 0x93,
+	0x7c, 0xff, 0xb4, 0x2e, 0x19, 0x40, 0xc2, 0x8b, 0x5e, 0xb8, 0x88, 0xc2, 0xe3, 0xcd, 0xa0, 0xc3,
+	0x3e, 0x47, 0x5e, 0x31, 0x2f, 0x5c, 0xd6, 0x0d, 0x2c, 0xa4, 0xa8, 0xd9, 0xd8, 0x92, 0xea, 0xee,
+	0x8c, 0xc1, 0xb4, 0xf9, 0x51, 0x9d, 0x75, 0x13, 0x0d, 0x69, 0x7a, 0x6a, 0x09, 0x29, 0x4b, 0x62,
+	0xc6, 0xb4, 0x84, 0x72, 0xac, 0x89, 0x3a, 0x5a, 0x1c, 0x30, 0x37, 0x53, 0x47, 0x22, 0xc5, 0x86,
+	0xac, 0x04, 0xde, 0x35, 0xd1, 0x90, 0xa6, 0xa7, 0x11, 0x32, 0x21, 0xd5, 0x97, 0x8a, 0x01, 0x0f,
+	0x00, 0x53, 0x11, 0x32, 0xa0, 0x23, 0xc1, 0xa4, 0xa5, 0xd5, 0xdd, 0x93, 0xda, 0xb3, 0x92, 0x01,
+	0x8f, 0x08, 0x53, 0xf5, 0x0d, 0xeb, 0x69, 0x02, 0xc8, 0xb6, 0xc1, 0x7f, 0x19, 0x2d, 0x69, 0x4f,
+	0x82, 0x95, 0x78, 0x16, 0xf5, 0x41, 0xd9, 0xf7, 0xcf, 0xd6, 0x53, 0x38, 0xc8, 0x50, 0xe3, 0x9f,
+	0x40, 0x0b, 0xed, 0xc0, 0xf3, 0x98, 0x96, 0xe3, 0x1f, 0x74, 0xe1, 0x85, 0x40, 0x79, 0xc9, 0x54,
+	0x03, 0x03, 0x29, 0x4a, 0x9a, 0x3b, 0x16, 0xec, 0x46, 0x24, 0x3c, 0x20, 0x9d, 0x37, 0x88, 0x4f,
+	0x42, 0x47, 0x6d, 0x6c, 0x5a, 0xee, 0xd8, 0xed, 0x0c, 0x05, 0xe4, 0xb4, 0x62, 0xe5, 0x11, 0xb5,
+	0xcc, 0xfc, 0x85, 0x02, 0xb7, 0xa9, 0xd1, 0xd3, 0xf2, 0x43, 0x34, 0xcd, 0x53, 0xf9, 0x8a, 0xa9,
+	0x08, 0xaa, 0x7f, 0x61, 0x21, 0xd9, 0x22, 0x39, 0x14, 0x84, 0x24, 0x7a, 0x63, 0xbe, 0x2b, 0x3f,
+	0xf4, 0x53, 0x8c, 0x62, 0x4c, 0x7d, 0xb3, 0x2a, 0xf1, 0x6f, 0x29, 0x04, 0x24, 0x22, 0xf1, 0x07,
+	0xd1, 0xdc, 0x9b, 0xcd, 0xba, 0x9a, 0x85, 0xcb, 0xec, 0xed, 0x4f, 0xd1, 0x26, 0xa0, 0x23, 0xd8,
+	0xa5, 0xb8, 0xb4, 0xc0, 0x71, 0xea, 0x52, 0x3c, 0x6b, 0x50, 0x7f, 0x88, 0xdd, 0xfc, 0xd1, 0xa9,
+	0xda, 0xaa, 0x9d, 0x4f, 0x51, 0x0b, 0x38, 0x28, 0x0a, 0x5a, 0xf5, 0x41, 0xe8, 0x69, 0xb6, 0x37,
+	0x5d, 0x38, 0x5d, 0xd5, 0x07, 0x48, 0x58, 0x80, 0xce, 0x8f, 0x85, 0xd0, 0xb0, 0xef, 0x9f, 0x90,
+	0x9b, 0x03, 0xcf, 0xab, 0x5d, 0x64, 0xfb, 0x66, 0x12, 0x42, 0x93, 0xa0, 0x40, 0xa7, 0xc3, 0x1f,
+	0x95, 0xd1, 0xb7, 0xcf, 0x1b, 0xd7, 0xa3, 0x2a, 0xfa, 0x56, 0x9d, 0x95, 0x87, 0x24, 0x87, 0x5d,
+	0x7a, 0x4a, 0xd8, 0xeb, 0x2e, 0x5a, 0x91, 0x46, 0x7b, 0x76, 0x91, 0xd4, 0x6a, 0x86, 0xaf, 0x71,
+	0xe5, 0xfe, 0x50, 0x4a, 0x38, 0x81, 0x0b, 0x0d, 0xe8, 0x76, 0xbc, 0xdd, 0xda, 0x0b, 0x45, 0x9c,
+	0x3e, 0xea, 0x5b, 0x0d, 0x31, 0xa3, 0x58, 0x40, 0x77, 0x7d, 0xab, 0x01, 0x94, 0x39, 0x0d, 0xa8,
+	0x56, 0x56, 0xcd, 0x4a, 0x11, 0x01, 0xd5, 0xd2, 0x80, 0x11, 0xd2, 0x86, 0x19, 0x35, 0x8f, 0x50,
+	0x55, 0xda, 0xb2, 0xb5, 0x17, 0x0b, 0xb4, 0xa6, 0xa4, 0xdd, 0xcc, 0x05, 0xcb, 0x5f, 0xa0, 0x84,
+	0xe1, 0x5f, 0xb6, 0xd0, 0xf3, 0x6e, 0x6e, 0x39, 0x87, 0xda, 0x4b, 0xac, 0x1f, 0x3b, 0xc5, 0xdd,
+	0x2c, 0x26, 0xbc, 0x1b, 0x2b, 0xc7, 0x47, 0xab, 0x43, 0xca, 0x48, 0xc0, 0x90, 0xfe, 0xe0, 0x77,
+	0x59, 0xd0, 0xce, 0x80, 0xd4, 0x2e, 0x17, 0x71, 0xe5, 0x99, 0x75, 0x41, 0xf0, 0x0c, 0x2c, 0x06,
+	0x00, 0x2e, 0x89, 0x8a, 0x64, 0xd6, 0x5f, 0xed, 0x4a, 0x81, 0x22, 0xb5, 0xc8, 0x06, 0x2e, 0x92,
+	0x01, 0x80, 0x4b, 0xc2, 0x9f, 0x47, 0xcf, 0xfb, 0xe4, 0xb1, 0x32, 0xf2, 0x3b, 0xea, 0x20, 0x52,
+	0x5b, 0x1d, 0xff, 0xb2, 0x88, 0x3e, 0xe5, 0x5b, 0xb9, 0xdc, 0x60, 0x88, 0x14, 0x1a, 0x74, 0xdb,
+	0xd7, 0xca, 0x2d, 0x33, 0x0b, 0xe6, 0xaa, 0x19, 0x74, 0xdb, 0x4c, 0xe1, 0x21, 0xd3, 0xc2, 0xfe,
+	0xe9, 0xc4, 0x23, 0xa6, 0xbe, 0x38, 0xf0, 0xbe, 0xae, 0x1d, 0xac, 0x22, 0xec, 0xe7, 0xcc, 0x57,
+	0xe5, 0xb8, 0x61, 0x97, 0xab, 0x1b, 0xfa, 0x4a, 0x1f, 0x16, 0x52, 0xd7, 0xd1, 0xfc, 0x9a, 0x02,
+	0xf7, 0xad, 0x9a, 0xda, 0xd0, 0xfe, 0xde, 0xb4, 0xba, 0x12, 0x4a, 0x05, 0x36, 0x87, 0xa8, 0xe2,
+	0x46, 0xb1, 0x1b, 0x14, 0x58, 0x42, 0xc3, 0x94, 0xc0, 0xe7, 0x15, 0x43, 0x00, 0x17, 0x45, 0x65,
+	0xfa, 0x34, 0xcc, 0xb8, 0x56, 0x2a, 0x42, 0x66, 0x4e, 0xc4, 0x32, 0x97, 0xc9, 0x10, 0xc0, 0x45,
+	0xe1, 0x07, 0x7c, 0xc7, 0x2e, 0x17, 0xf1, 0xae, 0xeb, 0x5b, 0x8d, 0x94, 0x3c, 0x73, 0xe7, 0x7e,
+	0x80, 0xca, 0x51, 0xcf, 0xad, 0x4d, 0x15, 0x21, 0xab, 0xb5, 0xbd, 0x99, 0x27, 0xab, 0xb5, 0xbd,
+	0x09, 0x54, 0x08, 0x8d, 0xce, 0x41, 0x4e, 0x6f, 0xd7, 0x89, 0x22, 0xa7, 0xa3, 0x7c, 0xf7, 0x13,
+	0x7e, 0x8a, 0xa9, 0xae, 0xf8, 0xa5, 0x44, 0xb3, 0x23, 0x61, 0x82, 0x05, 0x4d, 0x32, 0x7e, 0x0f,
+	0xcd, 0x38, 0xfc, 0x03, 0xb2, 0xb5, 0xe9, 0x22, 0xbe, 0x69, 0x91, 0xfb, 0x0d, 0x66, 0x9e, 0x53,
+	0x26, 0x50, 0x20, 0x05, 0x52, 0xd9, 0x71, 0xe8, 0x90, 0x3d, 0xf7, 0x61, 0x6d, 0xa6, 0x08, 0xd9,
+	0x3b, 0x9c, 0x59, 0x9e, 0x6c, 0x81, 0x02, 0x29, 0xd0, 0xfe, 0x1f, 0x16, 0x42, 0xd4, 0x49, 0x21,
+	0x62, 0xb3, 0x54, 0xd2, 0x8d, 0x35, 0x72, 0xd2, 0x4d, 0x69, 0xcc, 0xa4, 0x9b, 0xf2, 0x58, 0x49,
+	0x37, 0x53, 0xe3, 0x27, 0xdd, 0x54, 0x86, 0x27, 0xdd, 0xd8, 0x5f, 0xb7, 0xd0, 0x72, 0x66, 0x4e,
+	0x52, 0x53, 0x30, 0x0c, 0x82, 0x78, 0x48, 0x10, 0x36, 0x24, 0x28, 0xd0, 0xe9, 0xe8, 0x06, 0x2f,
+	0xbe, 0x47, 0xd2, 0xea, 0x7b, 0x6e, 0x6e, 0xb5, 0xa1, 0x9d, 0x14, 0x1e, 0x32, 0x2d, 0xec, 0x7f,
+	0x6e, 0xa1, 0x39, 0xad, 0x38, 0x02, 0x1d, 0x07, 0x2b, 0x22, 0x21, 0xba, 0xa1, 0xc6, 0xc1, 0x68,
+	0x80, 0xe3, 0x78, 0x30, 0x42, 0x57, 0x2b, 0x42, 0x9f, 0x04, 0x23, 0x74, 0x5d, 0x1e, 0x8c, 0xd0,
+	0x15, 0x29, 0x00, 0x51, 0x4c, 0xfa, 0xb5, 0xb2, 0x59, 0x2b, 0x81, 0x85, 0xe4, 0x30, 0x0c, 0x13,
+	0x17, 0x3b, 0xa1, 0xac, 0x2f, 0x9e, 0x88, 0xa3, 0x40, 0xe0, 0x38, 0xfa, 0x4d, 0x5c, 0xe2, 0x77,
+	0x6a, 0x15, 0xf3, 0x9b, 0xb8, 0x37, 0xfc, 0x0e, 0x50, 0xb8, 0x7d, 0x1b, 0xcd, 0xb7, 0x48, 0x3b,
+	0x24, 0xf1, 0xdb, 0xe4, 0x70, 0xe4, 0x8f, 0xec, 0xd2, 0xe8, 0xe7, 0xd4, 0x47, 0x76, 0x69, 0x73,
+	0x0a, 0xb7, 0xbf, 0x68, 0xa1, 0x45, 0xce, 0xb1, 0xa5, 0xbe, 0xdc, 0xdb, 0xa3, 0xe1, 0xd1, 0x03,
+	0x2f, 0xae, 0x59, 0x45, 0x68, 0x9d, 0x7b, 0x94, 0x15, 0x17, 0x41, 0x3d, 0xe7, 0xe2, 0x13, 0xcd,
+	0x03, 0x2f, 0x06, 0x2e, 0xc5, 0xfe, 0x15, 0x0b, 0xa5, 0xbe, 0xe2, 0xa4, 0x5d, 0x03, 0x5a, 0xc3,
+	0xae, 0x01, 0x8d, 0xcb, 0x8b, 0xd2, 0x89, 0x97, 0x17, 0xb4, 0x1a, 0x0c, 0xd5, 0xf2, 0xc6, 0xb7,
+	0xd3, 0x84, 0xfb, 0x26, 0xa9, 0x06, 0x93, 0xa1, 0x80, 0x9c, 0x56, 0xf4, 0x79, 0x2d, 0xb5, 0x62,
+	0xb7, 0xfd, 0xd0, 0xf5, 0x79, 0xc6, 0xfa, 0x9e, 0xdb, 0xa5, 0x87, 0x0e, 0x22, 0x3e, 0xa1, 0xca,
+	0xbd, 0x5a, 0xea, 0xd0, 0x21, 0xbf, 0x9c, 0x2a, 0xf1, 0xd4, 0xf5, 0x21, 0xaf, 0xe0, 0xa4, 0x2f,
+	0x9a, 0xd7, 0xcd, 0x50, 0xae, 0x8f, 0x0d, 0x13, 0x0d, 0x69, 0x7a, 0xfb, 0x1e, 0xaa, 0xca, 0xe2,
+	0x42, 0xf4, 0xfd, 0xf7, 0xa5, 0x33, 0x4d, 0xaf, 0xd0, 0x11, 0x84, 0x31, 0x30, 0x0c, 0x7d, 0x4c,
+	0x91, 0xef, 0xbe, 0x19, 0x44, 0xb1, 0xac, 0x88, 0xc4, 0x2f, 0x61, 0x6e, 0x6d, 0x32, 0x18, 0x28,
+	0xac, 0xbd, 0x8c, 0x16, 0xd5, 0xed, 0x8a, 0xc8, 0x62, 0xf8, 0x9d, 0x32, 0x9a, 0xd7, 0x6f, 0x5c,
+	0x46, 0x98, 0x6f, 0xa3, 0xbf, 0x96, 0x9c, 0x5b, 0x92, 0xf2, 0x98, 0xb7, 0x24, 0xfa, 0xb5, 0xd4,
+	0xd4, 0xd9, 0x5e, 0x4b, 0x55, 0x8a, 0xb9, 0x96, 0x8a, 0xd1, 0x4c, 0x24, 0x36, 0xbf, 0xe9, 0x22,
+	0xce, 0x4c, 0xa9, 0x37, 0xc6, 0x75, 0x8f, 0xf8, 0x01, 0x52, 0x94, 0xfd, 0x9b, 0x15, 0xb4, 0x60,
+	0x56, 0x7f, 0x1c, 0xe1, 0x4d, 0x7e, 0x28, 0xf3, 0x26, 0xc7, 0xf4, 0xe9, 0x95, 0x27, 0xf5, 0xe9,
+	0x4d, 0x4d, 0xea, 0xd3, 0xab, 0x9c, 0xc2, 0xa7, 0x97, 0xf5, 0xc8, 0x4d, 0x8f, 0xec, 0x91, 0xfb,
+	0x84, 0x8a, 0xf5, 0x9b, 0x31, 0xcb, 0x02, 0xaa, 0x58, 0x3f, 0x6c, 0xbe, 0x86, 0xf5, 0xa0, 0x93,
+	0x1b, 0x33, 0x59, 0x7d, 0x8a, 0xef, 0x22, 0xcc, 0x0d, 0xcd, 0x1b, 0xff, 0xde, 0xe8, 0xf9, 0x31,
+	0xc2, 0xf2, 0x3e, 0x86, 0xe6, 0xc4, 0x7c, 0x62, 0xfa, 0x17, 0x99, 0xba, 0xbb, 0x95, 0xa0, 0x40,
+	0xa7, 0xa3, 0x13, 0x23, 0xf5, 0x8d, 0xfa, 0xda, 0x9c, 0xe9, 0x5d, 0x4e, 0x7f, 0xd3, 0x3e, 0x4d,
+	0x6f, 0x7f, 0x0e, 0x5d, 0xcc, 0xb5, 0xb4, 0x98, 0x0b, 0x87, 0xed, 0xcb, 0xa4, 0x23, 0x08, 0xb4,
+	0x6e, 0xa4, 0x3e, 0x0e, 0xb0, 0x72, 0x7f, 0x28, 0x25, 0x9c, 0xc0, 0xc5, 0xfe, 0xb5, 0x32, 0x5a,
+	0x30, 0xbf, 0xba, 0x89, 0x1f, 0xa9, 0x73, 0x59, 0x21, 0x47, 0x42, 0xce, 0x56, 0x2b, 0xd5, 0x37,
+	0xd4, 0x59, 0xf9, 0x88, 0xcd, 0xaf, 0x5d, 0x55, 0x37, 0xf0, 0xec, 0x04, 0x0b, 0x2f, 0xa1, 0x10,
+	0xc7, 0x3e, 0x68, 0x99, 0x24, 0x65, 0x8a, 0x38, 0xba, 0xc2, 0xa5, 0x27, 0x69, 0x96, 0x4a, 0x14,
+	0x68, 0x62, 0xa9, 0x6e, 0x39, 0x20, 0xa1, 0xbb, 0xe7, 0xaa, 0x6f, 0x95, 0xb3, 0x9d, 0xfb, 0x9e,
+	0x80, 0x81, 0xc2, 0xda, 0x3f, 0x5b, 0x46, 0xb3, 0xac, 0x5e, 0xd2, 0xcd, 0x30, 0xe8, 0xb1, 0xcf,
+	0xad, 0x45, 0x9a, 0xd9, 0x54, 0xb3, 0x8a, 0x70, 0x2f, 0xeb, 0x86, 0x98, 0x88, 0xc3, 0xd6, 0x20,
+	0x60, 0x48, 0xc4, 0x7d, 0x54, 0xdd, 0x13, 0xb5, 0x5d, 0xc5, 0xbb, 0x9b, 0xb0, 0xe2, 0xa0, 0xac,
+	0x14, 0xcb, 0x1f, 0x81, 0xfc, 0x05, 0x4a, 0x0a, 0xbb, 0xf8, 0x8d, 0x4c, 0xcb, 0xae, 0x56, 0x2e,
+	0x42, 0xe5, 0xa4, 0xcc, 0x45, 0x7e, 0xf1, 0x9b, 0x02, 0x42, 0x5a, 0xb4, 0xfd, 0x3e, 0x5a, 0x30,
+	0x2d, 0xc1, 0x71, 0x52, 0xb2, 0x59, 0x21, 0xb2, 0x78, 0x3f, 0x9d, 0x5f, 0xcb, 0x6a, 0xe0, 0x32,
+	0x8c, 0x34, 0x73, 0xcb, 0x43, 0xcc, 0x5c, 0x07, 0x2d, 0xa6, 0xaa, 0x7f, 0x14, 0x5e, 0x1e, 0xf7,
+	0xef, 0x95, 0xd1, 0xac, 0xaa, 0x9f, 0x42, 0xd3, 0x61, 0x7b, 0x24, 0xde, 0x0f, 0x3a, 0xe9, 0x74,
+	0xd8, 0x6d, 0x06, 0xa5, 0xe9, 0xb0, 0x8a, 0x98, 0x83, 0x40, 0x34, 0xa0, 0x43, 0x19, 0x84, 0x5e,
+	0xda, 0x62, 0xbf, 0x0b, 0x5b, 0x40, 0xe1, 0xf8, 0x31, 0x9a, 0xd9, 0x27, 0x4e, 0x87, 0x84, 0x32,
+	0x9c, 0x75, 0xbb, 0xa0, 0x9a, 0x2f, 0x6f, 0x32, 0xae, 0xc9, 0x63, 0xe0, 0xbf, 0x23, 0x90, 0xe2,
+	0xe8, 0x5b, 0xd8, 0x0d, 0x3a, 0x87, 0xe9, 0xef, 0x23, 0x35, 0x82, 0xce, 0x21, 0x30, 0x0c, 0xbd,
+	0x89, 0x14, 0x35, 0x77, 0xa5, 0x45, 0x57, 0x61, 0x86, 0xa9, 0xba, 0x89, 0xdc, 0x31, 0xb0, 0x90,
+	0xa2, 0xa6, 0x26, 0xc7, 0x83, 0x28, 0xf0, 0xe9, 0x7b, 0x15, 0x57, 0x90, 0xca, 0xe4, 0x78, 0xab,
+	0x75, 0xfb, 0x16, 0x7b, 0xdf, 0x8a, 0x82, 0x52, 0xbb, 0xac, 0x48, 0x43, 0x48, 0x44, 0x24, 0xc8,
+	0x52, 0x52, 0x4a, 0x8b, 0xc3, 0x41, 0x51, 0xd8, 0x77, 0xd1, 0x62, 0x6a, 0xa8, 0x72, 0xd2, 0x58,
+	0xf9, 0x93, 0x66, 0xb4, 0x8f, 0x11, 0xfd, 0x53, 0x0b, 0x2d, 0x67, 0x76, 0xb2, 0x51, 0x93, 0x42,
+	0xd3, 0x3a, 0xb5, 0x74, 0x7a, 0x9d, 0x5a, 0x1e, 0x4f, 0xa7, 0x36, 0xd6, 0xbe, 0xfd, 0xfd, 0x2b,
+	0xcf, 0x7d, 0xe7, 0xfb, 0x57, 0x9e, 0xfb, 0xde, 0xf7, 0xaf, 0x3c, 0xf7, 0xc5, 0xe3, 0x2b, 0xd6,
+	0xb7, 0x8f, 0xaf, 0x58, 0xdf, 0x39, 0xbe, 0x62, 0x7d, 0xef, 0xf8, 0x8a, 0xf5, 0x5f, 0x8e, 0xaf,
+	0x58, 0x5f, 0xff, 0x83, 0x2b, 0xcf, 0xbd, 0x53, 0x95, 0xd3, 0xe4, 0xff, 0x0d, 0x00, 0xd6, 0x46,
+	0x63, 0x2c, 0xaf, 0xa0, 0x00, 0x00,
 }
 
 func (m *ALBStatus) Marshal() (dAtA []byte, err error) {
@@ -3408,6 +3920,54 @@ func (m *ALBTrafficRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AdoptionStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AdoptionStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AdoptionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AdoptedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.PodTemplateHash)
+	copy(dAtA[i:], m.PodTemplateHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodTemplateHash)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.ReplicaSetName)
+	copy(dAtA[i:], m.ReplicaSetName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReplicaSetName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.DeploymentName)
+	copy(dAtA[i:], m.DeploymentName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeploymentName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AmbassadorTrafficRouting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3896,6 +4456,18 @@ func (m *AnalysisTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NamespacePolicy != nil {
+		{
+			size, err := m.NamespacePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.MeasurementRetention) > 0 {
 		for iNdEx := len(m.MeasurementRetention) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4368,6 +4940,18 @@ func (m *BlueGreenStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PreviewPodSpecPatch != nil {
+		{
+			size, err := m.PreviewPodSpecPatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
 	if m.AbortScaleDownDelaySeconds != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.AbortScaleDownDelaySeconds))
 		i--
@@ -4506,14 +5090,28 @@ func (m *CanaryStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.StablePingPong)
-	copy(dAtA[i:], m.StablePingPong)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StablePingPong)))
-	i--
-	dAtA[i] = 0x2a
-	if m.Weights != nil {
-		{
-			size, err := m.Weights.MarshalToSizedBuffer(dAtA[:i])
+	if len(m.StepHistory) > 0 {
+		for iNdEx := len(m.StepHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StepHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	i -= len(m.StablePingPong)
+	copy(dAtA[i:], m.StablePingPong)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.StablePingPong)))
+	i--
+	dAtA[i] = 0x2a
+	if m.Weights != nil {
+		{
+			size, err := m.Weights.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4575,6 +5173,18 @@ func (m *CanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Deadline != nil {
+		{
+			size, err := m.Deadline.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.SetCanaryScale != nil {
 		{
 			size, err := m.SetCanaryScale.MarshalToSizedBuffer(dAtA[:i])
@@ -4631,6 +5241,64 @@ func (m *CanaryStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CanaryStepRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CanaryStepRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CanaryStepRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.Outcome)
+	copy(dAtA[i:], m.Outcome)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Outcome)))
+	i--
+	dAtA[i] = 0x22
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Index))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *CanaryStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4651,6 +5319,20 @@ func (m *CanaryStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CanaryPodSpecPatch != nil {
+		{
+			size, err := m.CanaryPodSpecPatch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.PingPong != nil {
 		{
 			size, err := m.PingPong.MarshalToSizedBuffer(dAtA[:i])
@@ -5720,6 +6402,88 @@ func (m *GraphiteMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *InconclusivePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InconclusivePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InconclusivePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Timeout)
+	copy(dAtA[i:], m.Timeout)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Timeout)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Reruns))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *InconclusiveResolution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InconclusiveResolution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InconclusiveResolution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ResolvedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Reruns))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.AnalysisRun)
+	copy(dAtA[i:], m.AnalysisRun)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AnalysisRun)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *IstioDestinationRule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6535,6 +7299,50 @@ func (m *MetricResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NamespacePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NamespacePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NamespacePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NamespaceSelector != nil {
+		{
+			size, err := m.NamespaceSelector.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespaces) > 0 {
+		for iNdEx := len(m.Namespaces) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Namespaces[iNdEx])
+			copy(dAtA[i:], m.Namespaces[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespaces[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NewRelicMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6701,6 +7509,46 @@ func (m *PauseCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PerSeriesEvaluation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerSeriesEvaluation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerSeriesEvaluation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Quorum != nil {
+		{
+			size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Policy)
+	copy(dAtA[i:], m.Policy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Policy)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *PingPongSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6851,6 +7699,18 @@ func (m *PrometheusMetric) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PerSeries != nil {
+		{
+			size, err := m.PerSeries.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	i -= len(m.Query)
 	copy(dAtA[i:], m.Query)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Query)))
@@ -6887,6 +7747,39 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) MarshalToSizedBuffer(dA
 	return len(dAtA) - i, nil
 }
 
+func (m *RestartSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RestartSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RestartSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.TimeZone)
+	copy(dAtA[i:], m.TimeZone)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.TimeZone)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Schedule)
+	copy(dAtA[i:], m.Schedule)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Schedule)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Rollout) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -6940,7 +7833,7 @@ func (m *Rollout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RolloutAnalysis) Marshal() (dAtA []byte, err error) {
+func (m *RolloutAdoption) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6950,7 +7843,121 @@ func (m *RolloutAnalysis) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RolloutAnalysis) MarshalTo(dAtA []byte) (int, error) {
+func (m *RolloutAdoption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutAdoption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.DeploymentName)
+	copy(dAtA[i:], m.DeploymentName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.DeploymentName)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutAlertRule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutAlertRule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutAlertRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Selector.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutAlertStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutAlertStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutAlertStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.StartsAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Summary)
+	copy(dAtA[i:], m.Summary)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Summary)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Action)
+	copy(dAtA[i:], m.Action)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Action)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutAnalysis) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutAnalysis) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
@@ -6960,6 +7967,18 @@ func (m *RolloutAnalysis) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.InconclusivePolicy != nil {
+		{
+			size, err := m.InconclusivePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.MeasurementRetention) > 0 {
 		for iNdEx := len(m.MeasurementRetention) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -7194,6 +8213,49 @@ func (m *RolloutCondition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutCrashGuard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutCrashGuard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutCrashGuard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxOOMKilled != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxOOMKilled))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxImagePullBackOff != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxImagePullBackOff))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxCrashLoopBackOff != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxCrashLoopBackOff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxRestarts != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRestarts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutExperimentStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7460,6 +8522,95 @@ func (m *RolloutPause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RolloutProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x22
+	if m.PodReadySeconds != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.PodReadySeconds))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EstimatedCompletionTime != nil {
+		{
+			size, err := m.EstimatedCompletionTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Percent))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
+func (m *RolloutQueueStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RolloutQueueStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RolloutQueueStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.QueuedAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Budget)
+	copy(dAtA[i:], m.Budget)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Budget)))
+	i--
+	dAtA[i] = 0x12
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Position))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *RolloutSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -7480,6 +8631,65 @@ func (m *RolloutSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CrashGuard != nil {
+		{
+			size, err := m.CrashGuard.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RestartSchedule != nil {
+		{
+			size, err := m.RestartSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Alerts) > 0 {
+		for iNdEx := len(m.Alerts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Alerts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.RevisionSnapshotLimit != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionSnapshotLimit))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Adoption != nil {
+		{
+			size, err := m.Adoption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	i--
 	if m.ProgressDeadlineAbort {
 		dAtA[i] = 1
@@ -7605,9 +8815,100 @@ func (m *RolloutStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.ALB != nil {
-		{
-			size, err := m.ALB.MarshalToSizedBuffer(dAtA[:i])
+	i -= len(m.PodSpecPatchHash)
+	copy(dAtA[i:], m.PodSpecPatchHash)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PodSpecPatchHash)))
+	i--
+	dAtA[i] = 0x2
+	i--
+	dAtA[i] = 0x82
+	if m.NextScheduledRestartAt != nil {
+		{
+			size, err := m.NextScheduledRestartAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	if m.Alert != nil {
+		{
+			size, err := m.Alert.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
+	if m.Queue != nil {
+		{
+			size, err := m.Queue.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if m.InconclusiveResolution != nil {
+		{
+			size, err := m.InconclusiveResolution.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.Progress != nil {
+		{
+			size, err := m.Progress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if m.Adoption != nil {
+		{
+			size, err := m.Adoption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.ALB != nil {
+		{
+			size, err := m.ALB.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -8105,6 +9406,41 @@ func (m *SecretKeyRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SecretSourceRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretSourceRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretSourceRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Vault != nil {
+		{
+			size, err := m.Vault.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SetCanaryScale) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8500,6 +9836,18 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SecretSourceRef != nil {
+		{
+			size, err := m.SecretSourceRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.FieldRef != nil {
 		{
 			size, err := m.FieldRef.MarshalToSizedBuffer(dAtA[:i])
@@ -8527,6 +9875,44 @@ func (m *ValueFrom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VaultSecretRef) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultSecretRef) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultSecretRef) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Path)
+	copy(dAtA[i:], m.Path)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Path)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Profile)
+	copy(dAtA[i:], m.Profile)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Profile)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WavefrontMetric) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8743,6 +10129,23 @@ func (m *ALBTrafficRouting) Size() (n int) {
 	return n
 }
 
+func (m *AdoptionStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeploymentName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ReplicaSetName)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.PodTemplateHash)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.AdoptedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *AmbassadorTrafficRouting) Size() (n int) {
 	if m == nil {
 		return 0
@@ -8945,6 +10348,10 @@ func (m *AnalysisTemplateSpec) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.NamespacePolicy != nil {
+		l = m.NamespacePolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9148,6 +10555,10 @@ func (m *BlueGreenStrategy) Size() (n int) {
 	if m.AbortScaleDownDelaySeconds != nil {
 		n += 1 + sovGenerated(uint64(*m.AbortScaleDownDelaySeconds))
 	}
+	if m.PreviewPodSpecPatch != nil {
+		l = m.PreviewPodSpecPatch.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9173,6 +10584,12 @@ func (m *CanaryStatus) Size() (n int) {
 	}
 	l = len(m.StablePingPong)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.StepHistory) > 0 {
+		for _, e := range m.StepHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -9201,6 +10618,30 @@ func (m *CanaryStep) Size() (n int) {
 		l = m.SetCanaryScale.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Deadline != nil {
+		l = m.Deadline.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *CanaryStepRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Index))
+	l = m.StartedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Outcome)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -9262,6 +10703,10 @@ func (m *CanaryStrategy) Size() (n int) {
 		l = m.PingPong.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.CanaryPodSpecPatch != nil {
+		l = m.CanaryPodSpecPatch.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -9609,6 +11054,36 @@ func (m *GraphiteMetric) Size() (n int) {
 	return n
 }
 
+func (m *InconclusivePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Reruns))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Timeout)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *InconclusiveResolution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AnalysisRun)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Reruns))
+	l = m.ResolvedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *IstioDestinationRule) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9903,6 +11378,25 @@ func (m *MetricResult) Size() (n int) {
 	return n
 }
 
+func (m *NamespacePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Namespaces) > 0 {
+		for _, s := range m.Namespaces {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.NamespaceSelector != nil {
+		l = m.NamespaceSelector.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *NewRelicMetric) Size() (n int) {
 	if m == nil {
 		return 0
@@ -9965,6 +11459,21 @@ func (m *PauseCondition) Size() (n int) {
 	return n
 }
 
+func (m *PerSeriesEvaluation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Policy)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Quorum != nil {
+		l = m.Quorum.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *PingPongSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10023,6 +11532,10 @@ func (m *PrometheusMetric) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.Query)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.PerSeries != nil {
+		l = m.PerSeries.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10035,6 +11548,19 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Size() (n int) {
 	return n
 }
 
+func (m *RestartSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Schedule)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.TimeZone)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *Rollout) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10050,17 +11576,58 @@ func (m *Rollout) Size() (n int) {
 	return n
 }
 
-func (m *RolloutAnalysis) Size() (n int) {
+func (m *RolloutAdoption) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Templates) > 0 {
-		for _, e := range m.Templates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.DeploymentName)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutAlertRule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Selector.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutAlertStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Action)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Summary)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.StartsAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutAnalysis) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Templates) > 0 {
+		for _, e := range m.Templates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	if len(m.Args) > 0 {
 		for _, e := range m.Args {
@@ -10080,6 +11647,10 @@ func (m *RolloutAnalysis) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.InconclusivePolicy != nil {
+		l = m.InconclusivePolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10145,6 +11716,27 @@ func (m *RolloutCondition) Size() (n int) {
 	return n
 }
 
+func (m *RolloutCrashGuard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRestarts != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxRestarts))
+	}
+	if m.MaxCrashLoopBackOff != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxCrashLoopBackOff))
+	}
+	if m.MaxImagePullBackOff != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxImagePullBackOff))
+	}
+	if m.MaxOOMKilled != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxOOMKilled))
+	}
+	return n
+}
+
 func (m *RolloutExperimentStep) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10244,6 +11836,39 @@ func (m *RolloutPause) Size() (n int) {
 	return n
 }
 
+func (m *RolloutProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Percent))
+	if m.EstimatedCompletionTime != nil {
+		l = m.EstimatedCompletionTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.PodReadySeconds != nil {
+		n += 1 + sovGenerated(uint64(*m.PodReadySeconds))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *RolloutQueueStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.Position))
+	l = len(m.Budget)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.QueuedAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RolloutSpec) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10282,6 +11907,27 @@ func (m *RolloutSpec) Size() (n int) {
 		n += 1 + l + sovGenerated(uint64(l))
 	}
 	n += 2
+	if m.Adoption != nil {
+		l = m.Adoption.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.RevisionSnapshotLimit != nil {
+		n += 1 + sovGenerated(uint64(*m.RevisionSnapshotLimit))
+	}
+	if len(m.Alerts) > 0 {
+		for _, e := range m.Alerts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.RestartSchedule != nil {
+		l = m.RestartSchedule.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.CrashGuard != nil {
+		l = m.CrashGuard.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -10349,6 +11995,32 @@ func (m *RolloutStatus) Size() (n int) {
 		l = m.ALB.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.Adoption != nil {
+		l = m.Adoption.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Progress != nil {
+		l = m.Progress.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.InconclusiveResolution != nil {
+		l = m.InconclusiveResolution.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Queue != nil {
+		l = m.Queue.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.Alert != nil {
+		l = m.Alert.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if m.NextScheduledRestartAt != nil {
+		l = m.NextScheduledRestartAt.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PodSpecPatchHash)
+	n += 2 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10464,6 +12136,19 @@ func (m *SecretKeyRef) Size() (n int) {
 	return n
 }
 
+func (m *SecretSourceRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Vault != nil {
+		l = m.Vault.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *SetCanaryScale) Size() (n int) {
 	if m == nil {
 		return 0
@@ -10618,6 +12303,25 @@ func (m *ValueFrom) Size() (n int) {
 		l = m.FieldRef.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.SecretSourceRef != nil {
+		l = m.SecretSourceRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *VaultSecretRef) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Profile)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Path)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -10718,6 +12422,19 @@ func (this *ALBTrafficRouting) String() string {
 	}, "")
 	return s
 }
+func (this *AdoptionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AdoptionStatus{`,
+		`DeploymentName:` + fmt.Sprintf("%v", this.DeploymentName) + `,`,
+		`ReplicaSetName:` + fmt.Sprintf("%v", this.ReplicaSetName) + `,`,
+		`PodTemplateHash:` + fmt.Sprintf("%v", this.PodTemplateHash) + `,`,
+		`AdoptedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.AdoptedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *AmbassadorTrafficRouting) String() string {
 	if this == nil {
 		return "nil"
@@ -10889,6 +12606,7 @@ func (this *AnalysisTemplateSpec) String() string {
 		`Args:` + repeatedStringForArgs + `,`,
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`MeasurementRetention:` + repeatedStringForMeasurementRetention + `,`,
+		`NamespacePolicy:` + strings.Replace(this.NamespacePolicy.String(), "NamespacePolicy", "NamespacePolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11014,6 +12732,7 @@ func (this *BlueGreenStrategy) String() string {
 		`PreviewMetadata:` + strings.Replace(this.PreviewMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
 		`ActiveMetadata:` + strings.Replace(this.ActiveMetadata.String(), "PodTemplateMetadata", "PodTemplateMetadata", 1) + `,`,
 		`AbortScaleDownDelaySeconds:` + valueToStringGenerated(this.AbortScaleDownDelaySeconds) + `,`,
+		`PreviewPodSpecPatch:` + strings.Replace(fmt.Sprintf("%v", this.PreviewPodSpecPatch), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11022,12 +12741,18 @@ func (this *CanaryStatus) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForStepHistory := "[]CanaryStepRecord{"
+	for _, f := range this.StepHistory {
+		repeatedStringForStepHistory += strings.Replace(strings.Replace(f.String(), "CanaryStepRecord", "CanaryStepRecord", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStepHistory += "}"
 	s := strings.Join([]string{`&CanaryStatus{`,
 		`CurrentStepAnalysisRunStatus:` + strings.Replace(this.CurrentStepAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
 		`CurrentBackgroundAnalysisRunStatus:` + strings.Replace(this.CurrentBackgroundAnalysisRunStatus.String(), "RolloutAnalysisRunStatus", "RolloutAnalysisRunStatus", 1) + `,`,
 		`CurrentExperiment:` + fmt.Sprintf("%v", this.CurrentExperiment) + `,`,
 		`Weights:` + strings.Replace(this.Weights.String(), "TrafficWeights", "TrafficWeights", 1) + `,`,
 		`StablePingPong:` + fmt.Sprintf("%v", this.StablePingPong) + `,`,
+		`StepHistory:` + repeatedStringForStepHistory + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11042,6 +12767,21 @@ func (this *CanaryStep) String() string {
 		`Experiment:` + strings.Replace(this.Experiment.String(), "RolloutExperimentStep", "RolloutExperimentStep", 1) + `,`,
 		`Analysis:` + strings.Replace(this.Analysis.String(), "RolloutAnalysis", "RolloutAnalysis", 1) + `,`,
 		`SetCanaryScale:` + strings.Replace(this.SetCanaryScale.String(), "SetCanaryScale", "SetCanaryScale", 1) + `,`,
+		`Deadline:` + strings.Replace(fmt.Sprintf("%v", this.Deadline), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CanaryStepRecord) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CanaryStepRecord{`,
+		`Index:` + fmt.Sprintf("%v", this.Index) + `,`,
+		`StartedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`Outcome:` + fmt.Sprintf("%v", this.Outcome) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11071,6 +12811,7 @@ func (this *CanaryStrategy) String() string {
 		`AbortScaleDownDelaySeconds:` + valueToStringGenerated(this.AbortScaleDownDelaySeconds) + `,`,
 		`DynamicStableScale:` + fmt.Sprintf("%v", this.DynamicStableScale) + `,`,
 		`PingPong:` + strings.Replace(this.PingPong.String(), "PingPongSpec", "PingPongSpec", 1) + `,`,
+		`CanaryPodSpecPatch:` + strings.Replace(fmt.Sprintf("%v", this.CanaryPodSpecPatch), "RawExtension", "runtime.RawExtension", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11358,6 +13099,31 @@ func (this *GraphiteMetric) String() string {
 	}, "")
 	return s
 }
+func (this *InconclusivePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InconclusivePolicy{`,
+		`Reruns:` + fmt.Sprintf("%v", this.Reruns) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Timeout:` + fmt.Sprintf("%v", this.Timeout) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *InconclusiveResolution) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&InconclusiveResolution{`,
+		`AnalysisRun:` + fmt.Sprintf("%v", this.AnalysisRun) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Reruns:` + fmt.Sprintf("%v", this.Reruns) + `,`,
+		`ResolvedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ResolvedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *IstioDestinationRule) String() string {
 	if this == nil {
 		return "nil"
@@ -11570,6 +13336,17 @@ func (this *MetricResult) String() string {
 	}, "")
 	return s
 }
+func (this *NamespacePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&NamespacePolicy{`,
+		`Namespaces:` + fmt.Sprintf("%v", this.Namespaces) + `,`,
+		`NamespaceSelector:` + strings.Replace(fmt.Sprintf("%v", this.NamespaceSelector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *NewRelicMetric) String() string {
 	if this == nil {
 		return "nil"
@@ -11626,6 +13403,17 @@ func (this *PauseCondition) String() string {
 	}, "")
 	return s
 }
+func (this *PerSeriesEvaluation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PerSeriesEvaluation{`,
+		`Policy:` + fmt.Sprintf("%v", this.Policy) + `,`,
+		`Quorum:` + strings.Replace(fmt.Sprintf("%v", this.Quorum), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *PingPongSpec) String() string {
 	if this == nil {
 		return "nil"
@@ -11685,6 +13473,7 @@ func (this *PrometheusMetric) String() string {
 	s := strings.Join([]string{`&PrometheusMetric{`,
 		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
 		`Query:` + fmt.Sprintf("%v", this.Query) + `,`,
+		`PerSeries:` + strings.Replace(this.PerSeries.String(), "PerSeriesEvaluation", "PerSeriesEvaluation", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11698,6 +13487,17 @@ func (this *RequiredDuringSchedulingIgnoredDuringExecution) String() string {
 	}, "")
 	return s
 }
+func (this *RestartSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RestartSchedule{`,
+		`Schedule:` + fmt.Sprintf("%v", this.Schedule) + `,`,
+		`TimeZone:` + fmt.Sprintf("%v", this.TimeZone) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Rollout) String() string {
 	if this == nil {
 		return "nil"
@@ -11710,6 +13510,40 @@ func (this *Rollout) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutAdoption) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutAdoption{`,
+		`DeploymentName:` + fmt.Sprintf("%v", this.DeploymentName) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutAlertRule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutAlertRule{`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutAlertStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutAlertStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Action:` + fmt.Sprintf("%v", this.Action) + `,`,
+		`Summary:` + fmt.Sprintf("%v", this.Summary) + `,`,
+		`StartsAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.StartsAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutAnalysis) String() string {
 	if this == nil {
 		return "nil"
@@ -11739,6 +13573,7 @@ func (this *RolloutAnalysis) String() string {
 		`Args:` + repeatedStringForArgs + `,`,
 		`DryRun:` + repeatedStringForDryRun + `,`,
 		`MeasurementRetention:` + repeatedStringForMeasurementRetention + `,`,
+		`InconclusivePolicy:` + strings.Replace(this.InconclusivePolicy.String(), "InconclusivePolicy", "InconclusivePolicy", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11792,6 +13627,19 @@ func (this *RolloutCondition) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutCrashGuard) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutCrashGuard{`,
+		`MaxRestarts:` + valueToStringGenerated(this.MaxRestarts) + `,`,
+		`MaxCrashLoopBackOff:` + valueToStringGenerated(this.MaxCrashLoopBackOff) + `,`,
+		`MaxImagePullBackOff:` + valueToStringGenerated(this.MaxImagePullBackOff) + `,`,
+		`MaxOOMKilled:` + valueToStringGenerated(this.MaxOOMKilled) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutExperimentStep) String() string {
 	if this == nil {
 		return "nil"
@@ -11874,10 +13722,40 @@ func (this *RolloutPause) String() string {
 	}, "")
 	return s
 }
+func (this *RolloutProgress) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutProgress{`,
+		`Percent:` + fmt.Sprintf("%v", this.Percent) + `,`,
+		`EstimatedCompletionTime:` + strings.Replace(fmt.Sprintf("%v", this.EstimatedCompletionTime), "Time", "v1.Time", 1) + `,`,
+		`PodReadySeconds:` + valueToStringGenerated(this.PodReadySeconds) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RolloutQueueStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RolloutQueueStatus{`,
+		`Position:` + fmt.Sprintf("%v", this.Position) + `,`,
+		`Budget:` + fmt.Sprintf("%v", this.Budget) + `,`,
+		`QueuedAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.QueuedAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RolloutSpec) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForAlerts := "[]RolloutAlertRule{"
+	for _, f := range this.Alerts {
+		repeatedStringForAlerts += strings.Replace(strings.Replace(f.String(), "RolloutAlertRule", "RolloutAlertRule", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAlerts += "}"
 	s := strings.Join([]string{`&RolloutSpec{`,
 		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
 		`Selector:` + strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1) + `,`,
@@ -11891,6 +13769,11 @@ func (this *RolloutSpec) String() string {
 		`WorkloadRef:` + strings.Replace(this.WorkloadRef.String(), "ObjectRef", "ObjectRef", 1) + `,`,
 		`Analysis:` + strings.Replace(this.Analysis.String(), "AnalysisRunStrategy", "AnalysisRunStrategy", 1) + `,`,
 		`ProgressDeadlineAbort:` + fmt.Sprintf("%v", this.ProgressDeadlineAbort) + `,`,
+		`Adoption:` + strings.Replace(this.Adoption.String(), "RolloutAdoption", "RolloutAdoption", 1) + `,`,
+		`RevisionSnapshotLimit:` + valueToStringGenerated(this.RevisionSnapshotLimit) + `,`,
+		`Alerts:` + repeatedStringForAlerts + `,`,
+		`RestartSchedule:` + strings.Replace(this.RestartSchedule.String(), "RestartSchedule", "RestartSchedule", 1) + `,`,
+		`CrashGuard:` + strings.Replace(this.CrashGuard.String(), "RolloutCrashGuard", "RolloutCrashGuard", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -11935,6 +13818,13 @@ func (this *RolloutStatus) String() string {
 		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
 		`WorkloadObservedGeneration:` + fmt.Sprintf("%v", this.WorkloadObservedGeneration) + `,`,
 		`ALB:` + strings.Replace(this.ALB.String(), "ALBStatus", "ALBStatus", 1) + `,`,
+		`Adoption:` + strings.Replace(this.Adoption.String(), "AdoptionStatus", "AdoptionStatus", 1) + `,`,
+		`Progress:` + strings.Replace(this.Progress.String(), "RolloutProgress", "RolloutProgress", 1) + `,`,
+		`InconclusiveResolution:` + strings.Replace(this.InconclusiveResolution.String(), "InconclusiveResolution", "InconclusiveResolution", 1) + `,`,
+		`Queue:` + strings.Replace(this.Queue.String(), "RolloutQueueStatus", "RolloutQueueStatus", 1) + `,`,
+		`Alert:` + strings.Replace(this.Alert.String(), "RolloutAlertStatus", "RolloutAlertStatus", 1) + `,`,
+		`NextScheduledRestartAt:` + strings.Replace(fmt.Sprintf("%v", this.NextScheduledRestartAt), "Time", "v1.Time", 1) + `,`,
+		`PodSpecPatchHash:` + fmt.Sprintf("%v", this.PodSpecPatchHash) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12016,6 +13906,16 @@ func (this *SecretKeyRef) String() string {
 	}, "")
 	return s
 }
+func (this *SecretSourceRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretSourceRef{`,
+		`Vault:` + strings.Replace(this.Vault.String(), "VaultSecretRef", "VaultSecretRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *SetCanaryScale) String() string {
 	if this == nil {
 		return "nil"
@@ -12129,6 +14029,19 @@ func (this *ValueFrom) String() string {
 	s := strings.Join([]string{`&ValueFrom{`,
 		`SecretKeyRef:` + strings.Replace(this.SecretKeyRef.String(), "SecretKeyRef", "SecretKeyRef", 1) + `,`,
 		`FieldRef:` + strings.Replace(this.FieldRef.String(), "FieldRef", "FieldRef", 1) + `,`,
+		`SecretSourceRef:` + strings.Replace(this.SecretSourceRef.String(), "SecretSourceRef", "SecretSourceRef", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VaultSecretRef) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VaultSecretRef{`,
+		`Profile:` + fmt.Sprintf("%v", this.Profile) + `,`,
+		`Path:` + fmt.Sprintf("%v", this.Path) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -12546,7 +14459,7 @@ func (m *ALBTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *AdoptionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12569,15 +14482,15 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AmbassadorTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: AdoptionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AmbassadorTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AdoptionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12605,25 +14518,204 @@ func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mappings = append(m.Mappings, string(dAtA[iNdEx:postIndex]))
+			m.DeploymentName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicaSetName", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplicaSetName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodTemplateHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodTemplateHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdoptedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdoptedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AmbassadorTrafficRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AmbassadorTrafficRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AmbassadorTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mappings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mappings = append(m.Mappings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -13991,6 +16083,42 @@ func (m *AnalysisTemplateSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespacePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespacePolicy == nil {
+				m.NamespacePolicy = &NamespacePolicy{}
+			}
+			if err := m.NamespacePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15593,6 +17721,42 @@ func (m *BlueGreenStrategy) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AbortScaleDownDelaySeconds = &v
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviewPodSpecPatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PreviewPodSpecPatch == nil {
+				m.PreviewPodSpecPatch = &runtime.RawExtension{}
+			}
+			if err := m.PreviewPodSpecPatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -15815,9 +17979,43 @@ func (m *CanaryStatus) Unmarshal(dAtA []byte) error {
 			}
 			m.StablePingPong = PingPongType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StepHistory = append(m.StepHistory, CanaryStepRecord{})
+			if err := m.StepHistory[len(m.StepHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
@@ -16029,6 +18227,42 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deadline == nil {
+				m.Deadline = &intstr.IntOrString{}
+			}
+			if err := m.Deadline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16050,7 +18284,7 @@ func (m *CanaryStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
+func (m *CanaryStepRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16073,17 +18307,17 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
+			return fmt.Errorf("proto: CanaryStepRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CanaryStepRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			var stringLen uint64
+			m.Index = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16093,29 +18327,16 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Index |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CanaryService = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16125,27 +18346,28 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableService = string(dAtA[iNdEx:postIndex])
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16172,16 +18394,18 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, CanaryStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16191,33 +18415,29 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TrafficRouting == nil {
-				m.TrafficRouting = &RolloutTrafficRouting{}
-			}
-			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Outcome = CanaryStepOutcome(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16227,33 +18447,79 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxUnavailable == nil {
-				m.MaxUnavailable = &intstr.IntOrString{}
-			}
-			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 6:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CanaryStrategy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CanaryStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16263,33 +18529,29 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MaxSurge == nil {
-				m.MaxSurge = &intstr.IntOrString{}
-			}
-			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CanaryService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableService", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16299,31 +18561,27 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &RolloutAnalysisBackground{}
-			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StableService = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16350,16 +18608,14 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AntiAffinity == nil {
-				m.AntiAffinity = &AntiAffinity{}
-			}
-			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, CanaryStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrafficRouting", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16386,16 +18642,196 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CanaryMetadata == nil {
-				m.CanaryMetadata = &PodTemplateMetadata{}
+			if m.TrafficRouting == nil {
+				m.TrafficRouting = &RolloutTrafficRouting{}
 			}
-			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TrafficRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxUnavailable == nil {
+				m.MaxUnavailable = &intstr.IntOrString{}
+			}
+			if err := m.MaxUnavailable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSurge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxSurge == nil {
+				m.MaxSurge = &intstr.IntOrString{}
+			}
+			if err := m.MaxSurge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &RolloutAnalysisBackground{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AntiAffinity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AntiAffinity == nil {
+				m.AntiAffinity = &AntiAffinity{}
+			}
+			if err := m.AntiAffinity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanaryMetadata == nil {
+				m.CanaryMetadata = &PodTemplateMetadata{}
+			}
+			if err := m.CanaryMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16545,6 +18981,42 @@ func (m *CanaryStrategy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryPodSpecPatch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CanaryPodSpecPatch == nil {
+				m.CanaryPodSpecPatch = &runtime.RawExtension{}
+			}
+			if err := m.CanaryPodSpecPatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19397,7 +21869,7 @@ func (m *GraphiteMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
+func (m *InconclusivePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19420,17 +21892,17 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
+			return fmt.Errorf("proto: InconclusivePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InconclusivePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reruns", wireType)
 			}
-			var stringLen uint64
+			m.Reruns = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19440,27 +21912,14 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Reruns |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19488,11 +21947,11 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
+			m.Action = InconclusiveAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19520,7 +21979,7 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
+			m.Timeout = DurationString(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19543,7 +22002,7 @@ func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
+func (m *InconclusiveResolution) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19566,17 +22025,17 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
+			return fmt.Errorf("proto: InconclusiveResolution: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InconclusiveResolution: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19586,33 +22045,29 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VirtualService == nil {
-				m.VirtualService = &IstioVirtualService{}
-			}
-			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AnalysisRun = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19622,31 +22077,46 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DestinationRule == nil {
-				m.DestinationRule = &IstioDestinationRule{}
-			}
-			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Action = InconclusiveAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reruns", wireType)
+			}
+			m.Reruns = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reruns |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19673,8 +22143,7 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
-			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ResolvedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19699,7 +22168,7 @@ func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
+func (m *IstioDestinationRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19722,10 +22191,10 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioDestinationRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioDestinationRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -19762,7 +22231,7 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CanarySubsetName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -19790,13 +22259,13 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
+			m.CanarySubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StableSubsetName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -19806,25 +22275,23 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
-			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.StableSubsetName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -19847,7 +22314,7 @@ func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobMetric) Unmarshal(dAtA []byte) error {
+func (m *IstioTrafficRouting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19870,15 +22337,15 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioTrafficRouting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioTrafficRouting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualService", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19905,13 +22372,16 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.VirtualService == nil {
+				m.VirtualService = &IstioVirtualService{}
+			}
+			if err := m.VirtualService.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationRule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -19938,7 +22408,44 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DestinationRule == nil {
+				m.DestinationRule = &IstioDestinationRule{}
+			}
+			if err := m.DestinationRule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualServices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VirtualServices = append(m.VirtualServices, IstioVirtualService{})
+			if err := m.VirtualServices[len(m.VirtualServices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -19963,7 +22470,7 @@ func (m *JobMetric) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+func (m *IstioVirtualService) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -19986,15 +22493,15 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: IstioVirtualService: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IstioVirtualService: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20022,11 +22529,11 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -20054,13 +22561,13 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Application = string(dAtA[iNdEx:postIndex])
+			m.Routes = append(m.Routes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TLSRoutes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20070,61 +22577,81 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
+			m.TLSRoutes = append(m.TLSRoutes, TLSRoute{})
+			if err := m.TLSRoutes[len(m.TLSRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20134,29 +22661,30 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -20166,30 +22694,273 @@ func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KayentaMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KayentaMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KayentaMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Application", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Application = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanaryConfigName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanaryConfigName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetricsAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetricsAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigurationAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigurationAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageAccountName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageAccountName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
 				}
@@ -22192,7 +24963,7 @@ func (m *MetricResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+func (m *NamespacePolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -22215,15 +24986,15 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+			return fmt.Errorf("proto: NamespacePolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NamespacePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespaces", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -22251,13 +25022,131 @@ func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profile = string(dAtA[iNdEx:postIndex])
+			m.Namespaces = append(m.Namespaces, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NamespaceSelector", wireType)
 			}
-			var stringLen uint64
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NamespaceSelector == nil {
+				m.NamespaceSelector = &v1.LabelSelector{}
+			}
+			if err := m.NamespaceSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NewRelicMetric) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NewRelicMetric: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NewRelicMetric: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -22808,6 +25697,124 @@ func (m *PauseCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PerSeriesEvaluation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerSeriesEvaluation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerSeriesEvaluation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = PerSeriesPolicy(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quorum == nil {
+				m.Quorum = &intstr.IntOrString{}
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *PingPongSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23388,19 +26395,55 @@ func (m *PrometheusMetric) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerSeries", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
+			if m.PerSeries == nil {
+				m.PerSeries = &PerSeriesEvaluation{}
+			}
+			if err := m.PerSeries.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
 
@@ -23459,7 +26502,7 @@ func (m *RequiredDuringSchedulingIgnoredDuringExecution) Unmarshal(dAtA []byte)
 	}
 	return nil
 }
-func (m *Rollout) Unmarshal(dAtA []byte) error {
+func (m *RestartSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23482,17 +26525,17 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
+			return fmt.Errorf("proto: RestartSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RestartSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23502,63 +26545,29 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Schedule = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeZone", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23568,24 +26577,23 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TimeZone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23608,7 +26616,7 @@ func (m *Rollout) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
+func (m *Rollout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23631,15 +26639,15 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
+			return fmt.Errorf("proto: Rollout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Rollout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23666,14 +26674,13 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, RolloutAnalysisTemplate{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23700,14 +26707,13 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23734,16 +26740,65 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DryRun = append(m.DryRun, DryRun{})
-			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAdoption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAdoption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAdoption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeploymentName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23753,25 +26808,23 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
-			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DeploymentName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -23794,7 +26847,7 @@ func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
+func (m *RolloutAlertRule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23817,15 +26870,15 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAlertRule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAlertRule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -23852,15 +26905,15 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -23870,12 +26923,24 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.StartingStep = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = AlertAction(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -23897,7 +26962,7 @@ func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
+func (m *RolloutAlertStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -23920,10 +26985,10 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAlertStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAlertStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -23960,7 +27025,7 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -23988,11 +27053,11 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
+			m.Action = AlertAction(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Summary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24020,7 +27085,40 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Summary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartsAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartsAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24043,7 +27141,7 @@ func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
+func (m *RolloutAnalysis) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24066,17 +27164,17 @@ func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutAnalysisTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAnalysis: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAnalysis: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24086,29 +27184,31 @@ func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			m.Templates = append(m.Templates, RolloutAnalysisTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24118,99 +27218,31 @@ func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ClusterScope = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24220,27 +27252,29 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
+			m.DryRun = append(m.DryRun, DryRun{})
+			if err := m.DryRun[len(m.DryRun)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MeasurementRetention", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24267,13 +27301,14 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MeasurementRetention = append(m.MeasurementRetention, MeasurementRetention{})
+			if err := m.MeasurementRetention[len(m.MeasurementRetention)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InconclusivePolicy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24300,15 +27335,68 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.InconclusivePolicy == nil {
+				m.InconclusivePolicy = &InconclusivePolicy{}
+			}
+			if err := m.InconclusivePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutAnalysisBackground) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutAnalysisBackground: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutAnalysisBackground: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RolloutAnalysis", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24318,29 +27406,30 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			if err := m.RolloutAnalysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingStep", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24350,24 +27439,12 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.StartingStep = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24389,7 +27466,7 @@ func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
+func (m *RolloutAnalysisRunStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24412,17 +27489,17 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAnalysisRunStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24432,29 +27509,27 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
-			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24482,13 +27557,13 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			m.Status = AnalysisPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24498,25 +27573,23 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
-			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -24539,7 +27612,7 @@ func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+func (m *RolloutAnalysisTemplate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24562,15 +27635,15 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutAnalysisTemplate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutAnalysisTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24598,41 +27671,9 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TemplateName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
 			}
@@ -24652,60 +27693,6 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 				}
 			}
 			m.ClusterScope = bool(v != 0)
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.RequiredForCompletion = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24727,7 +27714,7 @@ func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
+func (m *RolloutCondition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24750,15 +27737,15 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutCondition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutCondition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24786,11 +27773,11 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Type = RolloutConditionType(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -24818,13 +27805,13 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
+			m.Status = k8s_io_api_core_v1.ConditionStatus(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateTime", wireType)
 			}
-			var v int32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24834,15 +27821,28 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Replicas = &v
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastUpdateTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransitionTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -24869,15 +27869,15 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastTransitionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24887,33 +27887,29 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Selector == nil {
-				m.Selector = &v1.LabelSelector{}
-			}
-			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int32
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24923,12 +27919,24 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Weight = &v
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -24950,7 +27958,7 @@ func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutList) Unmarshal(dAtA []byte) error {
+func (m *RolloutCrashGuard) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -24973,17 +27981,17 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutCrashGuard: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutCrashGuard: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRestarts", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -24993,30 +28001,17 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxRestarts = &v
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCrashLoopBackOff", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -25026,26 +28021,52 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+			m.MaxCrashLoopBackOff = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxImagePullBackOff", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.MaxImagePullBackOff = &v
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOOMKilled", wireType)
 			}
-			m.Items = append(m.Items, Rollout{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.MaxOOMKilled = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -25067,7 +28088,7 @@ func (m *RolloutList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RolloutPause) Unmarshal(dAtA []byte) error {
+func (m *RolloutExperimentStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -25090,15 +28111,15 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
+			return fmt.Errorf("proto: RolloutExperimentStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RolloutExperimentStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Templates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25125,15 +28146,984 @@ func (m *RolloutPause) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Duration == nil {
-				m.Duration = &intstr.IntOrString{}
-			}
-			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Templates = append(m.Templates, RolloutExperimentTemplate{})
+			if err := m.Templates[len(m.Templates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Duration = DurationString(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analyses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Analyses = append(m.Analyses, RolloutExperimentStepAnalysisTemplateRef{})
+			if err := m.Analyses[len(m.Analyses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentStepAnalysisTemplateRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentStepAnalysisTemplateRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TemplateName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ClusterScope = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredForCompletion", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequiredForCompletion = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutExperimentTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutExperimentTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutExperimentTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpecRef", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpecRef = ReplicaSetSpecRef(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Replicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Replicas = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selector == nil {
+				m.Selector = &v1.LabelSelector{}
+			}
+			if err := m.Selector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Weight = &v
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Rollout{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutPause) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutPause: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutPause: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Duration == nil {
+				m.Duration = &intstr.IntOrString{}
+			}
+			if err := m.Duration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutProgress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutProgress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedCompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EstimatedCompletionTime == nil {
+				m.EstimatedCompletionTime = &v1.Time{}
+			}
+			if err := m.EstimatedCompletionTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodReadySeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PodReadySeconds = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RolloutQueueStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RolloutQueueStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RolloutQueueStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.QueuedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -25365,7 +29355,191 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			m.Paused = bool(v != 0)
 		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineSeconds", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineSeconds = &v
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RestartAt == nil {
+				m.RestartAt = &v1.Time{}
+			}
+			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WorkloadRef == nil {
+				m.WorkloadRef = &ObjectRef{}
+			}
+			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Analysis == nil {
+				m.Analysis = &AnalysisRunStrategy{}
+			}
+			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineAbort", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ProgressDeadlineAbort = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adoption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Adoption == nil {
+				m.Adoption = &RolloutAdoption{}
+			}
+			if err := m.Adoption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevisionSnapshotLimit", wireType)
 			}
 			var v int32
 			for shift := uint(0); ; shift += 7 {
@@ -25382,10 +29556,10 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.ProgressDeadlineSeconds = &v
-		case 9:
+			m.RevisionSnapshotLimit = &v
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RestartAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alerts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25412,16 +29586,14 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RestartAt == nil {
-				m.RestartAt = &v1.Time{}
-			}
-			if err := m.RestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Alerts = append(m.Alerts, RolloutAlertRule{})
+			if err := m.Alerts[len(m.Alerts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WorkloadRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartSchedule", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25448,16 +29620,16 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WorkloadRef == nil {
-				m.WorkloadRef = &ObjectRef{}
+			if m.RestartSchedule == nil {
+				m.RestartSchedule = &RestartSchedule{}
 			}
-			if err := m.WorkloadRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RestartSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Analysis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CrashGuard", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -25484,33 +29656,13 @@ func (m *RolloutSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Analysis == nil {
-				m.Analysis = &AnalysisRunStrategy{}
+			if m.CrashGuard == nil {
+				m.CrashGuard = &RolloutCrashGuard{}
 			}
-			if err := m.Analysis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CrashGuard.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgressDeadlineAbort", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ProgressDeadlineAbort = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -26250,9 +30402,257 @@ func (m *RolloutStatus) Unmarshal(dAtA []byte) error {
 			if m.ALB == nil {
 				m.ALB = &ALBStatus{}
 			}
-			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if err := m.ALB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adoption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Adoption == nil {
+				m.Adoption = &AdoptionStatus{}
+			}
+			if err := m.Adoption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Progress == nil {
+				m.Progress = &RolloutProgress{}
+			}
+			if err := m.Progress.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InconclusiveResolution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InconclusiveResolution == nil {
+				m.InconclusiveResolution = &InconclusiveResolution{}
+			}
+			if err := m.InconclusiveResolution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Queue == nil {
+				m.Queue = &RolloutQueueStatus{}
+			}
+			if err := m.Queue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alert", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Alert == nil {
+				m.Alert = &RolloutAlertStatus{}
+			}
+			if err := m.Alert.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextScheduledRestartAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextScheduledRestartAt == nil {
+				m.NextScheduledRestartAt = &v1.Time{}
+			}
+			if err := m.NextScheduledRestartAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecPatchHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PodSpecPatchHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -27269,6 +31669,92 @@ func (m *SecretKeyRef) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SecretSourceRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretSourceRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretSourceRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vault", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vault == nil {
+				m.Vault = &VaultSecretRef{}
+			}
+			if err := m.Vault.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *SetCanaryScale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -28541,6 +33027,188 @@ func (m *ValueFrom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretSourceRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretSourceRef == nil {
+				m.SecretSourceRef = &SecretSourceRef{}
+			}
+			if err := m.SecretSourceRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VaultSecretRef) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultSecretRef: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultSecretRef: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
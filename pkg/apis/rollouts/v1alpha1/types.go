@@ -61,6 +61,12 @@ type RolloutSpec struct {
 	Strategy RolloutStrategy `json:"strategy" protobuf:"bytes,5,opt,name=strategy"`
 	// The number of old ReplicaSets to retain. If unspecified, will retain 10 old ReplicaSets
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,6,opt,name=revisionHistoryLimit"`
+	// RevisionSnapshotLimit is the number of revision snapshots to retain. Each revision's pod template
+	// and metadata is stored in a ControllerRevision, independently of revisionHistoryLimit, so that
+	// the rollout can be rolled back to a revision whose ReplicaSet was already deleted.
+	// Defaults to 0, which disables revision snapshots.
+	// +optional
+	RevisionSnapshotLimit *int32 `json:"revisionSnapshotLimit,omitempty" protobuf:"varint,14,opt,name=revisionSnapshotLimit"`
 	// Paused pauses the rollout at its current step.
 	Paused bool `json:"paused,omitempty" protobuf:"varint,7,opt,name=paused"`
 	// ProgressDeadlineSeconds The maximum time in seconds for a rollout to
@@ -76,8 +82,65 @@ type RolloutSpec struct {
 	ProgressDeadlineAbort bool `json:"progressDeadlineAbort,omitempty" protobuf:"varint,12,opt,name=progressDeadlineAbort"`
 	// RestartAt indicates when all the pods of a Rollout should be restarted
 	RestartAt *metav1.Time `json:"restartAt,omitempty" protobuf:"bytes,9,opt,name=restartAt"`
+	// RestartSchedule restarts all the pods of the Rollout on a recurring schedule. Scheduled restarts
+	// are skipped while an update is in progress.
+	// +optional
+	RestartSchedule *RestartSchedule `json:"restartSchedule,omitempty" protobuf:"bytes,16,opt,name=restartSchedule"`
 	// Analysis configuration for the analysis runs to retain
 	Analysis *AnalysisRunStrategy `json:"analysis,omitempty" protobuf:"bytes,11,opt,name=analysis"`
+	// Adoption adopts the current ReplicaSet of an existing Deployment as the stable revision of the
+	// Rollout, when its pod template is equivalent, so that migrating does not restart any pods
+	// +optional
+	Adoption *RolloutAdoption `json:"adoption,omitempty" protobuf:"bytes,13,opt,name=adoption"`
+	// Alerts pause or abort an update of the rollout when the Alertmanager alerts they select fire.
+	// The alerts are received by the alert receiver of the controller.
+	// +optional
+	Alerts []RolloutAlertRule `json:"alerts,omitempty" protobuf:"bytes,15,rep,name=alerts"`
+	// CrashGuard aborts an update of the rollout as soon as the pods of the new revision crash, fail
+	// to pull their images or run out of memory beyond its thresholds, instead of waiting for the
+	// progress deadline
+	// +optional
+	CrashGuard *RolloutCrashGuard `json:"crashGuard,omitempty" protobuf:"bytes,17,opt,name=crashGuard"`
+}
+
+// RolloutCrashGuard holds the thresholds on the pod statuses of the new revision beyond which its
+// update is aborted
+type RolloutCrashGuard struct {
+	// MaxRestarts is the number of restarts of a container of a pod of the new revision tolerated.
+	// Defaults to 3.
+	// +optional
+	MaxRestarts *int32 `json:"maxRestarts,omitempty" protobuf:"varint,1,opt,name=maxRestarts"`
+	// MaxCrashLoopBackOff is the number of pods of the new revision tolerated in CrashLoopBackOff.
+	// Defaults to 0.
+	// +optional
+	MaxCrashLoopBackOff *int32 `json:"maxCrashLoopBackOff,omitempty" protobuf:"varint,2,opt,name=maxCrashLoopBackOff"`
+	// MaxImagePullBackOff is the number of pods of the new revision tolerated in ImagePullBackOff
+	// or ErrImagePull. Defaults to 0.
+	// +optional
+	MaxImagePullBackOff *int32 `json:"maxImagePullBackOff,omitempty" protobuf:"varint,3,opt,name=maxImagePullBackOff"`
+	// MaxOOMKilled is the number of pods of the new revision tolerated with a container killed
+	// because it ran out of memory. Defaults to 0.
+	// +optional
+	MaxOOMKilled *int32 `json:"maxOOMKilled,omitempty" protobuf:"varint,4,opt,name=maxOOMKilled"`
+}
+
+// AlertAction is the action taken on a rollout when an alert fires during its update
+type AlertAction string
+
+const (
+	// AlertActionPause pauses the rollout until it is resumed
+	AlertActionPause AlertAction = "Pause"
+	// AlertActionAbort aborts the update of the rollout
+	AlertActionAbort AlertAction = "Abort"
+)
+
+// RolloutAlertRule selects the Alertmanager alerts which pause or abort an update of the rollout
+type RolloutAlertRule struct {
+	// Selector selects the alerts by their labels
+	Selector metav1.LabelSelector `json:"selector" protobuf:"bytes,1,opt,name=selector"`
+	// Action is the action taken when a selected alert fires: Pause or Abort. Defaults to Abort.
+	// +optional
+	Action AlertAction `json:"action,omitempty" protobuf:"bytes,2,opt,name=action,casttype=AlertAction"`
 }
 
 func (s *RolloutSpec) SetResolvedSelector(selector *metav1.LabelSelector) {
@@ -136,6 +199,24 @@ type ObjectRef struct {
 	Name string `json:"name,omitempty" protobuf:"bytes,3,opt,name=name"`
 }
 
+// RestartSchedule defines when the pods of a Rollout are restarted on a recurring schedule
+type RestartSchedule struct {
+	// Schedule is the cron expression of the restarts, e.g. "0 3 * * *" to restart every day at 3am
+	Schedule string `json:"schedule" protobuf:"bytes,1,opt,name=schedule"`
+	// TimeZone is the IANA time zone the schedule is evaluated in, e.g. "America/New_York".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty" protobuf:"bytes,2,opt,name=timeZone"`
+}
+
+// RolloutAdoption defines the Deployment whose ReplicaSet is adopted by a Rollout
+type RolloutAdoption struct {
+	// DeploymentName is the name of the Deployment to adopt the ReplicaSet from. Defaults to the
+	// name of the workloadRef when it references a Deployment
+	// +optional
+	DeploymentName string `json:"deploymentName,omitempty" protobuf:"bytes,1,opt,name=deploymentName"`
+}
+
 const (
 	// DefaultRolloutUniqueLabelKey is the default key of the selector that is added
 	// to existing ReplicaSets (and label key that is added to its pods) to prevent the existing ReplicaSets
@@ -144,6 +225,9 @@ const (
 	// DefaultReplicaSetScaleDownDeadlineAnnotationKey is the default key attached to an old stable ReplicaSet after
 	// the rollout transitioned to a new version. It contains the time when the controller can scale down the RS.
 	DefaultReplicaSetScaleDownDeadlineAnnotationKey = "scale-down-deadline"
+	// RevisionSnapshotLabelKey is the label key of the ControllerRevisions storing the revision snapshots of a
+	// rollout. Its value is the name of the rollout.
+	RevisionSnapshotLabelKey = "rollout.argoproj.io/revision-snapshot"
 	// ManagedByRolloutKey is the key used to indicate which rollout(s) manage a resource but doesn't own it.
 	ManagedByRolloutsKey = "argo-rollouts.argoproj.io/managed-by-rollouts"
 	// DefaultReplicaSetRestartAnnotationKey indicates that the ReplicaSet with this annotation was restarted at the
@@ -214,6 +298,11 @@ type BlueGreenStrategy struct {
 	// Default is 30 second
 	// +optional
 	AbortScaleDownDelaySeconds *int32 `json:"abortScaleDownDelaySeconds,omitempty" protobuf:"varint,14,opt,name=abortScaleDownDelaySeconds"`
+	// PreviewPodSpecPatch is a strategic merge patch of the pod spec applied to the preview pods only.
+	// It does not change the pod template hash, and is removed once the revision becomes active.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	PreviewPodSpecPatch *runtime.RawExtension `json:"previewPodSpecPatch,omitempty" protobuf:"bytes,15,opt,name=previewPodSpecPatch"`
 }
 
 // AntiAffinity defines which inter-pod scheduling rule to use for anti-affinity injection
@@ -307,6 +396,11 @@ type CanaryStrategy struct {
 	DynamicStableScale bool `json:"dynamicStableScale,omitempty" protobuf:"varint,14,opt,name=dynamicStableScale"`
 	// PingPongSpec holds the ping and pong services
 	PingPong *PingPongSpec `json:"pingPong,omitempty" protobuf:"varint,15,opt,name=pingPong"`
+	// CanaryPodSpecPatch is a strategic merge patch of the pod spec applied to the canary pods only.
+	// It does not change the pod template hash, and is removed once the revision becomes stable.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	CanaryPodSpecPatch *runtime.RawExtension `json:"canaryPodSpecPatch,omitempty" protobuf:"bytes,16,opt,name=canaryPodSpecPatch"`
 }
 
 // PingPongSpec holds the ping and pong service name.
@@ -554,6 +648,17 @@ type CanaryStep struct {
 	// SetCanaryScale defines how to scale the newRS without changing traffic weight
 	// +optional
 	SetCanaryScale *SetCanaryScale `json:"setCanaryScale,omitempty" protobuf:"bytes,5,opt,name=setCanaryScale"`
+	// Deadline is the maximum amount of time the step may take, after which the rollout is aborted.
+	// It is not enforced while the rollout is paused with spec.paused.
+	// +optional
+	Deadline *intstr.IntOrString `json:"deadline,omitempty" protobuf:"bytes,6,opt,name=deadline"`
+}
+
+// DeadlineSeconds converts the step deadline to seconds
+// If Deadline is nil 0 is returned
+// if Deadline values is string and does not contain a valid unit -1 is returned
+func (s CanaryStep) DeadlineSeconds() int32 {
+	return durationSeconds(s.Deadline)
 }
 
 // SetCanaryScale defines how to scale the newRS without changing traffic weight
@@ -595,6 +700,41 @@ type RolloutAnalysis struct {
 	// +patchStrategy=merge
 	// +optional
 	MeasurementRetention []MeasurementRetention `json:"measurementRetention,omitempty" patchStrategy:"merge" patchMergeKey:"metricName" protobuf:"bytes,4,rep,name=measurementRetention"`
+	// InconclusivePolicy resolves an inconclusive result of the analysis, which otherwise pauses the
+	// rollout until it is promoted
+	// +optional
+	InconclusivePolicy *InconclusivePolicy `json:"inconclusivePolicy,omitempty" protobuf:"bytes,5,opt,name=inconclusivePolicy"`
+}
+
+// InconclusiveAction is an action which resolves an inconclusive analysis
+type InconclusiveAction string
+
+const (
+	// InconclusiveActionRerun reruns the inconclusive analysis
+	InconclusiveActionRerun InconclusiveAction = "Rerun"
+	// InconclusiveActionSucceed treats the inconclusive analysis as successful
+	InconclusiveActionSucceed InconclusiveAction = "Succeed"
+	// InconclusiveActionFail treats the inconclusive analysis as failed, which aborts the rollout
+	InconclusiveActionFail InconclusiveAction = "Fail"
+	// InconclusiveActionEscalate sends a notification, and keeps the rollout paused until it is promoted
+	InconclusiveActionEscalate InconclusiveAction = "Escalate"
+)
+
+// InconclusivePolicy defines how an inconclusive analysis is resolved. An inconclusive analysis is
+// first rerun up to the number of reruns. The rollout is then paused, and the action is taken once
+// it has been paused for the timeout.
+type InconclusivePolicy struct {
+	// Reruns is the number of times an inconclusive analysis is rerun before the rollout is paused
+	// +optional
+	Reruns int32 `json:"reruns,omitempty" protobuf:"varint,1,opt,name=reruns"`
+	// Action is the action taken once the rollout has been paused for the timeout: Succeed, Fail or
+	// Escalate. The rollout stays paused until it is promoted when no action is set.
+	// +kubebuilder:validation:Enum=Succeed;Fail;Escalate
+	// +optional
+	Action InconclusiveAction `json:"action,omitempty" protobuf:"bytes,2,opt,name=action,casttype=InconclusiveAction"`
+	// Timeout is how long the rollout stays paused before the action is taken (default: 0s)
+	// +optional
+	Timeout DurationString `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout,casttype=DurationString"`
 }
 
 type RolloutAnalysisTemplate struct {
@@ -665,11 +805,15 @@ type RolloutPause struct {
 // If Duration is nil 0 is returned
 // if Duration values is string and does not contain a valid unit -1 is returned
 func (p RolloutPause) DurationSeconds() int32 {
-	if p.Duration != nil {
-		if p.Duration.Type == intstr.String {
-			s, err := strconv.ParseInt(p.Duration.StrVal, 10, 32)
+	return durationSeconds(p.Duration)
+}
+
+func durationSeconds(duration *intstr.IntOrString) int32 {
+	if duration != nil {
+		if duration.Type == intstr.String {
+			s, err := strconv.ParseInt(duration.StrVal, 10, 32)
 			if err != nil {
-				d, err := time.ParseDuration(p.Duration.StrVal)
+				d, err := time.ParseDuration(duration.StrVal)
 				if err != nil {
 					return -1
 				}
@@ -678,7 +822,7 @@ func (p RolloutPause) DurationSeconds() int32 {
 			// special case where no unit was specified
 			return int32(s)
 		}
-		return p.Duration.IntVal
+		return duration.IntVal
 	}
 	return 0
 }
@@ -708,6 +852,8 @@ const (
 	PauseReasonCanaryPauseStep PauseReason = "CanaryPauseStep"
 	// PauseReasonBlueGreenPause pause rollout before promoting rollout
 	PauseReasonBlueGreenPause PauseReason = "BlueGreenPause"
+	// PauseReasonAlert pauses rollout when an alert selected by its alert rules fires
+	PauseReasonAlert PauseReason = "AlertFiring"
 )
 
 // PauseCondition the reason for a pause and when it started
@@ -728,6 +874,9 @@ const (
 	RolloutPhaseProgressing RolloutPhase = "Progressing"
 	// RolloutPhasePaused indicates a rollout is not yet healthy and will not make progress until unpaused
 	RolloutPhasePaused RolloutPhase = "Paused"
+	// RolloutPhaseQueued indicates a rollout is waiting for the concurrency budget of the controller
+	// before it progresses past its first step
+	RolloutPhaseQueued RolloutPhase = "Queued"
 )
 
 // RolloutStatus is the status for a Rollout resource
@@ -813,6 +962,98 @@ type RolloutStatus struct {
 	WorkloadObservedGeneration string `json:"workloadObservedGeneration,omitempty" protobuf:"bytes,24,opt,name=workloadObservedGeneration"`
 	/// ALB keeps information regarding the ALB and TargetGroups
 	ALB *ALBStatus `json:"alb,omitempty" protobuf:"bytes,25,opt,name=alb"`
+	// Adoption records the ReplicaSet which was adopted from an existing Deployment
+	// +optional
+	Adoption *AdoptionStatus `json:"adoption,omitempty" protobuf:"bytes,26,opt,name=adoption"`
+	// Progress is the estimated progress of the update in progress
+	// +optional
+	Progress *RolloutProgress `json:"progress,omitempty" protobuf:"bytes,27,opt,name=progress"`
+	// InconclusiveResolution records how the inconclusive policy of an analysis last resolved an
+	// inconclusive AnalysisRun of the update
+	// +optional
+	InconclusiveResolution *InconclusiveResolution `json:"inconclusiveResolution,omitempty" protobuf:"bytes,28,opt,name=inconclusiveResolution"`
+	// Queue is set while the rollout waits for the concurrency budget of the controller before it
+	// progresses past its first step
+	// +optional
+	Queue *RolloutQueueStatus `json:"queue,omitempty" protobuf:"bytes,29,opt,name=queue"`
+	// Alert is a firing alert selected by the alert rules of the rollout, which was received during
+	// its update and is yet to pause or abort it
+	// +optional
+	Alert *RolloutAlertStatus `json:"alert,omitempty" protobuf:"bytes,30,opt,name=alert"`
+	// NextScheduledRestartAt is when the restart schedule of the rollout next restarts its pods
+	// +optional
+	NextScheduledRestartAt *metav1.Time `json:"nextScheduledRestartAt,omitempty" protobuf:"bytes,31,opt,name=nextScheduledRestartAt"`
+	// PodSpecPatchHash is the hash of the canary or preview pod spec patch of the new ReplicaSet which
+	// all its pods were last checked to be created with
+	// +optional
+	PodSpecPatchHash string `json:"podSpecPatchHash,omitempty" protobuf:"bytes,32,opt,name=podSpecPatchHash"`
+}
+
+// RolloutAlertStatus is a firing Alertmanager alert received for a rollout
+type RolloutAlertStatus struct {
+	// Name is the name of the alert, from its alertname label
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Action is the action of the alert rule which selected the alert
+	Action AlertAction `json:"action" protobuf:"bytes,2,opt,name=action,casttype=AlertAction"`
+	// Summary is the summary or the description annotation of the alert
+	// +optional
+	Summary string `json:"summary,omitempty" protobuf:"bytes,3,opt,name=summary"`
+	// StartsAt is the time the alert started firing
+	StartsAt metav1.Time `json:"startsAt" protobuf:"bytes,4,opt,name=startsAt"`
+}
+
+// RolloutQueueStatus describes the place of a rollout in the queue of a concurrency budget
+type RolloutQueueStatus struct {
+	// Position is the position of the rollout in the queue, starting at 1
+	Position int32 `json:"position" protobuf:"varint,1,opt,name=position"`
+	// Budget is the value of the budget label the budget is scoped to. It is empty when the budget
+	// applies to all rollouts.
+	// +optional
+	Budget string `json:"budget,omitempty" protobuf:"bytes,2,opt,name=budget"`
+	// QueuedAt is the time the rollout was queued
+	QueuedAt metav1.Time `json:"queuedAt" protobuf:"bytes,3,opt,name=queuedAt"`
+}
+
+// InconclusiveResolution describes how the inconclusive policy of an analysis resolved an
+// inconclusive AnalysisRun
+type InconclusiveResolution struct {
+	// AnalysisRun is the name of the inconclusive AnalysisRun
+	AnalysisRun string `json:"analysisRun" protobuf:"bytes,1,opt,name=analysisRun"`
+	// Action is the action which resolved it: Rerun, Succeed, Fail or Escalate
+	Action InconclusiveAction `json:"action" protobuf:"bytes,2,opt,name=action,casttype=InconclusiveAction"`
+	// Reruns is the number of times the analysis had been rerun
+	// +optional
+	Reruns int32 `json:"reruns,omitempty" protobuf:"varint,3,opt,name=reruns"`
+	// ResolvedAt is the time the action was taken
+	ResolvedAt metav1.Time `json:"resolvedAt" protobuf:"bytes,4,opt,name=resolvedAt"`
+}
+
+// RolloutProgress describes the estimated progress of an update
+type RolloutProgress struct {
+	// Percent is the estimated percentage of the update which is complete
+	Percent int32 `json:"percent" protobuf:"varint,1,opt,name=percent"`
+	// EstimatedCompletionTime is the time the update is estimated to complete. It is not set when
+	// the completion time depends on a manual promotion or on analysis running indefinitely.
+	// +optional
+	EstimatedCompletionTime *metav1.Time `json:"estimatedCompletionTime,omitempty" protobuf:"bytes,2,opt,name=estimatedCompletionTime"`
+	// PodReadySeconds is the number of seconds the pods of the update were observed to take to become available
+	// +optional
+	PodReadySeconds *int32 `json:"podReadySeconds,omitempty" protobuf:"varint,3,opt,name=podReadySeconds"`
+	// Message explains why the completion time cannot be estimated
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+}
+
+// AdoptionStatus describes a ReplicaSet adopted from an existing Deployment
+type AdoptionStatus struct {
+	// DeploymentName is the name of the Deployment the ReplicaSet was adopted from
+	DeploymentName string `json:"deploymentName" protobuf:"bytes,1,opt,name=deploymentName"`
+	// ReplicaSetName is the name of the adopted ReplicaSet
+	ReplicaSetName string `json:"replicaSetName" protobuf:"bytes,2,opt,name=replicaSetName"`
+	// PodTemplateHash is the rollouts-pod-template-hash the adopted ReplicaSet and its pods were labeled with
+	PodTemplateHash string `json:"podTemplateHash" protobuf:"bytes,3,opt,name=podTemplateHash"`
+	// AdoptedAt is the time the ReplicaSet was adopted
+	AdoptedAt metav1.Time `json:"adoptedAt" protobuf:"bytes,4,opt,name=adoptedAt"`
 }
 
 // BlueGreenStatus status fields that only pertain to the blueGreen rollout
@@ -844,6 +1085,41 @@ type CanaryStatus struct {
 	Weights *TrafficWeights `json:"weights,omitempty" protobuf:"bytes,4,opt,name=weights"`
 	// StablePingPong For the ping-pong feature holds the current stable service, ping or pong
 	StablePingPong PingPongType `json:"stablePingPong,omitempty" protobuf:"bytes,5,opt,name=stablePingPong"`
+	// StepHistory records the steps executed for the current revision, in the order they started
+	// +optional
+	StepHistory []CanaryStepRecord `json:"stepHistory,omitempty" protobuf:"bytes,6,rep,name=stepHistory"`
+}
+
+// CanaryStepOutcome is the outcome of a canary step
+type CanaryStepOutcome string
+
+const (
+	// CanaryStepOutcomeRunning means the step has started and not finished yet
+	CanaryStepOutcomeRunning CanaryStepOutcome = "Running"
+	// CanaryStepOutcomeCompleted means the step completed
+	CanaryStepOutcomeCompleted CanaryStepOutcome = "Completed"
+	// CanaryStepOutcomeSkipped means the step was skipped by promoting the rollout
+	CanaryStepOutcomeSkipped CanaryStepOutcome = "Skipped"
+	// CanaryStepOutcomeAborted means the rollout was aborted during the step
+	CanaryStepOutcomeAborted CanaryStepOutcome = "Aborted"
+	// CanaryStepOutcomeDeadlineExceeded means the step did not complete before its deadline, which aborted the rollout
+	CanaryStepOutcomeDeadlineExceeded CanaryStepOutcome = "DeadlineExceeded"
+)
+
+// CanaryStepRecord records the execution of a canary step
+type CanaryStepRecord struct {
+	// Index is the index of the step in the canary steps
+	Index int32 `json:"index" protobuf:"varint,1,opt,name=index"`
+	// StartedAt is the time the step started
+	StartedAt metav1.Time `json:"startedAt" protobuf:"bytes,2,opt,name=startedAt"`
+	// FinishedAt is the time the step finished
+	// +optional
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,3,opt,name=finishedAt"`
+	// Outcome is the outcome of the step
+	Outcome CanaryStepOutcome `json:"outcome" protobuf:"bytes,4,opt,name=outcome,casttype=CanaryStepOutcome"`
+	// Message explains the outcome of the step
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,5,opt,name=message"`
 }
 
 type PingPongType string
@@ -915,6 +1191,12 @@ const (
 	RolloutPaused RolloutConditionType = "Paused"
 	// RolloutCompleted means that rollout is in a completed state. It is still progressing at this point.
 	RolloutCompleted RolloutConditionType = "Completed"
+	// RolloutPermissionDenied means that the ServiceAccount impersonated by the controller was denied
+	// a change to the traffic routing objects or services of the rollout.
+	RolloutPermissionDenied RolloutConditionType = "PermissionDenied"
+	// RolloutFrozen means that an emergency stop of the controller holds the update of the rollout
+	// at its current step.
+	RolloutFrozen RolloutConditionType = "Frozen"
 )
 
 // RolloutCondition describes the state of a rollout at a certain point.
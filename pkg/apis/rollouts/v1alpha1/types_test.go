@@ -24,3 +24,14 @@ func TestRolloutPauseDuration(t *testing.T) {
 	rp.Duration = DurationFromString("20000000000") // out of int32
 	assert.Equal(t, int32(-1), rp.DurationSeconds())
 }
+
+func TestCanaryStepDeadline(t *testing.T) {
+	step := CanaryStep{}
+	assert.Equal(t, int32(0), step.DeadlineSeconds())
+	step.Deadline = DurationFromInt(30)
+	assert.Equal(t, int32(30), step.DeadlineSeconds())
+	step.Deadline = DurationFromString("15m")
+	assert.Equal(t, int32(900), step.DeadlineSeconds())
+	step.Deadline = DurationFromString("1z")
+	assert.Equal(t, int32(-1), step.DeadlineSeconds())
+}
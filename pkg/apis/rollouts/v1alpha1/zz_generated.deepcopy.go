@@ -67,6 +67,23 @@ func (in *ALBTrafficRouting) DeepCopy() *ALBTrafficRouting {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdoptionStatus) DeepCopyInto(out *AdoptionStatus) {
+	*out = *in
+	in.AdoptedAt.DeepCopyInto(&out.AdoptedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdoptionStatus.
+func (in *AdoptionStatus) DeepCopy() *AdoptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AdoptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AmbassadorTrafficRouting) DeepCopyInto(out *AmbassadorTrafficRouting) {
 	*out = *in
@@ -356,6 +373,11 @@ func (in *AnalysisTemplateSpec) DeepCopyInto(out *AnalysisTemplateSpec) {
 		*out = make([]MeasurementRetention, len(*in))
 		copy(*out, *in)
 	}
+	if in.NamespacePolicy != nil {
+		in, out := &in.NamespacePolicy, &out.NamespacePolicy
+		*out = new(NamespacePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -636,6 +658,11 @@ func (in *BlueGreenStrategy) DeepCopyInto(out *BlueGreenStrategy) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PreviewPodSpecPatch != nil {
+		in, out := &in.PreviewPodSpecPatch, &out.PreviewPodSpecPatch
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -667,6 +694,13 @@ func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
 		*out = new(TrafficWeights)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StepHistory != nil {
+		in, out := &in.StepHistory, &out.StepHistory
+		*out = make([]CanaryStepRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -708,6 +742,11 @@ func (in *CanaryStep) DeepCopyInto(out *CanaryStep) {
 		*out = new(SetCanaryScale)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Deadline != nil {
+		in, out := &in.Deadline, &out.Deadline
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	return
 }
 
@@ -721,6 +760,27 @@ func (in *CanaryStep) DeepCopy() *CanaryStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStepRecord) DeepCopyInto(out *CanaryStepRecord) {
+	*out = *in
+	in.StartedAt.DeepCopyInto(&out.StartedAt)
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStepRecord.
+func (in *CanaryStepRecord) DeepCopy() *CanaryStepRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStepRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 	*out = *in
@@ -786,6 +846,11 @@ func (in *CanaryStrategy) DeepCopyInto(out *CanaryStrategy) {
 		*out = new(PingPongSpec)
 		**out = **in
 	}
+	if in.CanaryPodSpecPatch != nil {
+		in, out := &in.CanaryPodSpecPatch, &out.CanaryPodSpecPatch
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1254,6 +1319,39 @@ func (in *GraphiteMetric) DeepCopy() *GraphiteMetric {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InconclusivePolicy) DeepCopyInto(out *InconclusivePolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InconclusivePolicy.
+func (in *InconclusivePolicy) DeepCopy() *InconclusivePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(InconclusivePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InconclusiveResolution) DeepCopyInto(out *InconclusiveResolution) {
+	*out = *in
+	in.ResolvedAt.DeepCopyInto(&out.ResolvedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InconclusiveResolution.
+func (in *InconclusiveResolution) DeepCopy() *InconclusiveResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(InconclusiveResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IstioDestinationRule) DeepCopyInto(out *IstioDestinationRule) {
 	*out = *in
@@ -1499,7 +1597,7 @@ func (in *MetricProvider) DeepCopyInto(out *MetricProvider) {
 	if in.Prometheus != nil {
 		in, out := &in.Prometheus, &out.Prometheus
 		*out = new(PrometheusMetric)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Kayenta != nil {
 		in, out := &in.Kayenta, &out.Kayenta
@@ -1584,6 +1682,32 @@ func (in *MetricResult) DeepCopy() *MetricResult {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespacePolicy) DeepCopyInto(out *NamespacePolicy) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespacePolicy.
+func (in *NamespacePolicy) DeepCopy() *NamespacePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespacePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NewRelicMetric) DeepCopyInto(out *NewRelicMetric) {
 	*out = *in
@@ -1656,6 +1780,27 @@ func (in *PauseCondition) DeepCopy() *PauseCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerSeriesEvaluation) DeepCopyInto(out *PerSeriesEvaluation) {
+	*out = *in
+	if in.Quorum != nil {
+		in, out := &in.Quorum, &out.Quorum
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerSeriesEvaluation.
+func (in *PerSeriesEvaluation) DeepCopy() *PerSeriesEvaluation {
+	if in == nil {
+		return nil
+	}
+	out := new(PerSeriesEvaluation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PingPongSpec) DeepCopyInto(out *PingPongSpec) {
 	*out = *in
@@ -1721,6 +1866,11 @@ func (in *PreferredDuringSchedulingIgnoredDuringExecution) DeepCopy() *Preferred
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PrometheusMetric) DeepCopyInto(out *PrometheusMetric) {
 	*out = *in
+	if in.PerSeries != nil {
+		in, out := &in.PerSeries, &out.PerSeries
+		*out = new(PerSeriesEvaluation)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1750,6 +1900,22 @@ func (in *RequiredDuringSchedulingIgnoredDuringExecution) DeepCopy() *RequiredDu
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestartSchedule) DeepCopyInto(out *RestartSchedule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestartSchedule.
+func (in *RestartSchedule) DeepCopy() *RestartSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(RestartSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Rollout) DeepCopyInto(out *Rollout) {
 	*out = *in
@@ -1778,6 +1944,56 @@ func (in *Rollout) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAdoption) DeepCopyInto(out *RolloutAdoption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAdoption.
+func (in *RolloutAdoption) DeepCopy() *RolloutAdoption {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAdoption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAlertRule) DeepCopyInto(out *RolloutAlertRule) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAlertRule.
+func (in *RolloutAlertRule) DeepCopy() *RolloutAlertRule {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAlertRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAlertStatus) DeepCopyInto(out *RolloutAlertStatus) {
+	*out = *in
+	in.StartsAt.DeepCopyInto(&out.StartsAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAlertStatus.
+func (in *RolloutAlertStatus) DeepCopy() *RolloutAlertStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAlertStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutAnalysis) DeepCopyInto(out *RolloutAnalysis) {
 	*out = *in
@@ -1803,6 +2019,11 @@ func (in *RolloutAnalysis) DeepCopyInto(out *RolloutAnalysis) {
 		*out = make([]MeasurementRetention, len(*in))
 		copy(*out, *in)
 	}
+	if in.InconclusivePolicy != nil {
+		in, out := &in.InconclusivePolicy, &out.InconclusivePolicy
+		*out = new(InconclusivePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -1888,6 +2109,42 @@ func (in *RolloutCondition) DeepCopy() *RolloutCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutCrashGuard) DeepCopyInto(out *RolloutCrashGuard) {
+	*out = *in
+	if in.MaxRestarts != nil {
+		in, out := &in.MaxRestarts, &out.MaxRestarts
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxCrashLoopBackOff != nil {
+		in, out := &in.MaxCrashLoopBackOff, &out.MaxCrashLoopBackOff
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxImagePullBackOff != nil {
+		in, out := &in.MaxImagePullBackOff, &out.MaxImagePullBackOff
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxOOMKilled != nil {
+		in, out := &in.MaxOOMKilled, &out.MaxOOMKilled
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutCrashGuard.
+func (in *RolloutCrashGuard) DeepCopy() *RolloutCrashGuard {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutCrashGuard)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutExperimentStep) DeepCopyInto(out *RolloutExperimentStep) {
 	*out = *in
@@ -2027,6 +2284,48 @@ func (in *RolloutPause) DeepCopy() *RolloutPause {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutProgress) DeepCopyInto(out *RolloutProgress) {
+	*out = *in
+	if in.EstimatedCompletionTime != nil {
+		in, out := &in.EstimatedCompletionTime, &out.EstimatedCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PodReadySeconds != nil {
+		in, out := &in.PodReadySeconds, &out.PodReadySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutProgress.
+func (in *RolloutProgress) DeepCopy() *RolloutProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutQueueStatus) DeepCopyInto(out *RolloutQueueStatus) {
+	*out = *in
+	in.QueuedAt.DeepCopyInto(&out.QueuedAt)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutQueueStatus.
+func (in *RolloutQueueStatus) DeepCopy() *RolloutQueueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutQueueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 	*out = *in
@@ -2052,6 +2351,11 @@ func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RevisionSnapshotLimit != nil {
+		in, out := &in.RevisionSnapshotLimit, &out.RevisionSnapshotLimit
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ProgressDeadlineSeconds != nil {
 		in, out := &in.ProgressDeadlineSeconds, &out.ProgressDeadlineSeconds
 		*out = new(int32)
@@ -2061,11 +2365,33 @@ func (in *RolloutSpec) DeepCopyInto(out *RolloutSpec) {
 		in, out := &in.RestartAt, &out.RestartAt
 		*out = (*in).DeepCopy()
 	}
+	if in.RestartSchedule != nil {
+		in, out := &in.RestartSchedule, &out.RestartSchedule
+		*out = new(RestartSchedule)
+		**out = **in
+	}
 	if in.Analysis != nil {
 		in, out := &in.Analysis, &out.Analysis
 		*out = new(AnalysisRunStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(RolloutAdoption)
+		**out = **in
+	}
+	if in.Alerts != nil {
+		in, out := &in.Alerts, &out.Alerts
+		*out = make([]RolloutAlertRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CrashGuard != nil {
+		in, out := &in.CrashGuard, &out.CrashGuard
+		*out = new(RolloutCrashGuard)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2121,6 +2447,35 @@ func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
 		*out = new(ALBStatus)
 		**out = **in
 	}
+	if in.Adoption != nil {
+		in, out := &in.Adoption, &out.Adoption
+		*out = new(AdoptionStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(RolloutProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InconclusiveResolution != nil {
+		in, out := &in.InconclusiveResolution, &out.InconclusiveResolution
+		*out = new(InconclusiveResolution)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Queue != nil {
+		in, out := &in.Queue, &out.Queue
+		*out = new(RolloutQueueStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Alert != nil {
+		in, out := &in.Alert, &out.Alert
+		*out = new(RolloutAlertStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextScheduledRestartAt != nil {
+		in, out := &in.NextScheduledRestartAt, &out.NextScheduledRestartAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -2275,6 +2630,27 @@ func (in *SecretKeyRef) DeepCopy() *SecretKeyRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretSourceRef) DeepCopyInto(out *SecretSourceRef) {
+	*out = *in
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretRef)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretSourceRef.
+func (in *SecretSourceRef) DeepCopy() *SecretSourceRef {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretSourceRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SetCanaryScale) DeepCopyInto(out *SetCanaryScale) {
 	*out = *in
@@ -2468,6 +2844,11 @@ func (in *ValueFrom) DeepCopyInto(out *ValueFrom) {
 		*out = new(FieldRef)
 		**out = **in
 	}
+	if in.SecretSourceRef != nil {
+		in, out := &in.SecretSourceRef, &out.SecretSourceRef
+		*out = new(SecretSourceRef)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2481,6 +2862,22 @@ func (in *ValueFrom) DeepCopy() *ValueFrom {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VaultSecretRef) DeepCopyInto(out *VaultSecretRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VaultSecretRef.
+func (in *VaultSecretRef) DeepCopy() *VaultSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(VaultSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WavefrontMetric) DeepCopyInto(out *WavefrontMetric) {
 	*out = *in
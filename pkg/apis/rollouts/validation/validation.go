@@ -9,6 +9,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unversionedvalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	validationutil "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -20,6 +21,8 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	"github.com/argoproj/argo-rollouts/utils/restart"
 )
 
 const (
@@ -35,6 +38,16 @@ const (
 	InvalidSetCanaryScaleTrafficPolicy = "SetCanaryScale requires TrafficRouting to be set"
 	// InvalidDurationMessage indicates the Duration value needs to be greater than 0
 	InvalidDurationMessage = "Duration needs to be greater than 0"
+	// InvalidStepDeadlineMessage indicates the deadline of a step would expire before the end of its pause
+	InvalidStepDeadlineMessage = "Step deadline needs to be greater than the pause duration of the step"
+	// InvalidInconclusivePolicyTimeoutMessage indicates the timeout of an inconclusive policy is not a valid duration
+	InvalidInconclusivePolicyTimeoutMessage = "Inconclusive policy timeout needs to be a valid duration which is not negative"
+	// InconclusivePolicyTimeoutWithoutActionMessage indicates an inconclusive policy has a timeout but no action to take after it
+	InconclusivePolicyTimeoutWithoutActionMessage = "Inconclusive policy timeout requires an action"
+	// EmptyAlertSelectorMessage indicates an alert rule selects all the alerts
+	EmptyAlertSelectorMessage = "Alert rule selector must select alerts by at least one label"
+	// InvalidAlertActionMessage indicates the action of an alert rule is neither Pause nor Abort
+	InvalidAlertActionMessage = "Alert rule action must be Pause or Abort"
 	// InvalidMaxSurgeMaxUnavailable indicates both maxSurge and MaxUnavailable can not be set to zero
 	InvalidMaxSurgeMaxUnavailable = "MaxSurge and MaxUnavailable both can not be zero"
 	// InvalidStepMessage indicates that a step must have either setWeight or pause set
@@ -70,6 +83,8 @@ const (
 	MissedAlbRootServiceMessage = "Root service field is required for the configuration with ALB and ping-pong feature enabled"
 	// PingPongWithAlbOnlyMessage At this moment ping-pong feature works with the ALB traffic routing only
 	PingPongWithAlbOnlyMessage = "Ping-pong feature works with the ALB traffic routing only"
+	// AdoptionDeploymentNameRequiredMessage indicates that the Deployment to adopt cannot be derived from the workloadRef
+	AdoptionDeploymentNameRequiredMessage = "Adoption deploymentName is required unless workloadRef references a Deployment"
 )
 
 // allowAllPodValidationOptions allows all pod options to be true for the purposes of rollout pod
@@ -118,6 +133,10 @@ func ValidateRolloutSpec(rollout *v1alpha1.Rollout, fldPath *field.Path) field.E
 			fmt.Errorf("template must be empty for workload reference rollout")))
 	}
 
+	if spec.Adoption != nil && spec.Adoption.DeploymentName == "" && (spec.WorkloadRef == nil || spec.WorkloadRef.Kind != "Deployment") {
+		allErrs = append(allErrs, field.Required(fldPath.Child("adoption", "deploymentName"), AdoptionDeploymentNameRequiredMessage))
+	}
+
 	selector, err := metav1.LabelSelectorAsSelector(spec.Selector)
 	if err != nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), spec.Selector, "invalid label selector"))
@@ -155,6 +174,8 @@ func ValidateRolloutSpec(rollout *v1alpha1.Rollout, fldPath *field.Path) field.E
 
 	revisionHistoryLimit := defaults.GetRevisionHistoryLimitOrDefault(rollout)
 	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(revisionHistoryLimit), fldPath.Child("revisionHistoryLimit"))...)
+	revisionSnapshotLimit := defaults.GetRevisionSnapshotLimitOrDefault(rollout)
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(revisionSnapshotLimit), fldPath.Child("revisionSnapshotLimit"))...)
 
 	progressDeadlineSeconds := defaults.GetProgressDeadlineSecondsOrDefault(rollout)
 	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(progressDeadlineSeconds), fldPath.Child("progressDeadlineSeconds"))...)
@@ -163,7 +184,63 @@ func ValidateRolloutSpec(rollout *v1alpha1.Rollout, fldPath *field.Path) field.E
 	}
 
 	allErrs = append(allErrs, ValidateRolloutStrategy(rollout, fldPath.Child("strategy"))...)
+	allErrs = append(allErrs, validateAlertRules(spec.Alerts, fldPath.Child("alerts"))...)
+	allErrs = append(allErrs, validateRestartSchedule(spec.RestartSchedule, fldPath.Child("restartSchedule"))...)
+	allErrs = append(allErrs, validateCrashGuard(spec.CrashGuard, fldPath.Child("crashGuard"))...)
+
+	return allErrs
+}
+
+// validateAlertRules validates the alert rules of a rollout
+func validateAlertRules(rules []v1alpha1.RolloutAlertRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, rule := range rules {
+		ruleFldPath := fldPath.Index(i)
+		allErrs = append(allErrs, unversionedvalidation.ValidateLabelSelector(&rule.Selector, ruleFldPath.Child("selector"))...)
+		if len(rule.Selector.MatchLabels)+len(rule.Selector.MatchExpressions) == 0 {
+			allErrs = append(allErrs, field.Invalid(ruleFldPath.Child("selector"), rule.Selector, EmptyAlertSelectorMessage))
+		}
+		switch rule.Action {
+		case "", v1alpha1.AlertActionPause, v1alpha1.AlertActionAbort:
+		default:
+			allErrs = append(allErrs, field.Invalid(ruleFldPath.Child("action"), rule.Action, InvalidAlertActionMessage))
+		}
+	}
+	return allErrs
+}
+
+// validateRestartSchedule validates the cron expression and the time zone of a restart schedule
+func validateRestartSchedule(schedule *v1alpha1.RestartSchedule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if schedule == nil {
+		return allErrs
+	}
+	if _, err := restart.ParseSchedule(*schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, *schedule, err.Error()))
+	}
+	return allErrs
+}
 
+// validateCrashGuard validates that the thresholds of a crash guard are not negative
+func validateCrashGuard(guard *v1alpha1.RolloutCrashGuard, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if guard == nil {
+		return allErrs
+	}
+	thresholds := []struct {
+		name  string
+		value *int32
+	}{
+		{"maxRestarts", guard.MaxRestarts},
+		{"maxCrashLoopBackOff", guard.MaxCrashLoopBackOff},
+		{"maxImagePullBackOff", guard.MaxImagePullBackOff},
+		{"maxOOMKilled", guard.MaxOOMKilled},
+	}
+	for _, threshold := range thresholds {
+		if threshold.value != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*threshold.value), fldPath.Child(threshold.name))...)
+		}
+	}
 	return allErrs
 }
 
@@ -212,6 +289,40 @@ func ValidateRolloutStrategyBlueGreen(rollout *v1alpha1.Rollout, fldPath *field.
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownDelayRevisionLimit"), *blueGreen.ScaleDownDelayRevisionLimit, ScaleDownLimitLargerThanRevisionLimit))
 	}
 	allErrs = append(allErrs, ValidateRolloutStrategyAntiAffinity(blueGreen.AntiAffinity, fldPath.Child("antiAffinity"))...)
+	allErrs = append(allErrs, validateInconclusivePolicy(blueGreen.PrePromotionAnalysis, fldPath.Child("prePromotionAnalysis"))...)
+	allErrs = append(allErrs, validateInconclusivePolicy(blueGreen.PostPromotionAnalysis, fldPath.Child("postPromotionAnalysis"))...)
+	allErrs = append(allErrs, validatePodSpecPatch(rollout, blueGreen.PreviewPodSpecPatch, fldPath.Child("previewPodSpecPatch"))...)
+	return allErrs
+}
+
+// validatePodSpecPatch validates that the canary/preview pod spec patch applies to the pod template
+func validatePodSpecPatch(rollout *v1alpha1.Rollout, patch *runtime.RawExtension, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if patch == nil || len(patch.Raw) == 0 {
+		return allErrs
+	}
+	if _, err := replicasetutil.PatchPodSpec(&rollout.Spec.Template.Spec, patch.Raw); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, string(patch.Raw), err.Error()))
+	}
+	return allErrs
+}
+
+// validateInconclusivePolicy validates the inconclusive policy of an analysis
+func validateInconclusivePolicy(analysis *v1alpha1.RolloutAnalysis, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if analysis == nil || analysis.InconclusivePolicy == nil {
+		return allErrs
+	}
+	policy := analysis.InconclusivePolicy
+	fldPath = fldPath.Child("inconclusivePolicy")
+	allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(policy.Reruns), fldPath.Child("reruns"))...)
+	if policy.Timeout != "" {
+		if timeout, err := policy.Timeout.Duration(); err != nil || timeout < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), policy.Timeout, InvalidInconclusivePolicyTimeoutMessage))
+		} else if policy.Action == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), policy.Timeout, InconclusivePolicyTimeoutWithoutActionMessage))
+		}
+	}
 	return allErrs
 }
 
@@ -229,6 +340,7 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 	canary := rollout.Spec.Strategy.Canary
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, invalidMaxSurgeMaxUnavailable(rollout, fldPath.Child("maxSurge"))...)
+	allErrs = append(allErrs, validatePodSpecPatch(rollout, canary.CanaryPodSpecPatch, fldPath.Child("canaryPodSpecPatch"))...)
 	if canary.CanaryService != "" && canary.StableService != "" && canary.CanaryService == canary.StableService {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("stableService"), canary.StableService, DuplicatedServicesCanaryMessage))
 	}
@@ -271,6 +383,10 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		}
 	}
 
+	if canary.Analysis != nil {
+		allErrs = append(allErrs, validateInconclusivePolicy(&canary.Analysis.RolloutAnalysis, fldPath.Child("analysis"))...)
+	}
+
 	for i, step := range canary.Steps {
 		stepFldPath := fldPath.Child("steps").Index(i)
 		allErrs = append(allErrs, hasMultipleStepsType(step, stepFldPath)...)
@@ -285,6 +401,13 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 		if step.Pause != nil && step.Pause.DurationSeconds() < 0 {
 			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("pause").Child("duration"), step.Pause.DurationSeconds(), InvalidDurationMessage))
 		}
+		if step.Deadline != nil {
+			if step.DeadlineSeconds() <= 0 {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("deadline"), step.DeadlineSeconds(), InvalidDurationMessage))
+			} else if step.Pause != nil && step.DeadlineSeconds() <= step.Pause.DurationSeconds() {
+				allErrs = append(allErrs, field.Invalid(stepFldPath.Child("deadline"), step.DeadlineSeconds(), InvalidStepDeadlineMessage))
+			}
+		}
 		if rollout.Spec.Strategy.Canary != nil && rollout.Spec.Strategy.Canary.TrafficRouting == nil && step.SetCanaryScale != nil {
 			allErrs = append(allErrs, field.Invalid(stepFldPath.Child("setCanaryScale"), step.SetCanaryScale, InvalidSetCanaryScaleTrafficPolicy))
 		}
@@ -309,6 +432,7 @@ func ValidateRolloutStrategyCanary(rollout *v1alpha1.Rollout, fldPath *field.Pat
 			for _, arg := range step.Analysis.Args {
 				analysisRunArgs = append(analysisRunArgs, arg)
 			}
+			allErrs = append(allErrs, validateInconclusivePolicy(step.Analysis, stepFldPath.Child("analysis"))...)
 		}
 
 		for _, arg := range analysisRunArgs {
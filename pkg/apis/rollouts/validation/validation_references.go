@@ -146,6 +146,10 @@ func ValidateAnalysisTemplatesWithType(rollout *v1alpha1.Rollout, templates Anal
 	}
 
 	for _, template := range templates.AnalysisTemplates {
+		for _, err := range ValidateAnalysisTemplate(template) {
+			msg := fmt.Sprintf("AnalysisTemplate %s: %s", template.Name, err.Detail)
+			allErrs = append(allErrs, field.Invalid(fldPath, template.Name, msg))
+		}
 		allErrs = append(allErrs, ValidateAnalysisTemplateWithType(rollout, template, nil, templates.TemplateType, fldPath)...)
 	}
 	for _, clusterTemplate := range templates.ClusterAnalysisTemplates {
@@ -154,6 +158,16 @@ func ValidateAnalysisTemplatesWithType(rollout *v1alpha1.Rollout, templates Anal
 	return allErrs
 }
 
+// ValidateAnalysisTemplate validates the fields of an AnalysisTemplate which only apply to
+// ClusterAnalysisTemplates, and would otherwise be silently ignored
+func ValidateAnalysisTemplate(template *v1alpha1.AnalysisTemplate) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if template.Spec.NamespacePolicy != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "namespacePolicy"), "namespacePolicy only applies to ClusterAnalysisTemplates"))
+	}
+	return allErrs
+}
+
 func ValidateAnalysisTemplateWithType(rollout *v1alpha1.Rollout, template *v1alpha1.AnalysisTemplate, clusterTemplate *v1alpha1.ClusterAnalysisTemplate, templateType AnalysisTemplateType, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -289,6 +289,28 @@ func TestValidateAnalysisTemplatesWithType(t *testing.T) {
 		assert.Equal(t, msg, allErrs[0].Error())
 	})
 
+	t.Run("failure - namespace policy of a namespaced template", func(t *testing.T) {
+		rollout := getRollout()
+		templates := getAnalysisTemplatesWithType()
+		templates.AnalysisTemplates[0].Spec.Args = append(templates.AnalysisTemplates[0].Spec.Args, v1alpha1.Argument{Name: "valid"})
+		templates.Args = []v1alpha1.AnalysisRunArgument{{Name: "valid", Value: "true"}}
+		templates.AnalysisTemplates[0].Spec.NamespacePolicy = &v1alpha1.NamespacePolicy{Namespaces: []string{"team-a"}}
+		templates.ClusterAnalysisTemplates[0].Spec.NamespacePolicy = &v1alpha1.NamespacePolicy{Namespaces: []string{rollout.Namespace}}
+		allErrs := ValidateAnalysisTemplatesWithType(rollout, templates)
+		assert.Len(t, allErrs, 1)
+		msg := "spec.strategy.canary.steps[0].analysis.templates: Invalid value: \"analysis-template-name\": AnalysisTemplate analysis-template-name: namespacePolicy only applies to ClusterAnalysisTemplates"
+		assert.Equal(t, msg, allErrs[0].Error())
+	})
+}
+
+func TestValidateAnalysisTemplate(t *testing.T) {
+	template := &v1alpha1.AnalysisTemplate{}
+	assert.Empty(t, ValidateAnalysisTemplate(template))
+
+	template.Spec.NamespacePolicy = &v1alpha1.NamespacePolicy{Namespaces: []string{"team-a"}}
+	allErrs := ValidateAnalysisTemplate(template)
+	assert.Len(t, allErrs, 1)
+	assert.Equal(t, "spec.namespacePolicy: Forbidden: namespacePolicy only applies to ClusterAnalysisTemplates", allErrs[0].Error())
 }
 
 func TestValidateAnalysisTemplateWithType(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/pointer"
@@ -241,6 +242,32 @@ func TestValidateRolloutStrategyCanary(t *testing.T) {
 		assert.Equal(t, InvalidSetWeightMessage, allErrs[0].Detail)
 	})
 
+	t.Run("invalid step deadline", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].SetWeight = pointer.Int32Ptr(10)
+		invalidRo.Spec.Strategy.Canary.Steps[0].Deadline = v1alpha1.DurationFromString("0s")
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Equal(t, InvalidDurationMessage, allErrs[0].Detail)
+
+		invalidRo.Spec.Strategy.Canary.Steps[0].Deadline = v1alpha1.DurationFromString("bad")
+		allErrs = ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Equal(t, InvalidDurationMessage, allErrs[0].Detail)
+	})
+
+	t.Run("step deadline shorter than pause duration", func(t *testing.T) {
+		invalidRo := ro.DeepCopy()
+		invalidRo.Spec.Strategy.Canary.Steps[0].Pause = &v1alpha1.RolloutPause{
+			Duration: v1alpha1.DurationFromString("10m"),
+		}
+		invalidRo.Spec.Strategy.Canary.Steps[0].Deadline = v1alpha1.DurationFromString("5m")
+		allErrs := ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Equal(t, InvalidStepDeadlineMessage, allErrs[0].Detail)
+
+		invalidRo.Spec.Strategy.Canary.Steps[0].Deadline = v1alpha1.DurationFromString("15m")
+		allErrs = ValidateRolloutStrategyCanary(invalidRo, field.NewPath(""))
+		assert.Empty(t, allErrs)
+	})
+
 	t.Run("invalid duration set in paused step", func(t *testing.T) {
 		pauseDuration := intstr.FromInt(-1)
 		invalidRo := ro.DeepCopy()
@@ -455,6 +482,191 @@ func TestWorkloadRefWithTemplate(t *testing.T) {
 	})
 }
 
+func TestAdoption(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			WorkloadRef: &v1alpha1.ObjectRef{
+				Name:       "my-deployment",
+				Kind:       "Deployment",
+				APIVersion: "apps/v1",
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					StableService: "stable",
+					CanaryService: "canary",
+				},
+			},
+			Adoption: &v1alpha1.RolloutAdoption{},
+		},
+	}
+	t.Run("deployment name from workload reference", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		allErrs := ValidateRollout(ro)
+		assert.Equal(t, 0, len(allErrs))
+	})
+	t.Run("workload reference to a non deployment", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.WorkloadRef.Kind = "PodTemplate"
+		ro.Spec.WorkloadRef.APIVersion = "v1"
+		allErrs := ValidateRollout(ro)
+		assert.Equal(t, 1, len(allErrs))
+		assert.Equal(t, "spec.adoption.deploymentName", allErrs[0].Field)
+		assert.Equal(t, AdoptionDeploymentNameRequiredMessage, allErrs[0].Detail)
+	})
+	t.Run("explicit deployment name", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.WorkloadRef.Kind = "PodTemplate"
+		ro.Spec.WorkloadRef.APIVersion = "v1"
+		ro.Spec.Adoption.DeploymentName = "my-deployment"
+		allErrs := ValidateRollout(ro)
+		assert.Equal(t, 0, len(allErrs))
+	})
+}
+
+func TestInconclusivePolicy(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{{
+						Analysis: &v1alpha1.RolloutAnalysis{
+							Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "success-rate"}},
+							InconclusivePolicy: &v1alpha1.InconclusivePolicy{
+								Reruns:  2,
+								Action:  v1alpha1.InconclusiveActionFail,
+								Timeout: "1h",
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+	t.Run("valid policy", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Equal(t, 0, len(allErrs))
+	})
+	t.Run("negative reruns", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.Steps[0].Analysis.InconclusivePolicy.Reruns = -1
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Equal(t, 1, len(allErrs))
+		assert.Equal(t, "[].steps[0].analysis.inconclusivePolicy.reruns", allErrs[0].Field)
+	})
+	t.Run("invalid timeout", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.Steps[0].Analysis.InconclusivePolicy.Timeout = "1z"
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Equal(t, 1, len(allErrs))
+		assert.Equal(t, InvalidInconclusivePolicyTimeoutMessage, allErrs[0].Detail)
+	})
+	t.Run("timeout without action", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.Steps[0].Analysis.InconclusivePolicy.Action = ""
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Equal(t, 1, len(allErrs))
+		assert.Equal(t, InconclusivePolicyTimeoutWithoutActionMessage, allErrs[0].Detail)
+	})
+	t.Run("background analysis", func(t *testing.T) {
+		ro := ro.DeepCopy()
+		ro.Spec.Strategy.Canary.Analysis = &v1alpha1.RolloutAnalysisBackground{
+			RolloutAnalysis: v1alpha1.RolloutAnalysis{
+				Templates:          []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "success-rate"}},
+				InconclusivePolicy: &v1alpha1.InconclusivePolicy{Timeout: "-1m", Action: v1alpha1.InconclusiveActionSucceed},
+			},
+		}
+		allErrs := ValidateRolloutStrategyCanary(ro, field.NewPath(""))
+		assert.Equal(t, 1, len(allErrs))
+		assert.Equal(t, "[].analysis.inconclusivePolicy.timeout", allErrs[0].Field)
+	})
+}
+
+func TestAlertRules(t *testing.T) {
+	rules := []v1alpha1.RolloutAlertRule{{
+		Selector: metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+		Action:   v1alpha1.AlertActionPause,
+	}}
+	t.Run("valid rules", func(t *testing.T) {
+		allErrs := validateAlertRules(rules, field.NewPath("alerts"))
+		assert.Equal(t, 0, len(allErrs))
+	})
+	t.Run("empty selector", func(t *testing.T) {
+		invalid := []v1alpha1.RolloutAlertRule{{}}
+		allErrs := validateAlertRules(invalid, field.NewPath("alerts"))
+		assert.Equal(t, 1, len(allErrs))
+		assert.Equal(t, "alerts[0].selector", allErrs[0].Field)
+		assert.Equal(t, EmptyAlertSelectorMessage, allErrs[0].Detail)
+	})
+	t.Run("invalid action", func(t *testing.T) {
+		invalid := []v1alpha1.RolloutAlertRule{rules[0]}
+		invalid[0].Action = "Rollback"
+		allErrs := validateAlertRules(invalid, field.NewPath("alerts"))
+		assert.Equal(t, 1, len(allErrs))
+		assert.Equal(t, InvalidAlertActionMessage, allErrs[0].Detail)
+	})
+}
+
+func TestRestartSchedule(t *testing.T) {
+	t.Run("valid schedule", func(t *testing.T) {
+		schedule := &v1alpha1.RestartSchedule{Schedule: "0 3 * * *", TimeZone: "Europe/Paris"}
+		allErrs := validateRestartSchedule(schedule, field.NewPath("restartSchedule"))
+		assert.Equal(t, 0, len(allErrs))
+	})
+	t.Run("invalid schedule", func(t *testing.T) {
+		for _, schedule := range []v1alpha1.RestartSchedule{
+			{Schedule: "0 3 * *"},
+			{Schedule: "@every 1h"},
+			{Schedule: "CRON_TZ=Europe/Paris 0 3 * * *"},
+			{Schedule: "0 3 * * *", TimeZone: "Mars/Olympus"},
+		} {
+			allErrs := validateRestartSchedule(&schedule, field.NewPath("restartSchedule"))
+			assert.Equal(t, 1, len(allErrs))
+			assert.Equal(t, "restartSchedule", allErrs[0].Field)
+		}
+	})
+}
+
+func TestCrashGuard(t *testing.T) {
+	t.Run("valid thresholds", func(t *testing.T) {
+		guard := &v1alpha1.RolloutCrashGuard{MaxRestarts: pointer.Int32Ptr(0), MaxOOMKilled: pointer.Int32Ptr(1)}
+		allErrs := validateCrashGuard(guard, field.NewPath("crashGuard"))
+		assert.Equal(t, 0, len(allErrs))
+	})
+	t.Run("negative thresholds", func(t *testing.T) {
+		guard := &v1alpha1.RolloutCrashGuard{MaxRestarts: pointer.Int32Ptr(-1), MaxImagePullBackOff: pointer.Int32Ptr(-2)}
+		allErrs := validateCrashGuard(guard, field.NewPath("crashGuard"))
+		if assert.Equal(t, 2, len(allErrs)) {
+			assert.Equal(t, "crashGuard.maxRestarts", allErrs[0].Field)
+			assert.Equal(t, "crashGuard.maxImagePullBackOff", allErrs[1].Field)
+		}
+	})
+}
+
+func TestPodSpecPatch(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "app", Image: "guestbook:v2"}},
+				},
+			},
+		},
+	}
+	t.Run("valid patch", func(t *testing.T) {
+		patch := &runtime.RawExtension{Raw: []byte(`{"nodeSelector":{"pool":"canary"},"containers":[{"name":"app","env":[{"name":"DEBUG","value":"true"}]}]}`)}
+		allErrs := validatePodSpecPatch(ro, patch, field.NewPath("canaryPodSpecPatch"))
+		assert.Equal(t, 0, len(allErrs))
+	})
+	t.Run("invalid patch", func(t *testing.T) {
+		patch := &runtime.RawExtension{Raw: []byte(`{"containers":"app"}`)}
+		allErrs := validatePodSpecPatch(ro, patch, field.NewPath("canaryPodSpecPatch"))
+		if assert.Equal(t, 1, len(allErrs)) {
+			assert.Equal(t, "canaryPodSpecPatch", allErrs[0].Field)
+		}
+	})
+}
+
 func TestCanaryExperimentStepWithWeight(t *testing.T) {
 	canaryStrategy := &v1alpha1.CanaryStrategy{
 		CanaryService: "canary",
@@ -0,0 +1,238 @@
+// Package health assesses the health of Rollouts, AnalysisRuns and Experiments.
+//
+// The assessment is the one made by the controller and shown by `kubectl argo rollouts get` and
+// `kubectl argo rollouts status`, so tools which display these resources (e.g. GitOps UIs) can
+// report the same status instead of reimplementing it. It works on the objects alone and does not
+// need a Kubernetes client. It only depends on the API types, so it can be imported without the
+// dependencies of the controller.
+package health
+
+import (
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// Status is the health status of a resource
+type Status string
+
+const (
+	// StatusHealthy means the resource reached its desired state
+	StatusHealthy Status = "Healthy"
+	// StatusProgressing means the resource is moving towards its desired state
+	StatusProgressing Status = "Progressing"
+	// StatusPaused means the resource is waiting to be resumed, by a user or by a pause step
+	StatusPaused Status = "Paused"
+	// StatusQueued means the Rollout is waiting for the concurrency budget of the controller
+	StatusQueued Status = "Queued"
+	// StatusDegraded means the resource failed to reach its desired state
+	StatusDegraded Status = "Degraded"
+	// StatusUnknown means the outcome of the resource could not be determined
+	StatusUnknown Status = "Unknown"
+)
+
+// Health is the health assessment of a resource
+type Health struct {
+	// Status is the health status of the resource
+	Status Status `json:"status"`
+	// Message explains the status, if the status needs an explanation
+	Message string `json:"message,omitempty"`
+	// Progress describes how far along a Rollout is in its update. It is only set for Rollouts.
+	Progress *Progress `json:"progress,omitempty"`
+	// AnalysisRuns holds the health of the AnalysisRuns of the current revision of a Rollout, by
+	// name. It is only set for Rollouts.
+	AnalysisRuns map[string]Health `json:"analysisRuns,omitempty"`
+}
+
+// Progress describes how far along a Rollout is in its update
+type Progress struct {
+	// Strategy is the strategy of the rollout, Canary or BlueGreen
+	Strategy string `json:"strategy,omitempty"`
+	// Step is the index of the current canary step. It is only set if the canary has steps.
+	Step *int32 `json:"step,omitempty"`
+	// Steps is the number of canary steps
+	Steps int32 `json:"steps,omitempty"`
+	// SetWeight is the weight of the canary requested by the current step. It is only set for canaries.
+	SetWeight *int32 `json:"setWeight,omitempty"`
+	// ActualWeight is the weight of the canary which is actually reached, either from the traffic
+	// router or from the available replicas. It is only set for canaries.
+	ActualWeight *int32 `json:"actualWeight,omitempty"`
+	// Desired is the number of desired replicas
+	Desired int32 `json:"desired"`
+	// Current is the number of replicas
+	Current int32 `json:"current"`
+	// Updated is the number of replicas of the current revision
+	Updated int32 `json:"updated"`
+	// Ready is the number of ready replicas
+	Ready int32 `json:"ready"`
+	// Available is the number of available replicas
+	Available int32 `json:"available"`
+}
+
+// IsTerminal returns whether the status will not change without a change of the resource
+func (s Status) IsTerminal() bool {
+	return s == StatusHealthy || s == StatusDegraded
+}
+
+// StepString returns the current step of the progress as "<step>/<steps>", or an empty string if
+// the rollout has no steps
+func (p *Progress) StepString() string {
+	if p == nil || p.Step == nil {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", *p.Step, p.Steps)
+}
+
+// RolloutHealth returns the health of the rollout. The ReplicaSets and AnalysisRuns are optional
+// and are filtered down to the ones owned by the rollout: the ReplicaSets are needed to compute
+// the actual weight of a canary without traffic routing, and the AnalysisRuns to report the
+// health of the analysis of the current revision.
+func RolloutHealth(ro *v1alpha1.Rollout, replicaSets []*appsv1.ReplicaSet, analysisRuns []*v1alpha1.AnalysisRun) Health {
+	phase, message := RolloutPhase(ro, time.Now())
+	health := Health{
+		Status:   Status(phase),
+		Message:  message,
+		Progress: rolloutProgress(ro, replicaSets),
+	}
+	revision := ro.Annotations[revisionAnnotation]
+	for _, run := range analysisRuns {
+		if !isOwnedBy(run.OwnerReferences, ro) || run.Annotations[revisionAnnotation] != revision {
+			continue
+		}
+		if health.AnalysisRuns == nil {
+			health.AnalysisRuns = map[string]Health{}
+		}
+		health.AnalysisRuns[run.Name] = AnalysisRunHealth(run)
+	}
+	return health
+}
+
+func rolloutProgress(ro *v1alpha1.Rollout, replicaSets []*appsv1.ReplicaSet) *Progress {
+	progress := Progress{
+		Desired:   replicasOrDefault(ro.Spec.Replicas),
+		Current:   ro.Status.Replicas,
+		Updated:   ro.Status.UpdatedReplicas,
+		Ready:     ro.Status.ReadyReplicas,
+		Available: ro.Status.AvailableReplicas,
+	}
+	if ro.Spec.Strategy.BlueGreen != nil {
+		progress.Strategy = "BlueGreen"
+	}
+	canary := ro.Spec.Strategy.Canary
+	if canary == nil {
+		return &progress
+	}
+	progress.Strategy = "Canary"
+	if ro.Status.CurrentStepIndex != nil && len(canary.Steps) > 0 {
+		step := *ro.Status.CurrentStepIndex
+		progress.Step = &step
+		progress.Steps = int32(len(canary.Steps))
+	}
+	// NOTE that this is desired weight, not the actual current weight
+	setWeight := currentSetWeight(ro)
+	progress.SetWeight = &setWeight
+
+	actualWeight := int32(0)
+	if currentStepIndex(ro) < 0 {
+		actualWeight = 100
+	} else if ro.Status.AvailableReplicas > 0 {
+		if canary.TrafficRouting == nil {
+			for _, rs := range replicaSets {
+				if isOwnedBy(rs.OwnerReferences, ro) && isCanary(ro, rs) {
+					actualWeight = (rs.Status.AvailableReplicas * 100) / ro.Status.AvailableReplicas
+				}
+			}
+		} else if ro.Status.Canary.Weights != nil {
+			actualWeight = ro.Status.Canary.Weights.Canary.Weight
+		} else {
+			actualWeight = setWeight
+		}
+	}
+	progress.ActualWeight = &actualWeight
+	return &progress
+}
+
+// isCanary returns whether the ReplicaSet is the canary of the rollout, i.e. the ReplicaSet of the
+// current revision when it is not yet the stable one
+func isCanary(ro *v1alpha1.Rollout, rs *appsv1.ReplicaSet) bool {
+	podTemplateHash := rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	return podTemplateHash != ro.Status.StableRS && podTemplateHash == ro.Status.CurrentPodHash
+}
+
+// currentStepIndex returns the index of the current canary step, or -1 if the rollout has no
+// steps or went through all of them
+func currentStepIndex(ro *v1alpha1.Rollout) int32 {
+	index := int32(0)
+	if ro.Status.CurrentStepIndex != nil {
+		index = *ro.Status.CurrentStepIndex
+	}
+	if ro.Spec.Strategy.Canary == nil || int(index) >= len(ro.Spec.Strategy.Canary.Steps) {
+		return -1
+	}
+	return index
+}
+
+// currentSetWeight returns the weight of the last setWeight step up to the current step, 100 if
+// the rollout has no steps or went through all of them, and 0 if it was aborted
+func currentSetWeight(ro *v1alpha1.Rollout) int32 {
+	if ro.Status.Abort {
+		return 0
+	}
+	index := currentStepIndex(ro)
+	if index < 0 {
+		return 100
+	}
+	for i := index; i >= 0; i-- {
+		if setWeight := ro.Spec.Strategy.Canary.Steps[i].SetWeight; setWeight != nil {
+			return *setWeight
+		}
+	}
+	return 0
+}
+
+// AnalysisRunHealth returns the health of the analysis run
+func AnalysisRunHealth(run *v1alpha1.AnalysisRun) Health {
+	return analysisHealth(run.Status.Phase, run.Status.Message)
+}
+
+// ExperimentHealth returns the health of the experiment
+func ExperimentHealth(exp *v1alpha1.Experiment) Health {
+	return analysisHealth(exp.Status.Phase, exp.Status.Message)
+}
+
+func analysisHealth(phase v1alpha1.AnalysisPhase, message string) Health {
+	switch phase {
+	case v1alpha1.AnalysisPhaseSuccessful:
+		return Health{Status: StatusHealthy, Message: message}
+	case v1alpha1.AnalysisPhaseFailed, v1alpha1.AnalysisPhaseError:
+		if message == "" {
+			message = string(phase)
+		}
+		return Health{Status: StatusDegraded, Message: message}
+	case v1alpha1.AnalysisPhaseInconclusive:
+		if message == "" {
+			message = string(phase)
+		}
+		return Health{Status: StatusUnknown, Message: message}
+	case v1alpha1.AnalysisPhaseRunning:
+		return Health{Status: StatusProgressing, Message: message}
+	}
+	// pending, or not yet observed by the controller
+	if message == "" {
+		message = "waiting to start"
+	}
+	return Health{Status: StatusProgressing, Message: message}
+}
+
+func isOwnedBy(ownerRefs []metav1.OwnerReference, owner metav1.Object) bool {
+	for _, ownerRef := range ownerRefs {
+		if ownerRef.UID == owner.GetUID() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package health
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+var update = flag.Bool("update", false, "update the golden files of the testdata directory")
+
+// testObjects are the objects of a testdata file. The first object of the file is the one which
+// health is assessed.
+type testObjects struct {
+	subject      interface{}
+	replicaSets  []*appsv1.ReplicaSet
+	analysisRuns []*v1alpha1.AnalysisRun
+}
+
+func loadTestObjects(t *testing.T, path string) testObjects {
+	data, err := ioutil.ReadFile(path)
+	assert.NoError(t, err)
+	var objs testObjects
+	for _, doc := range strings.Split(string(data), "\n---\n") {
+		var typeMeta metav1.TypeMeta
+		assert.NoError(t, yaml.Unmarshal([]byte(doc), &typeMeta))
+		var obj interface{}
+		switch typeMeta.Kind {
+		case "Rollout":
+			obj = &v1alpha1.Rollout{}
+		case "AnalysisRun":
+			obj = &v1alpha1.AnalysisRun{}
+		case "Experiment":
+			obj = &v1alpha1.Experiment{}
+		case "ReplicaSet":
+			obj = &appsv1.ReplicaSet{}
+		default:
+			t.Fatalf("unexpected kind %q in %s", typeMeta.Kind, path)
+		}
+		assert.NoError(t, yaml.UnmarshalStrict([]byte(doc), obj, yaml.DisallowUnknownFields))
+		switch typedObj := obj.(type) {
+		case *appsv1.ReplicaSet:
+			objs.replicaSets = append(objs.replicaSets, typedObj)
+		case *v1alpha1.AnalysisRun:
+			objs.analysisRuns = append(objs.analysisRuns, typedObj)
+		}
+		if objs.subject == nil {
+			objs.subject = obj
+		}
+	}
+	return objs
+}
+
+// TestGolden assesses the health of the first object of every testdata file, and compares it to
+// the golden file of the same name. Run with -update to regenerate the golden files.
+func TestGolden(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.yaml")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, files)
+
+	// every status of every strategy needs to be covered
+	covered := map[string]bool{}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.Base(file), ".yaml")
+		t.Run(name, func(t *testing.T) {
+			objs := loadTestObjects(t, file)
+			var health Health
+			switch subject := objs.subject.(type) {
+			case *v1alpha1.Rollout:
+				health = RolloutHealth(subject, objs.replicaSets, objs.analysisRuns)
+				covered[health.Progress.Strategy+"/"+string(health.Status)] = true
+			case *v1alpha1.AnalysisRun:
+				health = AnalysisRunHealth(subject)
+				covered["AnalysisRun/"+string(health.Status)] = true
+			case *v1alpha1.Experiment:
+				health = ExperimentHealth(subject)
+				covered["Experiment/"+string(health.Status)] = true
+			default:
+				t.Fatalf("unexpected subject %T", subject)
+			}
+			var actual bytes.Buffer
+			enc := json.NewEncoder(&actual)
+			enc.SetEscapeHTML(false)
+			enc.SetIndent("", "  ")
+			assert.NoError(t, enc.Encode(health))
+
+			goldenFile := strings.TrimSuffix(file, ".yaml") + ".golden.json"
+			if *update {
+				assert.NoError(t, ioutil.WriteFile(goldenFile, actual.Bytes(), 0644))
+			}
+			expected, err := ioutil.ReadFile(goldenFile)
+			assert.NoError(t, err)
+			assert.JSONEq(t, string(expected), actual.String())
+		})
+	}
+
+	for _, kind := range []string{"Canary", "BlueGreen"} {
+		for _, status := range []Status{StatusHealthy, StatusProgressing, StatusPaused, StatusDegraded} {
+			assert.True(t, covered[kind+"/"+string(status)], "%s rollout %s is not covered", kind, status)
+		}
+	}
+	for _, kind := range []string{"AnalysisRun", "Experiment"} {
+		for _, status := range []Status{StatusHealthy, StatusProgressing, StatusDegraded, StatusUnknown} {
+			assert.True(t, covered[kind+"/"+string(status)], "%s %s is not covered", kind, status)
+		}
+	}
+}
+
+func TestRolloutHealthWithoutReplicaSets(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					Steps: []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(50)}, {Pause: &v1alpha1.RolloutPause{}}},
+				},
+			},
+		},
+		Status: v1alpha1.RolloutStatus{
+			Phase:             v1alpha1.RolloutPhaseProgressing,
+			CurrentStepIndex:  pointer.Int32Ptr(0),
+			CurrentPodHash:    "abc",
+			StableRS:          "def",
+			AvailableReplicas: 1,
+		},
+	}
+	health := RolloutHealth(ro, nil, nil)
+	assert.Equal(t, StatusProgressing, health.Status)
+	assert.Equal(t, "0/2", health.Progress.StepString())
+	assert.Equal(t, int32(50), *health.Progress.SetWeight)
+	assert.Equal(t, int32(0), *health.Progress.ActualWeight)
+	assert.Equal(t, int32(1), health.Progress.Desired)
+	assert.Nil(t, health.AnalysisRuns)
+}
+
+func TestStatusIsTerminal(t *testing.T) {
+	assert.True(t, StatusHealthy.IsTerminal())
+	assert.True(t, StatusDegraded.IsTerminal())
+	assert.False(t, StatusProgressing.IsTerminal())
+	assert.False(t, StatusPaused.IsTerminal())
+	assert.False(t, StatusUnknown.IsTerminal())
+}
+
+func TestIsWorkloadGenerationObserved(t *testing.T) {
+	ro := &v1alpha1.Rollout{}
+	assert.True(t, isWorkloadGenerationObserved(ro))
+
+	ro.Annotations = map[string]string{workloadGenerationAnnotation: "2"}
+	ro.Status.WorkloadObservedGeneration = "222222222222222222"
+	assert.True(t, isWorkloadGenerationObserved(ro))
+
+	ro.Status.WorkloadObservedGeneration = "1"
+	assert.False(t, isWorkloadGenerationObserved(ro))
+
+	ro.Status.WorkloadObservedGeneration = "2"
+	assert.True(t, isWorkloadGenerationObserved(ro))
+}
+
+// TestDependencies verifies the package stays importable without the dependencies of the
+// controller: it may only depend on the API types of this module, and not on client-go.
+func TestDependencies(t *testing.T) {
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go is not installed")
+	}
+	out, err := exec.Command(goBin, "list", "-deps", ".").Output()
+	assert.NoError(t, err)
+	allowed := map[string]bool{
+		"github.com/argoproj/argo-rollouts/pkg/apis/rollouts":          true,
+		"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1": true,
+		"github.com/argoproj/argo-rollouts/pkg/health":                 true,
+	}
+	for _, dep := range strings.Fields(string(out)) {
+		if strings.HasPrefix(dep, "github.com/argoproj/argo-rollouts/") {
+			assert.True(t, allowed[dep], "unexpected dependency %s", dep)
+		}
+		assert.False(t, strings.HasPrefix(dep, "k8s.io/client-go/"), "unexpected dependency %s", dep)
+		assert.False(t, strings.HasPrefix(dep, "k8s.io/kubernetes/"), "unexpected dependency %s", dep)
+	}
+}
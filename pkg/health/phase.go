@@ -0,0 +1,198 @@
+package health
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// The annotations, condition reasons and defaults the health is assessed from. They are the ones of
+// the controller's utilities, which this package does not import to stay dependency-light.
+const (
+	revisionAnnotation           = "rollout.argoproj.io/revision"
+	workloadGenerationAnnotation = "rollout.argoproj.io/workload-generation"
+	rolloutAbortedReason         = "RolloutAborted"
+	timedOutReason               = "ProgressDeadlineExceeded"
+	defaultReplicas              = int32(1)
+)
+
+// RolloutPhase returns a status and message for a rollout. Takes into consideration whether
+// or not metadata.generation was observed in status.observedGeneration. now is the time at which
+// a scheduled restart is assessed.
+func RolloutPhase(ro *v1alpha1.Rollout, now time.Time) (v1alpha1.RolloutPhase, string) {
+	if !isGenerationObserved(ro) {
+		return v1alpha1.RolloutPhaseProgressing, "waiting for rollout spec update to be observed"
+	}
+	if isUnpausing(ro) {
+		return v1alpha1.RolloutPhaseProgressing, "waiting for rollout to unpause"
+	}
+	if ro.Spec.TemplateResolvedFromRef && !isWorkloadGenerationObserved(ro) {
+		return v1alpha1.RolloutPhaseProgressing, "waiting for rollout spec update to be observed for the reference workload"
+	}
+
+	if ro.Status.Phase != "" {
+		// for 1.0+ phase/message is calculated controller side
+		return ro.Status.Phase, ro.Status.Message
+	}
+	// for v0.10 and below, fall back to client-side calculation
+	return CalculateRolloutPhase(ro.Spec, ro.Status, now)
+}
+
+// isGenerationObserved determines if the rollout spec has been observed by the controller. This
+// only applies to v0.10 rollout which uses a numeric status.observedGeneration. For v0.9 rollouts
+// and below this function always returns true.
+func isGenerationObserved(ro *v1alpha1.Rollout) bool {
+	observedGen, err := strconv.Atoi(ro.Status.ObservedGeneration)
+	if err != nil {
+		return true
+	}
+	// It's still possible for a v0.9 rollout to have an all numeric hash, this covers that corner case
+	if int64(observedGen) > ro.Generation {
+		return true
+	}
+	return int64(observedGen) == ro.Generation
+}
+
+// isUnpausing detects if we are in the process of unpausing a rollout. This is determined by seeing
+// if status.controllerPause is true, but the list of pause conditions (status.pauseConditions)
+// is empty. This implies that a user cleared the pause conditions but controller has not yet
+// observed or reacted to it.
+// NOTE: this function is necessary because unlike metadata.generation & status.observedGeneration
+// status.controllerPause & status.pauseConditions are both status fields and does not benefit from
+// the auto-incrementing behavior of metadata.generation.
+// A rollout queued at a completed pause step keeps status.controllerPause until it is dequeued, and
+// is not unpausing.
+func isUnpausing(ro *v1alpha1.Rollout) bool {
+	return ro.Status.ControllerPause && len(ro.Status.PauseConditions) == 0 && ro.Status.Queue == nil
+}
+
+func isWorkloadGenerationObserved(ro *v1alpha1.Rollout) bool {
+	workloadGeneration, err := strconv.ParseInt(ro.Annotations[workloadGenerationAnnotation], 10, 32)
+	if err != nil {
+		return true
+	}
+	observedWorkloadGen, err := strconv.ParseInt(ro.Status.WorkloadObservedGeneration, 10, 32)
+	if err != nil {
+		return true
+	}
+
+	return int32(observedWorkloadGen) == int32(workloadGeneration)
+}
+
+// CalculateRolloutPhase calculates a rollout phase and message for the given rollout based on
+// rollout spec and status. This function is intended to be used by the controller (and not
+// by clients). Clients should instead call RolloutPhase, which takes into consideration
+// status.observedGeneration. now is the time at which a scheduled restart is assessed.
+func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutStatus, now time.Time) (v1alpha1.RolloutPhase, string) {
+	ro := v1alpha1.Rollout{
+		Spec:   spec,
+		Status: status,
+	}
+	for _, cond := range ro.Status.Conditions {
+		if cond.Type == v1alpha1.InvalidSpec {
+			return v1alpha1.RolloutPhaseDegraded, fmt.Sprintf("%s: %s", v1alpha1.InvalidSpec, cond.Message)
+		}
+		switch cond.Reason {
+		case rolloutAbortedReason, timedOutReason:
+			return v1alpha1.RolloutPhaseDegraded, fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
+		}
+	}
+	for _, cond := range ro.Status.Conditions {
+		if cond.Type == v1alpha1.RolloutFrozen && cond.Status == corev1.ConditionTrue {
+			return v1alpha1.RolloutPhasePaused, cond.Message
+		}
+	}
+	if ro.Spec.Paused {
+		return v1alpha1.RolloutPhasePaused, "manually paused"
+	}
+	for _, pauseCond := range ro.Status.PauseConditions {
+		return v1alpha1.RolloutPhasePaused, string(pauseCond.Reason)
+	}
+	if ro.Status.Queue != nil {
+		return v1alpha1.RolloutPhaseQueued, fmt.Sprintf("waiting for a progressing slot (position %d)", ro.Status.Queue.Position)
+	}
+	if isRestartAtPending(&ro) {
+		return v1alpha1.RolloutPhaseProgressing, "rollout is restarting"
+	}
+	if next := ro.Status.NextScheduledRestartAt; ro.Spec.RestartSchedule != nil && next != nil && !now.Before(next.Time) {
+		return v1alpha1.RolloutPhaseProgressing, "rollout is restarting on schedule"
+	}
+	if ro.Status.UpdatedReplicas < replicasOrDefault(ro.Spec.Replicas) {
+		return v1alpha1.RolloutPhaseProgressing, "more replicas need to be updated"
+	}
+	if ro.Status.AvailableReplicas < ro.Status.UpdatedReplicas {
+		return v1alpha1.RolloutPhaseProgressing, "updated replicas are still becoming available"
+	}
+	if ro.Spec.Strategy.BlueGreen != nil {
+		if ro.Status.BlueGreen.ActiveSelector == "" || ro.Status.BlueGreen.ActiveSelector != ro.Status.CurrentPodHash {
+			return v1alpha1.RolloutPhaseProgressing, "active service cutover pending"
+		}
+		if ro.Status.StableRS == "" || !isFullyPromoted(&ro) {
+			// we switched the active selector to the desired ReplicaSet, but we have yet to mark it
+			// as stable. This could be caused by one of two things:
+			// 1. post-promotion analysis has yet to complete successfully
+			// 2. post-promotion verification (i.e. target group verification)
+			if waitingForBlueGreenPostPromotionAnalysis(&ro) {
+				return v1alpha1.RolloutPhaseProgressing, "waiting for analysis to complete"
+			}
+			return v1alpha1.RolloutPhaseProgressing, "waiting for post-promotion verification to complete"
+		}
+	} else if ro.Spec.Strategy.Canary != nil {
+		if ro.Spec.Strategy.Canary.TrafficRouting == nil {
+			if ro.Status.Replicas > ro.Status.UpdatedReplicas {
+				// This check should only be done for basic canary and not blue-green or canary with traffic routing
+				// since the latter two have the scaleDownDelay feature which leaves the old stack of replicas
+				// running for a long time
+				return v1alpha1.RolloutPhaseProgressing, "old replicas are pending termination"
+			}
+		}
+		if ro.Status.StableRS == "" || !isFullyPromoted(&ro) {
+			return v1alpha1.RolloutPhaseProgressing, "waiting for all steps to complete"
+		}
+	}
+	return v1alpha1.RolloutPhaseHealthy, ""
+}
+
+// waitingForBlueGreenPostPromotionAnalysis returns we are waiting for blue-green post promotion to complete
+func waitingForBlueGreenPostPromotionAnalysis(ro *v1alpha1.Rollout) bool {
+	if ro.Spec.Strategy.BlueGreen.PostPromotionAnalysis != nil {
+		if ro.Status.BlueGreen.PostPromotionAnalysisRunStatus == nil || !ro.Status.BlueGreen.PostPromotionAnalysisRunStatus.Status.Completed() {
+			return true
+		}
+	}
+	return false
+}
+
+// isRestartAtPending returns whether the restartAt of the spec of the rollout was not restarted at
+// yet. Without a restart schedule, any restartAt which differs from the last restart is pending, even
+// an earlier one. With a restart schedule, the scheduled restarts are recorded as the last restart,
+// so only a restartAt after the last restart is pending.
+func isRestartAtPending(ro *v1alpha1.Rollout) bool {
+	if ro.Spec.RestartAt == nil {
+		return false
+	}
+	if ro.Status.RestartedAt == nil {
+		return true
+	}
+	if ro.Spec.RestartSchedule == nil {
+		return !ro.Spec.RestartAt.Equal(ro.Status.RestartedAt)
+	}
+	return ro.Status.RestartedAt.Before(ro.Spec.RestartAt)
+}
+
+// isFullyPromoted returns whether or not the given rollout is in a fully promoted state.
+// (versus being in the middle of an update). This is determined by checking if stable hash == desired hash
+func isFullyPromoted(ro *v1alpha1.Rollout) bool {
+	return ro.Status.StableRS == ro.Status.CurrentPodHash
+}
+
+func replicasOrDefault(replicas *int32) int32 {
+	if replicas == nil {
+		return defaultReplicas
+	}
+	return *replicas
+}
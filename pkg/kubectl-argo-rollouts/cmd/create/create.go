@@ -12,9 +12,12 @@ import (
 
 	"github.com/ghodss/yaml"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
@@ -111,6 +114,7 @@ func NewCmdCreate(o *options.ArgoRolloutsOptions) *cobra.Command {
 		},
 	}
 	cmd.AddCommand(NewCmdCreateAnalysisRun(o))
+	cmd.AddCommand(NewCmdCreateRollout(o))
 	cmd.Flags().StringArrayVarP(&createOptions.Files, "filename", "f", []string{}, "Files to use to create the resource")
 	cmd.Flags().BoolVarP(&createOptions.Watch, "watch", "w", false, "Watch live updates to the resource after creating")
 	cmd.Flags().BoolVar(&createOptions.NoColor, "no-color", false, "Do not colorize output")
@@ -267,6 +271,10 @@ func NewCmdCreateAnalysisRun(o *options.ArgoRolloutsOptions) *cobra.Command {
 				if err != nil {
 					return err
 				}
+				err = createOptions.verifyClusterAnalysisTemplateAccess(ctx, obj)
+				if err != nil {
+					return err
+				}
 			} else {
 				obj, err = createOptions.getAnalysisTemplate()
 				if err != nil {
@@ -363,6 +371,41 @@ func (c *CreateAnalysisRunOptions) getClusterAnalysisTemplate() (*unstructured.U
 	}
 }
 
+// verifyClusterAnalysisTemplateAccess returns an error if the namespace policy of the
+// ClusterAnalysisTemplate does not allow the namespace of the AnalysisRun to use it
+func (c *CreateAnalysisRunOptions) verifyClusterAnalysisTemplateAccess(ctx context.Context, obj *unstructured.Unstructured) error {
+	var template v1alpha1.ClusterAnalysisTemplate
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, &template)
+	if err != nil {
+		return err
+	}
+	namespaceLister := &clientNamespaceLister{ctx: ctx, client: c.KubeClientset().CoreV1().Namespaces()}
+	return analysisutil.VerifyClusterAnalysisTemplateAccess(namespaceLister, &template, c.Namespace())
+}
+
+// clientNamespaceLister is a NamespaceLister which reads the namespaces from the API server, as
+// the CLI does not run informers
+type clientNamespaceLister struct {
+	ctx    context.Context
+	client corev1client.NamespaceInterface
+}
+
+func (l *clientNamespaceLister) List(selector labels.Selector) ([]*corev1.Namespace, error) {
+	list, err := l.client.List(l.ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	namespaces := make([]*corev1.Namespace, len(list.Items))
+	for i := range list.Items {
+		namespaces[i] = &list.Items[i]
+	}
+	return namespaces, nil
+}
+
+func (l *clientNamespaceLister) Get(name string) (*corev1.Namespace, error) {
+	return l.client.Get(l.ctx, name, metav1.GetOptions{})
+}
+
 func (c *CreateAnalysisRunOptions) ParseArgFlags() ([]v1alpha1.Argument, error) {
 	var args []v1alpha1.Argument
 	for _, argFlag := range c.ArgFlags {
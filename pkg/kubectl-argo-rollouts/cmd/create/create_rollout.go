@@ -0,0 +1,225 @@
+package create
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+type CreateRolloutOptions struct {
+	options.ArgoRolloutsOptions
+	ScaffoldOptions
+
+	OutputDir   string
+	Interactive bool
+}
+
+const (
+	createRolloutExample = `
+	# Print the manifests of a canary rollout, with nginx traffic routing and a Prometheus analysis
+	%[1]s create rollout guestbook --image argoproj/rollouts-demo:blue --traffic-provider nginx --analysis-provider prometheus
+
+	# Write the manifests of a blue-green rollout to a directory
+	%[1]s create rollout guestbook --image argoproj/rollouts-demo:blue --strategy blueGreen --output-dir ./guestbook
+
+	# Prompt for the image, strategy, steps and providers
+	%[1]s create rollout guestbook -i`
+)
+
+// NewCmdCreateRollout returns a new instance of an `rollouts create rollout` command
+func NewCmdCreateRollout(o *options.ArgoRolloutsOptions) *cobra.Command {
+	createOptions := CreateRolloutOptions{
+		ArgoRolloutsOptions: *o,
+	}
+	var cmd = &cobra.Command{
+		Use:          "rollout ROLLOUT_NAME",
+		Aliases:      []string{"ro", "rollouts"},
+		Short:        "Generate the manifests of a new Rollout",
+		Long:         "This command generates the manifests of a new Rollout, its Services, and the traffic routing and analysis resources it references. The manifests are printed, or written to a directory, to be reviewed and applied.",
+		Example:      o.Example(createRolloutExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 {
+				return o.UsageErr(c)
+			}
+			createOptions.Name = args[0]
+			if createOptions.Interactive {
+				err := createOptions.prompt(c)
+				if err != nil {
+					return err
+				}
+			}
+			scaffold, err := NewScaffold(createOptions.ScaffoldOptions)
+			if err != nil {
+				return err
+			}
+			if createOptions.OutputDir != "" {
+				return createOptions.writeManifests(scaffold.Objects())
+			}
+			return printManifests(createOptions.Out, scaffold.Objects())
+		},
+	}
+	cmd.Flags().StringVar(&createOptions.Image, "image", "", "Image of the container of the rollout")
+	cmd.Flags().Int32Var(&createOptions.Port, "port", 8080, "Port the container listens on")
+	cmd.Flags().Int32Var(&createOptions.Replicas, "replicas", 5, "Number of replicas of the rollout")
+	cmd.Flags().StringVar(&createOptions.Strategy, "strategy", StrategyCanary, fmt.Sprintf("Strategy of the rollout (%s, %s)", StrategyCanary, StrategyBlueGreen))
+	cmd.Flags().StringVar(&createOptions.Steps, "steps", "", fmt.Sprintf("Comma separated canary steps, each one of setWeight=PERCENT, pause, pause=DURATION or analysis (default %q)", DefaultSteps))
+	cmd.Flags().StringVar(&createOptions.TrafficProvider, "traffic-provider", TrafficProviderNone, fmt.Sprintf("Traffic provider of a canary rollout (%s)", strings.Join(trafficProviders, ", ")))
+	cmd.Flags().StringVar(&createOptions.AnalysisProvider, "analysis-provider", AnalysisProviderNone, fmt.Sprintf("Metric provider of the analysis of the rollout (%s)", strings.Join(analysisProviders, ", ")))
+	cmd.Flags().StringVar(&createOptions.PrometheusAddress, "prometheus-address", DefaultPrometheusAddress, "Address of Prometheus, for the prometheus analysis provider")
+	cmd.Flags().StringVar(&createOptions.Host, "host", "", "Host the rollout is exposed on by its Ingress or VirtualService")
+	cmd.Flags().StringVar(&createOptions.OutputDir, "output-dir", "", "Write the manifests to files in this directory instead of printing them")
+	cmd.Flags().BoolVarP(&createOptions.Interactive, "interactive", "i", false, "Prompt for the options which were not set by flags")
+	return cmd
+}
+
+var (
+	trafficProviders  = []string{TrafficProviderNone, TrafficProviderNginx, TrafficProviderALB, TrafficProviderIstio, TrafficProviderSMI}
+	analysisProviders = []string{AnalysisProviderNone, AnalysisProviderPrometheus, AnalysisProviderDatadog}
+)
+
+// prompt reads the options which were not set by flags from the input, keeping the default of an
+// option when its answer is empty
+func (c *CreateRolloutOptions) prompt(cmd *cobra.Command) error {
+	reader := bufio.NewReader(c.In)
+	ask := func(flag string, question string, value *string) error {
+		if cmd.Flags().Changed(flag) {
+			return nil
+		}
+		if *value != "" {
+			question = fmt.Sprintf("%s [%s]", question, *value)
+		}
+		fmt.Fprintf(c.Out, "%s: ", question)
+		answer, err := reader.ReadString('\n')
+		if err != nil && err != io.EOF {
+			return err
+		}
+		if answer = strings.TrimSpace(answer); answer != "" {
+			*value = answer
+		}
+		return nil
+	}
+
+	if err := ask("image", "Image", &c.Image); err != nil {
+		return err
+	}
+	if err := ask("strategy", fmt.Sprintf("Strategy (%s, %s)", StrategyCanary, StrategyBlueGreen), &c.Strategy); err != nil {
+		return err
+	}
+	if c.Strategy == StrategyCanary {
+		steps := c.Steps
+		if steps == "" {
+			steps = DefaultSteps
+		}
+		if err := ask("steps", "Steps", &steps); err != nil {
+			return err
+		}
+		c.Steps = steps
+		if err := ask("traffic-provider", fmt.Sprintf("Traffic provider (%s)", strings.Join(trafficProviders, ", ")), &c.TrafficProvider); err != nil {
+			return err
+		}
+	}
+	if err := ask("analysis-provider", fmt.Sprintf("Analysis provider (%s)", strings.Join(analysisProviders, ", ")), &c.AnalysisProvider); err != nil {
+		return err
+	}
+	if c.AnalysisProvider == AnalysisProviderPrometheus {
+		if err := ask("prometheus-address", "Prometheus address", &c.PrometheusAddress); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// marshalManifest marshals an object to YAML, without its status and the empty fields of its
+// typed representation
+func marshalManifest(obj runtime.Object) ([]byte, error) {
+	var un map[string]interface{}
+	if unObj, ok := obj.(*unstructured.Unstructured); ok {
+		un = unObj.Object
+	} else {
+		var err error
+		un, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, err
+		}
+	}
+	delete(un, "status")
+	pruneEmptyFields(un)
+	return yaml.Marshal(un)
+}
+
+// pruneEmptyFields removes the null values of an unstructured object, such as the creationTimestamp
+// of the metadata. Empty maps are kept since they are meaningful in some fields, e.g. `pause: {}`
+func pruneEmptyFields(obj map[string]interface{}) {
+	for key, value := range obj {
+		switch typedValue := value.(type) {
+		case nil:
+			delete(obj, key)
+		case map[string]interface{}:
+			pruneEmptyFields(typedValue)
+		case []interface{}:
+			for _, item := range typedValue {
+				if itemMap, ok := item.(map[string]interface{}); ok {
+					pruneEmptyFields(itemMap)
+				}
+			}
+		}
+	}
+}
+
+func printManifests(out io.Writer, objs []runtime.Object) error {
+	var buf bytes.Buffer
+	for i, obj := range objs {
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		manifest, err := marshalManifest(obj)
+		if err != nil {
+			return err
+		}
+		buf.Write(manifest)
+	}
+	_, err := out.Write(buf.Bytes())
+	return err
+}
+
+// writeManifests writes every object to its own file in the output directory, named after its
+// kind and name
+func (c *CreateRolloutOptions) writeManifests(objs []runtime.Object) error {
+	err := os.MkdirAll(c.OutputDir, 0755)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		manifest, err := marshalManifest(obj)
+		if err != nil {
+			return err
+		}
+		un, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return err
+		}
+		kind, _, _ := unstructured.NestedString(un, "kind")
+		name, _, _ := unstructured.NestedString(un, "metadata", "name")
+		path := filepath.Join(c.OutputDir, fmt.Sprintf("%s-%s.yaml", strings.ToLower(kind), name))
+		err = ioutil.WriteFile(path, manifest, 0644)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(c.Out, "%s written\n", strconv.Quote(path))
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package create
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func TestCreateRolloutScaffold(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdCreateRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--image", "argoproj/rollouts-demo:blue", "--traffic-provider", "nginx", "--analysis-provider", "prometheus", "--host", "guestbook.example.com"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stderr)
+	expected, err := ioutil.ReadFile("testdata/scaffold-canary-nginx-prometheus.yaml")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), stdout)
+}
+
+func TestCreateRolloutScaffoldInteractive(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	o.In = strings.NewReader("argoproj/rollouts-demo:blue\nblueGreen\ndatadog\n")
+	cmd := NewCmdCreateRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-i"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "Image: Strategy (canary, blueGreen) [canary]: Analysis provider (none, prometheus, datadog) [none]: ")
+	assert.Contains(t, stdout, "image: argoproj/rollouts-demo:blue")
+	assert.Contains(t, stdout, "activeService: guestbook-active")
+	assert.Contains(t, stdout, "datadog:")
+}
+
+func TestCreateRolloutScaffoldOutputDir(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	dir, err := ioutil.TempDir("", "scaffold")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cmd := NewCmdCreateRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--image", "argoproj/rollouts-demo:blue", "--strategy", "blueGreen", "--output-dir", dir})
+	err = cmd.Execute()
+	assert.NoError(t, err)
+	for _, file := range []string{"service-guestbook-active.yaml", "service-guestbook-preview.yaml", "rollout-guestbook.yaml"} {
+		path := filepath.Join(dir, file)
+		assert.FileExists(t, path)
+		assert.Contains(t, o.Out.(*bytes.Buffer).String(), "\""+path+"\" written\n")
+	}
+}
+
+func TestCreateRolloutScaffoldErrors(t *testing.T) {
+	tests := []struct {
+		args        []string
+		expectedErr string
+	}{
+		{
+			args:        []string{"guestbook"},
+			expectedErr: "an image is required",
+		},
+		{
+			args:        []string{"guestbook", "--image", "nginx", "--strategy", "blueGreen", "--traffic-provider", "istio"},
+			expectedErr: "traffic provider 'istio' is only supported by the canary strategy",
+		},
+		{
+			args:        []string{"guestbook", "--image", "nginx", "--steps", "setWeight=50,analysis"},
+			expectedErr: "an analysis step requires an analysis provider",
+		},
+		{
+			args:        []string{"guestbook", "--image", "nginx", "--traffic-provider", "contour"},
+			expectedErr: "unknown traffic provider 'contour'",
+		},
+		{
+			args:        []string{"guestbook", "--image", "nginx", "--steps", "setWeight=150"},
+			expectedErr: "spec.strategy.steps[0].setWeight",
+		},
+	}
+	for _, test := range tests {
+		tf, o := options.NewFakeArgoRolloutsOptions()
+		cmd := NewCmdCreateRollout(o)
+		cmd.PersistentPreRunE = o.PersistentPreRunE
+		cmd.SetArgs(test.args)
+		err := cmd.Execute()
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), test.expectedErr)
+		}
+		tf.Cleanup()
+	}
+}
+
+func TestNewScaffold(t *testing.T) {
+	for _, strategy := range []string{StrategyCanary, StrategyBlueGreen} {
+		for _, trafficProvider := range trafficProviders {
+			for _, analysisProvider := range analysisProviders {
+				if strategy == StrategyBlueGreen && trafficProvider != TrafficProviderNone {
+					continue
+				}
+				opts := ScaffoldOptions{
+					Name:              "guestbook",
+					Image:             "argoproj/rollouts-demo:blue",
+					Port:              8080,
+					Replicas:          5,
+					Strategy:          strategy,
+					TrafficProvider:   trafficProvider,
+					AnalysisProvider:  analysisProvider,
+					PrometheusAddress: DefaultPrometheusAddress,
+				}
+				scaffold, err := NewScaffold(opts)
+				if assert.NoError(t, err, "%s/%s/%s", strategy, trafficProvider, analysisProvider) {
+					assert.Equal(t, analysisProvider != AnalysisProviderNone, scaffold.AnalysisTemplate != nil)
+				}
+			}
+		}
+	}
+}
+
+func TestParseSteps(t *testing.T) {
+	analysis := &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "success-rate"}}}
+	steps, err := ParseSteps("setWeight=20, pause, pause=5m, analysis", analysis)
+	assert.NoError(t, err)
+	weight := int32(20)
+	fiveMinutes := intstr.FromString("5m")
+	assert.Equal(t, []v1alpha1.CanaryStep{
+		{SetWeight: &weight},
+		{Pause: &v1alpha1.RolloutPause{}},
+		{Pause: &v1alpha1.RolloutPause{Duration: &fiveMinutes}},
+		{Analysis: analysis},
+	}, steps)
+
+	_, err = ParseSteps("setWeight=abc", nil)
+	assert.EqualError(t, err, "invalid weight of step 'setWeight=abc'")
+	_, err = ParseSteps("scale=3", nil)
+	assert.Error(t, err)
+}
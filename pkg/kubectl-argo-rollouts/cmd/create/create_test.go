@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	core "k8s.io/client-go/testing"
@@ -306,3 +308,27 @@ func TestCreateAnalysisFromClusterTemplateRunUnresolvedArg(t *testing.T) {
 	assert.Empty(t, stdout)
 	assert.Equal(t, "Error: args.foo was not resolved\n", stderr)
 }
+
+func TestCreateAnalysisRunFromClusterTemplateNamespacePolicy(t *testing.T) {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+	tf, o := options.NewFakeArgoRolloutsOptions(ns)
+	defer tf.Cleanup()
+	cmd := NewCmdCreateAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-file", "testdata/cluster-analysis-template-namespace-policy.yaml", "-a", "foo=bar", "--name", "my-run", "--global"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "ClusterAnalysisTemplate 'pass' is not allowed in namespace 'default' by its namespace policy")
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+
+	ns = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default", Labels: map[string]string{"team": "b"}}}
+	tf, o = options.NewFakeArgoRolloutsOptions(ns)
+	defer tf.Cleanup()
+	cmd = NewCmdCreateAnalysisRun(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--from-file", "testdata/cluster-analysis-template-namespace-policy.yaml", "-a", "foo=bar", "--name", "my-run", "--global"})
+	err = cmd.Execute()
+	assert.NoError(t, err)
+	stdout = o.Out.(*bytes.Buffer).String()
+	assert.Equal(t, "analysisrun.argoproj.io/my-run created\n", stdout)
+}
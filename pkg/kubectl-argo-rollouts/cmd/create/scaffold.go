@@ -0,0 +1,480 @@
+package create
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/validation"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
+)
+
+const (
+	StrategyCanary    = "canary"
+	StrategyBlueGreen = "blueGreen"
+
+	TrafficProviderNone  = "none"
+	TrafficProviderNginx = "nginx"
+	TrafficProviderALB   = "alb"
+	TrafficProviderIstio = "istio"
+	TrafficProviderSMI   = "smi"
+
+	AnalysisProviderNone       = "none"
+	AnalysisProviderPrometheus = "prometheus"
+	AnalysisProviderDatadog    = "datadog"
+
+	// DefaultSteps are the canary steps of a scaffolded rollout, in the format of the --steps flag
+	DefaultSteps = "setWeight=20,pause,setWeight=40,pause=1m,setWeight=60,pause=1m,setWeight=80,pause=1m"
+	// DefaultPrometheusAddress is the address of Prometheus queried by a scaffolded AnalysisTemplate
+	DefaultPrometheusAddress = "http://prometheus.monitoring.svc.cluster.local:9090"
+
+	servicePort       = 80
+	containerPortName = "http"
+	analysisArgName   = "service-name"
+	appLabelKey       = "app"
+)
+
+// Scaffold is the set of manifests generated for a new rollout
+type Scaffold struct {
+	Rollout          *v1alpha1.Rollout
+	Services         []validation.ServiceWithType
+	Ingress          *networkingv1.Ingress
+	VirtualService   *unstructured.Unstructured
+	AnalysisTemplate *v1alpha1.AnalysisTemplate
+	// analysisTemplateType is the way the rollout runs the AnalysisTemplate
+	analysisTemplateType validation.AnalysisTemplateType
+	analysisStepIndex    int
+}
+
+// ScaffoldOptions are the choices a rollout is scaffolded from
+type ScaffoldOptions struct {
+	Name              string
+	Image             string
+	Port              int32
+	Replicas          int32
+	Strategy          string
+	Steps             string
+	TrafficProvider   string
+	AnalysisProvider  string
+	PrometheusAddress string
+	Host              string
+}
+
+// NewScaffold generates the manifests of a rollout, its services, and the traffic routing and
+// analysis objects it references
+func NewScaffold(opts ScaffoldOptions) (*Scaffold, error) {
+	if opts.Name == "" {
+		return nil, fmt.Errorf("a rollout name is required")
+	}
+	if opts.Image == "" {
+		return nil, fmt.Errorf("an image is required")
+	}
+	s := &Scaffold{}
+	s.Rollout = newScaffoldRollout(opts)
+
+	var analysis *v1alpha1.RolloutAnalysis
+	switch opts.AnalysisProvider {
+	case "", AnalysisProviderNone:
+	case AnalysisProviderPrometheus, AnalysisProviderDatadog:
+		s.AnalysisTemplate = newScaffoldAnalysisTemplate(opts)
+		analysis = &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: s.AnalysisTemplate.Name}},
+		}
+	default:
+		return nil, fmt.Errorf("unknown analysis provider '%s'", opts.AnalysisProvider)
+	}
+
+	switch opts.Strategy {
+	case "", StrategyCanary:
+		if err := s.scaffoldCanary(opts, analysis); err != nil {
+			return nil, err
+		}
+	case StrategyBlueGreen:
+		if err := s.scaffoldBlueGreen(opts, analysis); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown strategy '%s'", opts.Strategy)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func newScaffoldRollout(opts ScaffoldOptions) *v1alpha1.Rollout {
+	labels := map[string]string{appLabelKey: opts.Name}
+	return &v1alpha1.Rollout{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       rollouts.RolloutKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   opts.Name,
+			Labels: labels,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Replicas:             pointer.Int32Ptr(opts.Replicas),
+			RevisionHistoryLimit: pointer.Int32Ptr(3),
+			Selector:             &metav1.LabelSelector{MatchLabels: labels},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  opts.Name,
+						Image: opts.Image,
+						Ports: []corev1.ContainerPort{{
+							Name:          containerPortName,
+							ContainerPort: opts.Port,
+							Protocol:      corev1.ProtocolTCP,
+						}},
+					}},
+				},
+			},
+		},
+	}
+}
+
+func newScaffoldService(name string, rolloutName string) *corev1.Service {
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Service",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{appLabelKey: rolloutName},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{appLabelKey: rolloutName},
+			Ports: []corev1.ServicePort{{
+				Name:       containerPortName,
+				Port:       servicePort,
+				TargetPort: intstr.FromString(containerPortName),
+				Protocol:   corev1.ProtocolTCP,
+			}},
+		},
+	}
+}
+
+func newScaffoldAnalysisTemplate(opts ScaffoldOptions) *v1alpha1.AnalysisTemplate {
+	metric := v1alpha1.Metric{
+		Name:         "success-rate",
+		Interval:     "1m",
+		Count:        intstrPtr(intstr.FromInt(5)),
+		FailureLimit: intstrPtr(intstr.FromInt(1)),
+	}
+	switch opts.AnalysisProvider {
+	case AnalysisProviderPrometheus:
+		address := opts.PrometheusAddress
+		if address == "" {
+			address = DefaultPrometheusAddress
+		}
+		metric.SuccessCondition = "len(result) == 0 || result[0] >= 0.95"
+		metric.Provider.Prometheus = &v1alpha1.PrometheusMetric{
+			Address: address,
+			Query: `sum(rate(http_requests_total{service="{{args.service-name}}",code!~"5.."}[5m])) /
+sum(rate(http_requests_total{service="{{args.service-name}}"}[5m]))
+`,
+		}
+	case AnalysisProviderDatadog:
+		metric.SuccessCondition = "default(result, 0) < 0.05"
+		metric.Provider.Datadog = &v1alpha1.DatadogMetric{
+			Interval: "5m",
+			Query:    "sum:trace.http.request.errors{service:{{args.service-name}}}.as_count() / sum:trace.http.request.hits{service:{{args.service-name}}}.as_count()",
+		}
+	}
+	return &v1alpha1.AnalysisTemplate{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1alpha1.SchemeGroupVersion.String(),
+			Kind:       rollouts.AnalysisTemplateKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   opts.Name + "-success-rate",
+			Labels: map[string]string{appLabelKey: opts.Name},
+		},
+		Spec: v1alpha1.AnalysisTemplateSpec{
+			Args:    []v1alpha1.Argument{{Name: analysisArgName}},
+			Metrics: []v1alpha1.Metric{metric},
+		},
+	}
+}
+
+func intstrPtr(i intstr.IntOrString) *intstr.IntOrString {
+	return &i
+}
+
+// ParseSteps parses canary steps from a comma separated list of 'setWeight=PERCENT', 'pause',
+// 'pause=DURATION' and 'analysis' steps
+func ParseSteps(steps string, analysis *v1alpha1.RolloutAnalysis) ([]v1alpha1.CanaryStep, error) {
+	var canarySteps []v1alpha1.CanaryStep
+	for _, step := range strings.Split(steps, ",") {
+		step = strings.TrimSpace(step)
+		if step == "" {
+			continue
+		}
+		stepParts := strings.SplitN(step, "=", 2)
+		stepType, hasValue := stepParts[0], len(stepParts) == 2
+		var value string
+		if hasValue {
+			value = stepParts[1]
+		}
+		switch {
+		case stepType == "setWeight" && hasValue:
+			weight, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid weight of step '%s'", step)
+			}
+			canarySteps = append(canarySteps, v1alpha1.CanaryStep{SetWeight: pointer.Int32Ptr(int32(weight))})
+		case stepType == "pause" && !hasValue:
+			canarySteps = append(canarySteps, v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{}})
+		case stepType == "pause":
+			canarySteps = append(canarySteps, v1alpha1.CanaryStep{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromString(value)}})
+		case stepType == "analysis" && !hasValue:
+			if analysis == nil {
+				return nil, fmt.Errorf("an analysis step requires an analysis provider")
+			}
+			canarySteps = append(canarySteps, v1alpha1.CanaryStep{Analysis: analysis.DeepCopy()})
+		default:
+			return nil, fmt.Errorf("invalid step '%s': steps must be one of setWeight=PERCENT, pause, pause=DURATION or analysis", step)
+		}
+	}
+	return canarySteps, nil
+}
+
+func (s *Scaffold) scaffoldCanary(opts ScaffoldOptions, analysis *v1alpha1.RolloutAnalysis) error {
+	name := opts.Name
+	canary := &v1alpha1.CanaryStrategy{}
+	s.Rollout.Spec.Strategy.Canary = canary
+
+	analysisService := name
+	switch opts.TrafficProvider {
+	case "", TrafficProviderNone:
+		// without traffic routing, the traffic is split by the number of pods behind a single service
+		s.Services = append(s.Services, validation.ServiceWithType{Service: newScaffoldService(name, name)})
+	case TrafficProviderNginx, TrafficProviderALB, TrafficProviderIstio, TrafficProviderSMI:
+		canary.CanaryService = name + "-canary"
+		canary.StableService = name + "-stable"
+		analysisService = canary.CanaryService
+		s.Services = append(s.Services,
+			validation.ServiceWithType{Service: newScaffoldService(canary.CanaryService, name), Type: validation.CanaryService},
+			validation.ServiceWithType{Service: newScaffoldService(canary.StableService, name), Type: validation.StableService},
+		)
+		canary.TrafficRouting = s.scaffoldTrafficRouting(opts)
+	default:
+		return fmt.Errorf("unknown traffic provider '%s'", opts.TrafficProvider)
+	}
+
+	if analysis != nil {
+		analysis.Args = []v1alpha1.AnalysisRunArgument{{Name: analysisArgName, Value: analysisService}}
+	}
+	steps := opts.Steps
+	if steps == "" {
+		steps = DefaultSteps
+	}
+	canarySteps, err := ParseSteps(steps, analysis)
+	if err != nil {
+		return err
+	}
+	canary.Steps = canarySteps
+
+	if analysis != nil {
+		s.analysisTemplateType = validation.BackgroundAnalysis
+		for i, step := range canarySteps {
+			if step.Analysis != nil {
+				s.analysisTemplateType = validation.InlineAnalysis
+				s.analysisStepIndex = i
+				break
+			}
+		}
+		if s.analysisTemplateType == validation.BackgroundAnalysis {
+			canary.Analysis = &v1alpha1.RolloutAnalysisBackground{RolloutAnalysis: *analysis}
+		}
+	}
+	return nil
+}
+
+func (s *Scaffold) scaffoldTrafficRouting(opts ScaffoldOptions) *v1alpha1.RolloutTrafficRouting {
+	name := opts.Name
+	canary := s.Rollout.Spec.Strategy.Canary
+	switch opts.TrafficProvider {
+	case TrafficProviderNginx:
+		s.Ingress = newScaffoldIngress(opts, "nginx", canary.StableService, networkingv1.ServiceBackendPort{Number: servicePort})
+		return &v1alpha1.RolloutTrafficRouting{
+			Nginx: &v1alpha1.NginxTrafficRouting{StableIngress: s.Ingress.Name},
+		}
+	case TrafficProviderALB:
+		// the AWS Load Balancer Controller reads the weights of the services from an annotation of
+		// the ingress, which the backend of the rule refers to
+		s.Ingress = newScaffoldIngress(opts, "alb", canary.StableService, networkingv1.ServiceBackendPort{Name: "use-annotation"})
+		return &v1alpha1.RolloutTrafficRouting{
+			ALB: &v1alpha1.ALBTrafficRouting{Ingress: s.Ingress.Name, ServicePort: servicePort},
+		}
+	case TrafficProviderIstio:
+		s.VirtualService = newScaffoldVirtualService(opts, canary.StableService, canary.CanaryService)
+		return &v1alpha1.RolloutTrafficRouting{
+			Istio: &v1alpha1.IstioTrafficRouting{
+				VirtualService: &v1alpha1.IstioVirtualService{Name: name, Routes: []string{"primary"}},
+			},
+		}
+	case TrafficProviderSMI:
+		// the rollout creates the TrafficSplit itself, which splits the traffic of the root service
+		s.Services = append(s.Services, validation.ServiceWithType{Service: newScaffoldService(name, name)})
+		return &v1alpha1.RolloutTrafficRouting{
+			SMI: &v1alpha1.SMITrafficRouting{RootService: name, TrafficSplitName: name},
+		}
+	}
+	return nil
+}
+
+func newScaffoldIngress(opts ScaffoldOptions, ingressClassName string, serviceName string, port networkingv1.ServiceBackendPort) *networkingv1.Ingress {
+	pathType := networkingv1.PathTypePrefix
+	return &networkingv1.Ingress{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: networkingv1.SchemeGroupVersion.String(),
+			Kind:       "Ingress",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   opts.Name,
+			Labels: map[string]string{appLabelKey: opts.Name},
+		},
+		Spec: networkingv1.IngressSpec{
+			IngressClassName: pointer.StringPtr(ingressClassName),
+			Rules: []networkingv1.IngressRule{{
+				Host: opts.Host,
+				IngressRuleValue: networkingv1.IngressRuleValue{
+					HTTP: &networkingv1.HTTPIngressRuleValue{
+						Paths: []networkingv1.HTTPIngressPath{{
+							Path:     "/",
+							PathType: &pathType,
+							Backend: networkingv1.IngressBackend{
+								Service: &networkingv1.IngressServiceBackend{Name: serviceName, Port: port},
+							},
+						}},
+					},
+				},
+			}},
+		},
+	}
+}
+
+func newScaffoldVirtualService(opts ScaffoldOptions, stableService string, canaryService string) *unstructured.Unstructured {
+	host := opts.Host
+	if host == "" {
+		host = opts.Name
+	}
+	destination := func(service string, weight int64) interface{} {
+		return map[string]interface{}{
+			"destination": map[string]interface{}{"host": service},
+			"weight":      weight,
+		}
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.istio.io/v1alpha3",
+		"kind":       "VirtualService",
+		"metadata": map[string]interface{}{
+			"name":   opts.Name,
+			"labels": map[string]interface{}{appLabelKey: opts.Name},
+		},
+		"spec": map[string]interface{}{
+			"hosts": []interface{}{host},
+			"http": []interface{}{
+				map[string]interface{}{
+					"name":  "primary",
+					"route": []interface{}{destination(stableService, 100), destination(canaryService, 0)},
+				},
+			},
+		},
+	}}
+}
+
+func (s *Scaffold) scaffoldBlueGreen(opts ScaffoldOptions, analysis *v1alpha1.RolloutAnalysis) error {
+	switch opts.TrafficProvider {
+	case "", TrafficProviderNone:
+	default:
+		return fmt.Errorf("traffic provider '%s' is only supported by the %s strategy", opts.TrafficProvider, StrategyCanary)
+	}
+	if opts.Steps != "" {
+		return fmt.Errorf("steps are only supported by the %s strategy", StrategyCanary)
+	}
+	name := opts.Name
+	blueGreen := &v1alpha1.BlueGreenStrategy{
+		ActiveService:  name + "-active",
+		PreviewService: name + "-preview",
+	}
+	s.Rollout.Spec.Strategy.BlueGreen = blueGreen
+	s.Services = append(s.Services,
+		validation.ServiceWithType{Service: newScaffoldService(blueGreen.ActiveService, name), Type: validation.ActiveService},
+		validation.ServiceWithType{Service: newScaffoldService(blueGreen.PreviewService, name), Type: validation.PreviewService},
+	)
+	if analysis != nil {
+		// the preview is analyzed before it is promoted to receive the traffic of the active service
+		analysis.Args = []v1alpha1.AnalysisRunArgument{{Name: analysisArgName, Value: blueGreen.PreviewService}}
+		blueGreen.PrePromotionAnalysis = analysis
+		s.analysisTemplateType = validation.PrePromotionAnalysis
+	}
+	return nil
+}
+
+// Validate lints the rollout and checks it is consistent with the objects it references, the
+// same way the controller does
+func (s *Scaffold) Validate() error {
+	allErrs := validation.ValidateRollout(s.Rollout)
+	resources := validation.ReferencedResources{ServiceWithType: s.Services}
+	if s.Ingress != nil {
+		resources.Ingresses = append(resources.Ingresses, *ingressutil.NewIngress(s.Ingress))
+	}
+	if s.VirtualService != nil {
+		resources.VirtualServices = append(resources.VirtualServices, *s.VirtualService)
+	}
+	if s.AnalysisTemplate != nil {
+		// the validation replaces the arguments of the template with placeholders
+		templates := validation.AnalysisTemplatesWithType{
+			AnalysisTemplates: []*v1alpha1.AnalysisTemplate{s.AnalysisTemplate.DeepCopy()},
+			TemplateType:      s.analysisTemplateType,
+			CanaryStepIndex:   s.analysisStepIndex,
+		}
+		switch s.analysisTemplateType {
+		case validation.PrePromotionAnalysis:
+			templates.Args = s.Rollout.Spec.Strategy.BlueGreen.PrePromotionAnalysis.Args
+		case validation.BackgroundAnalysis:
+			templates.Args = s.Rollout.Spec.Strategy.Canary.Analysis.Args
+		case validation.InlineAnalysis:
+			templates.Args = s.Rollout.Spec.Strategy.Canary.Steps[s.analysisStepIndex].Analysis.Args
+		}
+		resources.AnalysisTemplatesWithType = append(resources.AnalysisTemplatesWithType, templates)
+	}
+	allErrs = append(allErrs, validation.ValidateRolloutReferencedResources(s.Rollout, resources)...)
+	if len(allErrs) > 0 {
+		return allErrs.ToAggregate()
+	}
+	return nil
+}
+
+// Objects returns the manifests of the scaffold, in the order they should be applied
+func (s *Scaffold) Objects() []runtime.Object {
+	var objs []runtime.Object
+	for _, svc := range s.Services {
+		objs = append(objs, svc.Service)
+	}
+	if s.Ingress != nil {
+		objs = append(objs, s.Ingress)
+	}
+	if s.VirtualService != nil {
+		objs = append(objs, s.VirtualService)
+	}
+	if s.AnalysisTemplate != nil {
+		objs = append(objs, s.AnalysisTemplate)
+	}
+	return append(objs, s.Rollout)
+}
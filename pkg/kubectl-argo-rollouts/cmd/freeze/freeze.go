@@ -0,0 +1,145 @@
+package freeze
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	freezeutil "github.com/argoproj/argo-rollouts/utils/freeze"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	freezeExample = `
+  # Freeze the rollouts of the current namespace
+  %[1]s freeze --reason "INC-42: elevated error rates"
+
+  # Freeze the rollouts of the frontend tier in all namespaces
+  %[1]s freeze --all-namespaces --selector tier=frontend`
+
+	freezeUsage = `This command stops all rollouts in its scope. The rollout controller holds the updating rollouts at
+their current step and weight, and holds the rollouts which start updating before their first step,
+until the rollouts are unfrozen.
+
+The emergency stop is stored in the 'argo-rollouts-config' ConfigMap of the controller namespace.`
+
+	// DefaultControllerNamespace is the namespace of the rollout controller
+	DefaultControllerNamespace = "argo-rollouts"
+)
+
+// FreezeOptions holds the scope of an emergency stop
+type FreezeOptions struct {
+	AllNamespaces       bool
+	Selector            string
+	ControllerNamespace string
+}
+
+// AddScopeFlags adds the flags selecting the scope of an emergency stop
+func (f *FreezeOptions) AddScopeFlags(cmd *cobra.Command) {
+	cmd.Flags().BoolVarP(&f.AllNamespaces, "all-namespaces", "A", false, "Apply to the rollouts of all namespaces")
+	cmd.Flags().StringVarP(&f.Selector, "selector", "l", "", "Apply to the rollouts with matching labels (e.g. -l tier=frontend)")
+	cmd.Flags().StringVar(&f.ControllerNamespace, "controller-namespace", DefaultControllerNamespace, "Namespace of the rollout controller")
+}
+
+// Scope returns the freeze of the rollouts in the scope selected by the flags
+func (f *FreezeOptions) Scope(o *options.ArgoRolloutsOptions) (freezeutil.Freeze, error) {
+	var scope freezeutil.Freeze
+	if !f.AllNamespaces {
+		scope.Namespace = o.Namespace()
+	}
+	if f.Selector != "" {
+		selector, err := metav1.ParseToLabelSelector(f.Selector)
+		if err != nil {
+			return scope, err
+		}
+		scope.Selector = selector
+	}
+	return scope, nil
+}
+
+// NewCmdFreeze returns a new instance of an `rollouts freeze` command
+func NewCmdFreeze(o *options.ArgoRolloutsOptions) *cobra.Command {
+	freezeOptions := FreezeOptions{}
+	var reason string
+	var cmd = &cobra.Command{
+		Use:          "freeze",
+		Short:        "Stop all rollouts of a namespace or cluster",
+		Long:         freezeUsage,
+		Example:      o.Example(freezeExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return o.UsageErr(c)
+			}
+			freeze, err := freezeOptions.Scope(o)
+			if err != nil {
+				return err
+			}
+			freeze.Reason = reason
+			freeze.FrozenAt = timeutil.MetaNow()
+			err = UpdateFreezes(o.KubeClientset(), freezeOptions.ControllerNamespace, func(freezes []freezeutil.Freeze) ([]freezeutil.Freeze, error) {
+				for i := range freezes {
+					if freezes[i].SameScope(freeze) {
+						freezes[i] = freeze
+						return freezes, nil
+					}
+				}
+				return append(freezes, freeze), nil
+			})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(o.Out, "rollouts of %s frozen\n", freeze.Scope())
+			return nil
+		},
+	}
+	freezeOptions.AddScopeFlags(cmd)
+	cmd.Flags().StringVar(&reason, "reason", "", "Reason of the emergency stop, shown in the status of the held rollouts")
+	return cmd
+}
+
+// UpdateFreezes updates the emergency stops stored in the ConfigMap of the controller namespace,
+// creating the ConfigMap when needed
+func UpdateFreezes(kubeClient kubernetes.Interface, controllerNamespace string, update func([]freezeutil.Freeze) ([]freezeutil.Freeze, error)) error {
+	ctx := context.TODO()
+	cmIf := kubeClient.CoreV1().ConfigMaps(controllerNamespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := cmIf.Get(ctx, freezeutil.ConfigMapName, metav1.GetOptions{})
+		notFound := k8serrors.IsNotFound(err)
+		if err != nil && !notFound {
+			return err
+		}
+		if notFound {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      freezeutil.ConfigMapName,
+					Namespace: controllerNamespace,
+				},
+			}
+		}
+		freezes, err := freezeutil.GetFreezes(cm)
+		if err != nil {
+			return err
+		}
+		freezes, err = update(freezes)
+		if err != nil {
+			return err
+		}
+		if err := freezeutil.SetFreezes(cm, freezes); err != nil {
+			return err
+		}
+		if notFound {
+			_, err = cmIf.Create(ctx, cm, metav1.CreateOptions{})
+			return err
+		}
+		_, err = cmIf.Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -0,0 +1,101 @@
+package freeze
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	freezeutil "github.com/argoproj/argo-rollouts/utils/freeze"
+)
+
+func getFreezes(t *testing.T, kubeClient kubernetes.Interface) []freezeutil.Freeze {
+	t.Helper()
+	cm, err := kubeClient.CoreV1().ConfigMaps(DefaultControllerNamespace).Get(context.TODO(), freezeutil.ConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	freezes, err := freezeutil.GetFreezes(cm)
+	assert.NoError(t, err)
+	return freezes
+}
+
+func TestFreezeCmdUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdFreeze(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Contains(t, stderr, "Usage:")
+}
+
+func TestFreezeCmd(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	o.RESTClientGetter = tf.WithNamespace("payments")
+	cmd := NewCmdFreeze(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"--reason", "INC-42"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	freezes := getFreezes(t, o.KubeClientset())
+	if assert.Len(t, freezes, 1) {
+		assert.Equal(t, "payments", freezes[0].Namespace)
+		assert.Nil(t, freezes[0].Selector)
+		assert.Equal(t, "INC-42", freezes[0].Reason)
+		assert.False(t, freezes[0].FrozenAt.IsZero())
+	}
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Equal(t, "rollouts of namespace 'payments' frozen\n", stdout)
+}
+
+func TestFreezeCmdExistingFreezes(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      freezeutil.ConfigMapName,
+			Namespace: DefaultControllerNamespace,
+		},
+		Data: map[string]string{"other": "value"},
+	}
+	assert.NoError(t, freezeutil.SetFreezes(cm, []freezeutil.Freeze{{Reason: "first"}}))
+	tf, o := options.NewFakeArgoRolloutsOptions(cm)
+	defer tf.Cleanup()
+
+	// freezing a scope again updates its reason
+	for _, args := range [][]string{
+		{"-A", "-l", "tier=frontend"},
+		{"--all-namespaces", "--reason", "second"},
+	} {
+		cmd := NewCmdFreeze(o)
+		cmd.PersistentPreRunE = o.PersistentPreRunE
+		cmd.SetArgs(args)
+		assert.NoError(t, cmd.Execute())
+	}
+
+	freezes := getFreezes(t, o.KubeClientset())
+	if assert.Len(t, freezes, 2) {
+		assert.Equal(t, "second", freezes[0].Reason)
+		assert.Equal(t, "", freezes[1].Namespace)
+		assert.Equal(t, map[string]string{"tier": "frontend"}, freezes[1].Selector.MatchLabels)
+	}
+	updated, err := o.KubeClientset().CoreV1().ConfigMaps(DefaultControllerNamespace).Get(context.TODO(), freezeutil.ConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "value", updated.Data["other"])
+}
+
+func TestFreezeCmdInvalidSelector(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdFreeze(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"-l", "tier in frontend"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/signals"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
@@ -125,6 +126,15 @@ func (o *GetOptions) formatImage(image info.ImageInfo) string {
 	return imageStr
 }
 
+func (o *GetOptions) formatStepRecord(stepRecord *v1alpha1.CanaryStepRecord, stepCount int) string {
+	step := fmt.Sprintf("%d/%d", stepRecord.Index+1, stepCount)
+	stepStr := fmt.Sprintf("%-6s %-17s %s", step, stepRecord.Outcome, info.StepDuration(stepRecord))
+	if stepRecord.Message != "" {
+		stepStr = fmt.Sprintf("%s (%s)", stepStr, stepRecord.Message)
+	}
+	return stepStr
+}
+
 func (o *GetOptions) PrintRollout(roInfo *rollout.RolloutInfo) {
 	fmt.Fprintf(o.Out, tableFormat, "Name:", roInfo.ObjectMeta.Name)
 	fmt.Fprintf(o.Out, tableFormat, "Namespace:", roInfo.ObjectMeta.Namespace)
@@ -132,11 +142,23 @@ func (o *GetOptions) PrintRollout(roInfo *rollout.RolloutInfo) {
 	if roInfo.Message != "" {
 		fmt.Fprintf(o.Out, tableFormat, "Message:", roInfo.Message)
 	}
+	if roInfo.Progress != nil {
+		fmt.Fprintf(o.Out, tableFormat, "Progress:", info.Progress(roInfo.Progress))
+	}
 	fmt.Fprintf(o.Out, tableFormat, "Strategy:", roInfo.Strategy)
 	if roInfo.Strategy == "Canary" {
 		fmt.Fprintf(o.Out, tableFormat, "  Step:", roInfo.Step)
 		fmt.Fprintf(o.Out, tableFormat, "  SetWeight:", roInfo.SetWeight)
 		fmt.Fprintf(o.Out, tableFormat, "  ActualWeight:", roInfo.ActualWeight)
+		if len(roInfo.StepHistory) > 0 {
+			fmt.Fprint(o.Out, "  StepHistory:\n")
+			for _, stepRecord := range roInfo.StepHistory {
+				fmt.Fprintf(o.Out, tableFormat, "", o.formatStepRecord(stepRecord, len(roInfo.Steps)))
+			}
+		}
+	}
+	if roInfo.NextScheduledRestartAt != "" {
+		fmt.Fprintf(o.Out, tableFormat, "NextRestart:", roInfo.NextScheduledRestartAt)
 	}
 	images := info.Images(roInfo)
 	if len(images) > 0 {
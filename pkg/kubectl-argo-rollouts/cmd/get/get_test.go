@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -304,6 +305,79 @@ NAME                                     KIND        STATUS              AGE  IN
 	assertStdout(t, expectedOut, o.IOStreams)
 }
 
+func TestGetCanaryRolloutStepHistory(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	startedAt := metav1.NewTime(timeutil.Now().Add(-10 * time.Minute))
+	finishedAt := metav1.NewTime(timeutil.Now().Add(-7 * time.Minute))
+	rolloutObjs.Rollouts[0].Status.Canary.StepHistory = []v1alpha1.CanaryStepRecord{
+		{Index: 0, StartedAt: startedAt, FinishedAt: &finishedAt, Outcome: v1alpha1.CanaryStepOutcomeCompleted},
+		{Index: 1, StartedAt: finishedAt, FinishedAt: &finishedAt, Outcome: v1alpha1.CanaryStepOutcomeSkipped},
+		{Index: 2, StartedAt: finishedAt, Outcome: v1alpha1.CanaryStepOutcomeRunning},
+	}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+  ActualWeight:  0
+  StepHistory:
+                 1/8    Completed         3m
+                 2/8    Skipped           0s
+                 3/8    Running           7m
+Images:`, "\n")
+	stdout := stripTrailingWhitespace(o.Out.(*bytes.Buffer).String())
+	assert.Contains(t, stdout, expectedOut)
+}
+
+func TestGetCanaryRolloutProgress(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	completion := metav1.NewTime(timeutil.Now().Add(10*time.Minute + 30*time.Second))
+	rolloutObjs.Rollouts[0].Status.Progress = &v1alpha1.RolloutProgress{Percent: 25, EstimatedCompletionTime: &completion}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+Progress:        25% (ETA 10m)
+Strategy:        Canary`, "\n")
+	stdout := stripTrailingWhitespace(o.Out.(*bytes.Buffer).String())
+	assert.Contains(t, stdout, expectedOut)
+}
+
+func TestGetRolloutNextScheduledRestart(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	next := metav1.NewTime(time.Date(2022, 3, 2, 3, 0, 0, 0, time.UTC))
+	rolloutObjs.Rollouts[0].Spec.RestartSchedule = &v1alpha1.RestartSchedule{Schedule: "0 3 * * *"}
+	rolloutObjs.Rollouts[0].Status.NextScheduledRestartAt = &next
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdGetRollout(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, "--no-color"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := strings.TrimPrefix(`
+NextRestart:     2022-03-02T03:00:00Z
+Images:`, "\n")
+	stdout := stripTrailingWhitespace(o.Out.(*bytes.Buffer).String())
+	assert.Contains(t, stdout, expectedOut)
+}
+
 func TestGetCanaryPingPongRollout(t *testing.T) {
 	rolloutObjs := testdata.NewCanaryRollout()
 
@@ -88,6 +88,16 @@ func validate(fileBytes []byte, un *unstructured.Unstructured) error {
 		if 0 < len(errs) {
 			return errs[0]
 		}
+	case gvk.Group == rollouts.Group && gvk.Kind == rollouts.AnalysisTemplateKind:
+		var template v1alpha1.AnalysisTemplate
+		err := unmarshal(fileBytes, &template)
+		if err != nil {
+			return err
+		}
+		errs := validation.ValidateAnalysisTemplate(&template)
+		if 0 < len(errs) {
+			return errs[0]
+		}
 	}
 	return nil
 }
@@ -42,6 +42,10 @@ func TestLintInvalidRollout(t *testing.T) {
 			"Error: spec.strategy.maxSurge: Invalid value: intstr.IntOrString{Type:0, IntVal:0, StrVal:\"\"}: MaxSurge and MaxUnavailable both can not be zero\n",
 		},
 
+		{
+			"testdata/invalid-analysis-template-namespace-policy.yml",
+			"Error: spec.namespacePolicy: Forbidden: namespacePolicy only applies to ClusterAnalysisTemplates\n",
+		},
 		{
 			"testdata/invalid-unknown-field.yml",
 			"Error: error unmarshaling JSON: while decoding JSON: json: unknown field \"unknown-strategy\"\n",
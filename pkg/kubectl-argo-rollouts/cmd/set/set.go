@@ -9,7 +9,13 @@ import (
 const (
 	setExample = `
   # Set rollout image
-  %[1]s set image my-rollout demo=argoproj/rollouts-demo:yellow`
+  %[1]s set image my-rollout demo=argoproj/rollouts-demo:yellow
+
+  # Set an environment variable of the containers of a rollout
+  %[1]s set env my-rollout STORAGE_DIR=/local
+
+  # Set the resource limits of the containers of a rollout
+  %[1]s set resources my-rollout --limits cpu=200m,memory=512Mi`
 )
 
 // NewCmdSet returns a new instance of an `rollouts set` command
@@ -25,5 +31,7 @@ func NewCmdSet(o *options.ArgoRolloutsOptions) *cobra.Command {
 		},
 	}
 	cmd.AddCommand(NewCmdSetImage(o))
+	cmd.AddCommand(NewCmdSetEnv(o))
+	cmd.AddCommand(NewCmdSetResources(o))
 	return cmd
 }
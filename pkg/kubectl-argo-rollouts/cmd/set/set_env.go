@@ -0,0 +1,232 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+const (
+	setEnvExample = `
+  # Set an environment variable in all the containers of a rollout
+  %[1]s set env my-rollout STORAGE_DIR=/local
+
+  # Remove an environment variable from the www container of a rollout
+  %[1]s set env my-rollout -c www STORAGE_DIR-
+
+  # Import the keys of a config map as environment variables, prefixed with MYSQL_
+  %[1]s set env my-rollout --from configmap/mysql-config --prefix MYSQL_
+
+  # Show the changes to the pod template without applying them
+  %[1]s set env my-rollout STORAGE_DIR=/local --dry-run`
+)
+
+var invalidEnvNameChars = regexp.MustCompile("[^a-zA-Z0-9_]")
+
+// NewCmdSetEnv returns a new instance of an `rollouts set env` command
+func NewCmdSetEnv(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var (
+		containers string
+		from       string
+		keys       []string
+		prefix     string
+		dryRun     bool
+	)
+	var cmd = &cobra.Command{
+		Use:          "env ROLLOUT_NAME KEY_1=VAL_1 ... KEY_N=VAL_N|KEY-",
+		Short:        "Update the environment variables of the containers of a rollout",
+		Long:         "This command updates the environment variables of the containers of a rollout. When the rollout has a workloadRef, the referenced workload is updated instead.",
+		Example:      o.Example(setEnvExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) < 1 {
+				return o.UsageErr(c)
+			}
+			rollout := args[0]
+			env, remove, err := parseEnvArgs(args[1:])
+			if err != nil {
+				return err
+			}
+			if from != "" {
+				fromEnv, err := envFromSource(o, from, keys, prefix)
+				if err != nil {
+					return err
+				}
+				env = append(env, fromEnv...)
+			}
+			if len(env) == 0 && len(remove) == 0 {
+				return o.UsageErr(c)
+			}
+
+			orig, updated, err := updatePodSpecWithRetries(o.DynamicClientset(), o.Namespace(), rollout, dryRun, func(podSpec map[string]interface{}) error {
+				return forEachContainer(podSpec, strings.Split(containers, ","), func(ctr map[string]interface{}) error {
+					return setContainerEnv(ctr, env, remove)
+				})
+			})
+			if err != nil {
+				return err
+			}
+			return printSetResult(o.Out, orig, updated, "env", dryRun)
+		},
+	}
+	cmd.Flags().StringVarP(&containers, "containers", "c", "*", "Comma separated names of the containers to update, or * for all of them")
+	cmd.Flags().StringVar(&from, "from", "", "Config map or secret to import the environment variables from (configmap/NAME or secret/NAME)")
+	cmd.Flags().StringSliceVar(&keys, "keys", nil, "Comma separated keys to import from the config map or secret, instead of all of them")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "Prefix of the names of the environment variables imported from the config map or secret")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes to the pod template without applying them")
+	return cmd
+}
+
+// parseEnvArgs parses KEY=VALUE arguments into environment variables and KEY- arguments into the
+// names of the environment variables to remove
+func parseEnvArgs(args []string) ([]corev1.EnvVar, []string, error) {
+	var env []corev1.EnvVar
+	var remove []string
+	for _, arg := range args {
+		if parts := strings.SplitN(arg, "=", 2); len(parts) == 2 {
+			if parts[0] == "" {
+				return nil, nil, fmt.Errorf("invalid environment variable '%s': the name is empty", arg)
+			}
+			env = append(env, corev1.EnvVar{Name: parts[0], Value: parts[1]})
+			continue
+		}
+		if strings.HasSuffix(arg, "-") && len(arg) > 1 {
+			remove = append(remove, strings.TrimSuffix(arg, "-"))
+			continue
+		}
+		return nil, nil, fmt.Errorf("invalid environment variable '%s': expected KEY=VALUE or KEY-", arg)
+	}
+	return env, remove, nil
+}
+
+// envFromSource returns environment variables referencing the keys of a config map or a secret
+func envFromSource(o *options.ArgoRolloutsOptions, from string, keys []string, prefix string) ([]corev1.EnvVar, error) {
+	ctx := context.TODO()
+	parts := strings.SplitN(from, "/", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return nil, fmt.Errorf("invalid source '%s': expected configmap/NAME or secret/NAME", from)
+	}
+	kind, name := strings.ToLower(parts[0]), parts[1]
+
+	var sourceKeys []string
+	var newEnvVarSource func(key string) *corev1.EnvVarSource
+	switch kind {
+	case "configmap", "configmaps", "cm":
+		cm, err := o.KubeClientset().CoreV1().ConfigMaps(o.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for key := range cm.Data {
+			sourceKeys = append(sourceKeys, key)
+		}
+		for key := range cm.BinaryData {
+			sourceKeys = append(sourceKeys, key)
+		}
+		newEnvVarSource = func(key string) *corev1.EnvVarSource {
+			return &corev1.EnvVarSource{
+				ConfigMapKeyRef: &corev1.ConfigMapKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+					Key:                  key,
+				},
+			}
+		}
+	case "secret", "secrets":
+		secret, err := o.KubeClientset().CoreV1().Secrets(o.Namespace()).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for key := range secret.Data {
+			sourceKeys = append(sourceKeys, key)
+		}
+		newEnvVarSource = func(key string) *corev1.EnvVarSource {
+			return &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: name},
+					Key:                  key,
+				},
+			}
+		}
+	default:
+		return nil, fmt.Errorf("invalid source '%s': expected configmap/NAME or secret/NAME", from)
+	}
+
+	if len(keys) > 0 {
+		for _, key := range keys {
+			found := false
+			for _, sourceKey := range sourceKeys {
+				if key == sourceKey {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return nil, fmt.Errorf("key '%s' not found in %s", key, from)
+			}
+		}
+		sourceKeys = keys
+	}
+	sort.Strings(sourceKeys)
+
+	env := make([]corev1.EnvVar, 0, len(sourceKeys))
+	for _, key := range sourceKeys {
+		env = append(env, corev1.EnvVar{
+			Name:      prefix + strings.ToUpper(invalidEnvNameChars.ReplaceAllString(key, "_")),
+			ValueFrom: newEnvVarSource(key),
+		})
+	}
+	return env, nil
+}
+
+// setContainerEnv sets and removes environment variables of a container. Environment variables
+// which are already defined keep their position.
+func setContainerEnv(ctr map[string]interface{}, env []corev1.EnvVar, remove []string) error {
+	newEnv := make(map[string]interface{}, len(env))
+	for i := range env {
+		envUn, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&env[i])
+		if err != nil {
+			return err
+		}
+		newEnv[env[i].Name] = envUn
+	}
+	removed := make(map[string]bool, len(remove))
+	for _, name := range remove {
+		removed[name] = true
+	}
+
+	envList, _ := ctr["env"].([]interface{})
+	updatedEnvList := make([]interface{}, 0, len(envList)+len(env))
+	for _, envVarIf := range envList {
+		envVar, _ := envVarIf.(map[string]interface{})
+		name, _ := envVar["name"].(string)
+		if removed[name] {
+			continue
+		}
+		if envUn, ok := newEnv[name]; ok {
+			updatedEnvList = append(updatedEnvList, envUn)
+			delete(newEnv, name)
+			continue
+		}
+		updatedEnvList = append(updatedEnvList, envVarIf)
+	}
+	for _, envVar := range env {
+		if envUn, ok := newEnv[envVar.Name]; ok {
+			updatedEnvList = append(updatedEnvList, envUn)
+			delete(newEnv, envVar.Name)
+		}
+	}
+	if len(updatedEnvList) == 0 {
+		delete(ctr, "env")
+	} else {
+		ctr["env"] = updatedEnvList
+	}
+	return nil
+}
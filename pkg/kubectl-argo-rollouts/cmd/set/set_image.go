@@ -1,18 +1,14 @@
 package set
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
 	"github.com/spf13/cobra"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 
-	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 )
 
@@ -64,65 +60,25 @@ func NewCmdSetImage(o *options.ArgoRolloutsOptions) *cobra.Command {
 	return cmd
 }
 
-var deploymentGVR = schema.GroupVersionResource{
-	Group:    "apps",
-	Version:  "v1",
-	Resource: "deployments",
-}
-
-// SetImage updates a rollout's container image
+// SetImage updates a rollout's container image, in the rollout itself or in the workload referenced
+// by its workloadRef
 // We use a dynamic clientset instead of a rollout clientset in order to allow an older plugin
 // to still work with a newer version of Rollouts (without dropping newly introduced fields during
 // the marshalling)
 func SetImage(dynamicClient dynamic.Interface, namespace, rollout, container, image string) (*unstructured.Unstructured, error) {
-	ctx := context.TODO()
-	rolloutIf := dynamicClient.Resource(v1alpha1.RolloutGVR).Namespace(namespace)
-	ro, err := rolloutIf.Get(ctx, rollout, metav1.GetOptions{})
-	if err != nil {
-		return nil, err
-	}
-	workloadRef, ok, err := unstructured.NestedMap(ro.Object, "spec", "workloadRef")
-	if err != nil {
-		return nil, err
-	}
-	if ok {
-		deployIf := dynamicClient.Resource(deploymentGVR).Namespace(namespace)
-		deployName, ok := workloadRef["name"].(string)
-		if !ok {
-			return nil, fmt.Errorf("spec.workloadRef.name is not a string: %v", workloadRef["name"])
-		}
-		deployUn, err := deployIf.Get(ctx, deployName, metav1.GetOptions{})
-		if err != nil {
-			return nil, err
-		}
-		newDeploy, err := newRolloutSetImage(deployUn, container, image)
-		if err != nil {
-			return nil, err
-		}
-		return deployIf.Update(ctx, newDeploy, metav1.UpdateOptions{})
-	} else {
-		newRo, err := newRolloutSetImage(ro, container, image)
-		if err != nil {
-			return nil, err
-		}
-		return rolloutIf.Update(ctx, newRo, metav1.UpdateOptions{})
-	}
+	_, updated, err := updatePodSpec(dynamicClient, namespace, rollout, false, func(podSpec map[string]interface{}) error {
+		return setPodSpecImage(podSpec, container, image)
+	})
+	return updated, err
 }
 
-func newRolloutSetImage(orig *unstructured.Unstructured, container string, image string) (*unstructured.Unstructured, error) {
-	ro := orig.DeepCopy()
+func setPodSpecImage(podSpec map[string]interface{}, container string, image string) error {
 	containerFound := false
-
-	fields := []string{"spec", "template", "spec"}
 	for _, field := range []string{"initContainers", "containers", "ephemeralContainers"} {
-		ctrListIf, ok, err := unstructured.NestedFieldNoCopy(ro.Object, append(fields, field)...)
-		if err != nil {
-			return nil, err
-		}
+		ctrList, ok := podSpec[field].([]interface{})
 		if !ok {
 			continue
 		}
-		ctrList := ctrListIf.([]interface{})
 		for _, ctrIf := range ctrList {
 			ctr := ctrIf.(map[string]interface{})
 			if name, _, _ := unstructured.NestedString(ctr, "name"); name == container || container == "*" {
@@ -132,7 +88,7 @@ func newRolloutSetImage(orig *unstructured.Unstructured, container string, image
 		}
 	}
 	if !containerFound {
-		return nil, fmt.Errorf("unable to find container named \"%s\"", container)
+		return fmt.Errorf("unable to find container named \"%s\"", container)
 	}
-	return ro, nil
+	return nil
 }
@@ -0,0 +1,135 @@
+package set
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+const (
+	setResourcesExample = `
+  # Set the cpu and memory limits of all the containers of a rollout
+  %[1]s set resources my-rollout --limits cpu=200m,memory=512Mi
+
+  # Set the requests and limits of the www container of a rollout
+  %[1]s set resources my-rollout -c www --requests cpu=100m,memory=256Mi --limits cpu=200m,memory=512Mi
+
+  # Show the changes to the pod template without applying them
+  %[1]s set resources my-rollout --limits cpu=200m --dry-run`
+)
+
+// NewCmdSetResources returns a new instance of an `rollouts set resources` command
+func NewCmdSetResources(o *options.ArgoRolloutsOptions) *cobra.Command {
+	var (
+		containers string
+		limits     string
+		requests   string
+		dryRun     bool
+	)
+	var cmd = &cobra.Command{
+		Use:          "resources ROLLOUT_NAME",
+		Short:        "Update the resource requests and limits of the containers of a rollout",
+		Long:         "This command updates the resource requests and limits of the containers of a rollout. When the rollout has a workloadRef, the referenced workload is updated instead.",
+		Example:      o.Example(setResourcesExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 || (limits == "" && requests == "") {
+				return o.UsageErr(c)
+			}
+			rollout := args[0]
+			limitsList, err := parseResourceList(limits)
+			if err != nil {
+				return err
+			}
+			requestsList, err := parseResourceList(requests)
+			if err != nil {
+				return err
+			}
+
+			orig, updated, err := updatePodSpecWithRetries(o.DynamicClientset(), o.Namespace(), rollout, dryRun, func(podSpec map[string]interface{}) error {
+				return forEachContainer(podSpec, strings.Split(containers, ","), func(ctr map[string]interface{}) error {
+					return setContainerResources(ctr, limitsList, requestsList)
+				})
+			})
+			if err != nil {
+				return err
+			}
+			return printSetResult(o.Out, orig, updated, "resources", dryRun)
+		},
+	}
+	cmd.Flags().StringVarP(&containers, "containers", "c", "*", "Comma separated names of the containers to update, or * for all of them")
+	cmd.Flags().StringVar(&limits, "limits", "", "Comma separated resource limits, e.g. cpu=200m,memory=512Mi")
+	cmd.Flags().StringVar(&requests, "requests", "", "Comma separated resource requests, e.g. cpu=100m,memory=256Mi")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes to the pod template without applying them")
+	return cmd
+}
+
+// parseResourceList parses a comma separated list of NAME=QUANTITY
+func parseResourceList(list string) (corev1.ResourceList, error) {
+	resources := corev1.ResourceList{}
+	if list == "" {
+		return resources, nil
+	}
+	for _, item := range strings.Split(list, ",") {
+		parts := strings.SplitN(item, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("invalid resource '%s': expected NAME=QUANTITY", item)
+		}
+		quantity, err := resource.ParseQuantity(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity of resource '%s': %v", item, err)
+		}
+		resources[corev1.ResourceName(parts[0])] = quantity
+	}
+	return resources, nil
+}
+
+// setContainerResources sets resource limits and requests of a container, keeping the ones which are
+// not given. It returns an error when a request would exceed its limit.
+func setContainerResources(ctr map[string]interface{}, limits, requests corev1.ResourceList) error {
+	resources, _ := ctr["resources"].(map[string]interface{})
+	if resources == nil {
+		resources = map[string]interface{}{}
+	}
+	for field, list := range map[string]corev1.ResourceList{"limits": limits, "requests": requests} {
+		if len(list) == 0 {
+			continue
+		}
+		values, _ := resources[field].(map[string]interface{})
+		if values == nil {
+			values = map[string]interface{}{}
+		}
+		for name, quantity := range list {
+			values[string(name)] = quantity.String()
+		}
+		resources[field] = values
+	}
+	ctr["resources"] = resources
+
+	limitValues, _ := resources["limits"].(map[string]interface{})
+	requestValues, _ := resources["requests"].(map[string]interface{})
+	for name, requestIf := range requestValues {
+		limitIf, ok := limitValues[name]
+		if !ok {
+			continue
+		}
+		request, err := resource.ParseQuantity(fmt.Sprint(requestIf))
+		if err != nil {
+			return err
+		}
+		limit, err := resource.ParseQuantity(fmt.Sprint(limitIf))
+		if err != nil {
+			return err
+		}
+		if request.Cmp(limit) > 0 {
+			ctrName, _ := ctr["name"].(string)
+			return fmt.Errorf("request of %s (%s) of container \"%s\" exceeds its limit (%s)", name, request.String(), ctrName, limit.String())
+		}
+	}
+	return nil
+}
@@ -10,6 +10,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -21,6 +22,12 @@ import (
 	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
 )
 
+var deploymentGVR = schema.GroupVersionResource{
+	Group:    "apps",
+	Version:  "v1",
+	Resource: "deployments",
+}
+
 // getRollout helper to get the rollout using the dynamic interface
 func getRollout(t *testing.T, o *cliopts.ArgoRolloutsOptions, namespace, name string) *v1alpha1.Rollout {
 	t.Helper()
@@ -381,3 +388,303 @@ func TestSetImageWorkloadRef(t *testing.T) {
 	assert.Equal(t, stdout, "deployment \"guestbook\" image updated\n")
 	assert.Empty(t, stderr)
 }
+
+func newEnvRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "guestbook",
+							Image: "argoproj/rollouts-demo:blue",
+							Env: []corev1.EnvVar{
+								{Name: "LOG_LEVEL", Value: "info"},
+								{Name: "STORAGE_DIR", Value: "/data"},
+							},
+						},
+						{
+							Name:  "sidecar",
+							Image: "alpine:3.8",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestSetEnvCmdUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	for _, args := range [][]string{
+		{},
+		{"guestbook"},
+	} {
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		assert.Error(t, err)
+		stderr := o.ErrOut.(*bytes.Buffer).String()
+		assert.Contains(t, stderr, "Usage:")
+		assert.Contains(t, stderr, "env ROLLOUT_NAME")
+	}
+
+	cmd.SetArgs([]string{"guestbook", "NOT_A_CHANGE"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "invalid environment variable 'NOT_A_CHANGE': expected KEY=VALUE or KEY-")
+}
+
+func TestSetEnvCmd(t *testing.T) {
+	ro := newEnvRollout()
+	tf, o := options.NewFakeArgoRolloutsOptions(ro)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-c", "guestbook", "LOG_LEVEL=debug", "STORAGE_DIR-", "CACHE=true"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	modifiedRo := getRollout(t, o, ro.Namespace, ro.Name)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "LOG_LEVEL", Value: "debug"},
+		{Name: "CACHE", Value: "true"},
+	}, modifiedRo.Spec.Template.Spec.Containers[0].Env)
+	assert.Empty(t, modifiedRo.Spec.Template.Spec.Containers[1].Env)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, "rollout \"guestbook\" env updated\n", stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestSetEnvCmdContainerNotFound(t *testing.T) {
+	ro := newEnvRollout()
+	tf, o := options.NewFakeArgoRolloutsOptions(ro)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-c", "typo", "LOG_LEVEL=debug"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "unable to find container named \"typo\"")
+}
+
+func TestSetEnvCmdFromSource(t *testing.T) {
+	ro := newEnvRollout()
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-config", Namespace: metav1.NamespaceDefault},
+		Data:       map[string]string{"host": "mysql", "max-connections": "10"},
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "mysql-credentials", Namespace: metav1.NamespaceDefault},
+		Data:       map[string][]byte{"password": []byte("secret"), "user": []byte("admin")},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(ro, cm, secret)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-c", "sidecar", "--from", "configmap/mysql-config", "--prefix", "MYSQL_"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	cmd = NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-c", "sidecar", "--from", "secret/mysql-credentials", "--keys", "password"})
+	err = cmd.Execute()
+	assert.NoError(t, err)
+
+	modifiedRo := getRollout(t, o, ro.Namespace, ro.Name)
+	assert.Equal(t, []corev1.EnvVar{
+		{Name: "MYSQL_HOST", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysql-config"}, Key: "host"}}},
+		{Name: "MYSQL_MAX_CONNECTIONS", ValueFrom: &corev1.EnvVarSource{ConfigMapKeyRef: &corev1.ConfigMapKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysql-config"}, Key: "max-connections"}}},
+		{Name: "PASSWORD", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "mysql-credentials"}, Key: "password"}}},
+	}, modifiedRo.Spec.Template.Spec.Containers[1].Env)
+
+	cmd = NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "--from", "secret/mysql-credentials", "--keys", "typo"})
+	err = cmd.Execute()
+	assert.EqualError(t, err, "key 'typo' not found in secret/mysql-credentials")
+}
+
+func TestSetEnvCmdDryRun(t *testing.T) {
+	ro := newEnvRollout()
+	tf, o := options.NewFakeArgoRolloutsOptions(ro)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-c", "guestbook", "LOG_LEVEL=debug", "--dry-run"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	modifiedRo := getRollout(t, o, ro.Namespace, ro.Name)
+	assert.Equal(t, ro.Spec.Template.Spec.Containers[0].Env, modifiedRo.Spec.Template.Spec.Containers[0].Env)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Equal(t, `--- rollout/guestbook spec.template
++++ rollout/guestbook spec.template
+@@ -4,7 +4,7 @@
+   containers:
+   - env:
+     - name: LOG_LEVEL
+-      value: info
++      value: debug
+     - name: STORAGE_DIR
+       value: /data
+     image: argoproj/rollouts-demo:blue
+rollout "guestbook" env updated (dry run)
+`, stdout)
+}
+
+func TestSetEnvCmdWorkloadRefPodTemplate(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			WorkloadRef: &v1alpha1.ObjectRef{
+				APIVersion: "v1",
+				Kind:       "PodTemplate",
+				Name:       "guestbook",
+			},
+		},
+	}
+	podTemplate := corev1.PodTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Template: newEnvRollout().Spec.Template,
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro, &podTemplate)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSetEnv(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "CACHE=true"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	newPodTemplateUn, err := o.DynamicClientset().Resource(corev1.SchemeGroupVersion.WithResource("podtemplates")).Namespace(ro.Namespace).Get(context.Background(), "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	var updated corev1.PodTemplate
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(newPodTemplateUn.Object, &updated)
+	assert.NoError(t, err)
+	assert.Equal(t, corev1.EnvVar{Name: "CACHE", Value: "true"}, updated.Template.Spec.Containers[0].Env[2])
+	assert.Equal(t, []corev1.EnvVar{{Name: "CACHE", Value: "true"}}, updated.Template.Spec.Containers[1].Env)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Equal(t, "podtemplate \"guestbook\" env updated\n", stdout)
+}
+
+func TestSetResourcesCmdUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdSetResources(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	for _, args := range [][]string{
+		{},
+		{"guestbook"},
+	} {
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		assert.Error(t, err)
+		stderr := o.ErrOut.(*bytes.Buffer).String()
+		assert.Contains(t, stderr, "Usage:")
+		assert.Contains(t, stderr, "resources ROLLOUT_NAME")
+	}
+
+	cmd.SetArgs([]string{"guestbook", "--limits", "cpu=lots"})
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid quantity of resource 'cpu=lots'")
+}
+
+func TestSetResourcesCmdWorkloadRef(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			WorkloadRef: &v1alpha1.ObjectRef{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "guestbook",
+			},
+		},
+	}
+	deploy := appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  "guestbook",
+							Image: "argoproj/rollouts-demo:blue",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceMemory: resource.MustParse("128Mi"),
+								},
+							},
+						},
+						{
+							Name:  "sidecar",
+							Image: "alpine:3.8",
+						},
+					},
+				},
+			},
+		},
+	}
+	tf, o := options.NewFakeArgoRolloutsOptions(&ro, &deploy)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSetResources(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-c", "guestbook", "--requests", "cpu=100m", "--limits", "cpu=200m,memory=512Mi"})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	newDeployUn, err := o.DynamicClientset().Resource(deploymentGVR).Namespace(ro.Namespace).Get(context.Background(), "guestbook", metav1.GetOptions{})
+	assert.NoError(t, err)
+	var newDeploy appsv1.Deployment
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(newDeployUn.Object, &newDeploy)
+	assert.NoError(t, err)
+	assert.Equal(t, "100m", newDeploy.Spec.Template.Spec.Containers[0].Resources.Requests.Cpu().String())
+	assert.Equal(t, "128Mi", newDeploy.Spec.Template.Spec.Containers[0].Resources.Requests.Memory().String())
+	assert.Equal(t, "200m", newDeploy.Spec.Template.Spec.Containers[0].Resources.Limits.Cpu().String())
+	assert.Equal(t, "512Mi", newDeploy.Spec.Template.Spec.Containers[0].Resources.Limits.Memory().String())
+	assert.Empty(t, newDeploy.Spec.Template.Spec.Containers[1].Resources)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, "deployment \"guestbook\" resources updated\n", stdout)
+	assert.Empty(t, stderr)
+}
+
+func TestSetResourcesCmdRequestExceedsLimit(t *testing.T) {
+	ro := newEnvRollout()
+	tf, o := options.NewFakeArgoRolloutsOptions(ro)
+	defer tf.Cleanup()
+
+	cmd := NewCmdSetResources(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"guestbook", "-c", "guestbook", "--requests", "memory=1Gi", "--limits", "memory=512Mi"})
+	err := cmd.Execute()
+	assert.EqualError(t, err, "request of memory (1Gi) of container \"guestbook\" exceeds its limit (512Mi)")
+}
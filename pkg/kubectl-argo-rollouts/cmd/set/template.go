@@ -0,0 +1,213 @@
+package set
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/pmezard/go-difflib/difflib"
+	k8serr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// workloadRefResources are the resources of the kinds of workload which can be referenced by the
+// workloadRef of a rollout
+var workloadRefResources = map[schema.GroupKind]string{
+	{Group: "apps", Kind: "Deployment"}: "deployments",
+	{Group: "apps", Kind: "ReplicaSet"}: "replicasets",
+	{Kind: "PodTemplate"}:               "podtemplates",
+}
+
+// podTemplatePath returns the path of the pod template in an object of the given kind
+func podTemplatePath(kind string) []string {
+	if kind == "PodTemplate" {
+		return []string{"template"}
+	}
+	return []string{"spec", "template"}
+}
+
+// podTemplateHolder is the object holding the pod template of a rollout, which is either the rollout
+// itself or the workload referenced by its workloadRef
+type podTemplateHolder struct {
+	obj        *unstructured.Unstructured
+	resourceIf dynamic.ResourceInterface
+}
+
+func getPodTemplateHolder(ctx context.Context, dynamicClient dynamic.Interface, namespace, rollout string) (*podTemplateHolder, error) {
+	rolloutIf := dynamicClient.Resource(v1alpha1.RolloutGVR).Namespace(namespace)
+	ro, err := rolloutIf.Get(ctx, rollout, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	workloadRef, ok, err := unstructured.NestedMap(ro.Object, "spec", "workloadRef")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &podTemplateHolder{obj: ro, resourceIf: rolloutIf}, nil
+	}
+	name, ok := workloadRef["name"].(string)
+	if !ok {
+		return nil, fmt.Errorf("spec.workloadRef.name is not a string: %v", workloadRef["name"])
+	}
+	apiVersion, _ := workloadRef["apiVersion"].(string)
+	kind, _ := workloadRef["kind"].(string)
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil {
+		return nil, err
+	}
+	resource, ok := workloadRefResources[schema.GroupKind{Group: gv.Group, Kind: kind}]
+	if !ok {
+		return nil, fmt.Errorf("workloadRef of kind %s is not supported", kind)
+	}
+	resourceIf := dynamicClient.Resource(gv.WithResource(resource)).Namespace(namespace)
+	obj, err := resourceIf.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &podTemplateHolder{obj: obj, resourceIf: resourceIf}, nil
+}
+
+// updatePodSpec applies a change to the pod spec of a rollout, in the rollout itself or in the
+// workload referenced by its workloadRef. It returns the object holding the pod template before and
+// after the change. With dryRun, the changed object is not sent to the API server.
+func updatePodSpec(dynamicClient dynamic.Interface, namespace, rollout string, dryRun bool, mutate func(podSpec map[string]interface{}) error) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	ctx := context.TODO()
+	holder, err := getPodTemplateHolder(ctx, dynamicClient, namespace, rollout)
+	if err != nil {
+		return nil, nil, err
+	}
+	newObj := holder.obj.DeepCopy()
+	templatePath := podTemplatePath(newObj.GetKind())
+	podSpec, ok, err := unstructured.NestedFieldNoCopy(newObj.Object, append(templatePath, "spec")...)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !ok {
+		return nil, nil, fmt.Errorf("%s of %s \"%s\" is not set", strings.Join(templatePath, "."), strings.ToLower(newObj.GetKind()), newObj.GetName())
+	}
+	podSpecMap, ok := podSpec.(map[string]interface{})
+	if !ok {
+		return nil, nil, fmt.Errorf("%s.spec is not an object", strings.Join(templatePath, "."))
+	}
+	err = mutate(podSpecMap)
+	if err != nil {
+		return nil, nil, err
+	}
+	if dryRun {
+		return holder.obj, newObj, nil
+	}
+	updated, err := holder.resourceIf.Update(ctx, newObj, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, nil, err
+	}
+	return holder.obj, updated, nil
+}
+
+// updatePodSpecWithRetries calls updatePodSpec until it does not fail because of a conflict
+func updatePodSpecWithRetries(dynamicClient dynamic.Interface, namespace, rollout string, dryRun bool, mutate func(podSpec map[string]interface{}) error) (*unstructured.Unstructured, *unstructured.Unstructured, error) {
+	var orig, updated *unstructured.Unstructured
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		orig, updated, err = updatePodSpec(dynamicClient, namespace, rollout, dryRun, mutate)
+		if err == nil || !k8serr.IsConflict(err) {
+			break
+		}
+	}
+	return orig, updated, err
+}
+
+// printPodTemplateDiff writes the unified diff between the pod templates of two versions of the
+// object holding the pod template of a rollout
+func printPodTemplateDiff(out io.Writer, orig, updated *unstructured.Unstructured) error {
+	templatePath := podTemplatePath(orig.GetKind())
+	toYAML := func(obj *unstructured.Unstructured) (string, error) {
+		template, _, err := unstructured.NestedFieldNoCopy(obj.Object, templatePath...)
+		if err != nil {
+			return "", err
+		}
+		templateYAML, err := yaml.Marshal(template)
+		return string(templateYAML), err
+	}
+	origYAML, err := toYAML(orig)
+	if err != nil {
+		return err
+	}
+	updatedYAML, err := toYAML(updated)
+	if err != nil {
+		return err
+	}
+	name := fmt.Sprintf("%s/%s %s", strings.ToLower(orig.GetKind()), orig.GetName(), strings.Join(templatePath, "."))
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(origYAML),
+		B:        difflib.SplitLines(updatedYAML),
+		FromFile: name,
+		ToFile:   name,
+		Context:  3,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(out, diff)
+	return err
+}
+
+// printSetResult prints the outcome of a set command, preceded in dry run by the diff of the pod
+// template which would be applied
+func printSetResult(out io.Writer, orig, updated *unstructured.Unstructured, field string, dryRun bool) error {
+	if !dryRun {
+		fmt.Fprintf(out, "%s \"%s\" %s updated\n", strings.ToLower(updated.GetKind()), updated.GetName(), field)
+		return nil
+	}
+	err := printPodTemplateDiff(out, orig, updated)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s \"%s\" %s updated (dry run)\n", strings.ToLower(updated.GetKind()), updated.GetName(), field)
+	return nil
+}
+
+// forEachContainer calls fn on the containers and init containers of a pod spec which are selected
+// by name, or on all of them when the selected names include "*". It returns an error when no
+// container was selected.
+func forEachContainer(podSpec map[string]interface{}, names []string, fn func(ctr map[string]interface{}) error) error {
+	selected := func(name string) bool {
+		for _, n := range names {
+			if n == "*" || n == name {
+				return true
+			}
+		}
+		return false
+	}
+	containerFound := false
+	for _, field := range []string{"initContainers", "containers"} {
+		ctrList, ok := podSpec[field].([]interface{})
+		if !ok {
+			continue
+		}
+		for _, ctrIf := range ctrList {
+			ctr, ok := ctrIf.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if name, _, _ := unstructured.NestedString(ctr, "name"); !selected(name) {
+				continue
+			}
+			containerFound = true
+			if err := fn(ctr); err != nil {
+				return err
+			}
+		}
+	}
+	if !containerFound {
+		return fmt.Errorf("unable to find container named \"%s\"", strings.Join(names, ","))
+	}
+	return nil
+}
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/health"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/signals"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/viewcontroller"
 	"github.com/spf13/cobra"
@@ -60,10 +62,10 @@ func NewCmdStatus(o *options.ArgoRolloutsOptions) *cobra.Command {
 			}
 
 			if !statusOptions.Watch {
-				if ri.Status == "Healthy" || ri.Status == "Degraded" {
+				if health.Status(ri.Status).IsTerminal() {
 					fmt.Fprintln(o.Out, ri.Status)
 				} else {
-					fmt.Fprintf(o.Out, "%s - %s\n", ri.Status, ri.Message)
+					fmt.Fprintf(o.Out, "%s - %s%s\n", ri.Status, ri.Message, progressSuffix(ri))
 				}
 			} else {
 				rolloutUpdates := make(chan *rollout.RolloutInfo)
@@ -81,9 +83,9 @@ func NewCmdStatus(o *options.ArgoRolloutsOptions) *cobra.Command {
 				}
 			}
 
-			if ri.Status == "Degraded" {
+			if ri.Status == string(health.StatusDegraded) {
 				return fmt.Errorf("The rollout is in a degraded state with message: %s", ri.Message)
-			} else if ri.Status != "Healthy" && statusOptions.Watch {
+			} else if ri.Status != string(health.StatusHealthy) && statusOptions.Watch {
 				return fmt.Errorf("Rollout status watch exceeded timeout")
 			}
 
@@ -112,9 +114,15 @@ func (o *StatusOptions) WatchStatus(stopCh <-chan struct{}, rolloutUpdates <-cha
 		if roInfo.Message != "" {
 			message = fmt.Sprintf("%s - %s", roInfo.Status, roInfo.Message)
 		}
-		if message != prevMessage {
-			fmt.Fprintln(o.Out, message)
-			prevMessage = message
+		// the estimated time to completion counts down, so the status is only printed again when the
+		// percentage or the reason the completion time cannot be estimated changes
+		key := message
+		if roInfo.Progress != nil {
+			key = fmt.Sprintf("%s %d %s", message, roInfo.Progress.Percent, roInfo.Progress.Message)
+		}
+		if key != prevMessage {
+			fmt.Fprintln(o.Out, message+progressSuffix(&roInfo))
+			prevMessage = key
 		}
 	}
 
@@ -123,7 +131,7 @@ func (o *StatusOptions) WatchStatus(stopCh <-chan struct{}, rolloutUpdates <-cha
 		case roInfo = <-rolloutUpdates:
 			if roInfo != nil {
 				printStatus(*roInfo)
-				if roInfo.Status == "Healthy" || roInfo.Status == "Degraded" {
+				if health.Status(roInfo.Status).IsTerminal() {
 					return roInfo.Status
 				}
 			}
@@ -134,3 +142,11 @@ func (o *StatusOptions) WatchStatus(stopCh <-chan struct{}, rolloutUpdates <-cha
 		}
 	}
 }
+
+// progressSuffix returns the estimated progress of the update in progress to append to its status
+func progressSuffix(roInfo *rollout.RolloutInfo) string {
+	if roInfo.Progress == nil {
+		return ""
+	}
+	return fmt.Sprintf(" [%s]", info.Progress(roInfo.Progress))
+}
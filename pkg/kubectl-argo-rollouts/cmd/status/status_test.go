@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info/testdata"
 	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
 	"github.com/stretchr/testify/assert"
@@ -70,6 +71,23 @@ func TestStatusBlueGreenRollout(t *testing.T) {
 	assert.Empty(t, stderr)
 }
 
+func TestStatusBlueGreenRolloutProgress(t *testing.T) {
+	rolloutObjs := testdata.NewBlueGreenRollout()
+	rolloutObjs.Rollouts[0].Status.Progress = &v1alpha1.RolloutProgress{Percent: 33, Message: "Waiting for a manual promotion"}
+
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdStatus(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name, noWatch})
+	err := cmd.Execute()
+
+	assert.NoError(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Equal(t, "Paused - BlueGreenPause [33% (Waiting for a manual promotion)]\n", stdout)
+}
+
 func TestStatusInvalidRollout(t *testing.T) {
 	rolloutObjs := testdata.NewInvalidRollout()
 
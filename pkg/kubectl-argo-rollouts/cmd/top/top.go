@@ -0,0 +1,313 @@
+package top
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/signals"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+)
+
+const (
+	topLong = `Show the CPU and memory usage of the pods of a rollout, as reported by the Metrics API. The
+usage is totaled per role (e.g. stable, canary, active, preview), averaged per pod, and the average
+usage of each role is compared with the one of the stable pods.`
+	topExample = `
+	# Show the resource usage of the stable and canary pods of a rollout
+	%[1]s top guestbook
+
+	# Watch the resource usage of the pods of a rollout, refreshed every 10 seconds
+	%[1]s top guestbook -w --interval 10s`
+
+	headerFmtString = "ROLE\tREPLICASETS\tPODS\tCPU\tMEMORY\tCPU/POD\tMEMORY/POD\tCPU/POD VS STABLE\tMEMORY/POD VS STABLE\n"
+
+	// RoleOld is the role of the ReplicaSets of a rollout which are neither stable, canary, active nor preview
+	RoleOld = "old"
+	// unknown is printed in place of a usage which cannot be computed
+	unknown = "-"
+)
+
+// roleOrder is the order in which the roles are printed
+var roleOrder = []string{"stable", "active", "canary", "preview", RoleOld}
+
+type TopOptions struct {
+	Watch    bool
+	Interval time.Duration
+
+	options.ArgoRolloutsOptions
+}
+
+// RoleUsage is the resource usage of the pods of the ReplicaSets of a rollout with the same role
+type RoleUsage struct {
+	Role string
+	// Stable indicates if the role is the one of the stable ReplicaSet
+	Stable      bool
+	ReplicaSets []string
+	// Pods is the number of pods of the role
+	Pods int
+	// MeasuredPods is the number of pods of the role reported by the Metrics API
+	MeasuredPods int
+	// MilliCPU is the total CPU usage of the measured pods, in millicores
+	MilliCPU int64
+	// Memory is the total memory usage of the measured pods, in bytes
+	Memory int64
+}
+
+// AverageMilliCPU returns the average CPU usage of the measured pods, in millicores
+func (u *RoleUsage) AverageMilliCPU() int64 {
+	return u.MilliCPU / int64(u.MeasuredPods)
+}
+
+// AverageMemory returns the average memory usage of the measured pods, in bytes
+func (u *RoleUsage) AverageMemory() int64 {
+	return u.Memory / int64(u.MeasuredPods)
+}
+
+// NewCmdTop returns a new instance of a `rollouts top` command
+func NewCmdTop(o *options.ArgoRolloutsOptions) *cobra.Command {
+	topOptions := TopOptions{
+		ArgoRolloutsOptions: *o,
+	}
+
+	var cmd = &cobra.Command{
+		Use:          "top ROLLOUT_NAME",
+		Short:        "Show the resource usage of the pods of a rollout",
+		Long:         topLong,
+		Example:      o.Example(topExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 1 || topOptions.Interval <= 0 {
+				return o.UsageErr(c)
+			}
+			name := args[0]
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if !topOptions.Watch {
+				usages, err := topOptions.GetRolloutUsage(ctx, name)
+				if err != nil {
+					return err
+				}
+				topOptions.PrintUsageTable(usages)
+				return nil
+			}
+			signals.SetupSignalHandler(cancel)
+			topOptions.WatchRolloutUsage(ctx, name)
+			return nil
+		},
+	}
+	cmd.Flags().BoolVarP(&topOptions.Watch, "watch", "w", false, "Watch the resource usage of the pods")
+	cmd.Flags().DurationVar(&topOptions.Interval, "interval", 5*time.Second, "Interval between refreshes of the resource usage in watch mode")
+	return cmd
+}
+
+// replicaSetRole returns the role of a ReplicaSet of a rollout
+func replicaSetRole(rsInfo *rollout.ReplicaSetInfo) string {
+	switch {
+	case rsInfo.Active:
+		return "active"
+	case rsInfo.Preview:
+		return "preview"
+	case rsInfo.Stable:
+		return "stable"
+	case rsInfo.Canary:
+		return "canary"
+	}
+	return RoleOld
+}
+
+// rolloutSelector returns the selector of the pods of a rollout, which is the one of the referenced
+// Deployment when the rollout references a workload
+func (o *TopOptions) rolloutSelector(ctx context.Context, ro *v1alpha1.Rollout) (*metav1.LabelSelector, error) {
+	if ro.Spec.WorkloadRef == nil {
+		return ro.Spec.Selector, nil
+	}
+	if ro.Spec.WorkloadRef.Kind != "Deployment" {
+		return nil, fmt.Errorf("workload of type %s is not supported", ro.Spec.WorkloadRef.Kind)
+	}
+	deployment, err := o.KubeClientset().AppsV1().Deployments(ro.Namespace).Get(ctx, ro.Spec.WorkloadRef.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return deployment.Spec.Selector, nil
+}
+
+// GetRolloutUsage returns the resource usage of the pods of the rollout, per role, in the order the
+// roles are printed. The roles without pods are omitted.
+func (o *TopOptions) GetRolloutUsage(ctx context.Context, name string) ([]*RoleUsage, error) {
+	namespace := o.Namespace()
+	ro, err := o.RolloutsClientset().ArgoprojV1alpha1().Rollouts(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	selector, err := o.rolloutSelector(ctx, ro)
+	if err != nil {
+		return nil, err
+	}
+	var listOpts metav1.ListOptions
+	if selector != nil {
+		listOpts.LabelSelector = metav1.FormatLabelSelector(selector)
+	}
+	rsList, err := o.KubeClientset().AppsV1().ReplicaSets(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+	podList, err := o.KubeClientset().CoreV1().Pods(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, err
+	}
+	metricsList, err := o.MetricsClientset().MetricsV1beta1().PodMetricses(namespace).List(ctx, listOpts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the metrics of the pods: %w", err)
+	}
+
+	var allRSs []*appsv1.ReplicaSet
+	for i := range rsList.Items {
+		allRSs = append(allRSs, &rsList.Items[i])
+	}
+	var allPods []*corev1.Pod
+	for i := range podList.Items {
+		allPods = append(allPods, &podList.Items[i])
+	}
+	// usage of each pod, by pod name
+	podMilliCPU := make(map[string]int64)
+	podMemory := make(map[string]int64)
+	for _, podMetrics := range metricsList.Items {
+		for _, container := range podMetrics.Containers {
+			podMilliCPU[podMetrics.Name] += container.Usage.Cpu().MilliValue()
+			podMemory[podMetrics.Name] += container.Usage.Memory().Value()
+		}
+	}
+
+	usagesByRole := make(map[string]*RoleUsage)
+	for _, rsInfo := range info.GetReplicaSetInfo(ro.UID, ro, allRSs, allPods) {
+		if len(rsInfo.Pods) == 0 {
+			continue
+		}
+		role := replicaSetRole(rsInfo)
+		usage, ok := usagesByRole[role]
+		if !ok {
+			usage = &RoleUsage{Role: role}
+			usagesByRole[role] = usage
+		}
+		usage.Stable = usage.Stable || rsInfo.Stable
+		usage.ReplicaSets = append(usage.ReplicaSets, rsInfo.ObjectMeta.Name)
+		for _, pod := range rsInfo.Pods {
+			usage.Pods++
+			if _, ok := podMilliCPU[pod.ObjectMeta.Name]; ok {
+				usage.MeasuredPods++
+				usage.MilliCPU += podMilliCPU[pod.ObjectMeta.Name]
+				usage.Memory += podMemory[pod.ObjectMeta.Name]
+			}
+		}
+	}
+	var usages []*RoleUsage
+	for _, role := range roleOrder {
+		if usage, ok := usagesByRole[role]; ok {
+			sort.Strings(usage.ReplicaSets)
+			usages = append(usages, usage)
+		}
+	}
+	return usages, nil
+}
+
+func formatMilliCPU(milliCPU int64) string {
+	return fmt.Sprintf("%dm", milliCPU)
+}
+
+func formatMemory(memory int64) string {
+	return fmt.Sprintf("%dMi", memory/(1024*1024))
+}
+
+// formatDelta formats the difference between a usage and the one of the stable pods, with the
+// relative difference when the stable usage is not zero
+func formatDelta(value, stable int64, format func(int64) string) string {
+	delta := format(value - stable)
+	if !strings.HasPrefix(delta, "-") {
+		delta = "+" + delta
+	}
+	if stable == 0 {
+		return delta
+	}
+	return fmt.Sprintf("%s (%+d%%)", delta, (value-stable)*100/stable)
+}
+
+// PrintUsageTable prints the resource usage of the pods of a rollout in table format
+func (o *TopOptions) PrintUsageTable(usages []*RoleUsage) {
+	if len(usages) == 0 {
+		fmt.Fprintln(o.ErrOut, "No pods found.")
+		return
+	}
+	var stable *RoleUsage
+	for _, usage := range usages {
+		if usage.Stable && usage.MeasuredPods > 0 {
+			stable = usage
+		}
+	}
+	w := tabwriter.NewWriter(o.Out, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, headerFmtString)
+	for _, usage := range usages {
+		columns := []string{
+			usage.Role,
+			strings.Join(usage.ReplicaSets, ","),
+			fmt.Sprintf("%d/%d", usage.MeasuredPods, usage.Pods),
+		}
+		if usage.MeasuredPods == 0 {
+			columns = append(columns, unknown, unknown, unknown, unknown, unknown, unknown)
+		} else {
+			columns = append(columns,
+				formatMilliCPU(usage.MilliCPU),
+				formatMemory(usage.Memory),
+				formatMilliCPU(usage.AverageMilliCPU()),
+				formatMemory(usage.AverageMemory()),
+			)
+			if stable == nil || usage == stable {
+				columns = append(columns, unknown, unknown)
+			} else {
+				columns = append(columns,
+					formatDelta(usage.AverageMilliCPU(), stable.AverageMilliCPU(), formatMilliCPU),
+					formatDelta(usage.AverageMemory(), stable.AverageMemory(), formatMemory),
+				)
+			}
+		}
+		fmt.Fprintln(w, strings.Join(columns, "\t"))
+	}
+	_ = w.Flush()
+}
+
+// WatchRolloutUsage prints the resource usage of the pods of a rollout every interval until the
+// context is done. The errors to get the usage are printed in place of the usage and retried.
+func (o *TopOptions) WatchRolloutUsage(ctx context.Context, name string) {
+	ticker := time.NewTicker(o.Interval)
+	defer ticker.Stop()
+	for {
+		usages, err := o.GetRolloutUsage(ctx, name)
+		o.Clear()
+		if err != nil {
+			fmt.Fprintf(o.Out, "Error: %v\n", err)
+		} else {
+			o.PrintUsageTable(usages)
+		}
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (o *TopOptions) Clear() {
+	fmt.Fprint(o.Out, "\033[H\033[2J")
+	fmt.Fprint(o.Out, "\033[0;0H")
+}
@@ -0,0 +1,229 @@
+package top
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/info/testdata"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+)
+
+func newPodMetrics(pod *corev1.Pod, cpu, memory string) *metricsv1beta1.PodMetrics {
+	return &metricsv1beta1.PodMetrics{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pod.Name,
+			Namespace: pod.Namespace,
+			Labels:    pod.Labels,
+		},
+		Containers: []metricsv1beta1.ContainerMetrics{{
+			Name: pod.Spec.Containers[0].Name,
+			Usage: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(cpu),
+				corev1.ResourceMemory: resource.MustParse(memory),
+			},
+		}},
+	}
+}
+
+// newCanaryRolloutObjects returns the objects of the canary rollout, with the metrics of its canary
+// pod and of all its stable pods but one
+func newCanaryRolloutObjects() (*testdata.RolloutObjects, []runtime.Object) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	objs := rolloutObjs.AllObjects()
+	for _, pod := range rolloutObjs.Pods {
+		switch pod.Labels["rollouts-pod-template-hash"] {
+		case rolloutObjs.Rollouts[0].Status.CurrentPodHash:
+			objs = append(objs, newPodMetrics(pod, "80m", "150Mi"))
+		case rolloutObjs.Rollouts[0].Status.StableRS:
+			if pod.Name != "canary-demo-877894d5b-jw5qm" {
+				objs = append(objs, newPodMetrics(pod, "50m", "100Mi"))
+			}
+		}
+	}
+	return rolloutObjs, objs
+}
+
+func TestTopUsage(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdTop(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{})
+	err := cmd.Execute()
+	assert.Error(t, err)
+}
+
+func TestTopInvalidInterval(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	for _, interval := range []string{"0", "-5s"} {
+		cmd := NewCmdTop(o)
+		cmd.PersistentPreRunE = o.PersistentPreRunE
+		cmd.SetArgs([]string{"guestbook", "-w", "--interval", interval})
+		err := cmd.Execute()
+		assert.Error(t, err)
+	}
+	assert.Empty(t, o.Out.(*bytes.Buffer).String())
+}
+
+func TestTopRolloutNotFound(t *testing.T) {
+	tf, o := options.NewFakeArgoRolloutsOptions()
+	defer tf.Cleanup()
+	cmd := NewCmdTop(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{"does-not-exist"})
+	err := cmd.Execute()
+
+	assert.Error(t, err)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Empty(t, stdout)
+	assert.Equal(t, "Error: rollouts.argoproj.io \"does-not-exist\" not found\n", stderr)
+}
+
+func TestTopCanaryRollout(t *testing.T) {
+	rolloutObjs, objs := newCanaryRolloutObjects()
+	tf, o := options.NewFakeArgoRolloutsOptions(objs...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdTop(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := `ROLE    REPLICASETS             PODS  CPU   MEMORY  CPU/POD  MEMORY/POD  CPU/POD VS STABLE  MEMORY/POD VS STABLE
+stable  canary-demo-877894d5b   4/5   200m  400Mi   50m      100Mi       -                  -
+canary  canary-demo-65fb5ffc84  1/1   80m   150Mi   80m      150Mi       +30m (+60%)        +50Mi (+50%)
+`
+	assert.Equal(t, expectedOut, o.Out.(*bytes.Buffer).String())
+	assert.Empty(t, o.ErrOut.(*bytes.Buffer).String())
+}
+
+func TestTopWorkloadRefRollout(t *testing.T) {
+	rolloutObjs, objs := newCanaryRolloutObjects()
+	ro := rolloutObjs.Rollouts[0]
+	selector := metav1.FormatLabelSelector(ro.Spec.Selector)
+	objs = append(objs, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "canary-demo", Namespace: ro.Namespace},
+		Spec:       appsv1.DeploymentSpec{Selector: ro.Spec.Selector},
+	})
+	ro.Spec.WorkloadRef = &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "canary-demo"}
+	ro.Spec.Selector = nil
+	tf, o := options.NewFakeArgoRolloutsOptions(objs...)
+	o.RESTClientGetter = tf.WithNamespace(ro.Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdTop(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{ro.Name})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := `ROLE    REPLICASETS             PODS  CPU   MEMORY  CPU/POD  MEMORY/POD  CPU/POD VS STABLE  MEMORY/POD VS STABLE
+stable  canary-demo-877894d5b   4/5   200m  400Mi   50m      100Mi       -                  -
+canary  canary-demo-65fb5ffc84  1/1   80m   150Mi   80m      150Mi       +30m (+60%)        +50Mi (+50%)
+`
+	assert.Equal(t, expectedOut, o.Out.(*bytes.Buffer).String())
+
+	// the pods are listed with the selector of the referenced Deployment rather than all the pods
+	// of the namespace
+	for _, action := range append(o.KubeClient.(*k8sfake.Clientset).Actions(), o.MetricsClient.(*metricsfake.Clientset).Actions()...) {
+		if listAction, ok := action.(k8stesting.ListAction); ok {
+			assert.Equal(t, selector, listAction.GetListRestrictions().Labels.String())
+		}
+	}
+}
+
+func TestTopWorkloadRefNotSupported(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	ro := rolloutObjs.Rollouts[0]
+	ro.Spec.WorkloadRef = &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "canary-demo"}
+	ro.Spec.Selector = nil
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(ro.Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdTop(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{ro.Name})
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "workload of type StatefulSet is not supported")
+	assert.Empty(t, o.Out.(*bytes.Buffer).String())
+}
+
+func TestTopCanaryRolloutWithoutMetrics(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	cmd := NewCmdTop(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name})
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	expectedOut := `ROLE    REPLICASETS             PODS  CPU  MEMORY  CPU/POD  MEMORY/POD  CPU/POD VS STABLE  MEMORY/POD VS STABLE
+stable  canary-demo-877894d5b   0/5   -    -       -        -           -                  -
+canary  canary-demo-65fb5ffc84  0/1   -    -       -        -           -                  -
+`
+	assert.Equal(t, expectedOut, o.Out.(*bytes.Buffer).String())
+}
+
+func TestTopMetricsAPIError(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	tf, o := options.NewFakeArgoRolloutsOptions(rolloutObjs.AllObjects()...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	o.MetricsClient.(*metricsfake.Clientset).PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("the server could not find the requested resource")
+	})
+	cmd := NewCmdTop(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{rolloutObjs.Rollouts[0].Name})
+	err := cmd.Execute()
+
+	assert.EqualError(t, err, "failed to get the metrics of the pods: the server could not find the requested resource")
+	assert.Empty(t, o.Out.(*bytes.Buffer).String())
+}
+
+func TestWatchRolloutUsage(t *testing.T) {
+	rolloutObjs, objs := newCanaryRolloutObjects()
+	tf, o := options.NewFakeArgoRolloutsOptions(objs...)
+	o.RESTClientGetter = tf.WithNamespace(rolloutObjs.Rollouts[0].Namespace)
+	defer tf.Cleanup()
+	topOptions := TopOptions{
+		Interval:            time.Second,
+		ArgoRolloutsOptions: *o,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	// the usage is printed once before the context is checked
+	cancel()
+	topOptions.WatchRolloutUsage(ctx, rolloutObjs.Rollouts[0].Name)
+
+	stdout := o.Out.(*bytes.Buffer).String()
+	assert.Contains(t, stdout, "\033[H\033[2J")
+	assert.Contains(t, stdout, "canary  canary-demo-65fb5ffc84  1/1   80m   150Mi   80m      150Mi       +30m (+60%)        +50Mi (+50%)\n")
+}
+
+func TestFormatDelta(t *testing.T) {
+	assert.Equal(t, "+30m (+60%)", formatDelta(80, 50, formatMilliCPU))
+	assert.Equal(t, "-25m (-50%)", formatDelta(25, 50, formatMilliCPU))
+	assert.Equal(t, "+0m (+0%)", formatDelta(50, 50, formatMilliCPU))
+	assert.Equal(t, "+10m", formatDelta(10, 0, formatMilliCPU))
+	assert.Equal(t, "-50Mi (-33%)", formatDelta(100*1024*1024, 150*1024*1024, formatMemory))
+}
@@ -7,9 +7,11 @@ import (
 	"strconv"
 
 	"github.com/spf13/cobra"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
@@ -17,6 +19,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	historyutil "github.com/argoproj/argo-rollouts/utils/history"
 	routils "github.com/argoproj/argo-rollouts/utils/unstructured"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -74,7 +77,7 @@ func RunUndoRollout(rolloutIf dynamic.ResourceInterface, c kubernetes.Interface,
 	if err != nil {
 		return "", err
 	}
-	rsForRevision, err := rolloutRevision(ro, c, toRevision)
+	rsForRevision, restore, err := rolloutRevision(ro, c, toRevision)
 	if err != nil {
 		return "", err
 	}
@@ -87,6 +90,15 @@ func RunUndoRollout(rolloutIf dynamic.ResourceInterface, c kubernetes.Interface,
 		return fmt.Sprintf("skipped rollback (current template already matches revision %d)", toRevision), nil
 	}
 
+	if restore {
+		// the ReplicaSet of the revision was deleted, recreate it from its revision snapshot so
+		// the rollout goes back to the same pod template hash
+		_, err = c.AppsV1().ReplicaSets(rsForRevision.Namespace).Create(ctx, rsForRevision, metav1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			return "", fmt.Errorf("failed restoring revision %d: %v", toRevision, err)
+		}
+	}
+
 	// remove hash label before patching back into the rollout
 	delete(rsForRevision.Spec.Template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
 
@@ -131,10 +143,13 @@ func undoWorkloadRef(c kubernetes.Interface, rollout *v1alpha1.Rollout, patchTyp
 	return err
 }
 
-func rolloutRevision(ro *unstructured.Unstructured, c kubernetes.Interface, toRevision int64) (*appsv1.ReplicaSet, error) {
+// rolloutRevision returns the ReplicaSet of the revision to roll back to. If the ReplicaSet no longer
+// exists, it is built from the revision snapshot of the revision and true is returned to indicate
+// that it has to be created.
+func rolloutRevision(ro *unstructured.Unstructured, c kubernetes.Interface, toRevision int64) (*appsv1.ReplicaSet, bool, error) {
 	allRSs, err := getAllReplicaSets(ro, c.AppsV1())
 	if err != nil {
-		return nil, fmt.Errorf("failed to retrieve replica sets from rollout %s: %v", ro.GetName(), err)
+		return nil, false, fmt.Errorf("failed to retrieve replica sets from rollout %s: %v", ro.GetName(), err)
 	}
 	var (
 		latestReplicaSet   *appsv1.ReplicaSet
@@ -157,20 +172,68 @@ func rolloutRevision(ro *unstructured.Unstructured, c kubernetes.Interface, toRe
 					previousReplicaSet = rs
 				}
 			} else if toRevision == v {
-				return rs, nil
+				return rs, false, nil
 			}
 		}
 	}
 
 	if toRevision > 0 {
-		return nil, fmt.Errorf("unable to find specified revision %v in history", toRevision)
+		rs, err := replicaSetFromSnapshot(ro, c, func(cr *appsv1.ControllerRevision) bool {
+			return cr.Revision == toRevision
+		})
+		if err != nil {
+			return nil, false, err
+		}
+		if rs == nil {
+			return nil, false, fmt.Errorf("unable to find specified revision %v in history", toRevision)
+		}
+		return rs, true, nil
 	}
 
 	if previousReplicaSet == nil {
-		return nil, fmt.Errorf("no revision found for rollout %q", ro.GetName())
+		if latestReplicaSet != nil {
+			rs, err := replicaSetFromSnapshot(ro, c, func(cr *appsv1.ControllerRevision) bool {
+				return cr.Revision < latestRevision
+			})
+			if err != nil {
+				return nil, false, err
+			}
+			if rs != nil {
+				return rs, true, nil
+			}
+		}
+		return nil, false, fmt.Errorf("no revision found for rollout %q", ro.GetName())
 	}
 
-	return previousReplicaSet, nil
+	return previousReplicaSet, false, nil
+}
+
+// replicaSetFromSnapshot builds a ReplicaSet from the latest revision snapshot of the rollout which
+// matches the filter. Returns nil if there is no such snapshot.
+func replicaSetFromSnapshot(ro *unstructured.Unstructured, c kubernetes.Interface, filter func(cr *appsv1.ControllerRevision) bool) (*appsv1.ReplicaSet, error) {
+	rollout := routils.ObjectToRollout(ro)
+	if rollout == nil {
+		return nil, fmt.Errorf("Invalid rollout object")
+	}
+	selector := labels.SelectorFromSet(labels.Set{v1alpha1.RevisionSnapshotLabelKey: rollout.Name})
+	crList, err := c.AppsV1().ControllerRevisions(rollout.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve revision snapshots from rollout %s: %v", rollout.Name, err)
+	}
+	var crs []*appsv1.ControllerRevision
+	for i := range crList.Items {
+		crs = append(crs, &crList.Items[i])
+	}
+	var snapshot *appsv1.ControllerRevision
+	for _, cr := range historyutil.FilterSnapshots(rollout, crs) {
+		if filter(cr) && (snapshot == nil || snapshot.Revision < cr.Revision) {
+			snapshot = cr
+		}
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+	return historyutil.NewReplicaSetFromSnapshot(rollout, snapshot)
 }
 
 func getRolloutPatch(podTemplate *corev1.PodTemplateSpec, annotations map[string]string) (types.PatchType, []byte, error) {
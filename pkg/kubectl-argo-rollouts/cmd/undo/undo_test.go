@@ -18,6 +18,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	historyutil "github.com/argoproj/argo-rollouts/utils/history"
 )
 
 func TestUndoCmdUsage(t *testing.T) {
@@ -119,6 +120,64 @@ func TestUndoCmdToRevision(t *testing.T) {
 	assert.Empty(t, stderr)
 }
 
+func TestUndoCmdToRevisionFromSnapshot(t *testing.T) {
+	rolloutObjs := testdata.NewCanaryRollout()
+	ro := rolloutObjs.Rollouts[0]
+	// the ReplicaSet of revision 29 was deleted, but its revision snapshot is left
+	var deletedRS *v1.ReplicaSet
+	var replicaSets []*v1.ReplicaSet
+	for _, rs := range rolloutObjs.ReplicaSets {
+		if rs.Name == "canary-demo-859c99b45c" {
+			deletedRS = rs
+			continue
+		}
+		replicaSets = append(replicaSets, rs)
+	}
+	rolloutObjs.ReplicaSets = replicaSets
+	snapshot, err := historyutil.NewSnapshot(ro, deletedRS)
+	assert.NoError(t, err)
+
+	tf, o := options.NewFakeArgoRolloutsOptions(append(rolloutObjs.AllObjects(), snapshot)...)
+	o.RESTClientGetter = tf.WithNamespace(ro.Namespace)
+	defer tf.Cleanup()
+	fakeClient := o.DynamicClient.(*dynamicfake.FakeDynamicClient)
+	fakeClient.PrependReactor("patch", "*", func(action kubetesting.Action) (handled bool, ret runtime.Object, err error) {
+		if patchAction, ok := action.(kubetesting.PatchAction); ok {
+			type patch struct {
+				Value corev1.PodTemplateSpec `json:"value"`
+			}
+			patchRo := []patch{}
+			err := json.Unmarshal(patchAction.GetPatch(), &patchRo)
+			if err != nil {
+				panic(err)
+			}
+			ro.Spec.Template = patchRo[0].Value
+		}
+		return true, ro, nil
+	})
+
+	cmd := NewCmdUndo(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs([]string{ro.Name, "--to-revision=29"})
+
+	err = cmd.Execute()
+	assert.Nil(t, err)
+
+	restoredRS, err := o.KubeClient.AppsV1().ReplicaSets(ro.Namespace).Get(context.TODO(), deletedRS.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.True(t, metav1.IsControlledBy(restoredRS, ro))
+	assert.Equal(t, int32(0), *restoredRS.Spec.Replicas)
+	assert.Equal(t, deletedRS.Spec.Template, restoredRS.Spec.Template)
+	assert.Equal(t, deletedRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], restoredRS.Spec.Selector.MatchLabels[v1alpha1.DefaultRolloutUniqueLabelKey])
+
+	delete(deletedRS.Spec.Template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+	assert.Equal(t, deletedRS.Spec.Template, ro.Spec.Template)
+	stdout := o.Out.(*bytes.Buffer).String()
+	stderr := o.ErrOut.(*bytes.Buffer).String()
+	assert.Equal(t, fmt.Sprintf("rollout '%s' undo\n", ro.Name), stdout)
+	assert.Empty(t, stderr)
+}
+
 func TestUndoCmdToRevisionOfWorkloadRef(t *testing.T) {
 
 	roTests := []struct {
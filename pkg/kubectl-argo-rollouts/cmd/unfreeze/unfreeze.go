@@ -0,0 +1,75 @@
+package unfreeze
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/freeze"
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options"
+	freezeutil "github.com/argoproj/argo-rollouts/utils/freeze"
+)
+
+const (
+	unfreezeExample = `
+  # Unfreeze the rollouts of the current namespace
+  %[1]s unfreeze
+
+  # Unfreeze the rollouts of the frontend tier in all namespaces
+  %[1]s unfreeze --all-namespaces --selector tier=frontend
+
+  # Lift all emergency stops
+  %[1]s unfreeze --all`
+
+	unfreezeUsage = `This command lifts the emergency stop of the scope selected by the flags, which must match the scope
+the rollouts were frozen with. The held rollouts resume their update, unless another emergency stop
+still holds them.`
+)
+
+// NewCmdUnfreeze returns a new instance of an `rollouts unfreeze` command
+func NewCmdUnfreeze(o *options.ArgoRolloutsOptions) *cobra.Command {
+	freezeOptions := freeze.FreezeOptions{}
+	var all bool
+	var cmd = &cobra.Command{
+		Use:          "unfreeze",
+		Short:        "Lift an emergency stop of rollouts",
+		Long:         unfreezeUsage,
+		Example:      o.Example(unfreezeExample),
+		SilenceUsage: true,
+		RunE: func(c *cobra.Command, args []string) error {
+			if len(args) != 0 {
+				return o.UsageErr(c)
+			}
+			scope, err := freezeOptions.Scope(o)
+			if err != nil {
+				return err
+			}
+			var lifted []freezeutil.Freeze
+			err = freeze.UpdateFreezes(o.KubeClientset(), freezeOptions.ControllerNamespace, func(freezes []freezeutil.Freeze) ([]freezeutil.Freeze, error) {
+				lifted = nil
+				var remaining []freezeutil.Freeze
+				for _, f := range freezes {
+					if all || f.SameScope(scope) {
+						lifted = append(lifted, f)
+						continue
+					}
+					remaining = append(remaining, f)
+				}
+				if !all && len(lifted) == 0 {
+					return nil, fmt.Errorf("rollouts of %s are not frozen", scope.Scope())
+				}
+				return remaining, nil
+			})
+			if err != nil {
+				return err
+			}
+			for _, f := range lifted {
+				fmt.Fprintf(o.Out, "rollouts of %s unfrozen\n", f.Scope())
+			}
+			return nil
+		},
+	}
+	freezeOptions.AddScopeFlags(cmd)
+	cmd.Flags().BoolVar(&all, "all", false, "Lift all emergency stops")
+	return cmd
+}
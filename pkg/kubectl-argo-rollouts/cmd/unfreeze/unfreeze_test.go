@@ -0,0 +1,77 @@
+package unfreeze
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/cmd/freeze"
+	options "github.com/argoproj/argo-rollouts/pkg/kubectl-argo-rollouts/options/fake"
+	freezeutil "github.com/argoproj/argo-rollouts/utils/freeze"
+)
+
+func newFreezeConfigMap(t *testing.T) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      freezeutil.ConfigMapName,
+			Namespace: freeze.DefaultControllerNamespace,
+		},
+	}
+	assert.NoError(t, freezeutil.SetFreezes(cm, []freezeutil.Freeze{
+		{Namespace: "payments"},
+		{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}},
+	}))
+	return cm
+}
+
+func runUnfreeze(t *testing.T, namespace string, args ...string) ([]freezeutil.Freeze, string, error) {
+	tf, o := options.NewFakeArgoRolloutsOptions(newFreezeConfigMap(t))
+	defer tf.Cleanup()
+	o.RESTClientGetter = tf.WithNamespace(namespace)
+	cmd := NewCmdUnfreeze(o)
+	cmd.PersistentPreRunE = o.PersistentPreRunE
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+
+	cm, getErr := o.KubeClientset().CoreV1().ConfigMaps(freeze.DefaultControllerNamespace).Get(context.TODO(), freezeutil.ConfigMapName, metav1.GetOptions{})
+	assert.NoError(t, getErr)
+	freezes, getErr := freezeutil.GetFreezes(cm)
+	assert.NoError(t, getErr)
+	return freezes, o.Out.(*bytes.Buffer).String(), err
+}
+
+func TestUnfreezeCmd(t *testing.T) {
+	freezes, stdout, err := runUnfreeze(t, "payments")
+	assert.NoError(t, err)
+	if assert.Len(t, freezes, 1) {
+		assert.NotNil(t, freezes[0].Selector)
+	}
+	assert.Equal(t, "rollouts of namespace 'payments' unfrozen\n", stdout)
+}
+
+func TestUnfreezeCmdSelector(t *testing.T) {
+	freezes, stdout, err := runUnfreeze(t, "payments", "-A", "-l", "tier=frontend")
+	assert.NoError(t, err)
+	if assert.Len(t, freezes, 1) {
+		assert.Equal(t, "payments", freezes[0].Namespace)
+	}
+	assert.Equal(t, "rollouts of all namespaces with labels 'tier=frontend' unfrozen\n", stdout)
+}
+
+func TestUnfreezeCmdAll(t *testing.T) {
+	freezes, stdout, err := runUnfreeze(t, "payments", "--all")
+	assert.NoError(t, err)
+	assert.Empty(t, freezes)
+	assert.Contains(t, stdout, "rollouts of namespace 'payments' unfrozen\n")
+	assert.Contains(t, stdout, "rollouts of all namespaces with labels 'tier=frontend' unfrozen\n")
+}
+
+func TestUnfreezeCmdNotFrozen(t *testing.T) {
+	freezes, _, err := runUnfreeze(t, "other")
+	assert.EqualError(t, err, "rollouts of namespace 'other' are not frozen")
+	assert.Len(t, freezes, 2)
+}
@@ -1,6 +1,7 @@
 package info
 
 import (
+	"fmt"
 	"strconv"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -44,6 +45,35 @@ func Age(m v1.ObjectMeta) string {
 	return duration.HumanDuration(timeutil.MetaNow().Sub(m.CreationTimestamp.Time))
 }
 
+// StepDuration returns how long the step of the record ran, or has been running for
+func StepDuration(stepRecord *v1alpha1.CanaryStepRecord) string {
+	finishedAt := timeutil.MetaNow()
+	if stepRecord.FinishedAt != nil {
+		finishedAt = *stepRecord.FinishedAt
+	}
+	return duration.HumanDuration(finishedAt.Sub(stepRecord.StartedAt.Time))
+}
+
+// Progress returns the percentage of the update which is complete, followed by its estimated time
+// to completion, or the reason it cannot be estimated
+func Progress(progress *v1alpha1.RolloutProgress) string {
+	if progress == nil {
+		return ""
+	}
+	progressStr := fmt.Sprintf("%d%%", progress.Percent)
+	if progress.Message != "" {
+		return fmt.Sprintf("%s (%s)", progressStr, progress.Message)
+	}
+	if progress.EstimatedCompletionTime != nil {
+		remaining := progress.EstimatedCompletionTime.Sub(timeutil.Now())
+		if remaining < 0 {
+			remaining = 0
+		}
+		return fmt.Sprintf("%s (ETA %s)", progressStr, duration.HumanDuration(remaining))
+	}
+	return progressStr
+}
+
 func ownerRef(ownerRefs []metav1.OwnerReference, uids []types.UID) *metav1.OwnerReference {
 	for _, ownerRef := range ownerRefs {
 		for _, uid := range uids {
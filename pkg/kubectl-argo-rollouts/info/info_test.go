@@ -169,3 +169,16 @@ func TestRolloutAborted(t *testing.T) {
 	assert.Equal(t, "Degraded", roInfo.Status)
 	assert.Equal(t, `RolloutAborted: metric "web" assessed Failed due to failed (1) > failureLimit (0)`, roInfo.Message)
 }
+
+func TestProgress(t *testing.T) {
+	now := time.Now()
+	timeutil.Now = func() time.Time { return now }
+	defer func() { timeutil.Now = time.Now }()
+
+	assert.Equal(t, "", Progress(nil))
+	completion := metav1.NewTime(now.Add(5 * time.Minute))
+	assert.Equal(t, "40% (ETA 5m)", Progress(&v1alpha1.RolloutProgress{Percent: 40, EstimatedCompletionTime: &completion}))
+	past := metav1.NewTime(now.Add(-time.Minute))
+	assert.Equal(t, "90% (ETA 0s)", Progress(&v1alpha1.RolloutProgress{Percent: 90, EstimatedCompletionTime: &past}))
+	assert.Equal(t, "20% (Step 2 waits for a manual promotion)", Progress(&v1alpha1.RolloutProgress{Percent: 20, Message: "Step 2 waits for a manual promotion"}))
+}
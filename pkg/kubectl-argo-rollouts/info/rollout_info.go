@@ -1,9 +1,9 @@
 package info
 
 import (
-	"fmt"
 	"sort"
 	"strconv"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -11,9 +11,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apiclient/rollout"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
-	"github.com/argoproj/argo-rollouts/utils/defaults"
-	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
-	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	"github.com/argoproj/argo-rollouts/pkg/health"
 )
 
 func NewRolloutInfo(
@@ -39,45 +37,28 @@ func NewRolloutInfo(
 	roInfo.Experiments = getExperimentInfo(ro, allExperiments, allReplicaSets, allARs, allPods)
 	roInfo.AnalysisRuns = getAnalysisRunInfo(ro.UID, allARs)
 
+	roHealth := health.RolloutHealth(ro, allReplicaSets, nil)
+	progress := roHealth.Progress
+	roInfo.Strategy = progress.Strategy
 	if ro.Spec.Strategy.Canary != nil {
-		roInfo.Strategy = "Canary"
-		if ro.Status.CurrentStepIndex != nil && len(ro.Spec.Strategy.Canary.Steps) > 0 {
-			roInfo.Step = fmt.Sprintf("%d/%d", *ro.Status.CurrentStepIndex, len(ro.Spec.Strategy.Canary.Steps))
+		roInfo.Step = progress.StepString()
+		if progress.Step != nil {
 			var steps []*v1alpha1.CanaryStep
 			for i := range ro.Spec.Strategy.Canary.Steps {
 				steps = append(steps, &ro.Spec.Strategy.Canary.Steps[i])
 			}
 			roInfo.Steps = steps
 		}
-		// NOTE that this is desired weight, not the actual current weight
-		roInfo.SetWeight = strconv.Itoa(int(replicasetutil.GetCurrentSetWeight(ro)))
-
-		roInfo.ActualWeight = "0"
-		currentStep, _ := replicasetutil.GetCurrentCanaryStep(ro)
-
-		if currentStep == nil {
-			roInfo.ActualWeight = "100"
-		} else if ro.Status.AvailableReplicas > 0 {
-			if ro.Spec.Strategy.Canary.TrafficRouting == nil {
-				for _, rs := range roInfo.ReplicaSets {
-					if rs.Canary {
-						roInfo.ActualWeight = fmt.Sprintf("%d", (rs.Available*100)/ro.Status.AvailableReplicas)
-					}
-				}
-			} else {
-				if ro.Status.Canary.Weights != nil {
-					roInfo.ActualWeight = fmt.Sprintf("%d", ro.Status.Canary.Weights.Canary.Weight)
-				} else {
-					roInfo.ActualWeight = roInfo.SetWeight
-				}
-			}
+		for i := range ro.Status.Canary.StepHistory {
+			roInfo.StepHistory = append(roInfo.StepHistory, &ro.Status.Canary.StepHistory[i])
 		}
-	} else if ro.Spec.Strategy.BlueGreen != nil {
-		roInfo.Strategy = "BlueGreen"
+		// NOTE that this is desired weight, not the actual current weight
+		roInfo.SetWeight = strconv.Itoa(int(*progress.SetWeight))
+		roInfo.ActualWeight = strconv.Itoa(int(*progress.ActualWeight))
 	}
-	phase, message := rolloututil.GetRolloutPhase(ro)
-	roInfo.Status = string(phase)
-	roInfo.Message = message
+	roInfo.Progress = ro.Status.Progress
+	roInfo.Status = string(roHealth.Status)
+	roInfo.Message = roHealth.Message
 	roInfo.Icon = rolloutIcon(roInfo.Status)
 	roInfo.Containers = []*rollout.ContainerInfo{}
 
@@ -97,14 +78,17 @@ func NewRolloutInfo(
 	} else {
 		roInfo.RestartedAt = "Never"
 	}
+	if ro.Spec.RestartSchedule != nil && ro.Status.NextScheduledRestartAt != nil {
+		roInfo.NextScheduledRestartAt = ro.Status.NextScheduledRestartAt.UTC().Format(time.RFC3339)
+	}
 
 	roInfo.Generation = ro.Status.ObservedGeneration
 
-	roInfo.Desired = defaults.GetReplicasOrDefault(ro.Spec.Replicas)
-	roInfo.Ready = ro.Status.ReadyReplicas
-	roInfo.Current = ro.Status.Replicas
-	roInfo.Updated = ro.Status.UpdatedReplicas
-	roInfo.Available = ro.Status.AvailableReplicas
+	roInfo.Desired = progress.Desired
+	roInfo.Ready = progress.Ready
+	roInfo.Current = progress.Current
+	roInfo.Updated = progress.Updated
+	roInfo.Available = progress.Available
 	return &roInfo
 }
 
@@ -116,6 +100,8 @@ func rolloutIcon(status string) string {
 		return IconWarning
 	case "Paused":
 		return IconPaused
+	case "Queued":
+		return IconWaiting
 	case "Healthy":
 		return IconOK
 	case "Degraded":
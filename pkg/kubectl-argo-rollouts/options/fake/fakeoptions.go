@@ -9,6 +9,8 @@ import (
 	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	cmdtesting "k8s.io/kubectl/pkg/cmd/testing"
+	metricsv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+	metricsfake "k8s.io/metrics/pkg/client/clientset/versioned/fake"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
@@ -26,6 +28,7 @@ func NewFakeArgoRolloutsOptions(obj ...runtime.Object) (*cmdtesting.TestFactory,
 	var rolloutObjs []runtime.Object
 	var kubeObjs []runtime.Object
 	var allObjs []runtime.Object
+	var podMetrics []*metricsv1beta1.PodMetrics
 
 	// Loop through supplied fake objects. Set TypeMeta if it wasn't set in the test
 	// so that the objects can also go into the fake dynamic client
@@ -61,6 +64,9 @@ func NewFakeArgoRolloutsOptions(obj ...runtime.Object) (*cmdtesting.TestFactory,
 				APIVersion: "argoproj.io/v1alpha1",
 			}
 			rolloutObjs = append(rolloutObjs, o)
+		case *metricsv1beta1.PodMetrics:
+			podMetrics = append(podMetrics, typedO)
+			continue
 		default:
 			kubeObjs = append(kubeObjs, o)
 		}
@@ -69,6 +75,16 @@ func NewFakeArgoRolloutsOptions(obj ...runtime.Object) (*cmdtesting.TestFactory,
 
 	o.RolloutsClient = fakeroclient.NewSimpleClientset(rolloutObjs...)
 	o.KubeClient = k8sfake.NewSimpleClientset(kubeObjs...)
+	// PodMetrics are served under the "pods" resource of the metrics API, which the object tracker
+	// cannot guess from their kind
+	metricsClient := metricsfake.NewSimpleClientset()
+	for _, m := range podMetrics {
+		err := metricsClient.Tracker().Create(metricsv1beta1.SchemeGroupVersion.WithResource("pods"), m, m.Namespace)
+		if err != nil {
+			panic(err)
+		}
+	}
+	o.MetricsClient = metricsClient
 	err := v1alpha1.AddToScheme(scheme.Scheme)
 	if err != nil {
 		panic(err)
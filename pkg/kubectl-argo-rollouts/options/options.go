@@ -14,6 +14,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+	metricsclientset "k8s.io/metrics/pkg/client/clientset/versioned"
 
 	roclientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 )
@@ -33,6 +34,7 @@ type ArgoRolloutsOptions struct {
 	RolloutsClient   roclientset.Interface
 	KubeClient       kubernetes.Interface
 	DynamicClient    dynamic.Interface
+	MetricsClient    metricsclientset.Interface
 
 	Log *log.Logger
 	genericclioptions.IOStreams
@@ -150,6 +152,23 @@ func (o *ArgoRolloutsOptions) DynamicClientset() dynamic.Interface {
 	return o.DynamicClient
 }
 
+// MetricsClientset returns a Metrics API client interface based on client flags
+func (o *ArgoRolloutsOptions) MetricsClientset() metricsclientset.Interface {
+	if o.MetricsClient != nil {
+		return o.MetricsClient
+	}
+	config, err := o.RESTClientGetter.ToRESTConfig()
+	if err != nil {
+		panic(err)
+	}
+	metricsClient, err := metricsclientset.NewForConfig(config)
+	if err != nil {
+		panic(err)
+	}
+	o.MetricsClient = metricsClient
+	return o.MetricsClient
+}
+
 // Namespace returns the namespace based on client flags or kube context
 func (o *ArgoRolloutsOptions) Namespace() string {
 	namespace, _, err := o.RESTClientGetter.ToRawKubeConfigLoader().Namespace()
@@ -0,0 +1,200 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	patchtypes "k8s.io/apimachinery/pkg/types"
+	labelsutil "k8s.io/kubernetes/pkg/util/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	pauseDeploymentPatch = `{"spec":{"paused":true}}`
+
+	// adoptionRequeueDelay is how long to wait for the Deployment controller to observe the pause
+	adoptionRequeueDelay = 5 * time.Second
+)
+
+// adoptionDeploymentName returns the name of the Deployment whose ReplicaSet should be adopted
+func adoptionDeploymentName(ro *v1alpha1.Rollout) string {
+	if ro.Spec.Adoption.DeploymentName != "" {
+		return ro.Spec.Adoption.DeploymentName
+	}
+	if ro.Spec.WorkloadRef != nil && ro.Spec.WorkloadRef.Kind == "Deployment" {
+		return ro.Spec.WorkloadRef.Name
+	}
+	return ""
+}
+
+// adoptDeploymentReplicaSet takes over the current ReplicaSet of the Deployment referenced by
+// spec.adoption, so that it becomes the stable ReplicaSet of a rollout which has not rolled out any
+// revision yet. The ReplicaSet and its pods are relabeled with the rollout's pod template hash in
+// place, so no pod is recreated. The ReplicaSet's selector is immutable and keeps the Deployment's
+// pod-template-hash, which still only selects the adopted pods.
+//
+// The Deployment is paused first: a paused Deployment does not create a new ReplicaSet when it
+// loses its current one. Returns true if the rollout should not be reconciled any further in this
+// sync, because the adoption is still in progress.
+func (c *Controller) adoptDeploymentReplicaSet(ro *v1alpha1.Rollout) (bool, error) {
+	if ro.Spec.Adoption == nil {
+		return false, nil
+	}
+	if ro.Status.Adoption != nil {
+		if ro.Status.StableRS != "" {
+			return false, nil
+		}
+		// Do not reconcile until the informer has observed the adopted ReplicaSet, otherwise the
+		// rollout would not find it and create a new ReplicaSet instead.
+		rs, err := c.replicaSetLister.ReplicaSets(ro.Namespace).Get(ro.Status.Adoption.ReplicaSetName)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return false, err
+		}
+		if rs == nil || !metav1.IsControlledBy(rs, ro) || replicasetutil.GetPodTemplateHash(rs) != ro.Status.Adoption.PodTemplateHash {
+			c.enqueueRolloutAfter(ro, adoptionRequeueDelay)
+			return true, nil
+		}
+		return false, nil
+	}
+	if ro.Status.StableRS != "" {
+		// the rollout already manages a revision of its own
+		return false, nil
+	}
+
+	ctx := context.TODO()
+	logCtx := logutil.WithRollout(ro)
+	deployName := adoptionDeploymentName(ro)
+	deploy, err := c.kubeclientset.AppsV1().Deployments(ro.Namespace).Get(ctx, deployName, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			c.recorder.Warnf(ro, record.EventOptions{EventReason: conditions.AdoptionSkippedReason}, conditions.AdoptionSkippedMessage, deployName, "Deployment not found")
+			return false, nil
+		}
+		return false, err
+	}
+	rs, err := c.findAdoptableReplicaSet(ro, deploy)
+	if err != nil {
+		return false, err
+	}
+	if rs == nil {
+		c.recorder.Warnf(ro, record.EventOptions{EventReason: conditions.AdoptionSkippedReason}, conditions.AdoptionSkippedMessage, deployName, "no ReplicaSet has an equivalent pod template")
+		return false, nil
+	}
+
+	if !deploy.Spec.Paused {
+		_, err = c.kubeclientset.AppsV1().Deployments(deploy.Namespace).Patch(ctx, deploy.Name, patchtypes.MergePatchType, []byte(pauseDeploymentPatch), metav1.PatchOptions{})
+		if err != nil {
+			return false, err
+		}
+		c.recorder.Eventf(ro, record.EventOptions{EventReason: conditions.DeploymentPausedReason}, conditions.DeploymentPausedMessage, deploy.Name)
+		c.enqueueRolloutAfter(ro, adoptionRequeueDelay)
+		return true, nil
+	}
+	if deploy.Status.ObservedGeneration < deploy.Generation {
+		logCtx.Infof("Waiting for Deployment %s to observe the pause before adopting ReplicaSet %s", deploy.Name, rs.Name)
+		c.enqueueRolloutAfter(ro, adoptionRequeueDelay)
+		return true, nil
+	}
+
+	podHash := hash.ComputePodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+
+	// Update the ReplicaSet before its pods so that any pod it creates in the meantime is
+	// already labeled
+	rsCopy := rs.DeepCopy()
+	rsCopy.Labels = labelsutil.CloneAndAddLabel(rsCopy.Labels, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+	rsCopy.Spec.Template.Labels = labelsutil.CloneAndAddLabel(rsCopy.Spec.Template.Labels, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+	var ownerRefs []metav1.OwnerReference
+	for _, ref := range rsCopy.OwnerReferences {
+		if ref.Controller == nil || !*ref.Controller {
+			ownerRefs = append(ownerRefs, ref)
+		}
+	}
+	rsCopy.OwnerReferences = append(ownerRefs, *metav1.NewControllerRef(ro, controllerKind))
+	updatedRS, err := c.kubeclientset.AppsV1().ReplicaSets(rsCopy.Namespace).Update(ctx, rsCopy, metav1.UpdateOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	pods, err := replicasetutil.GetPodsOwnedByReplicaSet(ctx, c.kubeclientset, updatedRS)
+	if err != nil {
+		return false, err
+	}
+	for _, pod := range pods {
+		if pod.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] == podHash {
+			continue
+		}
+		pod.Labels = labelsutil.CloneAndAddLabel(pod.Labels, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+		_, err = c.kubeclientset.CoreV1().Pods(pod.Namespace).Update(ctx, pod, metav1.UpdateOptions{})
+		if err != nil {
+			return false, err
+		}
+	}
+	logCtx.Infof("Relabeled ReplicaSet %s and %d pods with %s=%s", updatedRS.Name, len(pods), v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+
+	adoption := v1alpha1.AdoptionStatus{
+		DeploymentName:  deploy.Name,
+		ReplicaSetName:  updatedRS.Name,
+		PodTemplateHash: podHash,
+		AdoptedAt:       timeutil.MetaNow(),
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"adoption": adoption,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+	_, err = c.argoprojclientset.ArgoprojV1alpha1().Rollouts(ro.Namespace).Patch(ctx, ro.Name, patchtypes.MergePatchType, patch, metav1.PatchOptions{}, "status")
+	if err != nil {
+		return false, err
+	}
+	ro.Status.Adoption = &adoption
+	c.recorder.Eventf(ro, record.EventOptions{EventReason: conditions.ReplicaSetAdoptedReason}, conditions.ReplicaSetAdoptedMessage, updatedRS.Name, deploy.Name)
+	c.enqueueRolloutAfter(ro, adoptionRequeueDelay)
+	return true, nil
+}
+
+// findAdoptableReplicaSet returns the ReplicaSet of the Deployment whose pod template is equivalent
+// to the rollout's. A ReplicaSet which was already taken over by the rollout, but not yet recorded
+// in the status, is returned as well so that an interrupted adoption can be completed.
+func (c *Controller) findAdoptableReplicaSet(ro *v1alpha1.Rollout, deploy *appsv1.Deployment) (*appsv1.ReplicaSet, error) {
+	rsList, err := c.replicaSetLister.ReplicaSets(ro.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ro.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("rollout %s/%s has invalid label selector: %v", ro.Namespace, ro.Name, err)
+	}
+	for _, rs := range rsList {
+		if rs.DeletionTimestamp != nil || !selector.Matches(labels.Set(rs.Labels)) {
+			continue
+		}
+		if _, ok := rs.Labels[appsv1.DefaultDeploymentUniqueLabelKey]; !ok {
+			continue
+		}
+		if !metav1.IsControlledBy(rs, deploy) && !metav1.IsControlledBy(rs, ro) {
+			continue
+		}
+		live := rs.Spec.Template.DeepCopy()
+		delete(live.Labels, appsv1.DefaultDeploymentUniqueLabelKey)
+		if replicasetutil.PodTemplateEqualIgnoreHash(live, &ro.Spec.Template) {
+			return rs, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,235 @@
+package rollout
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	core "k8s.io/client-go/testing"
+	corev1defaults "k8s.io/kubernetes/pkg/apis/core/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+var deploymentKind = appsv1.SchemeGroupVersion.WithKind("Deployment")
+
+func newAdoptableDeployment(r *v1alpha1.Rollout, paused bool) (*appsv1.Deployment, *appsv1.ReplicaSet, *corev1.Pod) {
+	deploy := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "foo",
+			Namespace:  r.Namespace,
+			UID:        uuid.NewUUID(),
+			Generation: 2,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: r.Spec.Replicas,
+			Selector: r.Spec.Selector,
+			Template: r.Spec.Template,
+			Paused:   paused,
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration: 2,
+		},
+	}
+	rsLabels := map[string]string{appsv1.DefaultDeploymentUniqueLabelKey: "5d8f9c7b6"}
+	for k, v := range r.Spec.Selector.MatchLabels {
+		rsLabels[k] = v
+	}
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo-5d8f9c7b6",
+			Namespace:       r.Namespace,
+			UID:             uuid.NewUUID(),
+			Labels:          rsLabels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(deploy, deploymentKind)},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas: r.Spec.Replicas,
+			Selector: metav1.SetAsLabelSelector(rsLabels),
+			Template: *r.Spec.Template.DeepCopy(),
+		},
+	}
+	rs.Spec.Template.Labels = rsLabels
+	// the API server defaults the pod template of the live ReplicaSet
+	podTemplate := corev1.PodTemplate{Template: rs.Spec.Template}
+	corev1defaults.SetObjectDefaults_PodTemplate(&podTemplate)
+	rs.Spec.Template = podTemplate.Template
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "foo-5d8f9c7b6-abcde",
+			Namespace:       r.Namespace,
+			Labels:          rsLabels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rs, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+		},
+	}
+	return deploy, rs, pod
+}
+
+func newAdoptionRollout() *v1alpha1.Rollout {
+	r := newCanaryRollout("foo", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.Adoption = &v1alpha1.RolloutAdoption{DeploymentName: "foo"}
+	r.Status.CurrentPodHash = ""
+	return r
+}
+
+func TestAdoptDeploymentReplicaSet(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newAdoptionRollout()
+	deploy, rs, pod := newAdoptableDeployment(r, true)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.kubeobjects = append(f.kubeobjects, deploy, rs, pod)
+
+	f.kubeactions = append(f.kubeactions, core.NewGetAction(schema.GroupVersionResource{Resource: "deployments"}, deploy.Namespace, deploy.Name))
+	updatedRSIndex := f.expectUpdateReplicaSetAction(rs)
+	f.expectListPodAction(pod.Namespace)
+	f.expectUpdatePodAction(pod)
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	podHash := hash.ComputePodTemplateHash(&r.Spec.Template, r.Status.CollisionCount)
+	updatedRS := f.getUpdatedReplicaSet(updatedRSIndex)
+	assert.Equal(t, podHash, updatedRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, podHash, updatedRS.Spec.Template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	assert.Equal(t, rs.Spec.Selector, updatedRS.Spec.Selector)
+	assert.True(t, metav1.IsControlledBy(updatedRS, r))
+	assert.Len(t, updatedRS.OwnerReferences, 1)
+
+	updatedPod, err := f.kubeclient.CoreV1().Pods(pod.Namespace).Get(context.TODO(), pod.Name, metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, podHash, updatedPod.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+
+	patch := f.getPatchedRollout(patchIndex)
+	var patchObj struct {
+		Status struct {
+			Adoption v1alpha1.AdoptionStatus `json:"adoption"`
+		} `json:"status"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(patch), &patchObj))
+	assert.Equal(t, "foo", patchObj.Status.Adoption.DeploymentName)
+	assert.Equal(t, rs.Name, patchObj.Status.Adoption.ReplicaSetName)
+	assert.Equal(t, podHash, patchObj.Status.Adoption.PodTemplateHash)
+	assert.Equal(t, []string{conditions.ReplicaSetAdoptedReason}, f.events)
+}
+
+func TestAdoptDeploymentReplicaSetPausesDeployment(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newAdoptionRollout()
+	deploy, rs, pod := newAdoptableDeployment(r, false)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.kubeobjects = append(f.kubeobjects, deploy, rs, pod)
+
+	f.kubeactions = append(f.kubeactions, core.NewGetAction(schema.GroupVersionResource{Resource: "deployments"}, deploy.Namespace, deploy.Name))
+	f.kubeactions = append(f.kubeactions, core.NewPatchAction(schema.GroupVersionResource{Resource: "deployments"}, deploy.Namespace, deploy.Name, types.MergePatchType, []byte(pauseDeploymentPatch)))
+	f.run(getKey(r, t))
+
+	assert.Equal(t, []string{conditions.DeploymentPausedReason}, f.events)
+}
+
+func TestAdoptDeploymentReplicaSetWaitsForPauseObserved(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newAdoptionRollout()
+	deploy, rs, _ := newAdoptableDeployment(r, true)
+	deploy.Status.ObservedGeneration = 1
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.kubeobjects = append(f.kubeobjects, deploy, rs)
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	adopting, err := c.adoptDeploymentReplicaSet(r)
+	assert.NoError(t, err)
+	assert.True(t, adopting)
+	assert.Nil(t, r.Status.Adoption)
+	assert.Equal(t, 1, f.enqueuedObjects[getKey(r, t)])
+}
+
+func TestAdoptDeploymentReplicaSetTemplateMismatch(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newAdoptionRollout()
+	deploy, rs, _ := newAdoptableDeployment(r, true)
+	rs.Spec.Template.Spec.Containers[0].Image = "foo/bar:v2"
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.kubeobjects = append(f.kubeobjects, deploy, rs)
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	adopting, err := c.adoptDeploymentReplicaSet(r)
+	assert.NoError(t, err)
+	assert.False(t, adopting)
+	assert.Equal(t, []string{conditions.AdoptionSkippedReason}, c.recorder.(*record.FakeEventRecorder).Events)
+}
+
+func TestAdoptDeploymentReplicaSetDeploymentNotFound(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newAdoptionRollout()
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	adopting, err := c.adoptDeploymentReplicaSet(r)
+	assert.NoError(t, err)
+	assert.False(t, adopting)
+	assert.Equal(t, []string{conditions.AdoptionSkippedReason}, c.recorder.(*record.FakeEventRecorder).Events)
+}
+
+func TestAdoptDeploymentReplicaSetWaitsForInformer(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newAdoptionRollout()
+	_, rs, _ := newAdoptableDeployment(r, true)
+	podHash := hash.ComputePodTemplateHash(&r.Spec.Template, r.Status.CollisionCount)
+	r.Status.Adoption = &v1alpha1.AdoptionStatus{
+		DeploymentName:  "foo",
+		ReplicaSetName:  rs.Name,
+		PodTemplateHash: podHash,
+	}
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	c, _, _ := f.newController(noResyncPeriodFunc)
+
+	// the informer still has the ReplicaSet owned by the Deployment
+	adopting, err := c.adoptDeploymentReplicaSet(r)
+	assert.NoError(t, err)
+	assert.True(t, adopting)
+
+	adoptedRS := rs.DeepCopy()
+	adoptedRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = podHash
+	adoptedRS.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(r, controllerKind)}
+	f.replicaSetLister = []*appsv1.ReplicaSet{adoptedRS}
+	c, _, _ = f.newController(noResyncPeriodFunc)
+	adopting, err = c.adoptDeploymentReplicaSet(r)
+	assert.NoError(t, err)
+	assert.False(t, adopting)
+}
+
+func TestAdoptionDeploymentName(t *testing.T) {
+	r := newAdoptionRollout()
+	assert.Equal(t, "foo", adoptionDeploymentName(r))
+
+	r.Spec.Adoption.DeploymentName = ""
+	assert.Equal(t, "", adoptionDeploymentName(r))
+
+	r.Spec.WorkloadRef = &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "bar"}
+	assert.Equal(t, "bar", adoptionDeploymentName(r))
+}
@@ -0,0 +1,50 @@
+package rollout
+
+import (
+	"fmt"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+)
+
+// alertMessage returns the message describing the firing alert
+func alertMessage(alert *v1alpha1.RolloutAlertStatus) string {
+	message := fmt.Sprintf(conditions.AlertFiringMessage, alert.Name)
+	if alert.Summary != "" {
+		message = fmt.Sprintf("%s: %s", message, alert.Summary)
+	}
+	return message
+}
+
+// pausedByAlert returns whether the rollout is paused by a firing alert, or is about to be
+func (c *rolloutContext) pausedByAlert() bool {
+	if getPauseCondition(c.rollout, v1alpha1.PauseReasonAlert) != nil {
+		return true
+	}
+	alert := c.rollout.Status.Alert
+	return alert != nil && alert.Action == v1alpha1.AlertActionPause && !rolloututil.IsFullyPromoted(c.rollout) && !c.pauseContext.IsAborted()
+}
+
+// reconcileAlert pauses or aborts the update of the rollout on the firing alert recorded in its
+// status by the alert receiver. The alert is not carried over to the new status, so that it is
+// acted on once.
+func (c *rolloutContext) reconcileAlert() {
+	alert := c.rollout.Status.Alert
+	if alert == nil || rolloututil.IsFullyPromoted(c.rollout) || c.pauseContext.IsAborted() {
+		return
+	}
+	message := alertMessage(alert)
+	if alert.Action == v1alpha1.AlertActionPause {
+		if getPauseCondition(c.rollout, v1alpha1.PauseReasonAlert) != nil {
+			return
+		}
+		c.log.Infof("Pausing rollout: %s", message)
+		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonAlert)
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutPausedByAlertReason}, conditions.RolloutPausedByAlertMessage, message)
+		return
+	}
+	c.log.Infof("Aborting rollout: %s", message)
+	c.pauseContext.AddAbort(message)
+}
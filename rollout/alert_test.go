@@ -0,0 +1,75 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newAlertFixture returns a fixture with a canary rollout which completed its setWeight step, and
+// in whose status the alert receiver recorded a firing alert with the given action
+func newAlertFixture(t *testing.T, action v1alpha1.AlertAction) (*fixture, *v1alpha1.Rollout) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {SetWeight: int32Ptr(50)}}
+	f, r2, _, _ := newCanaryUpdateFixture(t, steps, 10, 1)
+	r2.Status.Alert = &v1alpha1.RolloutAlertStatus{
+		Name:     "HighErrorRate",
+		Action:   action,
+		Summary:  "Error rate is above 5%",
+		StartsAt: metav1.NewTime(timeutil.Now()),
+	}
+	return f, r2
+}
+
+func TestAbortOnFiringAlert(t *testing.T) {
+	f, r := newAlertFixture(t, v1alpha1.AlertActionAbort)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	status := getPatchedRolloutStatus(t, patch)
+	assert.True(t, status.Abort)
+	// the alert is acted on once
+	assert.Contains(t, patch, `"alert":null`)
+	progressing := conditions.GetRolloutCondition(status, v1alpha1.RolloutProgressing)
+	if assert.NotNil(t, progressing) {
+		assert.Equal(t, conditions.RolloutAbortedReason, progressing.Reason)
+		assert.Contains(t, progressing.Message, "alert 'HighErrorRate' is firing: Error rate is above 5%")
+	}
+}
+
+func TestPauseOnFiringAlert(t *testing.T) {
+	f, r := newAlertFixture(t, v1alpha1.AlertActionPause)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	status := getPatchedRolloutStatus(t, patch)
+	if assert.Len(t, status.PauseConditions, 1) {
+		assert.Equal(t, v1alpha1.PauseReasonAlert, status.PauseConditions[0].Reason)
+	}
+	// the rollout does not progress past its completed setWeight step while it is paused
+	assert.Nil(t, status.CurrentStepIndex)
+	// the alert is acted on once
+	assert.Contains(t, patch, `"alert":null`)
+	assert.Contains(t, f.events, conditions.RolloutPausedByAlertReason)
+}
+
+func TestIgnoreFiringAlertOnPromotedRollout(t *testing.T) {
+	f, r := newAlertFixture(t, v1alpha1.AlertActionAbort)
+	defer f.Close()
+	r.Status.StableRS = r.Status.CurrentPodHash
+
+	rc := &rolloutContext{rollout: r, pauseContext: &pauseContext{rollout: r}}
+	rc.reconcileAlert()
+	assert.False(t, rc.pauseContext.IsAborted())
+	assert.False(t, rc.pauseContext.HasAddPause())
+}
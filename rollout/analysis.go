@@ -112,6 +112,7 @@ func (c *rolloutContext) reconcileAnalysisRuns() error {
 		newCurrentAnalysisRuns.BlueGreenPostPromotion = postPromotionAr
 	}
 	c.SetCurrentAnalysisRuns(newCurrentAnalysisRuns)
+	c.reconcileInconclusivePause()
 
 	// Due to the possibility that we are operating on stale/inconsistent data in the informer, it's
 	// possible that otherArs includes the current analysis runs that we just created or reclaimed
@@ -151,7 +152,7 @@ func (c *rolloutContext) setPauseOrAbort(ar *v1alpha1.AnalysisRun) {
 	}
 	switch ar.Status.Phase {
 	case v1alpha1.AnalysisPhaseInconclusive:
-		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+		c.pauseOnInconclusive(ar)
 	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
 		c.pauseContext.AddAbort(ar.Status.Message)
 	}
@@ -170,6 +171,10 @@ func needsNewAnalysisRun(currentAr *v1alpha1.AnalysisRun, rollout *v1alpha1.Roll
 	// Pause and that causes controllerPause to be set. The extra check for the BlueGreen Pause ensures that a new Analysis
 	// Run is created only when the previous AnalysisRun is inconclusive
 	if rollout.Status.ControllerPause && getPauseCondition(rollout, v1alpha1.PauseReasonBlueGreenPause) == nil {
+		// An inconclusive AnalysisRun which the inconclusive policy treated as successful is not rerun
+		if resolution := rollout.Status.InconclusiveResolution; resolution != nil && resolution.AnalysisRun == currentAr.Name && resolution.Action == v1alpha1.InconclusiveActionSucceed {
+			return false
+		}
 		return currentAr.Status.Phase == v1alpha1.AnalysisPhaseInconclusive
 	}
 	return rollout.Status.AbortedAt != nil
@@ -239,16 +244,22 @@ func (c *rolloutContext) reconcilePrePromotionAnalysisRun() (*v1alpha1.AnalysisR
 		return currentAr, nil
 	}
 
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	instanceID := analysisutil.GetInstanceID(c.rollout)
+	prePromotionLabels := analysisutil.PrePromotionLabels(podHash, instanceID)
 	if needsNewAnalysisRun(currentAr, c.rollout) {
-		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
-		instanceID := analysisutil.GetInstanceID(c.rollout)
-		prePromotionLabels := analysisutil.PrePromotionLabels(podHash, instanceID)
-		currentAr, err := c.createAnalysisRun(c.rollout.Spec.Strategy.BlueGreen.PrePromotionAnalysis, "pre", prePromotionLabels)
+		currentAr, err := c.createAnalysisRun(c.rollout.Spec.Strategy.BlueGreen.PrePromotionAnalysis, "pre", prePromotionLabels, 0)
 		if err == nil {
 			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Info("Created Pre Promotion AnalysisRun")
 		}
 		return currentAr, err
 	}
+	if currentAr.Status.Phase == v1alpha1.AnalysisPhaseInconclusive {
+		rerunAr, err := c.rerunInconclusive(c.rollout.Spec.Strategy.BlueGreen.PrePromotionAnalysis, currentAr, "pre", prePromotionLabels)
+		if err != nil || rerunAr != nil {
+			return rerunAr, err
+		}
+	}
 	return currentAr, nil
 }
 
@@ -298,16 +309,22 @@ func (c *rolloutContext) reconcilePostPromotionAnalysisRun() (*v1alpha1.Analysis
 		return currentAr, nil
 	}
 
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	instanceID := analysisutil.GetInstanceID(c.rollout)
+	postPromotionLabels := analysisutil.PostPromotionLabels(podHash, instanceID)
 	if needsNewAnalysisRun(currentAr, c.rollout) {
-		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
-		instanceID := analysisutil.GetInstanceID(c.rollout)
-		postPromotionLabels := analysisutil.PostPromotionLabels(podHash, instanceID)
-		currentAr, err := c.createAnalysisRun(c.rollout.Spec.Strategy.BlueGreen.PostPromotionAnalysis, "post", postPromotionLabels)
+		currentAr, err := c.createAnalysisRun(c.rollout.Spec.Strategy.BlueGreen.PostPromotionAnalysis, "post", postPromotionLabels, 0)
 		if err == nil {
 			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Info("Created Post Promotion AnalysisRun")
 		}
 		return currentAr, err
 	}
+	if currentAr.Status.Phase == v1alpha1.AnalysisPhaseInconclusive {
+		rerunAr, err := c.rerunInconclusive(c.rollout.Spec.Strategy.BlueGreen.PostPromotionAnalysis, currentAr, "post", postPromotionLabels)
+		if err != nil || rerunAr != nil {
+			return rerunAr, err
+		}
+	}
 	return currentAr, nil
 }
 
@@ -327,11 +344,11 @@ func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun
 		return currentAr, nil
 	}
 
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	instanceID := analysisutil.GetInstanceID(c.rollout)
+	backgroundLabels := analysisutil.BackgroundLabels(podHash, instanceID)
 	if needsNewAnalysisRun(currentAr, c.rollout) {
-		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
-		instanceID := analysisutil.GetInstanceID(c.rollout)
-		backgroundLabels := analysisutil.BackgroundLabels(podHash, instanceID)
-		currentAr, err := c.createAnalysisRun(&c.rollout.Spec.Strategy.Canary.Analysis.RolloutAnalysis, "", backgroundLabels)
+		currentAr, err := c.createAnalysisRun(&c.rollout.Spec.Strategy.Canary.Analysis.RolloutAnalysis, "", backgroundLabels, 0)
 		if err == nil {
 			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Info("Created background AnalysisRun")
 		}
@@ -339,14 +356,20 @@ func (c *rolloutContext) reconcileBackgroundAnalysisRun() (*v1alpha1.AnalysisRun
 	}
 	switch currentAr.Status.Phase {
 	case v1alpha1.AnalysisPhaseInconclusive:
-		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+		rerunAr, err := c.rerunInconclusive(&c.rollout.Spec.Strategy.Canary.Analysis.RolloutAnalysis, currentAr, "", backgroundLabels)
+		if err != nil || rerunAr != nil {
+			return rerunAr, err
+		}
+		c.pauseOnInconclusive(currentAr)
 	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
 		c.pauseContext.AddAbort(currentAr.Status.Message)
 	}
 	return currentAr, nil
 }
 
-func (c *rolloutContext) createAnalysisRun(rolloutAnalysis *v1alpha1.RolloutAnalysis, infix string, labels map[string]string) (*v1alpha1.AnalysisRun, error) {
+// createAnalysisRun creates an AnalysisRun for the analysis. reruns is the number of times an
+// inconclusive AnalysisRun of the analysis was rerun before this one.
+func (c *rolloutContext) createAnalysisRun(rolloutAnalysis *v1alpha1.RolloutAnalysis, infix string, labels map[string]string, reruns int32) (*v1alpha1.AnalysisRun, error) {
 	args, err := analysisutil.BuildArgumentsForRolloutAnalysisRun(rolloutAnalysis.Args, c.stableRS, c.newRS, c.rollout)
 	if err != nil {
 		return nil, err
@@ -360,6 +383,9 @@ func (c *rolloutContext) createAnalysisRun(rolloutAnalysis *v1alpha1.RolloutAnal
 	if err != nil {
 		return nil, err
 	}
+	if reruns > 0 {
+		ar.Annotations[annotations.InconclusiveRerunsAnnotation] = strconv.Itoa(int(reruns))
+	}
 	analysisRunIf := c.argoprojclientset.ArgoprojV1alpha1().AnalysisRuns(c.rollout.Namespace)
 	return analysisutil.CreateWithCollisionCounter(c.log, analysisRunIf, *ar)
 }
@@ -377,11 +403,11 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 		return nil, err
 	}
 	c.log.Infof("Reconciling analysis step (stepIndex: %d)", *index)
+	podHash := replicasetutil.GetPodTemplateHash(c.newRS)
+	instanceID := analysisutil.GetInstanceID(c.rollout)
+	stepLabels := analysisutil.StepLabels(*index, podHash, instanceID)
 	if needsNewAnalysisRun(currentAr, c.rollout) {
-		podHash := replicasetutil.GetPodTemplateHash(c.newRS)
-		instanceID := analysisutil.GetInstanceID(c.rollout)
-		stepLabels := analysisutil.StepLabels(*index, podHash, instanceID)
-		currentAr, err := c.createAnalysisRun(step.Analysis, strconv.Itoa(int(*index)), stepLabels)
+		currentAr, err := c.createAnalysisRun(step.Analysis, strconv.Itoa(int(*index)), stepLabels, 0)
 		if err == nil {
 			c.log.WithField(logutil.AnalysisRunKey, currentAr.Name).Infof("Created AnalysisRun for step '%d'", *index)
 		}
@@ -390,7 +416,11 @@ func (c *rolloutContext) reconcileStepBasedAnalysisRun() (*v1alpha1.AnalysisRun,
 
 	switch currentAr.Status.Phase {
 	case v1alpha1.AnalysisPhaseInconclusive:
-		c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+		rerunAr, err := c.rerunInconclusive(step.Analysis, currentAr, strconv.Itoa(int(*index)), stepLabels)
+		if err != nil || rerunAr != nil {
+			return rerunAr, err
+		}
+		c.pauseOnInconclusive(currentAr)
 	case v1alpha1.AnalysisPhaseError, v1alpha1.AnalysisPhaseFailed:
 		c.pauseContext.AddAbort(currentAr.Status.Message)
 	}
@@ -439,6 +469,9 @@ func (c *rolloutContext) newAnalysisRunFromRollout(rolloutAnalysis *v1alpha1.Rol
 				}
 				return nil, err
 			}
+			if err := analysisutil.VerifyClusterAnalysisTemplateAccess(c.namespaceLister, template, c.rollout.Namespace); err != nil {
+				return nil, err
+			}
 			clusterTemplates = append(clusterTemplates, template)
 		} else {
 			template, err := c.analysisTemplateLister.AnalysisTemplates(c.rollout.Namespace).Get(templateRef.TemplateName)
@@ -460,6 +493,7 @@ func (c *rolloutContext) newAnalysisRunFromRollout(rolloutAnalysis *v1alpha1.Rol
 	run.Annotations = map[string]string{
 		annotations.RevisionAnnotation: revision,
 	}
+	logutil.InheritLogLevel(c.rollout, run)
 	run.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(c.rollout, controllerKind)}
 	return run, nil
 }
@@ -479,3 +513,59 @@ func (c *rolloutContext) deleteAnalysisRuns(ars []*v1alpha1.AnalysisRun) error {
 	}
 	return nil
 }
+
+// enqueueNamespaceRollouts enqueues the rollouts of the namespace which use a
+// ClusterAnalysisTemplate, so the namespace policies of their templates are verified again
+// against the labels of the namespace
+func (c *Controller) enqueueNamespaceRollouts(obj interface{}) {
+	ns, ok := obj.(*corev1.Namespace)
+	if !ok {
+		return
+	}
+	rollouts, err := c.rolloutsLister.Rollouts(ns.Name).List(labels.Everything())
+	if err != nil {
+		logutil.WithObject(ns).Warnf("Failed to list rollouts to enqueue: %v", err)
+		return
+	}
+	for _, ro := range rollouts {
+		if usesClusterAnalysisTemplate(ro) {
+			c.enqueueRollout(ro)
+		}
+	}
+}
+
+// usesClusterAnalysisTemplate returns whether any analysis or experiment of the rollout references
+// a ClusterAnalysisTemplate
+func usesClusterAnalysisTemplate(ro *v1alpha1.Rollout) bool {
+	var analyses []*v1alpha1.RolloutAnalysis
+	if blueGreen := ro.Spec.Strategy.BlueGreen; blueGreen != nil {
+		analyses = append(analyses, blueGreen.PrePromotionAnalysis, blueGreen.PostPromotionAnalysis)
+	}
+	if canary := ro.Spec.Strategy.Canary; canary != nil {
+		if canary.Analysis != nil {
+			analyses = append(analyses, &canary.Analysis.RolloutAnalysis)
+		}
+		for _, step := range canary.Steps {
+			analyses = append(analyses, step.Analysis)
+			if step.Experiment == nil {
+				continue
+			}
+			for _, analysis := range step.Experiment.Analyses {
+				if analysis.ClusterScope {
+					return true
+				}
+			}
+		}
+	}
+	for _, analysis := range analyses {
+		if analysis == nil {
+			continue
+		}
+		for _, templateRef := range analysis.Templates {
+			if templateRef.ClusterScope {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -1053,15 +1053,18 @@ func TestIncrementStepAfterSuccessfulAnalysisRun(t *testing.T) {
 	expectedPatch := `{
 		"status": {
 			"canary": {
-				"currentStepAnalysisRunStatus": null
+				"currentStepAnalysisRunStatus": null,
+				"stepHistory": %s
 			},
 			"currentStepIndex": 1,
-			"conditions": %s
+			"conditions": %s,
+			"progress": %s
 		}
 	}`
 	condition := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, rs2, false, "")
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeCompleted, ""))
 
-	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, condition)), patch)
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, stepHistory, condition, progressPatch(50, 30))), patch)
 }
 
 func TestPausedOnInconclusiveBackgroundAnalysisRun(t *testing.T) {
@@ -1125,12 +1128,13 @@ func TestPausedOnInconclusiveBackgroundAnalysisRun(t *testing.T) {
 			}],
 			"controllerPause": true,
 			"phase": "Paused",
-			"message": "%s"
+			"message": "%s",
+			"progress": %s
 		}
 	}`
 	condition := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, r2, false, "")
 
-	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, condition, v1alpha1.PauseReasonInconclusiveAnalysis, now, v1alpha1.PauseReasonInconclusiveAnalysis)), patch)
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, condition, v1alpha1.PauseReasonInconclusiveAnalysis, now, v1alpha1.PauseReasonInconclusiveAnalysis, unknownProgressPatch(0, progressInconclusiveAnalysisMessage))), patch)
 }
 
 func TestPausedStepAfterInconclusiveAnalysisRun(t *testing.T) {
@@ -1189,11 +1193,12 @@ func TestPausedStepAfterInconclusiveAnalysisRun(t *testing.T) {
 			}],
 			"controllerPause": true,
 			"phase": "Paused",
-			"message": "%s"
+			"message": "%s",
+			"progress": %s
 		}
 	}`
 	condition := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, r2, false, "")
-	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, condition, v1alpha1.PauseReasonInconclusiveAnalysis, now, v1alpha1.PauseReasonInconclusiveAnalysis)), patch)
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, condition, v1alpha1.PauseReasonInconclusiveAnalysis, now, v1alpha1.PauseReasonInconclusiveAnalysis, unknownProgressPatch(0, progressInconclusiveAnalysisMessage))), patch)
 }
 
 func TestErrorConditionAfterErrorAnalysisRunStep(t *testing.T) {
@@ -1247,7 +1252,8 @@ func TestErrorConditionAfterErrorAnalysisRunStep(t *testing.T) {
 				"currentStepAnalysisRunStatus": {
 					"status": "Error",
 					"message": "Error"
-				}
+				},
+				"stepHistory": %s
 			},
 			"conditions": %s,
 			"abort": true,
@@ -1259,7 +1265,8 @@ func TestErrorConditionAfterErrorAnalysisRunStep(t *testing.T) {
 	now := timeutil.MetaNow().UTC().Format(time.RFC3339)
 	errmsg := fmt.Sprintf(conditions.RolloutAbortedMessage, 2) + ": " + ar.Status.Message
 	condition := generateConditionsPatch(true, conditions.RolloutAbortedReason, r2, false, errmsg)
-	expectedPatch = fmt.Sprintf(expectedPatch, condition, now, errmsg)
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeAborted, ar.Status.Message))
+	expectedPatch = fmt.Sprintf(expectedPatch, stepHistory, condition, now, errmsg)
 	assert.Equal(t, calculatePatch(r2, expectedPatch), patch)
 }
 
@@ -1323,7 +1330,8 @@ func TestErrorConditionAfterErrorAnalysisRunBackground(t *testing.T) {
 			"canary":{
 				"currentBackgroundAnalysisRunStatus": {
 					"status": "Error"
-				}
+				},
+				"stepHistory": %s
 			},
 			"conditions": %s,
 			"abortedAt": "%s",
@@ -1334,9 +1342,10 @@ func TestErrorConditionAfterErrorAnalysisRunBackground(t *testing.T) {
 	}`
 	errmsg := fmt.Sprintf(conditions.RolloutAbortedMessage, 2)
 	condition := generateConditionsPatch(true, conditions.RolloutAbortedReason, r2, false, "")
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeAborted, ""))
 
 	now := timeutil.Now().UTC().Format(time.RFC3339)
-	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, condition, now, errmsg)), patch)
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, stepHistory, condition, now, errmsg)), patch)
 }
 
 func TestCancelAnalysisRunsWhenAborted(t *testing.T) {
@@ -1389,6 +1398,9 @@ func TestCancelAnalysisRunsWhenAborted(t *testing.T) {
 	newConditions := generateConditionsPatch(true, conditions.RolloutAbortedReason, r2, false, "")
 	expectedPatch := `{
 		"status": {
+			"canary": {
+				"stepHistory": %s
+			},
 			"conditions": %s,
 			"abortedAt": "%s",
 			"phase": "Degraded",
@@ -1396,8 +1408,9 @@ func TestCancelAnalysisRunsWhenAborted(t *testing.T) {
 		}
 	}`
 	errmsg := fmt.Sprintf(conditions.RolloutAbortedMessage, 2)
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeAborted, ""))
 	now := timeutil.Now().UTC().Format(time.RFC3339)
-	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, newConditions, now, errmsg)), patch)
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, stepHistory, newConditions, now, errmsg)), patch)
 }
 
 func TestCancelBackgroundAnalysisRunWhenRolloutIsCompleted(t *testing.T) {
@@ -2215,15 +2228,16 @@ func TestPostPromotionAnalysisRunHandleInconclusive(t *testing.T) {
 	patchIndex := f.expectPatchRolloutActionWithPatch(r2, OnlyObservedGenerationPatch)
 	f.run(getKey(r2, t))
 	patch := f.getPatchedRollout(patchIndex)
-	expectedPatch := fmt.Sprint(`{
+	expectedPatch := fmt.Sprintf(`{
 		"status": {
 			"blueGreen": {
 				"postPromotionAnalysisRunStatus": {"status":"Inconclusive"}
 			},
 			"phase": "Paused",
-			"message": "InconclusiveAnalysisRun"
+			"message": "InconclusiveAnalysisRun",
+			"progress": %s
 		}
-	}`)
+	}`, unknownProgressPatch(50, progressInconclusiveAnalysisMessage))
 	assert.Equal(t, calculatePatch(r2, expectedPatch), patch)
 }
 
@@ -2293,3 +2307,44 @@ func TestAbortRolloutOnErrorPostPromotionAnalysis(t *testing.T) {
 	newConditions := updateConditionsPatch(*r2, progressingFalseAborted)
 	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, now, newConditions, conditions.RolloutAbortedReason, progressingFalseAborted.Message)), patch)
 }
+
+func TestEnqueueNamespaceRollouts(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	clusterAnalysisStep := v1alpha1.CanaryStep{
+		Analysis: &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "cluster-template", ClusterScope: true}},
+		},
+	}
+	withClusterTemplate := newCanaryRollout("with-cluster-template", 1, nil, []v1alpha1.CanaryStep{clusterAnalysisStep}, nil, intstr.FromInt(0), intstr.FromInt(1))
+	withoutClusterTemplate := newCanaryRollout("without-cluster-template", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+	otherNamespace := newCanaryRollout("other-namespace", 1, nil, []v1alpha1.CanaryStep{clusterAnalysisStep}, nil, intstr.FromInt(0), intstr.FromInt(1))
+	otherNamespace.Namespace = "other"
+	f.rolloutLister = append(f.rolloutLister, withClusterTemplate, withoutClusterTemplate, otherNamespace)
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	c.enqueueNamespaceRollouts(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}})
+	assert.Equal(t, map[string]int{"default/with-cluster-template": 1}, f.enqueuedObjects)
+}
+
+func TestUsesClusterAnalysisTemplate(t *testing.T) {
+	ro := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(0), intstr.FromInt(1))
+	assert.False(t, usesClusterAnalysisTemplate(ro))
+
+	ro.Spec.Strategy.Canary.Steps = []v1alpha1.CanaryStep{{
+		Experiment: &v1alpha1.RolloutExperimentStep{
+			Analyses: []v1alpha1.RolloutExperimentStepAnalysisTemplateRef{{Name: "analysis", TemplateName: "template"}},
+		},
+	}}
+	assert.False(t, usesClusterAnalysisTemplate(ro))
+	ro.Spec.Strategy.Canary.Steps[0].Experiment.Analyses[0].ClusterScope = true
+	assert.True(t, usesClusterAnalysisTemplate(ro))
+
+	bg := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	assert.False(t, usesClusterAnalysisTemplate(bg))
+	bg.Spec.Strategy.BlueGreen.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "cluster-template", ClusterScope: true}},
+	}
+	assert.True(t, usesClusterAnalysisTemplate(bg))
+}
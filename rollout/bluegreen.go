@@ -35,6 +35,11 @@ func (c *rolloutContext) rolloutBlueGreen() error {
 		return c.syncRolloutStatusBlueGreen(previewSvc, activeSvc)
 	}
 
+	err = c.reconcilePodSpecPatch()
+	if err != nil {
+		return err
+	}
+
 	err = c.podRestarter.Reconcile(c)
 	if err != nil {
 		return err
@@ -106,6 +111,9 @@ func (c *rolloutContext) reconcileBlueGreenReplicaSets(activeSvc *corev1.Service
 	if err := c.reconcileRevisionHistoryLimit(c.otherRSs); err != nil {
 		return err
 	}
+	if err := c.reconcileRevisionSnapshots(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -209,7 +217,7 @@ func (c *rolloutContext) scaleDownOldReplicaSetsForBlueGreen(oldRSs []*appsv1.Re
 	}
 	if c.rollout.Spec.Strategy.BlueGreen != nil && c.rollout.Spec.Strategy.BlueGreen.PostPromotionAnalysis != nil && c.rollout.Spec.Strategy.BlueGreen.ScaleDownDelaySeconds == nil && !skipPostPromotionAnalysisRun(c.rollout, c.newRS) {
 		currentPostAr := c.currentArs.BlueGreenPostPromotion
-		if currentPostAr == nil || currentPostAr.Status.Phase != v1alpha1.AnalysisPhaseSuccessful {
+		if !c.analysisRunSucceeded(currentPostAr) {
 			c.log.Infof("Cannot scale down old ReplicaSets while Analysis is running and no ScaleDownDelaySeconds")
 			return false, nil
 		}
@@ -328,11 +328,12 @@ func TestBlueGreenHandlePause(t *testing.T) {
 				}],
 				"controllerPause": true,
 				"phase": "Paused",
-				"message": "BlueGreenPause"
+				"message": "BlueGreenPause",
+				"progress": %s
 			}
 		}`
 		now := timeutil.Now().UTC().Format(time.RFC3339)
-		assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, v1alpha1.PauseReasonBlueGreenPause, now)), patch)
+		assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, v1alpha1.PauseReasonBlueGreenPause, now, unknownProgressPatch(33, progressBlueGreenManualPromotionMessage))), patch)
 
 	})
 
@@ -692,10 +693,11 @@ func TestBlueGreenHandlePause(t *testing.T) {
 				}],
 				"controllerPause": true,
 				"phase": "Paused",
-				"message": "BlueGreenPause"
+				"message": "BlueGreenPause",
+				"progress": %s
 			}
 		}`
-		expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchWithoutSubs, v1alpha1.PauseReasonBlueGreenPause, now))
+		expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchWithoutSubs, v1alpha1.PauseReasonBlueGreenPause, now, unknownProgressPatch(33, progressBlueGreenManualPromotionMessage)))
 		patchIndex := f.expectPatchRolloutActionWithPatch(r2, expectedPatch)
 		f.run(getKey(r2, t))
 
@@ -901,11 +903,12 @@ func TestBlueGreenRolloutStatusHPAStatusFieldsActiveSelectorSet(t *testing.T) {
 			"availableReplicas":1,
 			"updatedReplicas":1,
 			"replicas":2,
-			"selector":"foo=bar,rollouts-pod-template-hash=%s"
+			"selector":"foo=bar,rollouts-pod-template-hash=%s",
+			"progress":%s
 		}
 	}`
 	//_, availableStr := newAvailableCondition(true)
-	expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchWithoutSubs, rs1PodHash))
+	expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchWithoutSubs, rs1PodHash, unknownProgressPatch(33, progressBlueGreenManualPromotionMessage)))
 
 	patchIndex := f.expectPatchRolloutActionWithPatch(r2, expectedPatch)
 	f.run(getKey(r2, t))
@@ -0,0 +1,199 @@
+package rollout
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// progressingSlotGrantTTL is the time after which a slot granted to a rollout is no longer counted
+// if the rollout was not observed to hold it, such as when its status failed to be persisted
+const progressingSlotGrantTTL = time.Minute
+
+// progressingSlots serializes the rollouts taking the slots of their concurrency budgets, and tracks
+// the slots granted to rollouts until the rollouts are observed to hold them in the lister, so that
+// two workers reading the rollouts from the lister cannot both take the last slot of a budget
+type progressingSlots struct {
+	lock sync.Mutex
+	// granted is the time a slot was granted, by UID of the rollout
+	granted map[types.UID]time.Time
+}
+
+func newProgressingSlots() *progressingSlots {
+	return &progressingSlots{granted: make(map[types.UID]time.Time)}
+}
+
+// pending returns whether a slot was granted to the rollout, which the lister does not show it
+// holding yet. The grants which are observed are forgotten.
+func (s *progressingSlots) pending(ro *v1alpha1.Rollout) bool {
+	if _, ok := s.granted[ro.UID]; !ok {
+		return false
+	}
+	atFirstStep := ro.Status.CurrentStepIndex == nil || *ro.Status.CurrentStepIndex == 0
+	if !atFirstStep || ro.Spec.Strategy.Canary == nil || ro.Status.Abort {
+		delete(s.granted, ro.UID)
+		return false
+	}
+	return true
+}
+
+// forgetExpired forgets the expired grants, such as the ones of rollouts which were deleted
+func (s *progressingSlots) forgetExpired() {
+	for uid, grantedAt := range s.granted {
+		if expiredGrant(grantedAt) {
+			delete(s.granted, uid)
+		}
+	}
+}
+
+func expiredGrant(grantedAt time.Time) bool {
+	return timeutil.Now().Sub(grantedAt) > progressingSlotGrantTTL
+}
+
+// concurrencyBudget returns the scope of the concurrency budget which applies to the rollout, which
+// is the value of its budget label, and whether a budget applies to the rollout at all
+func concurrencyBudget(ro *v1alpha1.Rollout) (string, bool) {
+	if defaults.GetMaxProgressingRollouts() <= 0 || ro.Spec.Strategy.Canary == nil || len(ro.Spec.Strategy.Canary.Steps) == 0 {
+		return "", false
+	}
+	label := defaults.GetProgressingBudgetLabel()
+	if label == "" {
+		return "", true
+	}
+	budget, ok := ro.Labels[label]
+	return budget, ok
+}
+
+// budgetSelector returns the selector of the rollouts which share the budget
+func budgetSelector(budget string) labels.Selector {
+	label := defaults.GetProgressingBudgetLabel()
+	if label == "" {
+		return labels.Everything()
+	}
+	return labels.SelectorFromSet(labels.Set{label: budget})
+}
+
+// holdsProgressingSlot returns whether the rollout progressed past its first step and takes a slot
+// of its concurrency budget until its update completes or is aborted
+func holdsProgressingSlot(ro *v1alpha1.Rollout) bool {
+	if ro.Spec.Strategy.Canary == nil || ro.Status.Abort || rolloututil.IsFullyPromoted(ro) {
+		return false
+	}
+	return ro.Status.CurrentStepIndex != nil && *ro.Status.CurrentStepIndex > 0
+}
+
+// isQueued returns whether the rollout waits in the queue of its concurrency budget
+func isQueued(ro *v1alpha1.Rollout) bool {
+	if ro.Status.Queue == nil || ro.Spec.Strategy.Canary == nil || ro.Status.Abort || rolloututil.IsFullyPromoted(ro) {
+		return false
+	}
+	return ro.Status.CurrentStepIndex != nil && *ro.Status.CurrentStepIndex == 0
+}
+
+// queuedBefore returns whether rollout a was queued before rollout b. Rollouts queued at the same
+// time are ordered by namespace and name.
+func queuedBefore(a, b *v1alpha1.Rollout, aQueuedAt, bQueuedAt time.Time) bool {
+	if !aQueuedAt.Equal(bQueuedAt) {
+		return aQueuedAt.Before(bQueuedAt)
+	}
+	if a.Namespace != b.Namespace {
+		return a.Namespace < b.Namespace
+	}
+	return a.Name < b.Name
+}
+
+// reconcileConcurrencyBudget returns whether the rollout may progress past its first step. When all
+// the slots of its concurrency budget are taken, or taken by the rollouts queued before it, the
+// rollout is queued and its place in the queue is set in the new status. The slots are taken one
+// rollout at a time, and count the slots granted to rollouts the lister is not up to date with.
+func (c *rolloutContext) reconcileConcurrencyBudget(newStatus *v1alpha1.RolloutStatus) (bool, error) {
+	budget, ok := concurrencyBudget(c.rollout)
+	if !ok {
+		return true, nil
+	}
+	c.progressingSlots.lock.Lock()
+	defer c.progressingSlots.lock.Unlock()
+	c.progressingSlots.forgetExpired()
+	rollouts, err := c.rolloutsLister.List(budgetSelector(budget))
+	if err != nil {
+		return false, err
+	}
+
+	queuedAt := timeutil.MetaNow()
+	if c.rollout.Status.Queue != nil {
+		queuedAt = c.rollout.Status.Queue.QueuedAt
+	}
+	progressing := int32(0)
+	var queuedAhead []*v1alpha1.Rollout
+	for _, ro := range rollouts {
+		if ro.UID == c.rollout.UID {
+			continue
+		}
+		if holdsProgressingSlot(ro) || c.progressingSlots.pending(ro) {
+			progressing++
+		} else if isQueued(ro) && queuedBefore(ro, c.rollout, ro.Status.Queue.QueuedAt.Time, queuedAt.Time) {
+			queuedAhead = append(queuedAhead, ro)
+		}
+	}
+
+	max := defaults.GetMaxProgressingRollouts()
+	if progressing+int32(len(queuedAhead)) < max {
+		c.progressingSlots.granted[c.rollout.UID] = timeutil.Now()
+		if c.rollout.Status.Queue != nil {
+			waited := timeutil.Now().Sub(c.rollout.Status.Queue.QueuedAt.Time).Round(time.Second)
+			c.log.Infof("Rollout dequeued after waiting %s", waited)
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutDequeuedReason}, conditions.RolloutDequeuedMessage, waited)
+		}
+		return true, nil
+	}
+
+	delete(c.progressingSlots.granted, c.rollout.UID)
+	sort.Slice(queuedAhead, func(i, j int) bool {
+		return queuedBefore(queuedAhead[i], queuedAhead[j], queuedAhead[i].Status.Queue.QueuedAt.Time, queuedAhead[j].Status.Queue.QueuedAt.Time)
+	})
+	position := int32(len(queuedAhead)) + 1
+	if c.rollout.Status.Queue == nil {
+		c.log.Infof("Rollout queued at position %d (progressing: %d, max: %d)", position, progressing, max)
+		c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutQueuedReason}, conditions.RolloutQueuedMessage, position, progressing)
+	}
+	newStatus.Queue = &v1alpha1.RolloutQueueStatus{
+		Position: position,
+		Budget:   budget,
+		QueuedAt: queuedAt,
+	}
+	return false, nil
+}
+
+// enqueueQueuedRollouts enqueues the rollouts queued by the concurrency budget of the rollout, so
+// they take the slot it released or move up the queue
+func (c *Controller) enqueueQueuedRollouts(ro *v1alpha1.Rollout) {
+	budget, ok := concurrencyBudget(ro)
+	if !ok {
+		return
+	}
+	rollouts, err := c.rolloutsLister.List(budgetSelector(budget))
+	if err != nil {
+		return
+	}
+	for _, queued := range rollouts {
+		if queued.UID != ro.UID && isQueued(queued) {
+			c.enqueueRollout(queued)
+		}
+	}
+}
+
+// leftConcurrencyBudget returns whether the update of the rollout released a slot of its
+// concurrency budget, or moved the rollout out of its queue
+func leftConcurrencyBudget(old, new *v1alpha1.Rollout) bool {
+	return (holdsProgressingSlot(old) && !holdsProgressingSlot(new)) || (isQueued(old) && !isQueued(new))
+}
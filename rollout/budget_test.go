@@ -0,0 +1,185 @@
+package rollout
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	listers "github.com/argoproj/argo-rollouts/pkg/client/listers/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newProgressingRollout returns a canary rollout which holds a slot of the concurrency budget
+func newProgressingRollout(name string) *v1alpha1.Rollout {
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{}}}
+	ro := newCanaryRollout(name, 1, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0))
+	ro.UID = types.UID(name)
+	ro.Status.StableRS = "stable"
+	ro.Status.CurrentPodHash = "canary"
+	return ro
+}
+
+// newBudgetFixture returns a fixture with a canary rollout which completed its first step, and the
+// given rollouts sharing its concurrency budget
+func newBudgetFixture(t *testing.T, others ...*v1alpha1.Rollout) (*fixture, *v1alpha1.Rollout) {
+	steps := []v1alpha1.CanaryStep{{Pause: &v1alpha1.RolloutPause{}}, {SetWeight: pointer.Int32Ptr(50)}}
+	f, r2, _, _ := newCanaryUpdateFixture(t, steps, 10, 0)
+	r2.Status.ControllerPause = true
+
+	f.rolloutLister = append(f.rolloutLister, others...)
+	for _, ro := range others {
+		f.objects = append(f.objects, ro)
+	}
+	return f, r2
+}
+
+func TestQueueRolloutWhenBudgetIsFull(t *testing.T) {
+	defaults.SetMaxProgressingRollouts(1)
+	defer defaults.SetMaxProgressingRollouts(0)
+
+	f, r := newBudgetFixture(t, newProgressingRollout("bar"))
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	// the rollout stays at its first step
+	assert.Nil(t, status.CurrentStepIndex)
+	if assert.NotNil(t, status.Queue) {
+		assert.Equal(t, int32(1), status.Queue.Position)
+		assert.Empty(t, status.Queue.Budget)
+	}
+	assert.Equal(t, v1alpha1.RolloutPhaseQueued, status.Phase)
+	assert.Contains(t, f.events, conditions.RolloutQueuedReason)
+}
+
+func TestQueueRolloutBehindRolloutsQueuedBefore(t *testing.T) {
+	defaults.SetMaxProgressingRollouts(2)
+	defer defaults.SetMaxProgressingRollouts(0)
+
+	queued := newProgressingRollout("baz")
+	queued.Status.CurrentStepIndex = pointer.Int32Ptr(0)
+	queued.Status.Queue = &v1alpha1.RolloutQueueStatus{
+		Position: 1,
+		QueuedAt: metav1.NewTime(timeutil.Now().Add(-time.Minute)),
+	}
+	f, r := newBudgetFixture(t, newProgressingRollout("bar"), queued)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	if assert.NotNil(t, status.Queue) {
+		assert.Equal(t, int32(2), status.Queue.Position)
+	}
+}
+
+func TestDequeueRolloutWhenBudgetHasSlot(t *testing.T) {
+	defaults.SetMaxProgressingRollouts(2)
+	defer defaults.SetMaxProgressingRollouts(0)
+
+	f, r := newBudgetFixture(t, newProgressingRollout("bar"))
+	defer f.Close()
+	r.Status.Queue = &v1alpha1.RolloutQueueStatus{
+		Position: 1,
+		QueuedAt: metav1.NewTime(timeutil.Now().Add(-time.Minute)),
+	}
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	assert.Equal(t, int32(1), *status.CurrentStepIndex)
+	assert.Nil(t, status.Queue)
+	assert.Contains(t, f.events, conditions.RolloutDequeuedReason)
+}
+
+func TestBudgetLabelScopesConcurrencyBudget(t *testing.T) {
+	defaults.SetMaxProgressingRollouts(1)
+	defaults.SetProgressingBudgetLabel("team")
+	defer defaults.SetMaxProgressingRollouts(0)
+	defer defaults.SetProgressingBudgetLabel("")
+
+	other := newProgressingRollout("bar")
+	other.Labels = map[string]string{"team": "b"}
+	f, r := newBudgetFixture(t, other)
+	defer f.Close()
+	r.Labels = map[string]string{"team": "a"}
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	assert.Equal(t, int32(1), *status.CurrentStepIndex)
+	assert.Nil(t, status.Queue)
+}
+
+func TestConcurrencyBudgetSlotsTakenOneAtATime(t *testing.T) {
+	defaults.SetMaxProgressingRollouts(1)
+	defer defaults.SetMaxProgressingRollouts(0)
+
+	// both rollouts completed their first step, and the lister is not updated when one takes the slot
+	first := newProgressingRollout("foo")
+	first.Status.CurrentStepIndex = pointer.Int32Ptr(0)
+	second := newProgressingRollout("bar")
+	second.Status.CurrentStepIndex = pointer.Int32Ptr(0)
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.NoError(t, indexer.Add(first))
+	assert.NoError(t, indexer.Add(second))
+	base := reconcilerBase{
+		rolloutsLister:   listers.NewRolloutLister(indexer),
+		recorder:         record.NewFakeEventRecorder(),
+		progressingSlots: newProgressingSlots(),
+	}
+	newContext := func(ro *v1alpha1.Rollout) *rolloutContext {
+		return &rolloutContext{reconcilerBase: base, rollout: ro, log: logutil.WithRollout(ro)}
+	}
+
+	canProgress, err := newContext(first).reconcileConcurrencyBudget(first.Status.DeepCopy())
+	assert.NoError(t, err)
+	assert.True(t, canProgress)
+
+	status := second.Status.DeepCopy()
+	canProgress, err = newContext(second).reconcileConcurrencyBudget(status)
+	assert.NoError(t, err)
+	assert.False(t, canProgress)
+	assert.NotNil(t, status.Queue)
+
+	// the grant is forgotten once it expires
+	timeutil.Now = func() time.Time {
+		return time.Now().Add(2 * progressingSlotGrantTTL)
+	}
+	defer func() { timeutil.Now = time.Now }()
+	canProgress, err = newContext(second).reconcileConcurrencyBudget(second.Status.DeepCopy())
+	assert.NoError(t, err)
+	assert.True(t, canProgress)
+}
+
+func TestLeftConcurrencyBudget(t *testing.T) {
+	progressing := newProgressingRollout("foo")
+	promoted := progressing.DeepCopy()
+	promoted.Status.StableRS = promoted.Status.CurrentPodHash
+	assert.True(t, leftConcurrencyBudget(progressing, promoted))
+	assert.False(t, leftConcurrencyBudget(progressing, progressing))
+
+	queued := progressing.DeepCopy()
+	queued.Status.CurrentStepIndex = pointer.Int32Ptr(0)
+	queued.Status.Queue = &v1alpha1.RolloutQueueStatus{Position: 1}
+	aborted := queued.DeepCopy()
+	aborted.Status.Abort = true
+	assert.True(t, leftConcurrencyBudget(queued, aborted))
+	assert.False(t, leftConcurrencyBudget(queued, queued))
+}
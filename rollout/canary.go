@@ -31,6 +31,11 @@ func (c *rolloutContext) rolloutCanary() error {
 		return err
 	}
 
+	err = c.reconcilePodSpecPatch()
+	if err != nil {
+		return err
+	}
+
 	err = c.podRestarter.Reconcile(c)
 	if err != nil {
 		return err
@@ -44,6 +49,9 @@ func (c *rolloutContext) rolloutCanary() error {
 	if err := c.reconcileRevisionHistoryLimit(c.otherRSs); err != nil {
 		return err
 	}
+	if err := c.reconcileRevisionSnapshots(); err != nil {
+		return err
+	}
 
 	if err := c.reconcilePingAndPongService(); err != nil {
 		return err
@@ -309,7 +317,7 @@ func (c *rolloutContext) canProceedWithScaleDownAnnotation(oldRSs []*appsv1.Repl
 }
 
 func (c *rolloutContext) completedCurrentCanaryStep() bool {
-	if c.rollout.Spec.Paused {
+	if c.rollout.Spec.Paused || c.frozenBy != nil || c.pausedByAlert() {
 		return false
 	}
 	currentStep, _ := replicasetutil.GetCurrentCanaryStep(c.rollout)
@@ -336,7 +344,7 @@ func (c *rolloutContext) completedCurrentCanaryStep() bool {
 	case currentStep.Analysis != nil:
 		currentStepAr := c.currentArs.CanaryStep
 		analysisExistsAndCompleted := currentStepAr != nil && currentStepAr.Status.Phase.Completed()
-		return analysisExistsAndCompleted && currentStepAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful
+		return analysisExistsAndCompleted && c.analysisRunSucceeded(currentStepAr)
 	}
 	return false
 }
@@ -379,10 +387,14 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 		if err != nil {
 			return err
 		}
+		c.calculateStepHistory(&newStatus, v1alpha1.CanaryStepOutcomeSkipped, "")
 		newStatus = c.calculateRolloutConditions(newStatus)
 		return c.persistRolloutStatus(&newStatus)
 	}
 
+	completedCurrentStep := !c.pauseContext.IsAborted() && c.completedCurrentCanaryStep()
+	exceededStepDeadline := !completedCurrentStep && c.checkStepDeadline(currentStep, currentStepIndex)
+
 	if c.pauseContext.IsAborted() {
 		if stepCount > int32(0) {
 			if newStatus.StableRS == newStatus.CurrentPodHash {
@@ -391,11 +403,35 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 				newStatus.CurrentStepIndex = pointer.Int32Ptr(0)
 			}
 		}
+		outcome := v1alpha1.CanaryStepOutcomeAborted
+		if exceededStepDeadline {
+			outcome = v1alpha1.CanaryStepOutcomeDeadlineExceeded
+		}
+		c.calculateStepHistory(&newStatus, outcome, c.pauseContext.abortMessage)
 		newStatus = c.calculateRolloutConditions(newStatus)
 		return c.persistRolloutStatus(&newStatus)
 	}
 
-	if c.completedCurrentCanaryStep() {
+	if completedCurrentStep && *currentStepIndex == 0 {
+		canProgress, err := c.reconcileConcurrencyBudget(&newStatus)
+		if err != nil {
+			return err
+		}
+		if !canProgress {
+			// the rollout waits at its first step until the concurrency budget has a slot for it
+			completedCurrentStep = false
+			if currentStep.Pause != nil {
+				// the pause step stays completed while the rollout is queued: the rollout is unpaused,
+				// but keeps controllerPause so the pause step is not started again
+				c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonCanaryPauseStep)
+				newStatus.ControllerPause = true
+			}
+		}
+	}
+
+	outcome := v1alpha1.CanaryStepOutcomeSkipped
+	if completedCurrentStep {
+		outcome = v1alpha1.CanaryStepOutcomeCompleted
 		stepStr := rolloututil.CanaryStepString(*currentStep)
 		*currentStepIndex++
 		newStatus.Canary.CurrentStepAnalysisRunStatus = nil
@@ -405,6 +441,7 @@ func (c *rolloutContext) syncRolloutStatusCanary() error {
 	}
 
 	newStatus.CurrentStepIndex = currentStepIndex
+	c.calculateStepHistory(&newStatus, outcome, "")
 	newStatus = c.calculateRolloutConditions(newStatus)
 	return c.persistRolloutStatus(&newStatus)
 }
@@ -38,6 +38,13 @@ func newCanaryRollout(name string, replicas int, revisionHistoryLimit *int32, st
 		Steps:          steps,
 	}
 	rollout.Status.CurrentStepIndex = stepIndex
+	if stepIndex != nil && int(*stepIndex) < len(steps) {
+		rollout.Status.Canary.StepHistory = []v1alpha1.CanaryStepRecord{{
+			Index:     *stepIndex,
+			StartedAt: timeutil.MetaNow(),
+			Outcome:   v1alpha1.CanaryStepOutcomeRunning,
+		}}
+	}
 	rollout.Status.CurrentStepHash = conditions.ComputeStepHash(rollout)
 	rollout.Status.CurrentPodHash = hash.ComputePodTemplateHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
 	rollout.Status.Selector = metav1.FormatLabelSelector(rollout.Spec.Selector)
@@ -174,13 +181,15 @@ func TestCanaryRolloutEnterPauseState(t *testing.T) {
 			"conditions": %s,
 			"controllerPause": true,
 			"phase": "Paused",
-			"message": "%s"
+			"message": "%s",
+			"progress": %s
 		}
 	}`
 
 	conditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, r2, false, "")
 	now := timeutil.MetaNow().UTC().Format(time.RFC3339)
-	expectedPatchWithoutObservedGen := fmt.Sprintf(expectedPatchTemplate, v1alpha1.PauseReasonCanaryPauseStep, now, conditions, v1alpha1.PauseReasonCanaryPauseStep)
+	progress := unknownProgressPatch(0, fmt.Sprintf(progressManualPromotionMessage, 1))
+	expectedPatchWithoutObservedGen := fmt.Sprintf(expectedPatchTemplate, v1alpha1.PauseReasonCanaryPauseStep, now, conditions, v1alpha1.PauseReasonCanaryPauseStep, progress)
 	expectedPatch := calculatePatch(r2, expectedPatchWithoutObservedGen)
 	assert.Equal(t, expectedPatch, patch)
 }
@@ -332,13 +341,18 @@ func TestCanaryRolloutIncrementStepAfterUnPaused(t *testing.T) {
 	patch := f.getPatchedRollout(patchIndex)
 	expectedPatchTemplate := `{
 	"status":{
+		"canary": {
+			"stepHistory": %s
+		},
 		"controllerPause": null,
 		"conditions" : %s,
-		"currentStepIndex": 1
+		"currentStepIndex": 1,
+		"progress": %s
 	}
 }`
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeCompleted, ""))
 	generatedConditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, rs2, false, "")
-	expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchTemplate, generatedConditions))
+	expectedPatch := calculatePatch(r2, fmt.Sprintf(expectedPatchTemplate, stepHistory, generatedConditions, progressPatch(50, 30)))
 	assert.Equal(t, expectedPatch, patch)
 }
 
@@ -835,6 +849,9 @@ func TestRollBackToStable(t *testing.T) {
 
 	expectedPatchWithoutSub := `{
 		"status":{
+			"canary": {
+				"stepHistory": null
+			},
 			"currentPodHash": "%s",
 			"currentStepIndex":1,
 			"conditions": %s
@@ -923,6 +940,9 @@ func TestRollBackToStableAndStepChange(t *testing.T) {
 
 	expectedPatchWithoutSub := `{
 		"status":{
+			"canary": {
+				"stepHistory": null
+			},
 			"currentPodHash": "%s",
 			"currentStepHash": "%s",
 			"currentStepIndex":1,
@@ -965,12 +985,23 @@ func TestCanaryRolloutIncrementStepIfSetWeightsAreCorrect(t *testing.T) {
 	patch := f.getPatchedRollout(patchIndex)
 	expectedPatch := `{
 		"status":{
+			"canary": {
+				"stepHistory": %s
+			},
 			"currentStepIndex":1,
-			"conditions": %s
+			"conditions": %s,
+			"progress": {
+				"percent": 50,
+				"estimatedCompletionTime": "%s",
+				"podReadySeconds": 0
+			}
 		}
 	}`
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeCompleted, ""))
 	newConditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, rs3, false, "")
-	assert.Equal(t, calculatePatch(r3, fmt.Sprintf(expectedPatch, newConditions)), patch)
+	// the setWeight step completed as soon as it started, so the pods are estimated to be ready immediately
+	now := timeutil.Now().UTC().Format(time.RFC3339)
+	assert.Equal(t, calculatePatch(r3, fmt.Sprintf(expectedPatch, stepHistory, newConditions, now)), patch)
 }
 
 func TestSyncRolloutWaitAddToQueue(t *testing.T) {
@@ -1006,6 +1037,9 @@ func TestSyncRolloutWaitAddToQueue(t *testing.T) {
 	conditions.SetRolloutCondition(&r2.Status, availableCondition)
 
 	r2.Status.ObservedGeneration = strconv.Itoa(int(r2.Generation))
+	// the completion time estimated in a previous reconciliation does not need to be updated
+	estimatedCompletionTime := timeutil.MetaNow()
+	r2.Status.Progress = &v1alpha1.RolloutProgress{Percent: 33, EstimatedCompletionTime: &estimatedCompletionTime}
 	f.rolloutLister = append(f.rolloutLister, r2)
 	f.objects = append(f.objects, r2)
 
@@ -1044,6 +1078,8 @@ func TestSyncRolloutIgnoreWaitOutsideOfReconciliationPeriod(t *testing.T) {
 
 	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 10, 1, 10, true)
 	r2.Status.ObservedGeneration = strconv.Itoa(int(r2.Generation))
+	estimatedCompletionTime := metav1.NewTime(timeutil.Now().Add(time.Hour))
+	r2.Status.Progress = &v1alpha1.RolloutProgress{Percent: 33, EstimatedCompletionTime: &estimatedCompletionTime}
 	progressingCondition, _ := newProgressingCondition(conditions.RolloutPausedReason, rs2, "")
 	conditions.SetRolloutCondition(&r2.Status, progressingCondition)
 
@@ -1112,12 +1148,21 @@ func TestSyncRolloutWaitIncrementStepIndex(t *testing.T) {
 	patch := f.getPatchedRolloutWithoutConditions(patchIndex)
 	expectedPatch := `{
 		"status":{
+			"canary": {
+				"stepHistory": %s
+			},
 			"controllerPause": null,
 			"pauseConditions": null,
-			"currentStepIndex":2
+			"currentStepIndex":2,
+			"progress": %s
 		}
 	}`
-	assert.Equal(t, calculatePatch(r2, expectedPatch), patch)
+	stepHistory := stepHistoryPatch(
+		finishedStepRecord(1, v1alpha1.CanaryStepOutcomeCompleted, ""),
+		v1alpha1.CanaryStepRecord{Index: 2, StartedAt: timeutil.MetaNow(), Outcome: v1alpha1.CanaryStepOutcomeRunning},
+	)
+	progress := unknownProgressPatch(50, fmt.Sprintf(progressManualPromotionMessage, 3))
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, stepHistory, progress)), patch)
 }
 
 func TestCanaryRolloutStatusHPAStatusFields(t *testing.T) {
@@ -1665,6 +1710,9 @@ func TestHandleCanaryAbort(t *testing.T) {
 		patch := f.getPatchedRollout(patchIndex)
 		expectedPatch := `{
 			"status":{
+				"canary": {
+					"stepHistory": %s
+				},
 				"currentStepIndex": 0,
 				"conditions": %s,
 				"phase": "Degraded",
@@ -1672,8 +1720,9 @@ func TestHandleCanaryAbort(t *testing.T) {
 			}
 		}`
 		errmsg := fmt.Sprintf(conditions.RolloutAbortedMessage, 2)
+		stepHistory := stepHistoryPatch(finishedStepRecord(1, v1alpha1.CanaryStepOutcomeAborted, ""))
 		newConditions := generateConditionsPatch(true, conditions.RolloutAbortedReason, r2, false, "")
-		assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, newConditions, conditions.RolloutAbortedReason, errmsg)), patch)
+		assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, stepHistory, newConditions, conditions.RolloutAbortedReason, errmsg)), patch)
 	})
 
 	t.Run("Do not reset currentStepCount if newRS is stableRS", func(t *testing.T) {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/freeze"
 )
 
 type rolloutContext struct {
@@ -42,6 +43,9 @@ type rolloutContext struct {
 	newStatus    v1alpha1.RolloutStatus
 	pauseContext *pauseContext
 
+	// frozenBy is the emergency stop holding the update of the rollout, nil when it is not frozen
+	frozenBy *freeze.Freeze
+
 	// targetsVerified indicates if the pods targets have been verified with underlying LoadBalancer.
 	// This is used in pod-aware flat networks where LoadBalancers target Pods and not Nodes.
 	// nil indicates the check was unnecessary or not performed.
@@ -69,6 +73,15 @@ func (c *rolloutContext) reconcile() error {
 		return err
 	}
 
+	c.reconcileAlert()
+
+	err = c.reconcileCrashGuard()
+	if err != nil {
+		return err
+	}
+
+	c.podRestarter.reconcileSchedule(c)
+
 	isScalingEvent, err := c.isScalingEvent()
 	if err != nil {
 		return err
@@ -79,15 +92,30 @@ func (c *rolloutContext) reconcile() error {
 	}
 
 	if c.rollout.Spec.Strategy.BlueGreen != nil {
-		return c.rolloutBlueGreen()
+		return c.reconcilePermissionDenied(c.rolloutBlueGreen())
 	}
 
 	// Due to the rollout validation before this, when we get here strategy is canary
-	return c.rolloutCanary()
+	return c.reconcilePermissionDenied(c.rolloutCanary())
 }
 
+// SetRestartedAt records that all the pods of the rollout were created after the restartAt of the
+// spec, and after the scheduled restart if it is due, in which case the next restart is scheduled
 func (c *rolloutContext) SetRestartedAt() {
-	c.newStatus.RestartedAt = c.rollout.Spec.RestartAt
+	restartedAt := c.rollout.Spec.RestartAt
+	if next := c.newStatus.NextScheduledRestartAt; next != nil && !nowFn().Before(next.Time) {
+		if restartedAt == nil || restartedAt.Before(next) {
+			restartedAt = next
+		}
+		c.newStatus.NextScheduledRestartAt = nil
+		c.podRestarter.reconcileSchedule(c)
+	}
+	lastRestartedAt := c.newStatus.RestartedAt
+	if c.rollout.Spec.RestartSchedule != nil && lastRestartedAt != nil && (restartedAt == nil || restartedAt.Before(lastRestartedAt)) {
+		// keep the last scheduled restart
+		return
+	}
+	c.newStatus.RestartedAt = restartedAt
 }
 
 func (c *rolloutContext) SetCurrentExperiment(ex *v1alpha1.Experiment) {
@@ -144,13 +172,20 @@ func (c *rolloutContext) SetCurrentAnalysisRuns(currARs analysisutil.CurrentAnal
 
 // haltProgress returns a reason on whether or not we should halt all progress with an update
 // to ReplicaSet counts (e.g. due to canary steps or blue-green promotion). This is either because
-// user explicitly paused the rollout by setting `spec.paused`, or the analysis was inconclusive
+// user explicitly paused the rollout by setting `spec.paused`, an emergency stop froze the rollout,
+// the analysis was inconclusive, or a firing alert paused the rollout
 func (c *rolloutContext) haltProgress() string {
 	if c.rollout.Spec.Paused {
 		return "user paused"
 	}
+	if c.frozenBy != nil {
+		return "frozen by emergency stop"
+	}
 	if getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis) != nil {
 		return "inconclusive analysis"
 	}
+	if c.pausedByAlert() {
+		return "paused by alert"
+	}
 	return ""
 }
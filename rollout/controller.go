@@ -15,6 +15,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -47,9 +48,12 @@ import (
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	experimentutil "github.com/argoproj/argo-rollouts/utils/experiment"
+	"github.com/argoproj/argo-rollouts/utils/freeze"
+	"github.com/argoproj/argo-rollouts/utils/impersonation"
 	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	istioutil "github.com/argoproj/argo-rollouts/utils/istio"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/queue"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
@@ -95,18 +99,25 @@ type ControllerConfig struct {
 	AnalysisTemplateInformer        informers.AnalysisTemplateInformer
 	ClusterAnalysisTemplateInformer informers.ClusterAnalysisTemplateInformer
 	ReplicaSetInformer              appsinformers.ReplicaSetInformer
+	ControllerRevisionInformer      appsinformers.ControllerRevisionInformer
 	ServicesInformer                coreinformers.ServiceInformer
-	IngressWrapper                  IngressWrapper
-	RolloutsInformer                informers.RolloutInformer
-	IstioPrimaryDynamicClient       dynamic.Interface
-	IstioVirtualServiceInformer     cache.SharedIndexInformer
-	IstioDestinationRuleInformer    cache.SharedIndexInformer
-	ResyncPeriod                    time.Duration
-	RolloutWorkQueue                workqueue.RateLimitingInterface
-	ServiceWorkQueue                workqueue.RateLimitingInterface
-	IngressWorkQueue                workqueue.RateLimitingInterface
-	MetricsServer                   *metrics.MetricsServer
-	Recorder                        record.EventRecorder
+	ConfigMapInformer               coreinformers.ConfigMapInformer
+	// NamespaceInformer is only set for the cluster-wide controllers, which may watch namespaces
+	NamespaceInformer            coreinformers.NamespaceInformer
+	IngressWrapper               IngressWrapper
+	RolloutsInformer             informers.RolloutInformer
+	IstioPrimaryDynamicClient    dynamic.Interface
+	IstioVirtualServiceInformer  cache.SharedIndexInformer
+	IstioDestinationRuleInformer cache.SharedIndexInformer
+	ResyncPeriod                 time.Duration
+	RolloutWorkQueue             workqueue.RateLimitingInterface
+	ServiceWorkQueue             workqueue.RateLimitingInterface
+	IngressWorkQueue             workqueue.RateLimitingInterface
+	MetricsServer                *metrics.MetricsServer
+	Recorder                     record.EventRecorder
+	// Impersonator, when set, provides the clients used to mutate the traffic routing objects and
+	// services of the rollouts on behalf of their ServiceAccount
+	Impersonator Impersonator
 }
 
 // reconcilerBase is a shared datastructure containing all clients and configuration necessary to
@@ -125,23 +136,30 @@ type reconcilerBase struct {
 
 	replicaSetLister              appslisters.ReplicaSetLister
 	replicaSetSynced              cache.InformerSynced
+	controllerRevisionLister      appslisters.ControllerRevisionLister
 	rolloutsInformer              cache.SharedIndexInformer
 	rolloutsLister                listers.RolloutLister
 	rolloutsSynced                cache.InformerSynced
 	rolloutsIndexer               cache.Indexer
 	servicesLister                v1.ServiceLister
+	configMapLister               v1.ConfigMapLister
+	namespaceLister               v1.NamespaceLister
 	ingressWrapper                IngressWrapper
 	experimentsLister             listers.ExperimentLister
 	analysisRunLister             listers.AnalysisRunLister
 	analysisTemplateLister        listers.AnalysisTemplateLister
 	clusterAnalysisTemplateLister listers.ClusterAnalysisTemplateLister
 	IstioController               *istio.IstioController
+	impersonator                  Impersonator
 
 	podRestarter RolloutPodRestarter
+	// progressingSlots tracks the slots of the concurrency budgets granted to the rollouts
+	progressingSlots *progressingSlots
 
 	// used for unit testing
 	enqueueRollout              func(obj interface{})                                                          //nolint:structcheck
 	enqueueRolloutAfter         func(obj interface{}, duration time.Duration)                                  //nolint:structcheck
+	enqueueRolloutWithPriority  func(obj interface{}, priority queue.Priority)                                 //nolint:structcheck
 	newTrafficRoutingReconciler func(roCtx *rolloutContext) ([]trafficrouting.TrafficRoutingReconciler, error) //nolint:structcheck
 
 	// recorder is an event recorder for recording Event resources to the Kubernetes API.
@@ -156,6 +174,11 @@ type IngressWrapper interface {
 	Create(ctx context.Context, namespace string, ingress *ingressutil.Ingress, opts metav1.CreateOptions) (*ingressutil.Ingress, error)
 }
 
+// Impersonator provides clients which impersonate the ServiceAccount configured for a rollout
+type Impersonator interface {
+	ClientsFor(namespace string, annotations map[string]string) (*impersonation.Clients, error)
+}
+
 // NewController returns a new rollout controller
 func NewController(cfg ControllerConfig) *Controller {
 
@@ -179,11 +202,13 @@ func NewController(cfg ControllerConfig) *Controller {
 		smiclientset:                  cfg.SmiClientSet,
 		replicaSetLister:              cfg.ReplicaSetInformer.Lister(),
 		replicaSetSynced:              cfg.ReplicaSetInformer.Informer().HasSynced,
+		controllerRevisionLister:      cfg.ControllerRevisionInformer.Lister(),
 		rolloutsInformer:              cfg.RolloutsInformer.Informer(),
 		rolloutsIndexer:               cfg.RolloutsInformer.Informer().GetIndexer(),
 		rolloutsLister:                cfg.RolloutsInformer.Lister(),
 		rolloutsSynced:                cfg.RolloutsInformer.Informer().HasSynced,
 		servicesLister:                cfg.ServicesInformer.Lister(),
+		configMapLister:               cfg.ConfigMapInformer.Lister(),
 		ingressWrapper:                cfg.IngressWrapper,
 		experimentsLister:             cfg.ExperimentInformer.Lister(),
 		analysisRunLister:             cfg.AnalysisRunInformer.Lister(),
@@ -192,7 +217,13 @@ func NewController(cfg ControllerConfig) *Controller {
 		recorder:                      cfg.Recorder,
 		resyncPeriod:                  cfg.ResyncPeriod,
 		podRestarter:                  podRestarter,
+		progressingSlots:              newProgressingSlots(),
 		refResolver:                   cfg.RefResolver,
+		impersonator:                  cfg.Impersonator,
+	}
+
+	if cfg.NamespaceInformer != nil {
+		base.namespaceLister = cfg.NamespaceInformer.Lister()
 	}
 
 	controller := &Controller{
@@ -210,6 +241,9 @@ func NewController(cfg ControllerConfig) *Controller {
 	controller.enqueueRolloutAfter = func(obj interface{}, duration time.Duration) {
 		controllerutil.EnqueueAfter(obj, duration, cfg.RolloutWorkQueue)
 	}
+	controller.enqueueRolloutWithPriority = func(obj interface{}, priority queue.Priority) {
+		controllerutil.EnqueueWithPriority(obj, priority, cfg.RolloutWorkQueue)
+	}
 
 	controller.IstioController = istio.NewIstioController(istio.IstioControllerConfig{
 		ArgoprojClientSet:       cfg.ArgoProjClientset,
@@ -238,6 +272,14 @@ func NewController(cfg ControllerConfig) *Controller {
 				for _, key := range removedKeys("DestinationRule", oldRollout, newRollout, istioutil.GetRolloutDesinationRuleKeys) {
 					controller.IstioController.EnqueueDestinationRule(key)
 				}
+				// Let the rollouts queued by the concurrency budget take the slot which was released
+				if leftConcurrencyBudget(oldRollout, newRollout) {
+					controller.enqueueQueuedRollouts(newRollout)
+				}
+			}
+			if priority := rolloutUpdatePriority(old, new); priority != queue.PriorityNormal {
+				controller.enqueueRolloutWithPriority(new, priority)
+				return
 			}
 			controller.enqueueRollout(new)
 		},
@@ -253,6 +295,9 @@ func NewController(cfg ControllerConfig) *Controller {
 				for _, key := range istioutil.GetRolloutDesinationRuleKeys(ro) {
 					controller.IstioController.EnqueueDestinationRule(key)
 				}
+				if holdsProgressingSlot(ro) || isQueued(ro) {
+					controller.enqueueQueuedRollouts(ro)
+				}
 			}
 		},
 	})
@@ -276,6 +321,33 @@ func NewController(cfg ControllerConfig) *Controller {
 		},
 	})
 
+	cfg.ConfigMapInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: controller.enqueueFrozenRollouts,
+		UpdateFunc: func(old, new interface{}) {
+			oldCM, oldOK := old.(*corev1.ConfigMap)
+			newCM, newOK := new.(*corev1.ConfigMap)
+			if oldOK && newOK && oldCM.Data[freeze.ConfigMapKey] == newCM.Data[freeze.ConfigMapKey] {
+				return
+			}
+			controller.enqueueFrozenRollouts(new)
+		},
+		DeleteFunc: controller.enqueueFrozenRollouts,
+	})
+
+	if cfg.NamespaceInformer != nil {
+		// The namespace policies of ClusterAnalysisTemplates may select namespaces by their labels
+		cfg.NamespaceInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+			UpdateFunc: func(old, new interface{}) {
+				oldNS, oldOK := old.(*corev1.Namespace)
+				newNS, newOK := new.(*corev1.Namespace)
+				if oldOK && newOK && labels.Equals(oldNS.Labels, newNS.Labels) {
+					return
+				}
+				controller.enqueueNamespaceRollouts(new)
+			},
+		})
+	}
+
 	cfg.AnalysisRunInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			controllerutil.EnqueueParentObject(obj, register.RolloutKind, controller.enqueueRollout)
@@ -300,6 +372,27 @@ func NewController(cfg ControllerConfig) *Controller {
 	return controller
 }
 
+// rolloutUpdatePriority returns the queue priority of a rollout update event. In addition to spec
+// changes, user actions which are performed against the status (abort, retry, promote) are treated
+// as high priority so they are not stuck behind periodic resyncs of other rollouts.
+func rolloutUpdatePriority(old, new interface{}) queue.Priority {
+	priority := controllerutil.UpdatePriority(old, new)
+	if priority != queue.PriorityNormal {
+		return priority
+	}
+	oldRollout := unstructuredutil.ObjectToRollout(old)
+	newRollout := unstructuredutil.ObjectToRollout(new)
+	if oldRollout == nil || newRollout == nil {
+		return priority
+	}
+	if oldRollout.Status.Abort != newRollout.Status.Abort ||
+		oldRollout.Status.PromoteFull != newRollout.Status.PromoteFull ||
+		len(newRollout.Status.PauseConditions) < len(oldRollout.Status.PauseConditions) {
+		return queue.PriorityHigh
+	}
+	return priority
+}
+
 // removedKeys returns list of indexer keys which have been removed from the old rollout
 func removedKeys(name string, old, new *v1alpha1.Rollout, keyFunc func(ro *v1alpha1.Rollout) []string) []string {
 	oldKeys := keyFunc(old)
@@ -376,6 +469,16 @@ func (c *Controller) syncHandler(key string) error {
 	}()
 
 	resolveErr := c.refResolver.Resolve(r)
+	if resolveErr == nil {
+		adopting, err := c.adoptDeploymentReplicaSet(r)
+		if err != nil {
+			logCtx.Errorf("adoptDeploymentReplicaSet err %v", err)
+			return err
+		}
+		if adopting {
+			return nil
+		}
+	}
 	roCtx, err := c.newRolloutContext(r)
 	if err != nil {
 		logCtx.Errorf("newRolloutContext err %v", err)
@@ -451,6 +554,11 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 	}
 	currentArs, otherArs := analysisutil.FilterCurrentRolloutAnalysisRuns(arList, rollout)
 
+	frozenBy, err := c.getFreeze(rollout, newRS, stableRS)
+	if err != nil {
+		return nil, err
+	}
+
 	logCtx := logutil.WithRollout(rollout)
 	roCtx := rolloutContext{
 		rollout:    rollout,
@@ -464,9 +572,15 @@ func (c *Controller) newRolloutContext(rollout *v1alpha1.Rollout) (*rolloutConte
 		otherArs:   otherArs,
 		currentEx:  currentEx,
 		otherExs:   otherExs,
+		frozenBy:   frozenBy,
 		newStatus: v1alpha1.RolloutStatus{
-			RestartedAt: rollout.Status.RestartedAt,
-			ALB:         rollout.Status.ALB,
+			RestartedAt:            rollout.Status.RestartedAt,
+			NextScheduledRestartAt: rollout.Status.NextScheduledRestartAt,
+			PodSpecPatchHash:       rollout.Status.PodSpecPatchHash,
+			ALB:                    rollout.Status.ALB,
+			Adoption:               rollout.Status.Adoption,
+			// carry over how the inconclusive policy resolved the current AnalysisRuns
+			InconclusiveResolution: rollout.Status.InconclusiveResolution,
 		},
 		pauseContext: &pauseContext{
 			rollout: rollout,
@@ -744,6 +858,12 @@ func (c *rolloutContext) getReferencedAnalysisTemplates(rollout *v1alpha1.Rollou
 				}
 				return nil, err
 			}
+			if err := analysisutil.VerifyClusterAnalysisTemplateAccess(c.namespaceLister, template, rollout.Namespace); err != nil {
+				if analysisutil.IsClusterAnalysisTemplateAccessDenied(err) {
+					return nil, field.Invalid(fldPath, templateRef.TemplateName, err.Error())
+				}
+				return nil, err
+			}
 			clusterTemplates = append(clusterTemplates, template)
 		} else {
 			template, err := c.analysisTemplateLister.AnalysisTemplates(c.rollout.Namespace).Get(templateRef.TemplateName)
@@ -92,6 +92,7 @@ type fixture struct {
 	clusterAnalysisTemplateLister []*v1alpha1.ClusterAnalysisTemplate
 	analysisTemplateLister        []*v1alpha1.AnalysisTemplate
 	replicaSetLister              []*appsv1.ReplicaSet
+	controllerRevisionLister      []*appsv1.ControllerRevision
 	serviceLister                 []*corev1.Service
 	ingressLister                 []*ingressutil.Ingress
 	// Actions expected to happen on the client.
@@ -401,6 +402,27 @@ func updateBaseRolloutStatus(r *v1alpha1.Rollout, availableReplicas, updatedRepl
 	return newRollout
 }
 
+// newCanaryUpdateFixture returns a fixture with a canary rollout named foo at the first of the given
+// steps of an update, whose canary ReplicaSet has canaryReplicas available replicas and whose stable
+// ReplicaSet has the others. The rollout and the ReplicaSets are added to the fixture, and may still
+// be changed before the fixture runs.
+func newCanaryUpdateFixture(t *testing.T, steps []v1alpha1.CanaryStep, replicas, canaryReplicas int32) (*fixture, *v1alpha1.Rollout, *appsv1.ReplicaSet, *appsv1.ReplicaSet) {
+	f := newFixture(t)
+
+	r1 := newCanaryRollout("foo", int(replicas), nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	rs1 := newReplicaSetWithStatus(r1, int(replicas-canaryReplicas), int(replicas-canaryReplicas))
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, int(canaryReplicas), int(canaryReplicas))
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, replicas, canaryReplicas, replicas, false)
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	return f, r2, rs1, rs2
+}
+
 func newReplicaSet(r *v1alpha1.Rollout, replicas int) *appsv1.ReplicaSet {
 	podHash := hash.ComputePodTemplateHash(&r.Spec.Template, r.Status.CollisionCount)
 	rsLabels := map[string]string{
@@ -457,6 +479,41 @@ func calculatePatch(ro *v1alpha1.Rollout, patch string) string {
 	return string(newPatchBytes)
 }
 
+// stepHistoryPatch returns the step history of a patch made of the given records
+func stepHistoryPatch(records ...v1alpha1.CanaryStepRecord) string {
+	recordBytes, err := json.Marshal(records)
+	if err != nil {
+		panic(err)
+	}
+	return string(recordBytes)
+}
+
+// finishedStepRecord returns the record of the step at the index, which started with the
+// test rollout and finished during the reconciliation
+func finishedStepRecord(index int32, outcome v1alpha1.CanaryStepOutcome, message string) v1alpha1.CanaryStepRecord {
+	now := timeutil.MetaNow()
+	return v1alpha1.CanaryStepRecord{
+		Index:      index,
+		StartedAt:  now,
+		FinishedAt: &now,
+		Outcome:    outcome,
+		Message:    message,
+	}
+}
+
+// progressPatch returns the progress of a patch for an update which is percent complete and is
+// estimated to complete after the remaining number of seconds
+func progressPatch(percent int32, remainingSeconds int) string {
+	completion := timeutil.Now().Add(time.Duration(remainingSeconds) * time.Second).UTC().Format(time.RFC3339)
+	return fmt.Sprintf(`{"percent":%d,"estimatedCompletionTime":"%s"}`, percent, completion)
+}
+
+// unknownProgressPatch returns the progress of a patch for an update which is percent complete and
+// whose completion time cannot be estimated
+func unknownProgressPatch(percent int32, message string) string {
+	return fmt.Sprintf(`{"percent":%d,"message":"%s"}`, percent, message)
+}
+
 func cleanPatch(expectedPatch string) string {
 	patch := make(map[string]interface{})
 	err := json.Unmarshal([]byte(expectedPatch), &patch)
@@ -529,7 +586,9 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 		AnalysisTemplateInformer:        i.Argoproj().V1alpha1().AnalysisTemplates(),
 		ClusterAnalysisTemplateInformer: i.Argoproj().V1alpha1().ClusterAnalysisTemplates(),
 		ReplicaSetInformer:              k8sI.Apps().V1().ReplicaSets(),
+		ControllerRevisionInformer:      k8sI.Apps().V1().ControllerRevisions(),
 		ServicesInformer:                k8sI.Core().V1().Services(),
+		ConfigMapInformer:               k8sI.Core().V1().ConfigMaps(),
 		IngressWrapper:                  ingressWrapper,
 		RolloutsInformer:                i.Argoproj().V1alpha1().Rollouts(),
 		IstioPrimaryDynamicClient:       dynamicClient,
@@ -564,6 +623,9 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	c.enqueueRolloutAfter = func(obj interface{}, duration time.Duration) {
 		c.enqueueRollout(obj)
 	}
+	c.enqueueRolloutWithPriority = func(obj interface{}, priority queue.Priority) {
+		c.enqueueRollout(obj)
+	}
 	c.newTrafficRoutingReconciler = func(roCtx *rolloutContext) ([]trafficrouting.TrafficRoutingReconciler, error) {
 		if roCtx.rollout.Spec.Strategy.Canary == nil || roCtx.rollout.Spec.Strategy.Canary.TrafficRouting == nil {
 			return nil, nil
@@ -584,6 +646,9 @@ func (f *fixture) newController(resync resyncFunc) (*Controller, informers.Share
 	for _, r := range f.replicaSetLister {
 		k8sI.Apps().V1().ReplicaSets().Informer().GetIndexer().Add(r)
 	}
+	for _, cr := range f.controllerRevisionLister {
+		k8sI.Apps().V1().ControllerRevisions().Informer().GetIndexer().Add(cr)
+	}
 	for _, s := range f.serviceLister {
 		k8sI.Core().V1().Services().Informer().GetIndexer().Add(s)
 	}
@@ -709,10 +774,14 @@ func filterInformerActions(actions []core.Action) []core.Action {
 			action.Matches("watch", "rollouts") ||
 			action.Matches("list", "replicaSets") ||
 			action.Matches("watch", "replicaSets") ||
+			action.Matches("list", "controllerrevisions") ||
+			action.Matches("watch", "controllerrevisions") ||
 			action.Matches("list", "services") ||
 			action.Matches("watch", "services") ||
 			action.Matches("list", "ingresses") ||
-			action.Matches("watch", "ingresses") {
+			action.Matches("watch", "ingresses") ||
+			action.Matches("list", "configmaps") ||
+			action.Matches("watch", "configmaps") {
 			continue
 		}
 		ret = append(ret, action)
@@ -1301,7 +1370,7 @@ func TestPodTemplateHashEquivalence(t *testing.T) {
 	var err error
 	// NOTE: This test will fail on every k8s library upgrade.
 	// To fix it, update expectedReplicaSetName to match the new hash.
-	expectedReplicaSetName := "guestbook-6c5667f666"
+	expectedReplicaSetName := "guestbook-657879d8f4"
 
 	r1 := newBlueGreenRollout("guestbook", 1, nil, "active", "")
 	r1Resources := `
@@ -1608,6 +1677,25 @@ func TestGetReferencedAnalysisTemplate(t *testing.T) {
 		_, err = roCtx.getReferencedAnalysisTemplates(r, roAnalysisTemplate, validation.PrePromotionAnalysis, 0)
 		assert.NoError(t, err)
 	})
+
+	t.Run("get referenced analysisTemplate - denied by namespace policy", func(t *testing.T) {
+		cat := clusterAnalysisTemplate("restricted-cluster-analysis-template")
+		cat.Spec.NamespacePolicy = &v1alpha1.NamespacePolicy{Namespaces: []string{"other"}}
+		f.clusterAnalysisTemplateLister = append(f.clusterAnalysisTemplateLister, cat)
+		c, _, _ := f.newController(noResyncPeriodFunc)
+		roCtx, err := c.newRolloutContext(r)
+		assert.NoError(t, err)
+		restrictedAnalysisTemplate := &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.RolloutAnalysisTemplate{{
+				TemplateName: "restricted-cluster-analysis-template",
+				ClusterScope: true,
+			}},
+		}
+		_, err = roCtx.getReferencedAnalysisTemplates(r, restrictedAnalysisTemplate, validation.PrePromotionAnalysis, 0)
+		msg := fmt.Sprintf("ClusterAnalysisTemplate 'restricted-cluster-analysis-template' is not allowed in namespace '%s' by its namespace policy", r.Namespace)
+		expectedErr := field.Invalid(validation.GetAnalysisTemplateWithTypeFieldPath(validation.PrePromotionAnalysis, 0), "restricted-cluster-analysis-template", msg)
+		assert.Equal(t, expectedErr.Error(), err.Error())
+	})
 }
 
 func TestGetReferencedIngressesALB(t *testing.T) {
@@ -1893,3 +1981,28 @@ func TestWriteBackToInformer(t *testing.T) {
 	assert.NotEmpty(t, stableRS)
 	assert.Equal(t, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], stableRS)
 }
+
+func TestRolloutUpdatePriority(t *testing.T) {
+	r1 := newCanaryRollout("foo", 10, nil, nil, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r1.ResourceVersion = "1"
+	assert.Equal(t, queue.PriorityLow, rolloutUpdatePriority(r1, r1.DeepCopy()))
+
+	statusUpdate := r1.DeepCopy()
+	statusUpdate.ResourceVersion = "2"
+	statusUpdate.Status.Message = "progressing"
+	assert.Equal(t, queue.PriorityNormal, rolloutUpdatePriority(r1, statusUpdate))
+
+	aborted := statusUpdate.DeepCopy()
+	aborted.Status.Abort = true
+	assert.Equal(t, queue.PriorityHigh, rolloutUpdatePriority(r1, aborted))
+
+	paused := statusUpdate.DeepCopy()
+	paused.Status.PauseConditions = []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonCanaryPauseStep}}
+	promoted := paused.DeepCopy()
+	promoted.ResourceVersion = "3"
+	promoted.Status.PauseConditions = nil
+	assert.Equal(t, queue.PriorityHigh, rolloutUpdatePriority(paused, promoted))
+
+	specChange := bumpVersion(statusUpdate)
+	assert.Equal(t, queue.PriorityHigh, rolloutUpdatePriority(r1, specChange))
+}
@@ -0,0 +1,161 @@
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labelsutil "k8s.io/kubernetes/pkg/util/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+const (
+	// crashGuardCheckInterval is how often the pods of the new revision are checked while the crash
+	// guard watches an update, since the statuses of their containers do not update the ReplicaSet
+	crashGuardCheckInterval = 30 * time.Second
+
+	reasonCrashLoopBackOff = "CrashLoopBackOff"
+	reasonImagePullBackOff = "ImagePullBackOff"
+	reasonErrImagePull     = "ErrImagePull"
+	reasonOOMKilled        = "OOMKilled"
+)
+
+// crashGuardCheck counts the pods of the new revision with a container in the given state
+type crashGuardCheck struct {
+	// state describes the pods counted by the check
+	state string
+	max   *int32
+	// match returns the description of the container state matched by the check, or an empty string
+	match func(status corev1.ContainerStatus) string
+}
+
+func waitingIn(status corev1.ContainerStatus, reasons ...string) string {
+	if status.State.Waiting == nil {
+		return ""
+	}
+	for _, reason := range reasons {
+		if status.State.Waiting.Reason == reason {
+			if status.State.Waiting.Message != "" {
+				return fmt.Sprintf("is in %s: %s", reason, status.State.Waiting.Message)
+			}
+			return fmt.Sprintf("is in %s", reason)
+		}
+	}
+	return ""
+}
+
+func oomKilled(status corev1.ContainerStatus) string {
+	for _, terminated := range []*corev1.ContainerStateTerminated{status.State.Terminated, status.LastTerminationState.Terminated} {
+		if terminated != nil && terminated.Reason == reasonOOMKilled {
+			return "was OOMKilled"
+		}
+	}
+	return ""
+}
+
+func containerStatuses(pod *corev1.Pod) []corev1.ContainerStatus {
+	statuses := append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...)
+	return append(statuses, pod.Status.ContainerStatuses...)
+}
+
+// crashGuardViolation returns the message describing the first threshold of the crash guard exceeded
+// by the pods of the new revision, or an empty string when none is exceeded
+func crashGuardViolation(guard *v1alpha1.RolloutCrashGuard, pods []*corev1.Pod) string {
+	// image pulls and OOM kills are checked first, since they also lead to back-offs and restarts
+	checks := []crashGuardCheck{{
+		state: "in ImagePullBackOff or ErrImagePull",
+		max:   guard.MaxImagePullBackOff,
+		match: func(status corev1.ContainerStatus) string {
+			return waitingIn(status, reasonImagePullBackOff, reasonErrImagePull)
+		},
+	}, {
+		state: "OOMKilled",
+		max:   guard.MaxOOMKilled,
+		match: oomKilled,
+	}, {
+		state: "in CrashLoopBackOff",
+		max:   guard.MaxCrashLoopBackOff,
+		match: func(status corev1.ContainerStatus) string {
+			return waitingIn(status, reasonCrashLoopBackOff)
+		},
+	}}
+	for _, check := range checks {
+		max := int32(0)
+		if check.max != nil {
+			max = *check.max
+		}
+		count := int32(0)
+		example := ""
+		for _, pod := range pods {
+			for _, status := range containerStatuses(pod) {
+				if description := check.match(status); description != "" {
+					count++
+					if example == "" {
+						example = fmt.Sprintf("container '%s' of pod '%s' %s", status.Name, pod.Name, description)
+					}
+					break
+				}
+			}
+		}
+		if count > max {
+			return fmt.Sprintf("crash guard: %d pod(s) %s, more than the %d tolerated: %s", count, check.state, max, example)
+		}
+	}
+
+	maxRestarts := defaults.GetCrashGuardMaxRestartsOrDefault(guard)
+	for _, pod := range pods {
+		for _, status := range containerStatuses(pod) {
+			if status.RestartCount > maxRestarts {
+				return fmt.Sprintf("crash guard: container '%s' of pod '%s' restarted %d times, more than the %d tolerated", status.Name, pod.Name, status.RestartCount, maxRestarts)
+			}
+		}
+	}
+	return ""
+}
+
+// getNewRSPods returns the pods of the new ReplicaSet of the rollout
+func (c *rolloutContext) getNewRSPods() ([]*corev1.Pod, error) {
+	podHash := c.newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	selector := labelsutil.CloneSelectorAndAddLabel(c.rollout.Spec.Selector, v1alpha1.DefaultRolloutUniqueLabelKey, podHash)
+	pods, err := c.kubeclientset.CoreV1().Pods(c.rollout.Namespace).List(context.TODO(), metav1.ListOptions{
+		LabelSelector: metav1.FormatLabelSelector(selector),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var newRSPods []*corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if controllerRef := metav1.GetControllerOf(pod); controllerRef != nil && controllerRef.UID == c.newRS.UID {
+			newRSPods = append(newRSPods, pod)
+		}
+	}
+	return newRSPods, nil
+}
+
+// reconcileCrashGuard aborts the update of the rollout as soon as the pods of the new revision exceed
+// the thresholds of its crash guard, and checks them again periodically while the update is in progress
+func (c *rolloutContext) reconcileCrashGuard() error {
+	guard := c.rollout.Spec.CrashGuard
+	if guard == nil || c.pauseContext.IsAborted() {
+		return nil
+	}
+	if c.newRS == nil || c.stableRS == nil || c.newRS.UID == c.stableRS.UID {
+		return nil
+	}
+	pods, err := c.getNewRSPods()
+	if err != nil {
+		return err
+	}
+	if message := crashGuardViolation(guard, pods); message != "" {
+		c.log.Infof("Aborting rollout: %s", message)
+		c.pauseContext.AddAbort(message)
+		return nil
+	}
+	c.enqueueRolloutAfter(c.rollout, crashGuardCheckInterval)
+	return nil
+}
@@ -0,0 +1,138 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+)
+
+// newCrashGuardPod returns a pod of the ReplicaSet with a container of the given status
+func newCrashGuardPod(rs *appsv1.ReplicaSet, name string, status corev1.ContainerStatus) *corev1.Pod {
+	status.Name = "app"
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       rs.Namespace,
+			Labels:          rs.Spec.Template.Labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rs, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{status},
+		},
+	}
+}
+
+// newCrashGuardFixture returns a fixture with a canary rollout with a crash guard which completed its
+// setWeight step, and whose new revision runs a pod of the given container status
+func newCrashGuardFixture(t *testing.T, guard *v1alpha1.RolloutCrashGuard, status corev1.ContainerStatus) (*fixture, *v1alpha1.Rollout) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{}}}
+	f, r2, rs1, rs2 := newCanaryUpdateFixture(t, steps, 10, 1)
+	r2.Spec.CrashGuard = guard
+
+	pod := newCrashGuardPod(rs2, "foo-new", status)
+	stablePod := newCrashGuardPod(rs1, "foo-stable", corev1.ContainerStatus{RestartCount: 10})
+	f.kubeobjects = append(f.kubeobjects, pod, stablePod)
+	return f, r2
+}
+
+func TestCrashGuardAborts(t *testing.T) {
+	tests := []struct {
+		name    string
+		guard   *v1alpha1.RolloutCrashGuard
+		status  corev1.ContainerStatus
+		message string
+	}{{
+		name:    "restarts",
+		guard:   &v1alpha1.RolloutCrashGuard{},
+		status:  corev1.ContainerStatus{RestartCount: 4},
+		message: "crash guard: container 'app' of pod 'foo-new' restarted 4 times, more than the 3 tolerated",
+	}, {
+		name:  "CrashLoopBackOff",
+		guard: &v1alpha1.RolloutCrashGuard{},
+		status: corev1.ContainerStatus{
+			RestartCount: 1,
+			State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+		},
+		message: "crash guard: 1 pod(s) in CrashLoopBackOff, more than the 0 tolerated: container 'app' of pod 'foo-new' is in CrashLoopBackOff",
+	}, {
+		name:  "ErrImagePull",
+		guard: &v1alpha1.RolloutCrashGuard{},
+		status: corev1.ContainerStatus{
+			State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ErrImagePull", Message: "manifest unknown"}},
+		},
+		message: "crash guard: 1 pod(s) in ImagePullBackOff or ErrImagePull, more than the 0 tolerated: container 'app' of pod 'foo-new' is in ErrImagePull: manifest unknown",
+	}, {
+		name:  "OOMKilled",
+		guard: &v1alpha1.RolloutCrashGuard{MaxCrashLoopBackOff: pointer.Int32Ptr(1)},
+		status: corev1.ContainerStatus{
+			RestartCount:         1,
+			State:                corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+			LastTerminationState: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{Reason: "OOMKilled"}},
+		},
+		message: "crash guard: 1 pod(s) OOMKilled, more than the 0 tolerated: container 'app' of pod 'foo-new' was OOMKilled",
+	}}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, r := newCrashGuardFixture(t, test.guard, test.status)
+			defer f.Close()
+
+			f.expectListPodAction(r.Namespace)
+			patchIndex := f.expectPatchRolloutAction(r)
+			f.run(getKey(r, t))
+
+			status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+			assert.True(t, status.Abort)
+			progressing := conditions.GetRolloutCondition(status, v1alpha1.RolloutProgressing)
+			if assert.NotNil(t, progressing) {
+				assert.Equal(t, conditions.RolloutAbortedReason, progressing.Reason)
+				assert.Contains(t, progressing.Message, test.message)
+			}
+		})
+	}
+}
+
+func TestCrashGuardWithinThresholds(t *testing.T) {
+	guard := &v1alpha1.RolloutCrashGuard{MaxRestarts: pointer.Int32Ptr(5), MaxCrashLoopBackOff: pointer.Int32Ptr(1)}
+	status := corev1.ContainerStatus{
+		RestartCount: 4,
+		State:        corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "CrashLoopBackOff"}},
+	}
+	f, r := newCrashGuardFixture(t, guard, status)
+	defer f.Close()
+
+	f.expectListPodAction(r.Namespace)
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	patchedStatus := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	assert.False(t, patchedStatus.Abort)
+	assert.NotNil(t, patchedStatus.CurrentStepIndex)
+}
+
+func TestCrashGuardIgnoresPromotedRollout(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 1, nil, nil, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.CrashGuard = &v1alpha1.RolloutCrashGuard{}
+	rs := newReplicaSetWithStatus(r, 1, 1)
+	r = updateCanaryRolloutStatus(r, rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 1, 1, 1, false)
+	pod := newCrashGuardPod(rs, "foo-crashing", corev1.ContainerStatus{RestartCount: 10})
+
+	f.kubeobjects = append(f.kubeobjects, rs, pod)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	// the pods of a promoted rollout are not listed
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+}
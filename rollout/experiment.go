@@ -16,6 +16,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	experimentutil "github.com/argoproj/argo-rollouts/utils/experiment"
 	"github.com/argoproj/argo-rollouts/utils/hash"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 )
@@ -53,6 +54,8 @@ func GetExperimentFromTemplate(r *v1alpha1.Rollout, stableRS, newRS *appsv1.Repl
 		},
 	}
 
+	logutil.InheritLogLevel(r, experiment)
+
 	instanceID := analysisutil.GetInstanceID(r)
 	if instanceID != "" {
 		experiment.Labels[v1alpha1.LabelKeyControllerInstanceID] = instanceID
@@ -304,7 +304,8 @@ func TestAbortRolloutAfterFailedExperiment(t *testing.T) {
 			"abortedAt": "%s",
 			"conditions": %s,
 			"canary": {
-				"currentExperiment": null
+				"currentExperiment": null,
+				"stepHistory": %s
 			},
 			"phase": "Degraded",
 			"message": "%s: %s"
@@ -312,7 +313,8 @@ func TestAbortRolloutAfterFailedExperiment(t *testing.T) {
 	}`
 	now := timeutil.Now().UTC().Format(time.RFC3339)
 	generatedConditions := generateConditionsPatch(true, conditions.RolloutAbortedReason, r2, false, "")
-	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, now, generatedConditions, conditions.RolloutAbortedReason, fmt.Sprintf(conditions.RolloutAbortedMessage, 2))), patch)
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeAborted, ""))
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, now, generatedConditions, stepHistory, conditions.RolloutAbortedReason, fmt.Sprintf(conditions.RolloutAbortedMessage, 2))), patch)
 }
 
 func TestPauseRolloutAfterInconclusiveExperiment(t *testing.T) {
@@ -471,15 +473,18 @@ func TestRolloutExperimentFinishedIncrementStep(t *testing.T) {
 	expectedPatch := `{
 		"status": {
 			"canary": {
-				"currentExperiment":null
+				"currentExperiment":null,
+				"stepHistory": %s
 			},
 			"currentStepIndex": 1,
-			"conditions": %s
+			"conditions": %s,
+			"progress": %s
 		}
 	}`
+	stepHistory := stepHistoryPatch(finishedStepRecord(0, v1alpha1.CanaryStepOutcomeCompleted, ""))
 	generatedConditions := generateConditionsPatch(true, conditions.ReplicaSetUpdatedReason, rs2, false, "")
 
-	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, generatedConditions)), patch)
+	assert.Equal(t, calculatePatch(r2, fmt.Sprintf(expectedPatch, stepHistory, generatedConditions, progressPatch(50, 30))), patch)
 }
 
 func TestRolloutDoNotCreateExperimentWithoutStableRS(t *testing.T) {
@@ -0,0 +1,92 @@
+package rollout
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/freeze"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// getFreeze returns the emergency stop holding the rollout, or nil when the rollout is not frozen.
+// Only updating rollouts are held, so a rollout in the scope of a freeze is held as soon as it is
+// updated.
+func (c *reconcilerBase) getFreeze(ro *v1alpha1.Rollout, newRS, stableRS *appsv1.ReplicaSet) (*freeze.Freeze, error) {
+	if newRS != nil && stableRS != nil && newRS.UID == stableRS.UID {
+		return nil, nil
+	}
+	cm, err := c.configMapLister.ConfigMaps(defaults.Namespace()).Get(freeze.ConfigMapName)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	// an invalid freeze fails the reconciliation rather than letting the rollouts progress
+	freezes, err := freeze.GetFreezes(cm)
+	if err != nil {
+		return nil, err
+	}
+	return freeze.MatchingFreeze(freezes, ro), nil
+}
+
+// calculateFrozenCondition returns the Frozen condition explaining why the rollout is held, or
+// lifting a previous hold. It returns nil when the condition is unchanged.
+func (c *rolloutContext) calculateFrozenCondition() *v1alpha1.RolloutCondition {
+	frozenCond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutFrozen)
+	frozenCondTrue := frozenCond != nil && frozenCond.Status == corev1.ConditionTrue
+	if c.frozenBy != nil {
+		message := c.frozenBy.Message()
+		if frozenCondTrue && frozenCond.Message == message {
+			return nil
+		}
+		return conditions.NewRolloutCondition(v1alpha1.RolloutFrozen, corev1.ConditionTrue, conditions.RolloutFrozenReason, message)
+	}
+	if !frozenCondTrue {
+		return nil
+	}
+	return conditions.NewRolloutCondition(v1alpha1.RolloutFrozen, corev1.ConditionFalse, conditions.RolloutUnfrozenReason, conditions.RolloutUnfrozenMessage)
+}
+
+// recordFrozenCondition emits the event of the Frozen condition which was set on the rollout
+func (c *rolloutContext) recordFrozenCondition(cond *v1alpha1.RolloutCondition) {
+	if cond.Status == corev1.ConditionTrue {
+		c.log.Infof("Holding rollout: %s", cond.Message)
+		c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutFrozenReason}, cond.Message)
+		return
+	}
+	c.log.Info("Releasing rollout held by emergency stop")
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.RolloutUnfrozenReason}, cond.Message)
+}
+
+// enqueueFrozenRollouts enqueues all the rollouts when the ConfigMap holding the emergency stops
+// changes, so they are held or released right away
+func (c *Controller) enqueueFrozenRollouts(obj interface{}) {
+	cm, ok := obj.(*corev1.ConfigMap)
+	if !ok {
+		if tombstone, isTombstone := obj.(cache.DeletedFinalStateUnknown); isTombstone {
+			cm, ok = tombstone.Obj.(*corev1.ConfigMap)
+		}
+		if !ok {
+			return
+		}
+	}
+	if cm.Name != freeze.ConfigMapName || cm.Namespace != defaults.Namespace() {
+		return
+	}
+	rollouts, err := c.rolloutsLister.List(labels.Everything())
+	if err != nil {
+		logutil.WithObject(cm).Warnf("Failed to list rollouts to enqueue: %v", err)
+		return
+	}
+	for _, ro := range rollouts {
+		c.enqueueRollout(ro)
+	}
+}
@@ -0,0 +1,124 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/utils/freeze"
+)
+
+func newFreezeConfigMap(t *testing.T, freezes ...freeze.Freeze) *corev1.ConfigMap {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      freeze.ConfigMapName,
+			Namespace: defaults.Namespace(),
+		},
+	}
+	assert.NoError(t, freeze.SetFreezes(cm, freezes))
+	return cm
+}
+
+// newFreezeFixture returns a fixture with a canary rollout which completed its setWeight step
+func newFreezeFixture(t *testing.T) (*fixture, *v1alpha1.Rollout) {
+	steps := []v1alpha1.CanaryStep{{SetWeight: int32Ptr(10)}, {SetWeight: int32Ptr(50)}}
+	f, r2, _, _ := newCanaryUpdateFixture(t, steps, 10, 1)
+	return f, r2
+}
+
+func TestFreezeHoldsUpdatingRollout(t *testing.T) {
+	f, r := newFreezeFixture(t)
+	defer f.Close()
+	f.kubeobjects = append(f.kubeobjects, newFreezeConfigMap(t, freeze.Freeze{Reason: "INC-42"}))
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	frozen := conditions.GetRolloutCondition(status, v1alpha1.RolloutFrozen)
+	if assert.NotNil(t, frozen) {
+		assert.Equal(t, corev1.ConditionTrue, frozen.Status)
+		assert.Equal(t, conditions.RolloutFrozenReason, frozen.Reason)
+		assert.Equal(t, "emergency stop of all namespaces: INC-42", frozen.Message)
+	}
+	progressing := conditions.GetRolloutCondition(status, v1alpha1.RolloutProgressing)
+	if assert.NotNil(t, progressing) {
+		assert.Equal(t, conditions.RolloutPausedReason, progressing.Reason)
+	}
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, status.Phase)
+	assert.Equal(t, "emergency stop of all namespaces: INC-42", status.Message)
+	assert.Contains(t, f.events, conditions.RolloutFrozenReason)
+
+	// the rollout does not progress past its completed setWeight step while it is frozen
+	status = getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex+1))
+	assert.Nil(t, status.CurrentStepIndex)
+}
+
+func TestFreezeOutOfScope(t *testing.T) {
+	f, r := newFreezeFixture(t)
+	defer f.Close()
+	f.kubeobjects = append(f.kubeobjects, newFreezeConfigMap(t,
+		freeze.Freeze{Namespace: "other"},
+		freeze.Freeze{Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}},
+	))
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	assert.Nil(t, conditions.GetRolloutCondition(status, v1alpha1.RolloutFrozen))
+	// the rollout moves on to its next step
+	if assert.NotNil(t, status.CurrentStepIndex) {
+		assert.Equal(t, int32(1), *status.CurrentStepIndex)
+	}
+}
+
+func TestUnfreezeReleasesRollout(t *testing.T) {
+	f, r := newFreezeFixture(t)
+	defer f.Close()
+	frozen := conditions.NewRolloutCondition(v1alpha1.RolloutFrozen, corev1.ConditionTrue, conditions.RolloutFrozenReason, "emergency stop of all namespaces")
+	paused := conditions.NewRolloutCondition(v1alpha1.RolloutPaused, corev1.ConditionTrue, conditions.RolloutPausedReason, conditions.RolloutPausedMessage)
+	conditions.SetRolloutCondition(&r.Status, *frozen)
+	conditions.SetRolloutCondition(&r.Status, *paused)
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	unfrozen := conditions.GetRolloutCondition(status, v1alpha1.RolloutFrozen)
+	if assert.NotNil(t, unfrozen) {
+		assert.Equal(t, corev1.ConditionFalse, unfrozen.Status)
+		assert.Equal(t, conditions.RolloutUnfrozenReason, unfrozen.Reason)
+	}
+	assert.Contains(t, f.events, conditions.RolloutUnfrozenReason)
+}
+
+func TestFreezeIgnoresRolloutNotUpdating(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	f.kubeobjects = append(f.kubeobjects, newFreezeConfigMap(t, freeze.Freeze{}))
+	c, i, k8sI := f.newController(noResyncPeriodFunc)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	i.Start(stopCh)
+	k8sI.Start(stopCh)
+	k8sI.WaitForCacheSync(stopCh)
+
+	r := newCanaryRollout("foo", 10, nil, nil, int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	rs := newReplicaSetWithStatus(r, 10, 10)
+	frozenBy, err := c.getFreeze(r, rs, rs)
+	assert.NoError(t, err)
+	assert.Nil(t, frozenBy)
+
+	frozenBy, err = c.getFreeze(r, nil, rs)
+	assert.NoError(t, err)
+	assert.NotNil(t, frozenBy)
+}
@@ -0,0 +1,103 @@
+package rollout
+
+import (
+	"context"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	historyutil "github.com/argoproj/argo-rollouts/utils/history"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// getRevisionSnapshots returns the revision snapshots of the rollout
+func (c *rolloutContext) getRevisionSnapshots() ([]*appsv1.ControllerRevision, error) {
+	selector := labels.SelectorFromSet(labels.Set{v1alpha1.RevisionSnapshotLabelKey: c.rollout.Name})
+	crs, err := c.controllerRevisionLister.ControllerRevisions(c.rollout.Namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	return historyutil.FilterSnapshots(c.rollout, crs), nil
+}
+
+// reconcileRevisionSnapshots snapshots every revision of the rollout which still has a ReplicaSet
+// into a ControllerRevision, and deletes the oldest snapshots beyond the revision snapshot limit.
+// Snapshots outlive the ReplicaSets deleted by the revision history limit, so a rollout can be
+// undone to a revision whose ReplicaSet is gone.
+func (c *rolloutContext) reconcileRevisionSnapshots() error {
+	ctx := context.TODO()
+	limit := defaults.GetRevisionSnapshotLimitOrDefault(c.rollout)
+	snapshots, err := c.getRevisionSnapshots()
+	if err != nil {
+		return err
+	}
+	if limit == 0 && len(snapshots) == 0 {
+		return nil
+	}
+
+	snapshotsByHash := map[string]*appsv1.ControllerRevision{}
+	for _, cr := range snapshots {
+		snapshotsByHash[historyutil.GetPodTemplateHash(cr)] = cr
+	}
+	if limit > 0 {
+		for _, rs := range c.allRSs {
+			if rs == nil || rs.DeletionTimestamp != nil {
+				continue
+			}
+			podHash := replicasetutil.GetPodTemplateHash(rs)
+			if podHash == "" {
+				continue
+			}
+			desired, err := historyutil.NewSnapshot(c.rollout, rs)
+			if err != nil {
+				c.log.Warnf("Failed to snapshot ReplicaSet %s: %v", rs.Name, err)
+				continue
+			}
+			existing, ok := snapshotsByHash[podHash]
+			if !ok {
+				c.log.Infof("Creating revision snapshot %s of revision %d", desired.Name, desired.Revision)
+				created, err := c.kubeclientset.AppsV1().ControllerRevisions(c.rollout.Namespace).Create(ctx, desired, metav1.CreateOptions{})
+				if err != nil && !errors.IsAlreadyExists(err) {
+					return err
+				}
+				if created != nil {
+					snapshots = append(snapshots, created)
+					snapshotsByHash[podHash] = created
+				}
+				continue
+			}
+			if existing.Revision != desired.Revision {
+				// a revision which is rolled out again gets a new revision number
+				crCopy := existing.DeepCopy()
+				crCopy.Revision = desired.Revision
+				c.log.Infof("Updating revision snapshot %s from revision %d to %d", crCopy.Name, existing.Revision, crCopy.Revision)
+				updated, err := c.kubeclientset.AppsV1().ControllerRevisions(crCopy.Namespace).Update(ctx, crCopy, metav1.UpdateOptions{})
+				if err != nil {
+					return err
+				}
+				for i := range snapshots {
+					if snapshots[i] == existing {
+						snapshots[i] = updated
+					}
+				}
+			}
+		}
+	}
+
+	diff := len(snapshots) - int(limit)
+	if diff <= 0 {
+		return nil
+	}
+	c.log.Infof("Cleaning up %d revision snapshots from revision snapshot limit %d", diff, limit)
+	historyutil.SortByRevision(snapshots)
+	for _, cr := range snapshots[:diff] {
+		if err := c.kubeclientset.AppsV1().ControllerRevisions(cr.Namespace).Delete(ctx, cr.Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
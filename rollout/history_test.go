@@ -0,0 +1,142 @@
+package rollout
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	appslisters "k8s.io/client-go/listers/apps/v1"
+	testclient "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	historyutil "github.com/argoproj/argo-rollouts/utils/history"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+func newRevisionSnapshotContext(r *v1alpha1.Rollout, allRSs []*appsv1.ReplicaSet, snapshots []*appsv1.ControllerRevision) (*rolloutContext, *k8sfake.Clientset) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	client := k8sfake.NewSimpleClientset()
+	for _, cr := range snapshots {
+		indexer.Add(cr)
+		client.Tracker().Add(cr)
+	}
+	return &rolloutContext{
+		rollout: r,
+		log:     logutil.WithRollout(r),
+		allRSs:  allRSs,
+		reconcilerBase: reconcilerBase{
+			kubeclientset:            client,
+			controllerRevisionLister: appslisters.NewControllerRevisionLister(indexer),
+			recorder:                 record.NewFakeEventRecorder(),
+		},
+	}, client
+}
+
+func newRevisionSnapshotRollout(image string, revision string) *v1alpha1.Rollout {
+	r := newCanaryRollout("foo", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r.Spec.Template.Spec.Containers[0].Image = image
+	r.Annotations = map[string]string{annotations.RevisionAnnotation: revision}
+	return r
+}
+
+func TestReconcileRevisionSnapshotsDisabled(t *testing.T) {
+	r := newRevisionSnapshotRollout("foo:v1", "1")
+	rs := newReplicaSet(r, 1)
+	roCtx, client := newRevisionSnapshotContext(r, []*appsv1.ReplicaSet{rs}, nil)
+
+	assert.NoError(t, roCtx.reconcileRevisionSnapshots())
+	assert.Len(t, client.Actions(), 0)
+}
+
+func TestReconcileRevisionSnapshotsCreate(t *testing.T) {
+	r1 := newRevisionSnapshotRollout("foo:v1", "1")
+	rs1 := newReplicaSet(r1, 0)
+	r2 := newRevisionSnapshotRollout("foo:v2", "2")
+	r2.UID = r1.UID
+	rs2 := newReplicaSet(r2, 1)
+	r2.Spec.RevisionSnapshotLimit = pointer.Int32Ptr(5)
+
+	existing, err := historyutil.NewSnapshot(r2, rs1)
+	assert.NoError(t, err)
+	roCtx, client := newRevisionSnapshotContext(r2, []*appsv1.ReplicaSet{rs1, rs2}, []*appsv1.ControllerRevision{existing})
+
+	assert.NoError(t, roCtx.reconcileRevisionSnapshots())
+	assert.Len(t, client.Actions(), 1)
+	created := client.Actions()[0].(testclient.CreateAction).GetObject().(*appsv1.ControllerRevision)
+	assert.Equal(t, historyutil.SnapshotName(r2, rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]), created.Name)
+	assert.Equal(t, int64(2), created.Revision)
+	assert.Equal(t, "foo", created.Labels[v1alpha1.RevisionSnapshotLabelKey])
+}
+
+func TestReconcileRevisionSnapshotsUpdateRevision(t *testing.T) {
+	r := newRevisionSnapshotRollout("foo:v1", "1")
+	rs := newReplicaSet(r, 1)
+	snapshot, err := historyutil.NewSnapshot(r, rs)
+	assert.NoError(t, err)
+
+	// the revision was rolled out again
+	rs.Annotations[annotations.RevisionAnnotation] = "3"
+	r.Spec.RevisionSnapshotLimit = pointer.Int32Ptr(5)
+	roCtx, client := newRevisionSnapshotContext(r, []*appsv1.ReplicaSet{rs}, []*appsv1.ControllerRevision{snapshot})
+
+	assert.NoError(t, roCtx.reconcileRevisionSnapshots())
+	assert.Len(t, client.Actions(), 1)
+	updated := client.Actions()[0].(testclient.UpdateAction).GetObject().(*appsv1.ControllerRevision)
+	assert.Equal(t, snapshot.Name, updated.Name)
+	assert.Equal(t, int64(3), updated.Revision)
+}
+
+func TestReconcileRevisionSnapshotsPrune(t *testing.T) {
+	r := newRevisionSnapshotRollout("foo:v1", "1")
+	r.Spec.RevisionSnapshotLimit = pointer.Int32Ptr(2)
+	var snapshots []*appsv1.ControllerRevision
+	var rs *appsv1.ReplicaSet
+	for i := 1; i <= 3; i++ {
+		r.Spec.Template.Spec.Containers[0].Image = fmt.Sprintf("foo:v%d", i)
+		r.Annotations[annotations.RevisionAnnotation] = strconv.Itoa(i)
+		rs = newReplicaSet(r, 0)
+		cr, err := historyutil.NewSnapshot(r, rs)
+		assert.NoError(t, err)
+		snapshots = append(snapshots, cr)
+	}
+	roCtx, client := newRevisionSnapshotContext(r, []*appsv1.ReplicaSet{rs}, snapshots)
+
+	assert.NoError(t, roCtx.reconcileRevisionSnapshots())
+	assert.Len(t, client.Actions(), 1)
+	assert.Equal(t, snapshots[0].Name, client.Actions()[0].(testclient.DeleteAction).GetName())
+}
+
+func TestReconcileRevisionSnapshotsDeleteAllWhenDisabled(t *testing.T) {
+	r := newRevisionSnapshotRollout("foo:v1", "1")
+	rs := newReplicaSet(r, 1)
+	snapshot, err := historyutil.NewSnapshot(r, rs)
+	assert.NoError(t, err)
+	roCtx, client := newRevisionSnapshotContext(r, []*appsv1.ReplicaSet{rs}, []*appsv1.ControllerRevision{snapshot})
+
+	assert.NoError(t, roCtx.reconcileRevisionSnapshots())
+	assert.Len(t, client.Actions(), 1)
+	assert.Equal(t, snapshot.Name, client.Actions()[0].(testclient.DeleteAction).GetName())
+}
+
+func TestReconcileRevisionSnapshotsIgnoresOtherRollouts(t *testing.T) {
+	other := newRevisionSnapshotRollout("foo:v1", "1")
+	other.Name = "bar"
+	other.UID = "bar-uid"
+	snapshot, err := historyutil.NewSnapshot(other, newReplicaSet(other, 0))
+	assert.NoError(t, err)
+	snapshot.Labels[v1alpha1.RevisionSnapshotLabelKey] = "foo"
+
+	r := newRevisionSnapshotRollout("foo:v1", "1")
+	roCtx, client := newRevisionSnapshotContext(r, nil, []*appsv1.ControllerRevision{snapshot})
+
+	assert.NoError(t, roCtx.reconcileRevisionSnapshots())
+	assert.Len(t, client.Actions(), 0)
+}
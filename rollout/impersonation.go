@@ -0,0 +1,48 @@
+package rollout
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/impersonation"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+// writeClients returns the clients used to mutate the traffic routing objects and services of a
+// rollout. They impersonate the ServiceAccount of the rollout when impersonation is enabled, and
+// use the identity of the controller otherwise.
+func (c *reconcilerBase) writeClients(ro *v1alpha1.Rollout) (*impersonation.Clients, error) {
+	if c.impersonator == nil {
+		return &impersonation.Clients{
+			KubeClientSet:    c.kubeclientset,
+			DynamicClientSet: c.dynamicclientset,
+			SmiClientSet:     c.smiclientset,
+		}, nil
+	}
+	return c.impersonator.ClientsFor(ro.Namespace, ro.Annotations)
+}
+
+// reconcilePermissionDenied sets the PermissionDenied condition of the rollout when the error of
+// the reconciliation is caused by the ServiceAccount impersonated by the controller. The error is
+// returned so that the rollout is requeued.
+func (c *rolloutContext) reconcilePermissionDenied(err error) error {
+	if err == nil || c.impersonator == nil || !impersonation.IsPermissionDenied(err) {
+		return err
+	}
+	msg := fmt.Sprintf(conditions.ImpersonationPermissionDeniedMessage, err.Error())
+	prevCond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutPermissionDenied)
+	if prevCond != nil && prevCond.Message == msg {
+		return err
+	}
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.ImpersonationPermissionDeniedReason}, msg)
+	newStatus := c.rollout.Status.DeepCopy()
+	conditions.RemoveRolloutCondition(newStatus, v1alpha1.RolloutPermissionDenied)
+	cond := conditions.NewRolloutCondition(v1alpha1.RolloutPermissionDenied, corev1.ConditionTrue, conditions.ImpersonationPermissionDeniedReason, msg)
+	if patchErr := c.patchCondition(c.rollout, newStatus, cond); patchErr != nil {
+		c.log.Warnf("Failed to set the PermissionDenied condition: %v", patchErr)
+	}
+	return err
+}
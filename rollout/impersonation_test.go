@@ -0,0 +1,157 @@
+package rollout
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	core "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/impersonation"
+	"github.com/argoproj/argo-rollouts/utils/record"
+)
+
+type fakeImpersonator struct {
+	clients *impersonation.Clients
+	err     error
+
+	namespace   string
+	annotations map[string]string
+}
+
+func (i *fakeImpersonator) ClientsFor(namespace string, annotations map[string]string) (*impersonation.Clients, error) {
+	i.namespace = namespace
+	i.annotations = annotations
+	return i.clients, i.err
+}
+
+func TestWriteClients(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r := newBlueGreenRollout("foo", 1, nil, "active", "")
+	r.Annotations = map[string]string{annotations.ImpersonateServiceAccountAnnotation: "tenant"}
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	t.Run("WithoutImpersonator", func(t *testing.T) {
+		c, _, _ := f.newController(noResyncPeriodFunc)
+		roCtx, err := c.newRolloutContext(r)
+		assert.NoError(t, err)
+		clients, err := roCtx.writeClients(r)
+		assert.NoError(t, err)
+		assert.Empty(t, clients.ServiceAccount)
+		assert.Equal(t, c.kubeclientset, clients.KubeClientSet)
+		assert.Equal(t, c.dynamicclientset, clients.DynamicClientSet)
+	})
+
+	t.Run("WithImpersonator", func(t *testing.T) {
+		c, _, _ := f.newController(noResyncPeriodFunc)
+		impersonator := &fakeImpersonator{clients: &impersonation.Clients{ServiceAccount: "system:serviceaccount:default:tenant"}}
+		c.impersonator = impersonator
+		roCtx, err := c.newRolloutContext(r)
+		assert.NoError(t, err)
+		clients, err := roCtx.writeClients(r)
+		assert.NoError(t, err)
+		assert.Equal(t, "system:serviceaccount:default:tenant", clients.ServiceAccount)
+		assert.Equal(t, r.Namespace, impersonator.namespace)
+		assert.Equal(t, r.Annotations, impersonator.annotations)
+	})
+}
+
+func TestSwitchServiceSelectorImpersonated(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	r := newBlueGreenRollout("foo", 1, nil, "active", "")
+	activeSvc := newService("active", 80, nil, r)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	impersonatedClient := k8sfake.NewSimpleClientset(activeSvc)
+	c.impersonator = &fakeImpersonator{clients: &impersonation.Clients{KubeClientSet: impersonatedClient}}
+	roCtx, err := c.newRolloutContext(r)
+	assert.NoError(t, err)
+
+	err = roCtx.switchServiceSelector(activeSvc, "abc123", r)
+	assert.NoError(t, err)
+	assert.Len(t, filterInformerActions(f.kubeclient.Actions()), 0)
+	actions := impersonatedClient.Actions()
+	if assert.Len(t, actions, 1) {
+		assert.Equal(t, "patch", actions[0].GetVerb())
+		assert.Equal(t, "services", actions[0].GetResource().Resource)
+	}
+}
+
+func TestReconcilePermissionDenied(t *testing.T) {
+	forbiddenErr := k8serrors.NewForbidden(schema.GroupResource{Resource: "services"}, "active", errors.New("not allowed"))
+	serviceAccountErr := &impersonation.ServiceAccountError{}
+
+	newRolloutContextWithImpersonator := func(t *testing.T, r *v1alpha1.Rollout, withImpersonator bool) (*fixture, *rolloutContext) {
+		f := newFixture(t)
+		f.rolloutLister = append(f.rolloutLister, r)
+		f.objects = append(f.objects, r)
+		c, _, _ := f.newController(noResyncPeriodFunc)
+		if withImpersonator {
+			c.impersonator = &fakeImpersonator{}
+		}
+		roCtx, err := c.newRolloutContext(r)
+		assert.NoError(t, err)
+		return f, roCtx
+	}
+
+	t.Run("SetsCondition", func(t *testing.T) {
+		r := newBlueGreenRollout("foo", 1, nil, "active", "")
+		f, roCtx := newRolloutContextWithImpersonator(t, r, true)
+		defer f.Close()
+
+		err := roCtx.reconcilePermissionDenied(forbiddenErr)
+		assert.Equal(t, forbiddenErr, err)
+		actions := f.client.Actions()
+		if assert.Len(t, actions, 1) {
+			patch := actions[0].(core.PatchAction).GetPatch()
+			assert.Contains(t, string(patch), `"type":"PermissionDenied"`)
+			assert.Contains(t, string(patch), conditions.ImpersonationPermissionDeniedReason)
+		}
+		assert.Equal(t, []string{conditions.ImpersonationPermissionDeniedReason}, roCtx.recorder.(*record.FakeEventRecorder).Events)
+	})
+
+	t.Run("SkipsUnchangedCondition", func(t *testing.T) {
+		r := newBlueGreenRollout("foo", 1, nil, "active", "")
+		msg := "Permission denied to the impersonated ServiceAccount: " + forbiddenErr.Error()
+		cond := conditions.NewRolloutCondition(v1alpha1.RolloutPermissionDenied, corev1.ConditionTrue, conditions.ImpersonationPermissionDeniedReason, msg)
+		conditions.SetRolloutCondition(&r.Status, *cond)
+		f, roCtx := newRolloutContextWithImpersonator(t, r, true)
+		defer f.Close()
+
+		err := roCtx.reconcilePermissionDenied(forbiddenErr)
+		assert.Equal(t, forbiddenErr, err)
+		assert.Len(t, f.client.Actions(), 0)
+	})
+
+	t.Run("IgnoresOtherErrors", func(t *testing.T) {
+		r := newBlueGreenRollout("foo", 1, nil, "active", "")
+		f, roCtx := newRolloutContextWithImpersonator(t, r, true)
+		defer f.Close()
+
+		otherErr := errors.New("boom")
+		assert.Equal(t, otherErr, roCtx.reconcilePermissionDenied(otherErr))
+		assert.NoError(t, roCtx.reconcilePermissionDenied(nil))
+		assert.Len(t, f.client.Actions(), 0)
+	})
+
+	t.Run("IgnoresWithoutImpersonator", func(t *testing.T) {
+		r := newBlueGreenRollout("foo", 1, nil, "active", "")
+		f, roCtx := newRolloutContextWithImpersonator(t, r, false)
+		defer f.Close()
+
+		assert.Equal(t, serviceAccountErr, roCtx.reconcilePermissionDenied(serviceAccountErr))
+		assert.Len(t, f.client.Actions(), 0)
+	})
+}
@@ -0,0 +1,173 @@
+package rollout
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// currentAnalysis is a current AnalysisRun along with the analysis of the rollout it runs
+type currentAnalysis struct {
+	analysis *v1alpha1.RolloutAnalysis
+	ar       *v1alpha1.AnalysisRun
+}
+
+// analysisRunReruns returns the number of times the analysis was rerun before the AnalysisRun
+func analysisRunReruns(ar *v1alpha1.AnalysisRun) int32 {
+	reruns, err := strconv.ParseInt(ar.Annotations[annotations.InconclusiveRerunsAnnotation], 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(reruns)
+}
+
+// inconclusiveResolution returns how the inconclusive policy resolved the AnalysisRun, or nil when
+// it did not resolve it
+func (c *rolloutContext) inconclusiveResolution(ar *v1alpha1.AnalysisRun) *v1alpha1.InconclusiveResolution {
+	resolution := c.newStatus.InconclusiveResolution
+	if ar == nil || resolution == nil || resolution.AnalysisRun != ar.Name {
+		return nil
+	}
+	return resolution
+}
+
+// analysisRunSucceeded returns whether the AnalysisRun was successful, or was inconclusive and
+// treated as successful by its inconclusive policy
+func (c *rolloutContext) analysisRunSucceeded(ar *v1alpha1.AnalysisRun) bool {
+	if ar == nil {
+		return false
+	}
+	if ar.Status.Phase == v1alpha1.AnalysisPhaseSuccessful {
+		return true
+	}
+	resolution := c.inconclusiveResolution(ar)
+	return ar.Status.Phase == v1alpha1.AnalysisPhaseInconclusive && resolution != nil && resolution.Action == v1alpha1.InconclusiveActionSucceed
+}
+
+// pauseOnInconclusive pauses the rollout on an inconclusive AnalysisRun, unless its inconclusive
+// policy treated it as successful
+func (c *rolloutContext) pauseOnInconclusive(ar *v1alpha1.AnalysisRun) {
+	if c.analysisRunSucceeded(ar) {
+		return
+	}
+	c.pauseContext.AddPauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+}
+
+// rerunInconclusive reruns an inconclusive AnalysisRun while the inconclusive policy of its analysis
+// allows more reruns. It returns the AnalysisRun which reruns the analysis, or nil when the analysis
+// is not rerun.
+func (c *rolloutContext) rerunInconclusive(rolloutAnalysis *v1alpha1.RolloutAnalysis, currentAr *v1alpha1.AnalysisRun, infix string, labels map[string]string) (*v1alpha1.AnalysisRun, error) {
+	policy := rolloutAnalysis.InconclusivePolicy
+	if policy == nil || c.inconclusiveResolution(currentAr) != nil {
+		return nil, nil
+	}
+	reruns := analysisRunReruns(currentAr)
+	if reruns >= policy.Reruns {
+		return nil, nil
+	}
+	rerunAr, err := c.createAnalysisRun(rolloutAnalysis, infix, labels, reruns+1)
+	if err != nil {
+		return nil, err
+	}
+	c.log.WithField(logutil.AnalysisRunKey, rerunAr.Name).Infof("Created AnalysisRun to rerun inconclusive AnalysisRun '%s'", currentAr.Name)
+	c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.InconclusiveAnalysisRerunReason}, conditions.InconclusiveAnalysisRerunMessage, currentAr.Name, reruns+1, policy.Reruns)
+	c.newStatus.InconclusiveResolution = &v1alpha1.InconclusiveResolution{
+		AnalysisRun: currentAr.Name,
+		Action:      v1alpha1.InconclusiveActionRerun,
+		Reruns:      reruns,
+		ResolvedAt:  timeutil.MetaNow(),
+	}
+	return rerunAr, nil
+}
+
+// currentAnalyses returns the current AnalysisRuns of the rollout along with their analysis
+func (c *rolloutContext) currentAnalyses() []currentAnalysis {
+	var analyses []currentAnalysis
+	if canary := c.rollout.Spec.Strategy.Canary; canary != nil {
+		if step, _ := replicasetutil.GetCurrentCanaryStep(c.rollout); step != nil && step.Analysis != nil {
+			analyses = append(analyses, currentAnalysis{analysis: step.Analysis, ar: c.currentArs.CanaryStep})
+		}
+		if canary.Analysis != nil {
+			analyses = append(analyses, currentAnalysis{analysis: &canary.Analysis.RolloutAnalysis, ar: c.currentArs.CanaryBackground})
+		}
+	}
+	if blueGreen := c.rollout.Spec.Strategy.BlueGreen; blueGreen != nil {
+		if blueGreen.PrePromotionAnalysis != nil {
+			analyses = append(analyses, currentAnalysis{analysis: blueGreen.PrePromotionAnalysis, ar: c.currentArs.BlueGreenPrePromotion})
+		}
+		if blueGreen.PostPromotionAnalysis != nil {
+			analyses = append(analyses, currentAnalysis{analysis: blueGreen.PostPromotionAnalysis, ar: c.currentArs.BlueGreenPostPromotion})
+		}
+	}
+	return analyses
+}
+
+// reconcileInconclusivePause takes the action of the inconclusive policy of an inconclusive
+// AnalysisRun, once the rollout has been paused on it for the timeout of the policy
+func (c *rolloutContext) reconcileInconclusivePause() {
+	pauseCond := getPauseCondition(c.rollout, v1alpha1.PauseReasonInconclusiveAnalysis)
+	if pauseCond == nil {
+		return
+	}
+	for _, current := range c.currentAnalyses() {
+		policy := current.analysis.InconclusivePolicy
+		ar := current.ar
+		if ar == nil || ar.Status.Phase != v1alpha1.AnalysisPhaseInconclusive || policy == nil || policy.Action == "" {
+			continue
+		}
+		if resolution := c.inconclusiveResolution(ar); resolution != nil {
+			// the policy already took its action on the AnalysisRun
+			continue
+		}
+		var timeout time.Duration
+		if policy.Timeout != "" {
+			var err error
+			timeout, err = policy.Timeout.Duration()
+			if err != nil {
+				c.log.Warnf("Invalid inconclusive policy timeout '%s': %v", policy.Timeout, err)
+				continue
+			}
+		}
+		if remaining := pauseCond.StartTime.Add(timeout).Sub(timeutil.Now()); remaining > 0 {
+			c.enqueueRolloutAfter(c.rollout, remaining)
+			continue
+		}
+		after := string(policy.Timeout)
+		if after == "" {
+			after = "0s"
+		}
+
+		c.newStatus.InconclusiveResolution = &v1alpha1.InconclusiveResolution{
+			AnalysisRun: ar.Name,
+			Action:      policy.Action,
+			Reruns:      analysisRunReruns(ar),
+			ResolvedAt:  timeutil.MetaNow(),
+		}
+		logCtx := c.log.WithField(logutil.AnalysisRunKey, ar.Name)
+		switch policy.Action {
+		case v1alpha1.InconclusiveActionSucceed:
+			logCtx.Info("Treating inconclusive AnalysisRun as successful")
+			c.pauseContext.RemovePauseCondition(v1alpha1.PauseReasonInconclusiveAnalysis)
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventReason: conditions.InconclusiveAnalysisSucceededReason}, conditions.InconclusiveAnalysisSucceededMessage, ar.Name, after)
+		case v1alpha1.InconclusiveActionFail:
+			logCtx.Info("Treating inconclusive AnalysisRun as failed")
+			message := fmt.Sprintf(conditions.InconclusiveAnalysisFailedMessage, ar.Name, after)
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: conditions.InconclusiveAnalysisFailedReason}, message)
+			c.pauseContext.AddAbort(message)
+		case v1alpha1.InconclusiveActionEscalate:
+			logCtx.Info("Escalating inconclusive AnalysisRun")
+			c.recorder.Eventf(c.rollout, record.EventOptions{EventType: corev1.EventTypeWarning, EventReason: conditions.InconclusiveAnalysisEscalatedReason}, conditions.InconclusiveAnalysisEscalatedMessage, ar.Name, after)
+		}
+		return
+	}
+}
@@ -0,0 +1,184 @@
+package rollout
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// newInconclusiveStepFixture returns a fixture with a canary rollout whose analysis step has an
+// inconclusive AnalysisRun, and whose analysis has the given inconclusive policy
+func newInconclusiveStepFixture(t *testing.T, policy *v1alpha1.InconclusivePolicy, pauseStartTime *metav1.Time) (*fixture, *v1alpha1.Rollout, *v1alpha1.AnalysisRun) {
+	at := analysisTemplate("bar")
+	steps := []v1alpha1.CanaryStep{{
+		Analysis: &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.RolloutAnalysisTemplate{{
+				TemplateName: at.Name,
+			}},
+			InconclusivePolicy: policy,
+		},
+	}}
+	f, r2, _, _ := newCanaryUpdateFixture(t, steps, 1, 0)
+
+	ar := analysisRun(at, v1alpha1.RolloutTypeStepLabel, r2)
+	ar.Name = "foo-inconclusive"
+	ar.Status = v1alpha1.AnalysisRunStatus{
+		Phase:   v1alpha1.AnalysisPhaseInconclusive,
+		Message: "metric inconclusive",
+	}
+	r2.Status.Canary.CurrentStepAnalysisRunStatus = &v1alpha1.RolloutAnalysisRunStatus{
+		Name:   ar.Name,
+		Status: v1alpha1.AnalysisPhaseInconclusive,
+	}
+	if pauseStartTime != nil {
+		r2.Status.ControllerPause = true
+		r2.Status.PauseConditions = []v1alpha1.PauseCondition{{
+			Reason:    v1alpha1.PauseReasonInconclusiveAnalysis,
+			StartTime: *pauseStartTime,
+		}}
+		r2.Status.Phase, r2.Status.Message = rolloututil.CalculateRolloutPhase(r2.Spec, r2.Status)
+		progressingCondition, _ := newProgressingCondition(conditions.RolloutPausedReason, r2, "")
+		conditions.SetRolloutCondition(&r2.Status, progressingCondition)
+		pausedCondition, _ := newPausedCondition(true)
+		conditions.SetRolloutCondition(&r2.Status, pausedCondition)
+		availableCondition, _ := newAvailableCondition(true)
+		conditions.SetRolloutCondition(&r2.Status, availableCondition)
+	}
+
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.analysisRunLister = append(f.analysisRunLister, ar)
+	f.objects = append(f.objects, at, ar)
+	return f, r2, ar
+}
+
+// getPatchedRolloutStatus returns the status of the rollout patch
+func getPatchedRolloutStatus(t *testing.T, patch string) v1alpha1.RolloutStatus {
+	var ro v1alpha1.Rollout
+	assert.NoError(t, json.Unmarshal([]byte(patch), &ro))
+	return ro.Status
+}
+
+func TestRerunInconclusiveAnalysisRun(t *testing.T) {
+	f, r, ar := newInconclusiveStepFixture(t, &v1alpha1.InconclusivePolicy{Reruns: 2}, nil)
+	defer f.Close()
+
+	createdIndex := f.expectCreateAnalysisRunAction(ar)
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	createdAr := f.getCreatedAnalysisRun(createdIndex)
+	assert.Equal(t, "1", createdAr.Annotations[annotations.InconclusiveRerunsAnnotation])
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	assert.Empty(t, status.PauseConditions)
+	assert.Equal(t, createdAr.Name, status.Canary.CurrentStepAnalysisRunStatus.Name)
+	if assert.NotNil(t, status.InconclusiveResolution) {
+		assert.Equal(t, ar.Name, status.InconclusiveResolution.AnalysisRun)
+		assert.Equal(t, v1alpha1.InconclusiveActionRerun, status.InconclusiveResolution.Action)
+	}
+	assert.Contains(t, f.events, conditions.InconclusiveAnalysisRerunReason)
+}
+
+func TestPauseWhenInconclusiveRerunsExhausted(t *testing.T) {
+	f, r, ar := newInconclusiveStepFixture(t, &v1alpha1.InconclusivePolicy{Reruns: 2}, nil)
+	defer f.Close()
+	ar.Annotations = map[string]string{annotations.InconclusiveRerunsAnnotation: "2"}
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	if assert.Len(t, status.PauseConditions, 1) {
+		assert.Equal(t, v1alpha1.PauseReasonInconclusiveAnalysis, status.PauseConditions[0].Reason)
+	}
+	assert.Nil(t, status.InconclusiveResolution)
+}
+
+func TestInconclusivePolicyWaitsForTimeout(t *testing.T) {
+	startTime := timeutil.MetaNow()
+	f, r, _ := newInconclusiveStepFixture(t, &v1alpha1.InconclusivePolicy{
+		Action:  v1alpha1.InconclusiveActionSucceed,
+		Timeout: "1h",
+	}, &startTime)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	assert.Nil(t, status.InconclusiveResolution)
+	assert.NotContains(t, f.events, conditions.InconclusiveAnalysisSucceededReason)
+	// the rollout is requeued for the end of the timeout
+	assert.Contains(t, f.enqueuedObjects, getKey(r, t))
+}
+
+func TestInconclusivePolicySucceed(t *testing.T) {
+	startTime := metav1.NewTime(timeutil.Now().Add(-time.Hour))
+	f, r, ar := newInconclusiveStepFixture(t, &v1alpha1.InconclusivePolicy{
+		Action:  v1alpha1.InconclusiveActionSucceed,
+		Timeout: "1h",
+	}, &startTime)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	// the rollout completes the analysis step
+	assert.Equal(t, int32(1), *status.CurrentStepIndex)
+	if assert.NotNil(t, status.InconclusiveResolution) {
+		assert.Equal(t, ar.Name, status.InconclusiveResolution.AnalysisRun)
+		assert.Equal(t, v1alpha1.InconclusiveActionSucceed, status.InconclusiveResolution.Action)
+	}
+	assert.Contains(t, f.events, conditions.InconclusiveAnalysisSucceededReason)
+}
+
+func TestInconclusivePolicyFail(t *testing.T) {
+	startTime := metav1.NewTime(timeutil.Now().Add(-time.Hour))
+	f, r, ar := newInconclusiveStepFixture(t, &v1alpha1.InconclusivePolicy{
+		Action:  v1alpha1.InconclusiveActionFail,
+		Timeout: "30m",
+	}, &startTime)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	assert.True(t, status.Abort)
+	assert.Equal(t, "Rollout aborted update to revision 2: Inconclusive AnalysisRun 'foo-inconclusive' treated as failed after 30m", conditions.GetRolloutCondition(status, v1alpha1.RolloutProgressing).Message)
+	if assert.NotNil(t, status.InconclusiveResolution) {
+		assert.Equal(t, ar.Name, status.InconclusiveResolution.AnalysisRun)
+		assert.Equal(t, v1alpha1.InconclusiveActionFail, status.InconclusiveResolution.Action)
+	}
+	assert.Contains(t, f.events, conditions.InconclusiveAnalysisFailedReason)
+}
+
+func TestInconclusivePolicyEscalate(t *testing.T) {
+	startTime := metav1.NewTime(timeutil.Now().Add(-time.Hour))
+	f, r, _ := newInconclusiveStepFixture(t, &v1alpha1.InconclusivePolicy{
+		Action: v1alpha1.InconclusiveActionEscalate,
+	}, &startTime)
+	defer f.Close()
+
+	patchIndex := f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIndex))
+	// the rollout stays paused
+	assert.Nil(t, status.PauseConditions)
+	assert.False(t, status.Abort)
+	if assert.NotNil(t, status.InconclusiveResolution) {
+		assert.Equal(t, v1alpha1.InconclusiveActionEscalate, status.InconclusiveResolution.Action)
+	}
+	assert.Contains(t, f.events, conditions.InconclusiveAnalysisEscalatedReason)
+}
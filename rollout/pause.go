@@ -132,10 +132,7 @@ func (c *rolloutContext) completedPrePromotionAnalysis() bool {
 		return true
 	}
 	currentAr := c.currentArs.BlueGreenPrePromotion
-	if currentAr != nil && currentAr.Status.Phase == v1alpha1.AnalysisPhaseSuccessful {
-		return true
-	}
-	return false
+	return c.analysisRunSucceeded(currentAr)
 }
 
 // CompletedBlueGreenPause returns true if we have already completed our automated pause, either
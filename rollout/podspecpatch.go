@@ -0,0 +1,47 @@
+package rollout
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// reconcilePodSpecPatch applies the canary/preview pod spec patch to the new ReplicaSet while it is a
+// canary or a preview, and removes it once the new ReplicaSet becomes stable. The pods created with
+// another patch are replaced by the pod restarter.
+func (c *rolloutContext) reconcilePodSpecPatch() error {
+	if c.newRS == nil {
+		return nil
+	}
+	var patch *runtime.RawExtension
+	fullyRolledOut := c.rollout.Status.StableRS == "" || c.rollout.Status.StableRS == replicasetutil.GetPodTemplateHash(c.newRS)
+	if !fullyRolledOut {
+		patch = replicasetutil.GetPodSpecPatch(c.rollout)
+	}
+	modifiedRS, modified, err := replicasetutil.SyncReplicaSetPodSpecPatch(c.newRS, c.rollout, patch)
+	if err != nil || !modified {
+		return err
+	}
+	updatedRS, err := c.kubeclientset.AppsV1().ReplicaSets(modifiedRS.Namespace).Update(context.TODO(), modifiedRS, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	if patch != nil {
+		c.log.Infof("synced pod spec patch to ReplicaSet %s", updatedRS.Name)
+	} else {
+		c.log.Infof("removed pod spec patch from ReplicaSet %s", updatedRS.Name)
+	}
+	for i, rs := range c.allRSs {
+		if rs != nil && rs.UID == updatedRS.UID {
+			c.allRSs[i] = updatedRS
+		}
+	}
+	if c.stableRS != nil && c.stableRS.UID == updatedRS.UID {
+		c.stableRS = updatedRS
+	}
+	c.newRS = updatedRS
+	return nil
+}
@@ -0,0 +1,244 @@
+package rollout
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	core "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+const testPodSpecPatch = `{"nodeSelector":{"pool":"canary"}}`
+
+func newPodSpecPatchPod(rs *appsv1.ReplicaSet, name string, patchHash string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       rs.Namespace,
+			Labels:          rs.Spec.Template.Labels,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(rs, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+		},
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{
+				Type:   corev1.PodReady,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+	if patchHash != "" {
+		pod.Annotations = map[string]string{replicasetutil.PodSpecPatchHashAnnotation: patchHash}
+	}
+	return pod
+}
+
+func TestCanaryPodSpecPatchSecondRevision(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newCanaryRollout("foo", 1, nil, nil, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(1))
+	r1.Annotations[annotations.RevisionAnnotation] = "1"
+	r1.Spec.Strategy.Canary.CanaryPodSpecPatch = &runtime.RawExtension{Raw: []byte(testPodSpecPatch)}
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	r2 := bumpVersion(r1)
+	r2.Status.StableRS = r1.Status.CurrentPodHash
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+	f.kubeobjects = append(f.kubeobjects, rs1)
+	f.replicaSetLister = append(f.replicaSetLister, rs1)
+
+	f.expectUpdateRolloutStatusAction(r2)         // Update Rollout conditions
+	rs2idx := f.expectCreateReplicaSetAction(rs2) // Create revision 2 ReplicaSet with the pod spec patch
+	f.expectListPodAction(r2.Namespace)           // list pods to check for pods with a stale pod spec patch
+	f.expectUpdateReplicaSetAction(rs1)           // scale revision 1 ReplicaSet down
+	patchIdx := f.expectPatchRolloutAction(r2)    // Patch Rollout status
+	f.run(getKey(r2, t))
+
+	createdRS2 := f.getCreatedReplicaSet(rs2idx)
+	assert.Equal(t, map[string]string{"pool": "canary"}, createdRS2.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, testPodSpecPatch, createdRS2.Annotations[replicasetutil.PodSpecPatchAnnotation])
+	assert.Equal(t, hash.ComputePodSpecPatchHash([]byte(testPodSpecPatch)), createdRS2.Spec.Template.Annotations[replicasetutil.PodSpecPatchHashAnnotation])
+	// the pod spec patch does not change the pod template hash
+	assert.Equal(t, r2.Status.CurrentPodHash, createdRS2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey])
+	// the pods are not checked again until the pod spec patch changes
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIdx))
+	assert.Equal(t, hash.ComputePodSpecPatchHash([]byte(testPodSpecPatch)), status.PodSpecPatchHash)
+}
+
+func TestCanaryPodSpecPatchRemovedOnceStable(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(1))
+	r.Spec.Strategy.Canary.CanaryPodSpecPatch = &runtime.RawExtension{Raw: []byte(testPodSpecPatch)}
+	rs := newReplicaSetWithStatus(r, 1, 1)
+	rs, _, err := replicasetutil.SyncReplicaSetPodSpecPatch(rs, r, r.Spec.Strategy.Canary.CanaryPodSpecPatch)
+	assert.NoError(t, err)
+	r = updateCanaryRolloutStatus(r, rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 1, 1, 1, false)
+	r.Status.PodSpecPatchHash = rs.Spec.Template.Annotations[replicasetutil.PodSpecPatchHashAnnotation]
+	pod := newPodSpecPatchPod(rs, "foo-canary", r.Status.PodSpecPatchHash)
+
+	f.kubeobjects = append(f.kubeobjects, rs, pod)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	rsIdx := f.expectUpdateReplicaSetAction(rs) // remove the pod spec patch from the stable ReplicaSet
+	f.expectListPodAction(r.Namespace)          // list pods to check for pods with a stale pod spec patch
+	f.kubeactions = append(f.kubeactions, core.NewCreateSubresourceAction(schema.GroupVersionResource{Resource: "pods"}, pod.Name, "eviction", pod.Namespace, nil))
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+
+	updatedRS := f.getUpdatedReplicaSet(rsIdx)
+	assert.Nil(t, updatedRS.Spec.Template.Spec.NodeSelector)
+	assert.NotContains(t, updatedRS.Annotations, replicasetutil.PodSpecPatchAnnotation)
+	assert.NotContains(t, updatedRS.Spec.Template.Annotations, replicasetutil.PodSpecPatchHashAnnotation)
+}
+
+func TestCanaryPodSpecPatchCurrentPods(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r := newCanaryRollout("foo", 1, nil, nil, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(1))
+	r.Spec.Strategy.Canary.CanaryPodSpecPatch = &runtime.RawExtension{Raw: []byte(testPodSpecPatch)}
+	rs := newReplicaSetWithStatus(r, 1, 1)
+	r = updateCanaryRolloutStatus(r, rs.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 1, 1, 1, false)
+	pod := newPodSpecPatchPod(rs, "foo-stable", "")
+
+	f.kubeobjects = append(f.kubeobjects, rs, pod)
+	f.replicaSetLister = append(f.replicaSetLister, rs)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r)
+
+	// the stable ReplicaSet is not patched, and its pods are neither listed nor restarted
+	f.expectPatchRolloutAction(r)
+	f.run(getKey(r, t))
+}
+
+func TestCanaryPodSpecPatchRemovedDuringUpdate(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{{SetWeight: pointer.Int32Ptr(10)}, {Pause: &v1alpha1.RolloutPause{}}}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(10))
+	r1.Spec.Strategy.Canary.CanaryPodSpecPatch = &runtime.RawExtension{Raw: []byte(testPodSpecPatch)}
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	r2 := bumpVersion(r1)
+	progressingCondition, _ := newProgressingCondition(conditions.RolloutPausedReason, r2, "")
+	conditions.SetRolloutCondition(&r2.Status, progressingCondition)
+	pausedCondition, _ := newPausedCondition(true)
+	conditions.SetRolloutCondition(&r2.Status, pausedCondition)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	rs2, _, err := replicasetutil.SyncReplicaSetPodSpecPatch(rs2, r2, r2.Spec.Strategy.Canary.CanaryPodSpecPatch)
+	assert.NoError(t, err)
+	r2 = updateCanaryRolloutStatus(r2, rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey], 10, 1, 10, true)
+	r2.Status.PodSpecPatchHash = rs2.Spec.Template.Annotations[replicasetutil.PodSpecPatchHashAnnotation]
+	pod := newPodSpecPatchPod(rs2, "foo-canary", r2.Status.PodSpecPatchHash)
+	// the pod spec patch is removed while the update is paused
+	r2.Spec.Strategy.Canary.CanaryPodSpecPatch = nil
+
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2, pod)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	rsIdx := f.expectUpdateReplicaSetAction(rs2) // remove the pod spec patch from the canary ReplicaSet
+	f.expectListPodAction(r2.Namespace)          // list pods to check for pods with a stale pod spec patch
+	f.kubeactions = append(f.kubeactions, core.NewCreateSubresourceAction(schema.GroupVersionResource{Resource: "pods"}, pod.Name, "eviction", pod.Namespace, nil))
+	patchIdx := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	updatedRS := f.getUpdatedReplicaSet(rsIdx)
+	assert.Nil(t, updatedRS.Spec.Template.Spec.NodeSelector)
+	assert.NotContains(t, updatedRS.Spec.Template.Annotations, replicasetutil.PodSpecPatchHashAnnotation)
+	// the pods were checked against the ReplicaSet without pod spec patch
+	assert.Contains(t, f.getPatchedRollout(patchIdx), `"podSpecPatchHash":null`)
+}
+
+func TestBlueGreenPreviewPodSpecPatch(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+	r1.Spec.Strategy.BlueGreen.AutoPromotionEnabled = pointer.BoolPtr(false)
+	r1.Spec.Strategy.BlueGreen.PreviewPodSpecPatch = &runtime.RawExtension{Raw: []byte(testPodSpecPatch)}
+	r2 := bumpVersion(r1)
+	rs1 := newReplicaSetWithStatus(r1, 1, 1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs1PodHash}, r2)
+	previewSvc := newService("preview", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}, r2)
+	r2 = updateBlueGreenRolloutStatus(r2, rs2PodHash, rs1PodHash, rs1PodHash, 1, 1, 2, 1, true, true)
+	progressingCondition, _ := newProgressingCondition(conditions.RolloutPausedReason, rs2, "")
+	conditions.SetRolloutCondition(&r2.Status, progressingCondition)
+	pausedCondition, _ := newPausedCondition(true)
+	conditions.SetRolloutCondition(&r2.Status, pausedCondition)
+	availableCondition, _ := newAvailableCondition(true)
+	conditions.SetRolloutCondition(&r2.Status, availableCondition)
+
+	f.kubeobjects = append(f.kubeobjects, activeSvc, previewSvc, rs1, rs2)
+	f.serviceLister = append(f.serviceLister, activeSvc, previewSvc)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	rsIdx := f.expectUpdateReplicaSetAction(rs2) // apply the pod spec patch to the preview ReplicaSet
+	f.expectListPodAction(r2.Namespace)          // list pods to check for pods with a stale pod spec patch
+	patchIdx := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	updatedRS := f.getUpdatedReplicaSet(rsIdx)
+	assert.Equal(t, map[string]string{"pool": "canary"}, updatedRS.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, testPodSpecPatch, updatedRS.Annotations[replicasetutil.PodSpecPatchAnnotation])
+	status := getPatchedRolloutStatus(t, f.getPatchedRollout(patchIdx))
+	assert.Equal(t, hash.ComputePodSpecPatchHash([]byte(testPodSpecPatch)), status.PodSpecPatchHash)
+}
+
+func TestBlueGreenPreviewPodSpecPatchRemovedOncePromoted(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	r1 := newBlueGreenRollout("foo", 1, nil, "active", "")
+	r1.Spec.Strategy.BlueGreen.PreviewPodSpecPatch = &runtime.RawExtension{Raw: []byte(testPodSpecPatch)}
+	r2 := bumpVersion(r1)
+	rs2 := newReplicaSetWithStatus(r2, 1, 1)
+	rs2, _, err := replicasetutil.SyncReplicaSetPodSpecPatch(rs2, r2, r2.Spec.Strategy.BlueGreen.PreviewPodSpecPatch)
+	assert.NoError(t, err)
+	rs2PodHash := rs2.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	activeSvc := newService("active", 80, map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: rs2PodHash}, r2)
+	// the preview ReplicaSet was promoted, and became the active and stable ReplicaSet
+	r2 = updateBlueGreenRolloutStatus(r2, "", rs2PodHash, rs2PodHash, 1, 1, 1, 1, false, true)
+	r2.Status.PodSpecPatchHash = rs2.Spec.Template.Annotations[replicasetutil.PodSpecPatchHashAnnotation]
+	pod := newPodSpecPatchPod(rs2, "foo-preview", r2.Status.PodSpecPatchHash)
+
+	f.kubeobjects = append(f.kubeobjects, activeSvc, rs2, pod)
+	f.serviceLister = append(f.serviceLister, activeSvc)
+	f.replicaSetLister = append(f.replicaSetLister, rs2)
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	rsIdx := f.expectUpdateReplicaSetAction(rs2) // remove the pod spec patch from the active ReplicaSet
+	f.expectListPodAction(r2.Namespace)          // list pods to check for pods with a stale pod spec patch
+	f.kubeactions = append(f.kubeactions, core.NewCreateSubresourceAction(schema.GroupVersionResource{Resource: "pods"}, pod.Name, "eviction", pod.Namespace, nil))
+	f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	updatedRS := f.getUpdatedReplicaSet(rsIdx)
+	assert.Nil(t, updatedRS.Spec.Template.Spec.NodeSelector)
+	assert.NotContains(t, updatedRS.Annotations, replicasetutil.PodSpecPatchAnnotation)
+	assert.NotContains(t, updatedRS.Spec.Template.Annotations, replicasetutil.PodSpecPatchHashAnnotation)
+}
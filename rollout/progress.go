@@ -0,0 +1,425 @@
+package rollout
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	analysisutil "github.com/argoproj/argo-rollouts/utils/analysis"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// defaultPodReadySeconds is the number of seconds the pods are assumed to take to become ready
+	// until the pods of the rollout were observed to become available
+	defaultPodReadySeconds = 30
+	// progressCompletionTimeTolerance is the change of the estimated completion time under which the
+	// previous estimate is kept, so that the status is not patched on every reconciliation
+	progressCompletionTimeTolerance = time.Minute
+
+	progressPausedMessage                   = "Rollout is paused"
+	progressInconclusiveAnalysisMessage     = "Inconclusive analysis waits for a manual promotion"
+	progressManualPromotionMessage          = "Step %d waits for a manual promotion"
+	progressUnknownStepMessage              = "Duration of step %d is unknown"
+	progressBlueGreenManualPromotionMessage = "Waiting for a manual promotion"
+	progressUnknownPrePromotionMessage      = "Duration of the pre-promotion analysis is unknown"
+	progressUnknownPostPromotionMessage     = "Duration of the post-promotion analysis is unknown"
+)
+
+// progressEstimate is the estimated progress of an update. The completion time is only meaningful
+// when there is no message explaining why it cannot be estimated.
+type progressEstimate struct {
+	percent    int32
+	completion time.Time
+	message    string
+	// overdue indicates that the current stage takes longer than estimated, so that the completion
+	// time moves forward with the current time
+	overdue bool
+}
+
+// notBefore returns the time a stage is estimated to end, or now when it should have ended already,
+// in which case the estimate is overdue
+func (e *progressEstimate) notBefore(end, now time.Time) time.Time {
+	if end.Before(now) {
+		e.overdue = true
+		return now
+	}
+	return end
+}
+
+// addAnalysis adds an analysis which takes the duration to the estimate. A running analysis is
+// estimated from the start of its AnalysisRun.
+func (e *progressEstimate) addAnalysis(duration time.Duration, currentAr *v1alpha1.AnalysisRun, now time.Time) {
+	if currentAr != nil && currentAr.Status.StartedAt != nil {
+		e.completion = laterTime(e.notBefore(currentAr.Status.StartedAt.Add(duration), now), e.completion)
+		return
+	}
+	e.completion = e.completion.Add(duration)
+}
+
+// progressChanged returns whether the step, the replica counts or the phase of the rollout changed
+// since the previous status. The progress is only estimated again then, so that reconciliations
+// which change nothing do not patch the status with a new estimate.
+func progressChanged(prevStatus, newStatus *v1alpha1.RolloutStatus) bool {
+	return !reflect.DeepEqual(prevStatus.CurrentStepIndex, newStatus.CurrentStepIndex) ||
+		prevStatus.Replicas != newStatus.Replicas ||
+		prevStatus.UpdatedReplicas != newStatus.UpdatedReplicas ||
+		prevStatus.ReadyReplicas != newStatus.ReadyReplicas ||
+		prevStatus.AvailableReplicas != newStatus.AvailableReplicas ||
+		prevStatus.Phase != newStatus.Phase
+}
+
+// calculateProgress estimates the percentage of the update which is complete and the time it will
+// complete. The progress is only reported while an update of a previously stable rollout is in progress.
+func (c *rolloutContext) calculateProgress(newStatus *v1alpha1.RolloutStatus) *v1alpha1.RolloutProgress {
+	if c.newRS == nil || newStatus.StableRS == "" || newStatus.StableRS == newStatus.CurrentPodHash ||
+		newStatus.Abort || newStatus.Phase == v1alpha1.RolloutPhaseDegraded {
+		return nil
+	}
+	var prevProgress v1alpha1.RolloutProgress
+	if c.rollout.Status.Progress != nil {
+		prevProgress = *c.rollout.Status.Progress
+	}
+	podReadySeconds := c.observedPodReadySeconds(newStatus)
+	if podReadySeconds == nil {
+		podReadySeconds = prevProgress.PodReadySeconds
+	}
+	podReady := time.Duration(defaultPodReadySeconds+c.rollout.Spec.MinReadySeconds) * time.Second
+	if podReadySeconds != nil {
+		podReady = time.Duration(*podReadySeconds) * time.Second
+	}
+
+	now := timeutil.Now()
+	var estimate progressEstimate
+	if c.rollout.Spec.Strategy.BlueGreen != nil {
+		estimate = c.estimateBlueGreenProgress(newStatus, podReady, now)
+	} else {
+		estimate = c.estimateCanaryProgress(newStatus, podReady, now)
+	}
+	for _, cond := range newStatus.PauseConditions {
+		if cond.Reason == v1alpha1.PauseReasonInconclusiveAnalysis {
+			estimate.message = progressInconclusiveAnalysisMessage
+		}
+	}
+	if c.rollout.Spec.Paused {
+		estimate.message = progressPausedMessage
+	}
+
+	progress := &v1alpha1.RolloutProgress{
+		Percent:         estimate.percent,
+		PodReadySeconds: podReadySeconds,
+		Message:         estimate.message,
+	}
+	if estimate.message == "" {
+		completion := metav1.NewTime(estimate.completion.Truncate(time.Second))
+		if prev := prevProgress.EstimatedCompletionTime; prev != nil {
+			change := completion.Sub(prev.Time)
+			if change > -progressCompletionTimeTolerance && change < progressCompletionTimeTolerance {
+				completion = *prev
+			}
+			// an overdue estimate would follow the current time, so the previous estimate is kept
+			// until the update moves on to its next stage
+			if estimate.overdue && estimate.percent == prevProgress.Percent && change > 0 {
+				completion = *prev
+			}
+		}
+		progress.EstimatedCompletionTime = &completion
+	}
+	return progress
+}
+
+// observedPodReadySeconds returns the average number of seconds the completed canary steps which
+// scaled the new ReplicaSet took, which is the time the pods took to become available
+func (c *rolloutContext) observedPodReadySeconds(newStatus *v1alpha1.RolloutStatus) *int32 {
+	if c.rollout.Spec.Strategy.Canary == nil {
+		return nil
+	}
+	steps := c.rollout.Spec.Strategy.Canary.Steps
+	var total time.Duration
+	var count int64
+	for _, stepRecord := range newStatus.Canary.StepHistory {
+		if stepRecord.Outcome != v1alpha1.CanaryStepOutcomeCompleted || stepRecord.FinishedAt == nil || int(stepRecord.Index) >= len(steps) {
+			continue
+		}
+		step := steps[stepRecord.Index]
+		if step.SetWeight == nil && step.SetCanaryScale == nil {
+			continue
+		}
+		total += stepRecord.FinishedAt.Sub(stepRecord.StartedAt.Time)
+		count++
+	}
+	if count == 0 {
+		return nil
+	}
+	seconds := int32((total / time.Duration(count)).Seconds())
+	return &seconds
+}
+
+// estimateCanaryProgress estimates the progress of a canary update from its remaining steps,
+// followed by the full promotion of the new ReplicaSet
+func (c *rolloutContext) estimateCanaryProgress(newStatus *v1alpha1.RolloutStatus, podReady time.Duration, now time.Time) progressEstimate {
+	stepCount := int32(len(c.rollout.Spec.Strategy.Canary.Steps))
+	currentStepIndex := stepCount
+	if newStatus.CurrentStepIndex != nil && *newStatus.CurrentStepIndex < stepCount {
+		currentStepIndex = *newStatus.CurrentStepIndex
+	}
+	estimate := progressEstimate{
+		percent:    currentStepIndex * 100 / (stepCount + 1),
+		completion: now,
+	}
+	for i := currentStepIndex; i < stepCount; i++ {
+		duration, message := c.estimateCanaryStepDuration(i, currentStepIndex, podReady)
+		if message != "" {
+			estimate.message = message
+			return estimate
+		}
+		if i == currentStepIndex {
+			// the current step is estimated from its start, so that the estimate does not move
+			// forward with each reconciliation
+			start := now
+			history := newStatus.Canary.StepHistory
+			if len(history) > 0 && history[len(history)-1].Outcome == v1alpha1.CanaryStepOutcomeRunning && history[len(history)-1].Index == i {
+				start = history[len(history)-1].StartedAt.Time
+			}
+			estimate.completion = estimate.notBefore(start.Add(duration), now)
+			continue
+		}
+		estimate.completion = estimate.completion.Add(duration)
+	}
+	estimate.completion = estimate.completion.Add(podReady)
+	return estimate
+}
+
+// estimateCanaryStepDuration estimates the duration of a canary step. It returns a message instead
+// when the duration cannot be estimated.
+func (c *rolloutContext) estimateCanaryStepDuration(index, currentStepIndex int32, podReady time.Duration) (time.Duration, string) {
+	step := c.rollout.Spec.Strategy.Canary.Steps[index]
+	switch {
+	case step.SetWeight != nil || step.SetCanaryScale != nil:
+		return podReady, ""
+	case step.Pause != nil:
+		if step.Pause.Duration == nil {
+			return 0, fmt.Sprintf(progressManualPromotionMessage, index+1)
+		}
+		seconds := step.Pause.DurationSeconds()
+		if seconds < 0 {
+			return 0, fmt.Sprintf(progressUnknownStepMessage, index+1)
+		}
+		return time.Duration(seconds) * time.Second, ""
+	case step.Analysis != nil:
+		var currentAr *v1alpha1.AnalysisRun
+		if index == currentStepIndex {
+			currentAr = c.currentArs.CanaryStep
+		}
+		duration, ok := c.estimateAnalysisDuration(step.Analysis, currentAr)
+		if !ok {
+			return 0, fmt.Sprintf(progressUnknownStepMessage, index+1)
+		}
+		return duration, ""
+	case step.Experiment != nil:
+		duration, ok := c.estimateExperimentDuration(step.Experiment)
+		if !ok {
+			return 0, fmt.Sprintf(progressUnknownStepMessage, index+1)
+		}
+		return podReady + duration, ""
+	}
+	return 0, ""
+}
+
+// estimateExperimentDuration estimates the duration of an experiment once its pods are available.
+// An experiment without a duration runs until the analyses required for its completion complete.
+func (c *rolloutContext) estimateExperimentDuration(experiment *v1alpha1.RolloutExperimentStep) (time.Duration, bool) {
+	if experiment.Duration != "" {
+		duration, err := experiment.Duration.Duration()
+		return duration, err == nil
+	}
+	var longest time.Duration
+	required := false
+	for _, analysis := range experiment.Analyses {
+		if !analysis.RequiredForCompletion {
+			continue
+		}
+		required = true
+		rolloutAnalysis := &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: analysis.TemplateName, ClusterScope: analysis.ClusterScope}},
+		}
+		duration, ok := c.estimateAnalysisDuration(rolloutAnalysis, nil)
+		if !ok {
+			return 0, false
+		}
+		if duration > longest {
+			longest = duration
+		}
+	}
+	return longest, required
+}
+
+// estimateBlueGreenProgress estimates the progress of a blue-green update from its stages: the new
+// ReplicaSet becoming available, the pre-promotion analysis, the promotion and the post-promotion analysis
+func (c *rolloutContext) estimateBlueGreenProgress(newStatus *v1alpha1.RolloutStatus, podReady time.Duration, now time.Time) progressEstimate {
+	blueGreen := c.rollout.Spec.Strategy.BlueGreen
+	promoted := newStatus.BlueGreen.ActiveSelector == newStatus.CurrentPodHash
+	estimate := progressEstimate{completion: now}
+	var stages, completedStages int32
+
+	stages++
+	if promoted || replicasetutil.ReadyForPause(c.rollout, c.newRS, c.allRSs) {
+		completedStages++
+	} else {
+		estimate.completion = estimate.notBefore(c.newRS.CreationTimestamp.Add(podReady), now)
+	}
+
+	if blueGreen.PrePromotionAnalysis != nil {
+		stages++
+		if promoted || c.completedPrePromotionAnalysis() {
+			completedStages++
+		} else {
+			duration, ok := c.estimateAnalysisDuration(blueGreen.PrePromotionAnalysis, c.currentArs.BlueGreenPrePromotion)
+			if !ok {
+				estimate.message = progressUnknownPrePromotionMessage
+			}
+			estimate.addAnalysis(duration, c.currentArs.BlueGreenPrePromotion, now)
+		}
+	}
+
+	stages++
+	if promoted {
+		completedStages++
+	} else {
+		if blueGreen.PreviewReplicaCount != nil {
+			// the new ReplicaSet is scaled up to the full count of replicas before the promotion
+			estimate.completion = estimate.completion.Add(podReady)
+		}
+		if !defaults.GetAutoPromotionEnabledOrDefault(c.rollout) {
+			if estimate.message == "" {
+				estimate.message = progressBlueGreenManualPromotionMessage
+			}
+		} else if blueGreen.AutoPromotionSeconds > 0 {
+			autoPromotion := time.Duration(blueGreen.AutoPromotionSeconds) * time.Second
+			pauseStart := estimate.completion
+			for _, cond := range newStatus.PauseConditions {
+				if cond.Reason == v1alpha1.PauseReasonBlueGreenPause {
+					pauseStart = cond.StartTime.Time
+				}
+			}
+			estimate.completion = laterTime(estimate.notBefore(pauseStart.Add(autoPromotion), now), estimate.completion)
+		}
+	}
+
+	if blueGreen.PostPromotionAnalysis != nil {
+		stages++
+		var currentAr *v1alpha1.AnalysisRun
+		if promoted {
+			currentAr = c.currentArs.BlueGreenPostPromotion
+		}
+		duration, ok := c.estimateAnalysisDuration(blueGreen.PostPromotionAnalysis, currentAr)
+		if !ok && estimate.message == "" {
+			estimate.message = progressUnknownPostPromotionMessage
+		}
+		estimate.addAnalysis(duration, currentAr, now)
+	}
+
+	// the update completes once the new ReplicaSet becomes stable
+	estimate.percent = completedStages * 100 / (stages + 1)
+	return estimate
+}
+
+// estimateAnalysisDuration estimates the duration of an analysis from the metrics of its running
+// AnalysisRun, or from the metrics of its templates when it has not started yet. It returns false
+// when the duration cannot be estimated, such as when a metric runs indefinitely.
+func (c *rolloutContext) estimateAnalysisDuration(rolloutAnalysis *v1alpha1.RolloutAnalysis, currentAr *v1alpha1.AnalysisRun) (time.Duration, bool) {
+	var metrics []v1alpha1.Metric
+	if currentAr != nil {
+		metrics = currentAr.Spec.Metrics
+	} else {
+		templates := make([]*v1alpha1.AnalysisTemplate, 0)
+		clusterTemplates := make([]*v1alpha1.ClusterAnalysisTemplate, 0)
+		for _, templateRef := range rolloutAnalysis.Templates {
+			if templateRef.ClusterScope {
+				template, err := c.clusterAnalysisTemplateLister.Get(templateRef.TemplateName)
+				if err != nil {
+					if !k8serrors.IsNotFound(err) {
+						c.log.Warnf("Failed to get ClusterAnalysisTemplate '%s' to estimate the progress: %v", templateRef.TemplateName, err)
+					}
+					return 0, false
+				}
+				clusterTemplates = append(clusterTemplates, template)
+			} else {
+				template, err := c.analysisTemplateLister.AnalysisTemplates(c.rollout.Namespace).Get(templateRef.TemplateName)
+				if err != nil {
+					if !k8serrors.IsNotFound(err) {
+						c.log.Warnf("Failed to get AnalysisTemplate '%s' to estimate the progress: %v", templateRef.TemplateName, err)
+					}
+					return 0, false
+				}
+				templates = append(templates, template)
+			}
+		}
+		template, err := analysisutil.FlattenTemplates(templates, clusterTemplates)
+		if err != nil {
+			return 0, false
+		}
+		metrics = template.Spec.Metrics
+	}
+	var longest time.Duration
+	for _, metric := range metrics {
+		duration, ok := metricDuration(metric)
+		if !ok {
+			return 0, false
+		}
+		if duration > longest {
+			longest = duration
+		}
+	}
+	return longest, true
+}
+
+// metricDuration returns the time a metric takes to take all its measurements, which is unknown
+// when it measures indefinitely or its count is resolved from arguments
+func metricDuration(metric v1alpha1.Metric) (time.Duration, bool) {
+	var duration time.Duration
+	if metric.InitialDelay != "" {
+		initialDelay, err := metric.InitialDelay.Duration()
+		if err != nil {
+			return 0, false
+		}
+		duration = initialDelay
+	}
+	if metric.Interval == "" {
+		return duration, true
+	}
+	if metric.Count == nil {
+		return 0, false
+	}
+	count := metric.Count.IntValue()
+	if metric.Count.Type == intstr.String {
+		parsed, err := strconv.Atoi(metric.Count.StrVal)
+		if err != nil {
+			return 0, false
+		}
+		count = parsed
+	}
+	if count <= 0 {
+		return 0, false
+	}
+	interval, err := metric.Interval.Duration()
+	if err != nil {
+		return 0, false
+	}
+	return duration + time.Duration(count-1)*interval, true
+}
+
+func laterTime(t1, t2 time.Time) time.Time {
+	if t1.After(t2) {
+		return t1
+	}
+	return t2
+}
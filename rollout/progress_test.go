@@ -0,0 +1,328 @@
+package rollout
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func newProgressContext(ro *v1alpha1.Rollout) *rolloutContext {
+	newRS := newReplicaSetWithStatus(ro, int(*ro.Spec.Replicas), int(*ro.Spec.Replicas))
+	ro.Status.StableRS = "stable"
+	ro.Status.CurrentPodHash = newRS.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	return &rolloutContext{
+		rollout:      ro,
+		log:          logutil.WithRollout(ro),
+		pauseContext: &pauseContext{rollout: ro},
+		newRS:        newRS,
+		allRSs:       []*appsv1.ReplicaSet{newRS},
+	}
+}
+
+func TestMetricDuration(t *testing.T) {
+	count := intstr.FromInt(5)
+	argCount := intstr.FromString("{{args.count}}")
+	tests := []struct {
+		name     string
+		metric   v1alpha1.Metric
+		duration time.Duration
+		ok       bool
+	}{
+		{"SingleMeasurement", v1alpha1.Metric{}, 0, true},
+		{"InitialDelay", v1alpha1.Metric{InitialDelay: "30s"}, 30 * time.Second, true},
+		{"Count", v1alpha1.Metric{InitialDelay: "30s", Interval: "1m", Count: &count}, 4*time.Minute + 30*time.Second, true},
+		{"StringCount", v1alpha1.Metric{Interval: "1m", Count: intstrPtr(intstr.FromString("3"))}, 2 * time.Minute, true},
+		{"Indefinite", v1alpha1.Metric{Interval: "1m"}, 0, false},
+		{"CountFromArgs", v1alpha1.Metric{Interval: "1m", Count: &argCount}, 0, false},
+		{"InvalidInterval", v1alpha1.Metric{Interval: "often", Count: &count}, 0, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			duration, ok := metricDuration(test.metric)
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.duration, duration)
+		})
+	}
+}
+
+func intstrPtr(value intstr.IntOrString) *intstr.IntOrString {
+	return &value
+}
+
+func TestProgressChanged(t *testing.T) {
+	prevStatus := v1alpha1.RolloutStatus{
+		CurrentStepIndex:  pointer.Int32Ptr(1),
+		Replicas:          3,
+		UpdatedReplicas:   1,
+		ReadyReplicas:     3,
+		AvailableReplicas: 3,
+		Phase:             v1alpha1.RolloutPhasePaused,
+		Message:           "CanaryPauseStep",
+	}
+	newStatus := prevStatus.DeepCopy()
+	newStatus.Message = "other"
+	assert.False(t, progressChanged(&prevStatus, newStatus))
+
+	newStatus = prevStatus.DeepCopy()
+	newStatus.CurrentStepIndex = pointer.Int32Ptr(2)
+	assert.True(t, progressChanged(&prevStatus, newStatus))
+
+	newStatus = prevStatus.DeepCopy()
+	newStatus.AvailableReplicas = 2
+	assert.True(t, progressChanged(&prevStatus, newStatus))
+
+	newStatus = prevStatus.DeepCopy()
+	newStatus.Phase = v1alpha1.RolloutPhaseProgressing
+	assert.True(t, progressChanged(&prevStatus, newStatus))
+}
+
+func TestCalculateProgressCanary(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	now := timeutil.Now()
+
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32Ptr(10)},
+		{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromString("1m")}},
+		{Experiment: &v1alpha1.RolloutExperimentStep{Duration: "5m"}},
+		{SetWeight: pointer.Int32Ptr(50)},
+		{Pause: &v1alpha1.RolloutPause{Duration: v1alpha1.DurationFromInt(30)}},
+	}
+	newStatus := func() *v1alpha1.RolloutStatus {
+		finishedAt := metav1.NewTime(now.Add(-30 * time.Second))
+		return &v1alpha1.RolloutStatus{
+			StableRS:         "stable",
+			CurrentStepIndex: pointer.Int32Ptr(1),
+			Canary: v1alpha1.CanaryStatus{
+				StepHistory: []v1alpha1.CanaryStepRecord{
+					{Index: 0, StartedAt: metav1.NewTime(now.Add(-90 * time.Second)), FinishedAt: &finishedAt, Outcome: v1alpha1.CanaryStepOutcomeCompleted},
+					{Index: 1, StartedAt: finishedAt, Outcome: v1alpha1.CanaryStepOutcomeRunning},
+				},
+			},
+		}
+	}
+	// the pause ends 30s from now, followed by the experiment with its pods, the scaling step, the
+	// last pause and the full promotion, with pods observed to take 60s to become available
+	expectedCompletion := now.Add(30*time.Second + 6*time.Minute + time.Minute + 30*time.Second + time.Minute).Truncate(time.Second)
+
+	t.Run("Estimated", func(t *testing.T) {
+		roCtx := newProgressContext(newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0)))
+		status := newStatus()
+		status.CurrentPodHash = roCtx.rollout.Status.CurrentPodHash
+		progress := roCtx.calculateProgress(status)
+		if assert.NotNil(t, progress) {
+			assert.Equal(t, int32(16), progress.Percent)
+			assert.Equal(t, pointer.Int32Ptr(60), progress.PodReadySeconds)
+			assert.Empty(t, progress.Message)
+			assert.Equal(t, expectedCompletion, progress.EstimatedCompletionTime.Time)
+		}
+	})
+
+	t.Run("KeepsPreviousEstimate", func(t *testing.T) {
+		roCtx := newProgressContext(newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0)))
+		previous := metav1.NewTime(expectedCompletion.Add(30 * time.Second))
+		roCtx.rollout.Status.Progress = &v1alpha1.RolloutProgress{Percent: 16, EstimatedCompletionTime: &previous}
+		status := newStatus()
+		status.CurrentPodHash = roCtx.rollout.Status.CurrentPodHash
+		assert.Equal(t, previous, *roCtx.calculateProgress(status).EstimatedCompletionTime)
+
+		previous = metav1.NewTime(expectedCompletion.Add(2 * time.Minute))
+		assert.Equal(t, expectedCompletion, roCtx.calculateProgress(status).EstimatedCompletionTime.Time)
+	})
+
+	t.Run("KeepsOverdueEstimate", func(t *testing.T) {
+		roCtx := newProgressContext(newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0)))
+		status := newStatus()
+		status.CurrentPodHash = roCtx.rollout.Status.CurrentPodHash
+		// the pause of 1m started 5m ago
+		status.Canary.StepHistory[1].StartedAt = metav1.NewTime(now.Add(-5 * time.Minute))
+		overdueCompletion := now.Add(6*time.Minute + time.Minute + 30*time.Second + time.Minute).Truncate(time.Second)
+		assert.Equal(t, overdueCompletion, roCtx.calculateProgress(status).EstimatedCompletionTime.Time)
+
+		previous := metav1.NewTime(now.Add(4 * time.Minute).Truncate(time.Second))
+		roCtx.rollout.Status.Progress = &v1alpha1.RolloutProgress{Percent: 16, EstimatedCompletionTime: &previous}
+		assert.Equal(t, previous, *roCtx.calculateProgress(status).EstimatedCompletionTime)
+
+		// the estimate moves once the update moves on to the next step
+		roCtx.rollout.Status.Progress.Percent = 0
+		assert.Equal(t, overdueCompletion, roCtx.calculateProgress(status).EstimatedCompletionTime.Time)
+	})
+
+	t.Run("DefaultPodReadySeconds", func(t *testing.T) {
+		roCtx := newProgressContext(newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(3), intstr.FromInt(1), intstr.FromInt(0)))
+		roCtx.rollout.Spec.MinReadySeconds = 10
+		status := &v1alpha1.RolloutStatus{StableRS: "stable", CurrentPodHash: roCtx.rollout.Status.CurrentPodHash, CurrentStepIndex: pointer.Int32Ptr(3)}
+		progress := roCtx.calculateProgress(status)
+		assert.Equal(t, int32(50), progress.Percent)
+		assert.Nil(t, progress.PodReadySeconds)
+		// the scaling step, the pause and the full promotion
+		assert.Equal(t, now.Add(40*time.Second+30*time.Second+40*time.Second).Truncate(time.Second), progress.EstimatedCompletionTime.Time)
+	})
+
+	t.Run("ManualPromotion", func(t *testing.T) {
+		manualSteps := append([]v1alpha1.CanaryStep{{Pause: &v1alpha1.RolloutPause{}}}, steps...)
+		roCtx := newProgressContext(newCanaryRollout("foo", 10, nil, manualSteps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0)))
+		status := &v1alpha1.RolloutStatus{StableRS: "stable", CurrentPodHash: roCtx.rollout.Status.CurrentPodHash, CurrentStepIndex: pointer.Int32Ptr(0)}
+		progress := roCtx.calculateProgress(status)
+		assert.Equal(t, int32(0), progress.Percent)
+		assert.Nil(t, progress.EstimatedCompletionTime)
+		assert.Equal(t, fmt.Sprintf(progressManualPromotionMessage, 1), progress.Message)
+	})
+
+	t.Run("Paused", func(t *testing.T) {
+		roCtx := newProgressContext(newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0)))
+		roCtx.rollout.Spec.Paused = true
+		status := newStatus()
+		status.CurrentPodHash = roCtx.rollout.Status.CurrentPodHash
+		progress := roCtx.calculateProgress(status)
+		assert.Nil(t, progress.EstimatedCompletionTime)
+		assert.Equal(t, progressPausedMessage, progress.Message)
+	})
+
+	t.Run("NotReported", func(t *testing.T) {
+		roCtx := newProgressContext(newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(1), intstr.FromInt(1), intstr.FromInt(0)))
+		status := newStatus()
+		status.CurrentPodHash = status.StableRS
+		assert.Nil(t, roCtx.calculateProgress(status))
+
+		status = newStatus()
+		status.CurrentPodHash = roCtx.rollout.Status.CurrentPodHash
+		status.Abort = true
+		assert.Nil(t, roCtx.calculateProgress(status))
+
+		status = newStatus()
+		status.CurrentPodHash = roCtx.rollout.Status.CurrentPodHash
+		status.StableRS = ""
+		assert.Nil(t, roCtx.calculateProgress(status))
+	})
+}
+
+func TestCalculateProgressCanaryAnalysis(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	now := timeutil.Now()
+
+	count := intstr.FromInt(5)
+	steps := []v1alpha1.CanaryStep{
+		{Analysis: &v1alpha1.RolloutAnalysis{Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "success-rate"}}}},
+	}
+	r := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	roCtx := newProgressContext(r)
+	status := &v1alpha1.RolloutStatus{StableRS: "stable", CurrentPodHash: r.Status.CurrentPodHash, CurrentStepIndex: pointer.Int32Ptr(0)}
+
+	roCtx.currentArs.CanaryStep = &v1alpha1.AnalysisRun{
+		Spec: v1alpha1.AnalysisRunSpec{
+			Metrics: []v1alpha1.Metric{
+				{Name: "short"},
+				{Name: "long", InitialDelay: "30s", Interval: "1m", Count: &count},
+			},
+		},
+	}
+	progress := roCtx.calculateProgress(status)
+	// the longest metric, followed by the full promotion
+	assert.Equal(t, now.Add(5*time.Minute).Truncate(time.Second), progress.EstimatedCompletionTime.Time)
+
+	roCtx.currentArs.CanaryStep.Spec.Metrics[1].Count = nil
+	progress = roCtx.calculateProgress(status)
+	assert.Nil(t, progress.EstimatedCompletionTime)
+	assert.Equal(t, fmt.Sprintf(progressUnknownStepMessage, 1), progress.Message)
+}
+
+func TestEstimateAnalysisDurationFromTemplates(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	count := intstr.FromInt(3)
+	at := analysisTemplate("success-rate")
+	at.Spec.Metrics[0].Interval = "1m"
+	at.Spec.Metrics[0].Count = &count
+	cat := clusterAnalysisTemplate("latency")
+	cat.Spec.Metrics[0].InitialDelay = "5m"
+	r := newCanaryRollout("foo", 1, nil, nil, nil, intstr.FromInt(1), intstr.FromInt(0))
+	f.analysisTemplateLister = append(f.analysisTemplateLister, at)
+	f.clusterAnalysisTemplateLister = append(f.clusterAnalysisTemplateLister, cat)
+	f.rolloutLister = append(f.rolloutLister, r)
+	f.objects = append(f.objects, r, at, cat)
+
+	c, _, _ := f.newController(noResyncPeriodFunc)
+	roCtx, err := c.newRolloutContext(r)
+	assert.NoError(t, err)
+
+	duration, ok := roCtx.estimateAnalysisDuration(&v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "success-rate"}},
+	}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Minute, duration)
+
+	duration, ok = roCtx.estimateAnalysisDuration(&v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "success-rate"}, {TemplateName: "latency", ClusterScope: true}},
+	}, nil)
+	assert.True(t, ok)
+	assert.Equal(t, 5*time.Minute, duration)
+
+	_, ok = roCtx.estimateAnalysisDuration(&v1alpha1.RolloutAnalysis{
+		Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "does-not-exist"}},
+	}, nil)
+	assert.False(t, ok)
+}
+
+func TestCalculateProgressBlueGreen(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+	now := timeutil.Now()
+
+	t.Run("AutoPromotion", func(t *testing.T) {
+		r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+		r.Spec.Strategy.BlueGreen.AutoPromotionSeconds = 120
+		roCtx := newProgressContext(r)
+		status := &v1alpha1.RolloutStatus{
+			StableRS:        "stable",
+			CurrentPodHash:  r.Status.CurrentPodHash,
+			PauseConditions: []v1alpha1.PauseCondition{{Reason: v1alpha1.PauseReasonBlueGreenPause, StartTime: metav1.NewTime(now.Add(-time.Minute))}},
+		}
+		status.BlueGreen.ActiveSelector = "stable"
+		progress := roCtx.calculateProgress(status)
+		assert.Equal(t, int32(33), progress.Percent)
+		assert.Equal(t, now.Add(time.Minute).Truncate(time.Second), progress.EstimatedCompletionTime.Time)
+	})
+
+	t.Run("ManualPromotion", func(t *testing.T) {
+		r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+		r.Spec.Strategy.BlueGreen.AutoPromotionEnabled = pointer.BoolPtr(false)
+		roCtx := newProgressContext(r)
+		status := &v1alpha1.RolloutStatus{StableRS: "stable", CurrentPodHash: r.Status.CurrentPodHash}
+		status.BlueGreen.ActiveSelector = "stable"
+		progress := roCtx.calculateProgress(status)
+		assert.Equal(t, int32(33), progress.Percent)
+		assert.Nil(t, progress.EstimatedCompletionTime)
+		assert.Equal(t, progressBlueGreenManualPromotionMessage, progress.Message)
+	})
+
+	t.Run("PostPromotionAnalysis", func(t *testing.T) {
+		r := newBlueGreenRollout("foo", 1, nil, "active", "preview")
+		r.Spec.Strategy.BlueGreen.PostPromotionAnalysis = &v1alpha1.RolloutAnalysis{
+			Templates: []v1alpha1.RolloutAnalysisTemplate{{TemplateName: "smoke-test"}},
+		}
+		roCtx := newProgressContext(r)
+		startedAt := metav1.NewTime(now.Add(-time.Minute))
+		roCtx.currentArs.BlueGreenPostPromotion = &v1alpha1.AnalysisRun{
+			Spec:   v1alpha1.AnalysisRunSpec{Metrics: []v1alpha1.Metric{{Name: "smoke", InitialDelay: "3m"}}},
+			Status: v1alpha1.AnalysisRunStatus{StartedAt: &startedAt},
+		}
+		status := &v1alpha1.RolloutStatus{StableRS: "stable", CurrentPodHash: r.Status.CurrentPodHash}
+		status.BlueGreen.ActiveSelector = r.Status.CurrentPodHash
+		progress := roCtx.calculateProgress(status)
+		assert.Equal(t, int32(50), progress.Percent)
+		assert.Equal(t, now.Add(2*time.Minute).Truncate(time.Second), progress.EstimatedCompletionTime.Time)
+	})
+}
@@ -16,8 +16,8 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
-	"github.com/argoproj/argo-rollouts/utils/replicaset"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+	"github.com/argoproj/argo-rollouts/utils/restart"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
@@ -39,31 +39,75 @@ type RolloutPodRestarter struct {
 	enqueueAfter func(obj interface{}, duration time.Duration)
 }
 
-// checkEnqueueRollout enqueues a Rollout if the Rollout's restartedAt is within the next resync
+// checkEnqueueRollout enqueues a Rollout if the Rollout's restartedAt, or its next scheduled restart,
+// is within the next resync
 func (p RolloutPodRestarter) checkEnqueueRollout(roCtx *rolloutContext) {
+	p.checkEnqueueRolloutAt(roCtx, roCtx.rollout.Spec.RestartAt)
+	p.checkEnqueueRolloutAt(roCtx, roCtx.newStatus.NextScheduledRestartAt)
+}
+
+func (p RolloutPodRestarter) checkEnqueueRolloutAt(roCtx *rolloutContext, restartAt *metav1.Time) {
 	logCtx := roCtx.log.WithField("Reconciler", "PodRestarter")
 	now := nowFn().UTC()
-	if roCtx.rollout.Spec.RestartAt == nil || now.After(roCtx.rollout.Spec.RestartAt.Time) {
+	if restartAt == nil || now.After(restartAt.Time) {
 		return
 	}
 	nextResync := now.Add(p.resyncPeriod)
 	// Only enqueue if the Restart time is before the next sync period
-	if nextResync.After(roCtx.rollout.Spec.RestartAt.Time) {
-		timeRemaining := roCtx.rollout.Spec.RestartAt.Sub(now)
+	if nextResync.After(restartAt.Time) {
+		timeRemaining := restartAt.Sub(now)
 		logCtx.Infof("Enqueueing Rollout in %s seconds for restart", timeRemaining.String())
 		p.enqueueAfter(roCtx.rollout, timeRemaining)
 	}
 }
 
+// reconcileSchedule computes the next scheduled restart of the Rollout, when the Rollout has a
+// restart schedule. A scheduled restart which is due while an update is in progress is skipped,
+// since the update replaces the pods anyway.
+func (p RolloutPodRestarter) reconcileSchedule(roCtx *rolloutContext) {
+	if roCtx.rollout.Spec.RestartSchedule == nil {
+		roCtx.newStatus.NextScheduledRestartAt = nil
+		return
+	}
+	logCtx := roCtx.log.WithField("Reconciler", "PodRestarter")
+	schedule, err := restart.ParseSchedule(*roCtx.rollout.Spec.RestartSchedule)
+	if err != nil {
+		// the schedule is validated before the rollout is reconciled
+		logCtx.Warnf("Invalid restart schedule: %v", err)
+		roCtx.newStatus.NextScheduledRestartAt = nil
+		return
+	}
+	now := nowFn().UTC()
+	next := roCtx.newStatus.NextScheduledRestartAt
+	updating := roCtx.newRS == nil || roCtx.stableRS == nil || roCtx.newRS.UID != roCtx.stableRS.UID
+	if next == nil || !restart.IsScheduledAt(schedule, *next) {
+		// the schedule was added or changed
+		next = restart.NextRestart(schedule, now)
+	} else if !now.Before(next.Time) && updating {
+		logCtx.Infof("Skipping restart scheduled at %s: update in progress", next.Format(time.RFC3339))
+		next = restart.NextRestart(schedule, now)
+	}
+	roCtx.newStatus.NextScheduledRestartAt = next
+}
+
 // Reconcile gets all pods of a Rollout and confirms that have creationTimestamps newer than
 // spec.restartAt. If not, iterates pods and deletes pods which do not have a deletion timestamp,
-// and were created before spec.restartedAt. If the rollout is a canary rollout, it can restart
-// multiple pods, up to maxUnavailable or 1, whichever is greater.
+// and were created before spec.restartedAt. The pods of the new ReplicaSet created with another
+// canary/preview pod spec patch than its current one are restarted as well, which is only checked
+// when the pod spec patch of the new ReplicaSet changed since its pods were last checked. If the
+// rollout is a canary rollout, it can restart multiple pods, up to maxUnavailable or 1, whichever
+// is greater.
 func (p *RolloutPodRestarter) Reconcile(roCtx *rolloutContext) error {
 	ctx := context.TODO()
 	logCtx := roCtx.log.WithField("Reconciler", "PodRestarter")
 	p.checkEnqueueRollout(roCtx)
-	if !replicaset.NeedsRestart(roCtx.rollout) {
+	restartedAt := replicasetutil.GetRestartAt(roCtx.rollout, roCtx.newStatus.NextScheduledRestartAt)
+	var podSpecPatchHash string
+	if roCtx.newRS != nil {
+		podSpecPatchHash = roCtx.newRS.Spec.Template.Annotations[replicasetutil.PodSpecPatchHashAnnotation]
+	}
+	checkPodSpecPatch := roCtx.newRS != nil && podSpecPatchHash != roCtx.newStatus.PodSpecPatchHash
+	if restartedAt == nil && !checkPodSpecPatch {
 		return nil
 	}
 	s := NewSortReplicaSetsByPriority(roCtx)
@@ -72,6 +116,27 @@ func (p *RolloutPodRestarter) Reconcile(roCtx *rolloutContext) error {
 	if err != nil {
 		return err
 	}
+	needsRestartFn := func(pod *corev1.Pod) bool {
+		if restartedAt != nil && pod.CreationTimestamp.Before(restartedAt) {
+			return true
+		}
+		return checkPodSpecPatch && replicasetutil.HasStalePodSpecPatch(pod, roCtx.newRS)
+	}
+	if restartedAt == nil {
+		// only the pods created with another pod spec patch need to be restarted, if any
+		stale := false
+		for _, pod := range rolloutPods {
+			if needsRestartFn(pod) {
+				stale = true
+				break
+			}
+		}
+		if !stale {
+			logCtx.Infof("all %d pods have the current pod spec patch", len(rolloutPods))
+			roCtx.newStatus.PodSpecPatchHash = podSpecPatchHash
+			return nil
+		}
+	}
 	// total replicas can be higher than spec.replicas (e.g. when we are a canary weight that is not
 	// evenly divisible by the spec.replicas)
 	totalReplicas := replicasetutil.GetReplicaCountForReplicaSets(s.allRSs)
@@ -89,11 +154,10 @@ func (p *RolloutPodRestarter) Reconcile(roCtx *rolloutContext) error {
 	logCtx.Infof("Reconcile pod restart (replicas:%d, totalReplicas:%d, available:%d, maxUnavailable:%d, effectiveMinAvailable:%d, concurrentRestart:%d, canRestart:%d)",
 		replicas, totalReplicas, available, maxUnavailable, effMinAvailable, concurrentRestart, canRestart)
 
-	restartedAt := roCtx.rollout.Spec.RestartAt
 	needsRestart := 0
 	restarted := 0
 	for _, pod := range rolloutPods {
-		if pod.CreationTimestamp.After(restartedAt.Time) || pod.CreationTimestamp.Equal(restartedAt) {
+		if !needsRestartFn(pod) {
 			continue
 		}
 		needsRestart += 1
@@ -103,8 +167,13 @@ func (p *RolloutPodRestarter) Reconcile(roCtx *rolloutContext) error {
 		if pod.DeletionTimestamp != nil {
 			continue
 		}
-		newLogCtx := logCtx.WithField("Pod", pod.Name).WithField("CreatedAt", pod.CreationTimestamp.Format(time.RFC3339)).WithField("RestartAt", restartedAt.Format(time.RFC3339))
-		newLogCtx.Info("restarting Pod that's older than restartAt Time")
+		newLogCtx := logCtx.WithField("Pod", pod.Name).WithField("CreatedAt", pod.CreationTimestamp.Format(time.RFC3339))
+		if restartedAt != nil && pod.CreationTimestamp.Before(restartedAt) {
+			newLogCtx = newLogCtx.WithField("RestartAt", restartedAt.Format(time.RFC3339))
+			newLogCtx.Info("restarting Pod that's older than restartAt Time")
+		} else {
+			newLogCtx.Info("restarting Pod created with another pod spec patch")
+		}
 		evictTarget := policy.Eviction{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      pod.Name,
@@ -129,9 +198,14 @@ func (p *RolloutPodRestarter) Reconcile(roCtx *rolloutContext) error {
 	if remaining != 0 {
 		logCtx.Infof("%d/%d pods require restart. restarted %d. retrying in %v", needsRestart, len(rolloutPods), restarted, restartPodCheckTime)
 		p.enqueueAfter(roCtx.rollout, restartPodCheckTime)
-	} else {
+	} else if restartedAt != nil {
 		logCtx.Infof("all %d pods are current. setting restartedAt", len(rolloutPods))
 		roCtx.SetRestartedAt()
+	} else {
+		logCtx.Infof("all %d pods are current", len(rolloutPods))
+	}
+	if remaining == 0 && roCtx.newRS != nil {
+		roCtx.newStatus.PodSpecPatchHash = podSpecPatchHash
 	}
 	return nil
 }
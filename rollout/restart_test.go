@@ -20,6 +20,7 @@ import (
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/log"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
 func rollout(selector string, restartAt metav1.Time, restartedAt *metav1.Time) *v1alpha1.Rollout {
@@ -658,3 +659,100 @@ func TestRestartRespectPodDisruptionBudget(t *testing.T) {
 	assert.NoError(t, err)
 	assert.True(t, enqueueCalled)
 }
+
+func TestRestartSchedule(t *testing.T) {
+	now := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	setNow := func(t *testing.T, at time.Time) {
+		nowFn = func() time.Time { return at }
+		timeutil.Now = func() time.Time { return at }
+		t.Cleanup(func() {
+			nowFn = time.Now
+			timeutil.Now = time.Now
+		})
+	}
+	stableRS := replicaSet("rollout-restart-abc123", "test", 1, 1)
+	newRS := replicaSet("rollout-restart-def456", "test", 1, 1)
+	newRS.UID = "66666666-7777-8888-9999-000000000000"
+	newRoCtx := func(schedule *v1alpha1.RestartSchedule, next *metav1.Time, updating bool) *rolloutContext {
+		ro := rollout("test", metav1.Time{}, nil)
+		ro.Spec.RestartAt = nil
+		ro.Spec.RestartSchedule = schedule
+		ro.Status.NextScheduledRestartAt = next
+		roCtx := &rolloutContext{
+			rollout:   ro,
+			log:       log.WithRollout(ro),
+			newRS:     stableRS,
+			stableRS:  stableRS,
+			newStatus: v1alpha1.RolloutStatus{NextScheduledRestartAt: next},
+		}
+		if updating {
+			roCtx.newRS = newRS
+		}
+		return roCtx
+	}
+	metaTime := func(s string) *metav1.Time {
+		parsed, err := time.Parse(time.RFC3339, s)
+		assert.NoError(t, err)
+		return &metav1.Time{Time: parsed}
+	}
+	daily := &v1alpha1.RestartSchedule{Schedule: "0 3 * * *"}
+
+	t.Run("Schedule next restart", func(t *testing.T) {
+		setNow(t, now)
+		roCtx := newRoCtx(daily, nil, false)
+		RolloutPodRestarter{}.reconcileSchedule(roCtx)
+		assert.Equal(t, metaTime("2022-03-02T03:00:00Z"), roCtx.newStatus.NextScheduledRestartAt)
+	})
+	t.Run("Schedule next restart in time zone", func(t *testing.T) {
+		setNow(t, now)
+		roCtx := newRoCtx(&v1alpha1.RestartSchedule{Schedule: "0 3 * * *", TimeZone: "Europe/Paris"}, nil, false)
+		RolloutPodRestarter{}.reconcileSchedule(roCtx)
+		assert.Equal(t, metaTime("2022-03-02T02:00:00Z"), roCtx.newStatus.NextScheduledRestartAt)
+	})
+	t.Run("Reschedule after schedule change", func(t *testing.T) {
+		setNow(t, now)
+		roCtx := newRoCtx(&v1alpha1.RestartSchedule{Schedule: "0 5 * * *"}, metaTime("2022-03-02T03:00:00Z"), false)
+		RolloutPodRestarter{}.reconcileSchedule(roCtx)
+		assert.Equal(t, metaTime("2022-03-02T05:00:00Z"), roCtx.newStatus.NextScheduledRestartAt)
+	})
+	t.Run("Clear next restart after schedule removal", func(t *testing.T) {
+		setNow(t, now)
+		roCtx := newRoCtx(nil, metaTime("2022-03-02T03:00:00Z"), false)
+		RolloutPodRestarter{}.reconcileSchedule(roCtx)
+		assert.Nil(t, roCtx.newStatus.NextScheduledRestartAt)
+	})
+	t.Run("Due restart is kept until restarted", func(t *testing.T) {
+		setNow(t, time.Date(2022, 3, 2, 3, 0, 30, 0, time.UTC))
+		roCtx := newRoCtx(daily, metaTime("2022-03-02T03:00:00Z"), false)
+		RolloutPodRestarter{}.reconcileSchedule(roCtx)
+		assert.Equal(t, metaTime("2022-03-02T03:00:00Z"), roCtx.newStatus.NextScheduledRestartAt)
+
+		roCtx.SetRestartedAt()
+		assert.Equal(t, metaTime("2022-03-02T03:00:00Z"), roCtx.newStatus.RestartedAt)
+		assert.Equal(t, metaTime("2022-03-03T03:00:00Z"), roCtx.newStatus.NextScheduledRestartAt)
+	})
+	t.Run("Skip due restart during update", func(t *testing.T) {
+		setNow(t, time.Date(2022, 3, 2, 3, 0, 30, 0, time.UTC))
+		roCtx := newRoCtx(daily, metaTime("2022-03-02T03:00:00Z"), true)
+		RolloutPodRestarter{}.reconcileSchedule(roCtx)
+		assert.Equal(t, metaTime("2022-03-03T03:00:00Z"), roCtx.newStatus.NextScheduledRestartAt)
+		assert.Nil(t, roCtx.newStatus.RestartedAt)
+	})
+	t.Run("Restart pods created before scheduled restart", func(t *testing.T) {
+		setNow(t, time.Date(2022, 3, 2, 3, 0, 30, 0, time.UTC))
+		olderPod := pod("older", "test", *metaTime("2022-03-01T12:00:00Z"), stableRS)
+		client := fake.NewSimpleClientset(stableRS, olderPod)
+		roCtx := newRoCtx(daily, metaTime("2022-03-02T03:00:00Z"), false)
+		roCtx.allRSs = []*appsv1.ReplicaSet{stableRS}
+		r := RolloutPodRestarter{
+			client:       client,
+			enqueueAfter: func(obj interface{}, duration time.Duration) {},
+		}
+		err := r.Reconcile(roCtx)
+		assert.NoError(t, err)
+		actions := client.Actions()
+		if assert.Len(t, actions, 2) {
+			assert.Equal(t, "pods/eviction", actions[1].GetResource().Resource+"/"+actions[1].GetSubresource())
+		}
+	})
+}
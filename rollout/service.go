@@ -64,7 +64,11 @@ func (c rolloutContext) switchServiceSelector(service *corev1.Service, newRollou
 		return nil
 	}
 	patch := generatePatch(service, newRolloutUniqueLabelValue, r)
-	_, err := c.kubeclientset.CoreV1().Services(service.Namespace).Patch(ctx, service.Name, patchtypes.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
+	clients, err := c.writeClients(r)
+	if err != nil {
+		return err
+	}
+	_, err = clients.KubeClientSet.CoreV1().Services(service.Namespace).Patch(ctx, service.Name, patchtypes.StrategicMergePatchType, []byte(patch), metav1.PatchOptions{})
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,87 @@
+package rollout
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	"github.com/argoproj/argo-rollouts/utils/record"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+// maxStepHistory is the maximum number of step records kept in the status. Retrying an aborted
+// update starts the steps over, so the history would otherwise grow without bounds.
+const maxStepHistory = 50
+
+// getRunningStepRecord returns the record of the step currently running, if any
+func getRunningStepRecord(rollout *v1alpha1.Rollout) *v1alpha1.CanaryStepRecord {
+	history := rollout.Status.Canary.StepHistory
+	if len(history) == 0 || history[len(history)-1].Outcome != v1alpha1.CanaryStepOutcomeRunning {
+		return nil
+	}
+	return &history[len(history)-1]
+}
+
+// checkStepDeadline aborts the rollout if the current step has been running for longer than its
+// deadline. Otherwise, it requeues the rollout for when the deadline would be exceeded.
+func (c *rolloutContext) checkStepDeadline(currentStep *v1alpha1.CanaryStep, currentStepIndex *int32) bool {
+	if currentStep == nil || currentStep.Deadline == nil || c.rollout.Spec.Paused || c.pauseContext.IsAborted() {
+		return false
+	}
+	stepRecord := getRunningStepRecord(c.rollout)
+	if stepRecord == nil || stepRecord.Index != *currentStepIndex {
+		return false
+	}
+	deadlineSeconds := currentStep.DeadlineSeconds()
+	if deadlineSeconds <= 0 {
+		return false
+	}
+	deadline := time.Duration(deadlineSeconds) * time.Second
+	if !timeutil.MetaNow().After(stepRecord.StartedAt.Add(deadline)) {
+		c.checkEnqueueRolloutDuringWait(stepRecord.StartedAt, deadlineSeconds)
+		return false
+	}
+	stepCount := len(c.rollout.Spec.Strategy.Canary.Steps)
+	msg := fmt.Sprintf(conditions.RolloutStepDeadlineExceededMessage, *currentStepIndex+1, stepCount, deadline.String())
+	c.recorder.Warnf(c.rollout, record.EventOptions{EventReason: conditions.RolloutStepDeadlineExceededReason}, msg)
+	c.pauseContext.AddAbort(msg)
+	return true
+}
+
+// calculateStepHistory records in the new status the start of the step at the current step index,
+// and the outcome of the running step when the rollout moved past it or was aborted
+func (c *rolloutContext) calculateStepHistory(newStatus *v1alpha1.RolloutStatus, outcome v1alpha1.CanaryStepOutcome, message string) {
+	history := make([]v1alpha1.CanaryStepRecord, 0, len(c.rollout.Status.Canary.StepHistory)+1)
+	for _, stepRecord := range c.rollout.Status.Canary.StepHistory {
+		history = append(history, *stepRecord.DeepCopy())
+	}
+	now := timeutil.MetaNow()
+	aborted := c.pauseContext.IsAborted()
+	currentStepIndex := newStatus.CurrentStepIndex
+
+	running := len(history) > 0 && history[len(history)-1].Outcome == v1alpha1.CanaryStepOutcomeRunning
+	if running {
+		current := &history[len(history)-1]
+		if aborted || currentStepIndex == nil || *currentStepIndex != current.Index {
+			current.Outcome = outcome
+			current.Message = message
+			current.FinishedAt = &now
+			running = false
+		}
+	}
+	stepCount := int32(len(c.rollout.Spec.Strategy.Canary.Steps))
+	if !running && !aborted && currentStepIndex != nil && *currentStepIndex < stepCount {
+		history = append(history, v1alpha1.CanaryStepRecord{
+			Index:     *currentStepIndex,
+			StartedAt: now,
+			Outcome:   v1alpha1.CanaryStepOutcomeRunning,
+		})
+	}
+	if len(history) > maxStepHistory {
+		history = history[len(history)-maxStepHistory:]
+	}
+	if len(history) > 0 {
+		newStatus.Canary.StepHistory = history
+	}
+}
@@ -0,0 +1,191 @@
+package rollout
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/conditions"
+	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+func TestStepDeadlineExceededAbortsRollout(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32Ptr(10), Deadline: v1alpha1.DurationFromString("5m")},
+		{SetWeight: pointer.Int32Ptr(20)},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r2 := bumpVersion(r1)
+	startedAt := metav1.NewTime(timeutil.Now().Add(-10 * time.Minute))
+	r2.Status.Canary.StepHistory[0].StartedAt = startedAt
+
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 0)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 9, 1, 10, false)
+	r2.Status.Replicas = 10
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patch := f.getPatchedRollout(patchIndex)
+	expectedPatch := `{
+		"status": {
+			"canary": {
+				"stepHistory": %s
+			},
+			"conditions": %s,
+			"abort": true,
+			"abortedAt": "%s",
+			"phase": "Degraded",
+			"message": "RolloutAborted: %s"
+		}
+	}`
+	now := timeutil.MetaNow()
+	deadlineMsg := fmt.Sprintf(conditions.RolloutStepDeadlineExceededMessage, 1, 2, "5m0s")
+	errmsg := fmt.Sprintf(conditions.RolloutAbortedMessage, 2) + ": " + deadlineMsg
+	condition := generateConditionsPatch(false, conditions.RolloutAbortedReason, r2, false, errmsg)
+	stepHistory := stepHistoryPatch(v1alpha1.CanaryStepRecord{
+		Index:      0,
+		StartedAt:  startedAt,
+		FinishedAt: &now,
+		Outcome:    v1alpha1.CanaryStepOutcomeDeadlineExceeded,
+		Message:    deadlineMsg,
+	})
+	expectedPatch = fmt.Sprintf(expectedPatch, stepHistory, condition, now.UTC().Format(time.RFC3339), errmsg)
+	assert.Equal(t, calculatePatch(r2, expectedPatch), patch)
+}
+
+func TestStepDeadlineNotEnforcedWhilePaused(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32Ptr(10), Deadline: v1alpha1.DurationFromString("5m")},
+		{SetWeight: pointer.Int32Ptr(20)},
+	}
+	r1 := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	r2 := bumpVersion(r1)
+	r2.Status.Canary.StepHistory[0].StartedAt = metav1.NewTime(timeutil.Now().Add(-10 * time.Minute))
+	r2.Spec.Paused = true
+
+	rs1 := newReplicaSetWithStatus(r1, 9, 9)
+	rs1PodHash := rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+	rs2 := newReplicaSetWithStatus(r2, 1, 0)
+	f.kubeobjects = append(f.kubeobjects, rs1, rs2)
+	f.replicaSetLister = append(f.replicaSetLister, rs1, rs2)
+
+	r2 = updateCanaryRolloutStatus(r2, rs1PodHash, 9, 1, 10, false)
+	r2.Status.Replicas = 10
+	f.rolloutLister = append(f.rolloutLister, r2)
+	f.objects = append(f.objects, r2)
+
+	f.expectPatchRolloutAction(r2)
+	patchIndex := f.expectPatchRolloutAction(r2)
+	f.run(getKey(r2, t))
+
+	patched := f.getPatchedRolloutAsObject(patchIndex)
+	assert.False(t, patched.Status.Abort)
+	assert.Nil(t, patched.Status.Canary.StepHistory)
+}
+
+func newStepHistoryContext(steps []v1alpha1.CanaryStep, history []v1alpha1.CanaryStepRecord, aborted bool) *rolloutContext {
+	ro := newCanaryRollout("foo", 10, nil, steps, pointer.Int32Ptr(0), intstr.FromInt(1), intstr.FromInt(0))
+	ro.Status.Canary.StepHistory = history
+	roCtx := &rolloutContext{
+		rollout:      ro,
+		log:          logutil.WithRollout(ro),
+		pauseContext: &pauseContext{rollout: ro},
+	}
+	if aborted {
+		roCtx.pauseContext.AddAbort("aborted by user")
+	}
+	return roCtx
+}
+
+func TestCalculateStepHistory(t *testing.T) {
+	f := newFixture(t)
+	defer f.Close()
+
+	steps := []v1alpha1.CanaryStep{
+		{SetWeight: pointer.Int32Ptr(10)},
+		{SetWeight: pointer.Int32Ptr(20)},
+	}
+	startedAt := metav1.NewTime(timeutil.Now().Add(-time.Minute))
+	now := timeutil.MetaNow()
+	running := v1alpha1.CanaryStepRecord{Index: 0, StartedAt: startedAt, Outcome: v1alpha1.CanaryStepOutcomeRunning}
+
+	t.Run("StartFirstStep", func(t *testing.T) {
+		roCtx := newStepHistoryContext(steps, nil, false)
+		newStatus := v1alpha1.RolloutStatus{CurrentStepIndex: pointer.Int32Ptr(0)}
+		roCtx.calculateStepHistory(&newStatus, v1alpha1.CanaryStepOutcomeCompleted, "")
+		assert.Equal(t, []v1alpha1.CanaryStepRecord{
+			{Index: 0, StartedAt: now, Outcome: v1alpha1.CanaryStepOutcomeRunning},
+		}, newStatus.Canary.StepHistory)
+	})
+
+	t.Run("StepStillRunning", func(t *testing.T) {
+		roCtx := newStepHistoryContext(steps, []v1alpha1.CanaryStepRecord{running}, false)
+		newStatus := v1alpha1.RolloutStatus{CurrentStepIndex: pointer.Int32Ptr(0)}
+		roCtx.calculateStepHistory(&newStatus, v1alpha1.CanaryStepOutcomeCompleted, "")
+		assert.Equal(t, []v1alpha1.CanaryStepRecord{running}, newStatus.Canary.StepHistory)
+	})
+
+	t.Run("SkipStep", func(t *testing.T) {
+		roCtx := newStepHistoryContext(steps, []v1alpha1.CanaryStepRecord{running}, false)
+		newStatus := v1alpha1.RolloutStatus{CurrentStepIndex: pointer.Int32Ptr(1)}
+		roCtx.calculateStepHistory(&newStatus, v1alpha1.CanaryStepOutcomeSkipped, "")
+		assert.Equal(t, []v1alpha1.CanaryStepRecord{
+			{Index: 0, StartedAt: startedAt, FinishedAt: &now, Outcome: v1alpha1.CanaryStepOutcomeSkipped},
+			{Index: 1, StartedAt: now, Outcome: v1alpha1.CanaryStepOutcomeRunning},
+		}, newStatus.Canary.StepHistory)
+		// the history of the rollout is left untouched
+		assert.Equal(t, []v1alpha1.CanaryStepRecord{running}, roCtx.rollout.Status.Canary.StepHistory)
+	})
+
+	t.Run("CompleteLastStep", func(t *testing.T) {
+		last := v1alpha1.CanaryStepRecord{Index: 1, StartedAt: startedAt, Outcome: v1alpha1.CanaryStepOutcomeRunning}
+		roCtx := newStepHistoryContext(steps, []v1alpha1.CanaryStepRecord{last}, false)
+		newStatus := v1alpha1.RolloutStatus{CurrentStepIndex: pointer.Int32Ptr(2)}
+		roCtx.calculateStepHistory(&newStatus, v1alpha1.CanaryStepOutcomeCompleted, "")
+		assert.Equal(t, []v1alpha1.CanaryStepRecord{
+			{Index: 1, StartedAt: startedAt, FinishedAt: &now, Outcome: v1alpha1.CanaryStepOutcomeCompleted},
+		}, newStatus.Canary.StepHistory)
+	})
+
+	t.Run("AbortStep", func(t *testing.T) {
+		roCtx := newStepHistoryContext(steps, []v1alpha1.CanaryStepRecord{running}, true)
+		newStatus := v1alpha1.RolloutStatus{CurrentStepIndex: pointer.Int32Ptr(0)}
+		roCtx.calculateStepHistory(&newStatus, v1alpha1.CanaryStepOutcomeAborted, "aborted by user")
+		assert.Equal(t, []v1alpha1.CanaryStepRecord{
+			{Index: 0, StartedAt: startedAt, FinishedAt: &now, Outcome: v1alpha1.CanaryStepOutcomeAborted, Message: "aborted by user"},
+		}, newStatus.Canary.StepHistory)
+	})
+
+	t.Run("TrimHistory", func(t *testing.T) {
+		history := make([]v1alpha1.CanaryStepRecord, 0, maxStepHistory)
+		for i := 0; i < maxStepHistory; i++ {
+			history = append(history, v1alpha1.CanaryStepRecord{Index: 0, StartedAt: startedAt, FinishedAt: &now, Outcome: v1alpha1.CanaryStepOutcomeAborted})
+		}
+		roCtx := newStepHistoryContext(steps, history, false)
+		newStatus := v1alpha1.RolloutStatus{CurrentStepIndex: pointer.Int32Ptr(0)}
+		roCtx.calculateStepHistory(&newStatus, v1alpha1.CanaryStepOutcomeCompleted, "")
+		assert.Len(t, newStatus.Canary.StepHistory, maxStepHistory)
+		assert.Equal(t, v1alpha1.CanaryStepOutcomeRunning, newStatus.Canary.StepHistory[maxStepHistory-1].Outcome)
+	})
+}
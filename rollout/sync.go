@@ -84,7 +84,12 @@ func (c *rolloutContext) syncReplicaSetRevision() (*appsv1.ReplicaSet, error) {
 
 	if annotationsUpdated || minReadySecondsNeedsUpdate || affinityNeedsUpdate {
 		rsCopy.Spec.MinReadySeconds = c.rollout.Spec.MinReadySeconds
-		rsCopy.Spec.Template.Spec.Affinity = replicasetutil.GenerateReplicaSetAffinity(*c.rollout)
+		// the canary/preview pod spec patch applied to the ReplicaSet may patch its affinity as well
+		podSpec, err := replicasetutil.NewReplicaSetPodSpec(c.rollout, []byte(rsCopy.Annotations[replicasetutil.PodSpecPatchAnnotation]))
+		if err != nil {
+			return nil, err
+		}
+		rsCopy.Spec.Template.Spec.Affinity = podSpec.Affinity
 		return c.kubeclientset.AppsV1().ReplicaSets(rsCopy.ObjectMeta.Namespace).Update(ctx, rsCopy, metav1.UpdateOptions{})
 	}
 
@@ -186,6 +191,14 @@ func (c *rolloutContext) createDesiredReplicaSet() (*appsv1.ReplicaSet, error) {
 		}
 		newRS, _ = replicasetutil.SyncReplicaSetEphemeralPodMetadata(newRS, ephemeralMetadata)
 	}
+	if c.stableRS != nil && c.stableRS != c.newRS {
+		// Inject the canary/preview pod spec patch, which is removed once the ReplicaSet becomes stable
+		patchedRS, _, err := replicasetutil.SyncReplicaSetPodSpecPatch(newRS, c.rollout, replicasetutil.GetPodSpecPatch(c.rollout))
+		if err != nil {
+			return nil, err
+		}
+		newRS = patchedRS
+	}
 
 	// Create the new ReplicaSet. If it already exists, then we need to check for possible
 	// hash collisions. If there is any other error, we need to report it in the status of
@@ -208,7 +221,10 @@ func (c *rolloutContext) createDesiredReplicaSet() (*appsv1.ReplicaSet, error) {
 		// Otherwise, this is a hash collision and we need to increment the collisionCount field in
 		// the status of the Rollout and requeue to try the creation in the next sync.
 		controllerRef := metav1.GetControllerOf(rs)
-		if controllerRef != nil && controllerRef.UID == c.rollout.UID && replicasetutil.PodTemplateEqualIgnoreHash(&rs.Spec.Template, &c.rollout.Spec.Template) {
+		// The pod template of a canary/preview ReplicaSet differs from the one of the Rollout by its
+		// pod spec patch.
+		if controllerRef != nil && controllerRef.UID == c.rollout.UID &&
+			(replicasetutil.PodTemplateEqualIgnoreHash(&rs.Spec.Template, &c.rollout.Spec.Template) || replicasetutil.PodTemplateEqualIgnoreHash(&rs.Spec.Template, &newRS.Spec.Template)) {
 			createdRS = rs
 			err = nil
 			break
@@ -394,6 +410,8 @@ func (c *rolloutContext) calculateBaseStatus() v1alpha1.RolloutStatus {
 	newStatus.CollisionCount = c.rollout.Status.CollisionCount
 	newStatus.Conditions = prevStatus.Conditions
 	newStatus.RestartedAt = c.newStatus.RestartedAt
+	newStatus.NextScheduledRestartAt = c.newStatus.NextScheduledRestartAt
+	newStatus.PodSpecPatchHash = c.newStatus.PodSpecPatchHash
 	newStatus.PromoteFull = (newStatus.CurrentPodHash != newStatus.StableRS) && prevStatus.PromoteFull
 	return newStatus
 }
@@ -462,7 +480,7 @@ func (c *rolloutContext) checkPausedConditions() error {
 	progCond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutProgressing)
 	progCondPaused := progCond != nil && progCond.Reason == conditions.RolloutPausedReason
 
-	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused
+	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused || c.frozenBy != nil
 	abortCondExists := progCond != nil && progCond.Reason == conditions.RolloutAbortedReason
 
 	var updatedConditions []*v1alpha1.RolloutCondition
@@ -490,12 +508,20 @@ func (c *rolloutContext) checkPausedConditions() error {
 		updatedConditions = append(updatedConditions, conditions.NewRolloutCondition(v1alpha1.RolloutPaused, condStatus, conditions.RolloutPausedReason, conditions.RolloutPausedMessage))
 	}
 
+	frozenCond := c.calculateFrozenCondition()
+	if frozenCond != nil {
+		updatedConditions = append(updatedConditions, frozenCond)
+	}
+
 	if len(updatedConditions) == 0 {
 		return nil
 	}
 
 	newStatus := c.rollout.Status.DeepCopy()
 	err := c.patchCondition(c.rollout, newStatus, updatedConditions...)
+	if err == nil && frozenCond != nil {
+		c.recordFrozenCondition(frozenCond)
+	}
 	return err
 }
 
@@ -544,9 +570,12 @@ func isIndefiniteStep(r *v1alpha1.Rollout) bool {
 }
 
 func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutStatus) v1alpha1.RolloutStatus {
-	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused
+	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused || c.frozenBy != nil
 	isAborted := c.pauseContext.IsAborted()
 
+	// the changes to the traffic routing objects and services were permitted if we got here
+	conditions.RemoveRolloutCondition(&newStatus, v1alpha1.RolloutPermissionDenied)
+
 	var becameIncomplete bool // remember if we transitioned from completed
 	completeCond := conditions.GetRolloutCondition(c.rollout.Status, v1alpha1.RolloutCompleted)
 	if !isPaused && conditions.RolloutComplete(c.rollout, &newStatus) {
@@ -624,9 +653,10 @@ func (c *rolloutContext) calculateRolloutConditions(newStatus v1alpha1.RolloutSt
 				conditions.RemoveRolloutCondition(&newStatus, v1alpha1.RolloutProgressing)
 			}
 			conditions.SetRolloutCondition(&newStatus, *condition)
-		case !isIndefiniteStep(c.rollout) && conditions.RolloutTimedOut(c.rollout, &newStatus):
+		case !isIndefiniteStep(c.rollout) && newStatus.Queue == nil && c.frozenBy == nil && conditions.RolloutTimedOut(c.rollout, &newStatus):
 			// Update the rollout with a timeout condition. If the condition already exists,
-			// we ignore this update.
+			// we ignore this update. A rollout waiting in the queue of its concurrency budget,
+			// or held by an emergency stop, does not time out.
 			msg := fmt.Sprintf(conditions.RolloutTimeOutMessage, c.rollout.Name)
 			if c.newRS != nil {
 				msg = fmt.Sprintf(conditions.ReplicaSetTimeOutMessage, c.newRS.Name)
@@ -694,6 +724,11 @@ func (c *rolloutContext) persistRolloutStatus(newStatus *v1alpha1.RolloutStatus)
 
 	newStatus.ObservedGeneration = strconv.Itoa(int(c.rollout.Generation))
 	newStatus.Phase, newStatus.Message = rolloututil.CalculateRolloutPhase(c.rollout.Spec, *newStatus)
+	if progressChanged(&prevStatus, newStatus) {
+		newStatus.Progress = c.calculateProgress(newStatus)
+	} else {
+		newStatus.Progress = prevStatus.Progress
+	}
 
 	patch, modified, err := diff.CreateTwoWayMergePatch(
 		&v1alpha1.Rollout{
@@ -754,7 +789,7 @@ func (c *rolloutContext) requeueStuckRollout(newStatus v1alpha1.RolloutStatus) t
 	}
 	// No need to estimate progress if the rollout is complete or already timed out.
 	isPaused := len(c.rollout.Status.PauseConditions) > 0 || c.rollout.Spec.Paused
-	if conditions.RolloutComplete(c.rollout, &newStatus) || currentCond.Reason == conditions.TimedOutReason || isPaused || c.rollout.Status.Abort || isIndefiniteStep(c.rollout) {
+	if conditions.RolloutComplete(c.rollout, &newStatus) || currentCond.Reason == conditions.TimedOutReason || isPaused || c.rollout.Status.Abort || isIndefiniteStep(c.rollout) || newStatus.Queue != nil {
 		return time.Duration(-1)
 	}
 	// If there is no sign of progress at this point then there is a high chance that the
@@ -834,6 +869,7 @@ func (c *rolloutContext) resetRolloutStatus(newStatus *v1alpha1.RolloutStatus) {
 	newStatus.BlueGreen.ScaleUpPreviewCheckPoint = false
 	newStatus.Canary.CurrentStepAnalysisRunStatus = nil
 	newStatus.Canary.CurrentBackgroundAnalysisRunStatus = nil
+	newStatus.InconclusiveResolution = nil
 	newStatus.CurrentStepIndex = replicasetutil.ResetCurrentStepIndex(c.rollout)
 }
 
@@ -887,7 +923,7 @@ func (c *rolloutContext) shouldFullPromote(newStatus v1alpha1.RolloutStatus) str
 				return fmt.Sprintf("Rollback to '%s' within scaleDownDelay", c.newRS.Name)
 			}
 			currentPostPromotionAnalysisRun := c.currentArs.BlueGreenPostPromotion
-			if currentPostPromotionAnalysisRun == nil || currentPostPromotionAnalysisRun.Status.Phase != v1alpha1.AnalysisPhaseSuccessful {
+			if !c.analysisRunSucceeded(currentPostPromotionAnalysisRun) {
 				// we have yet to start post-promotion analysis or post-promotion was not successful
 				return ""
 			}
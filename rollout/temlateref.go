@@ -11,6 +11,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
+	informerutil "github.com/argoproj/argo-rollouts/utils/informer"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
 
 	log "github.com/sirupsen/logrus"
@@ -23,7 +24,6 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/tools/cache"
 )
@@ -202,13 +202,15 @@ func (r *informerBasedTemplateResolver) newInformerForGVK(gvk schema.GroupVersio
 	if apiResource == nil {
 		return nil, errors.NewNotFound(schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}, "")
 	}
-	informer := dynamicinformer.NewFilteredDynamicInformer(
+	// only the pod template and selector of workloads are resolved, so the rest of their spec and
+	// their status are not cached
+	info := infoByGroupKind[gvk.GroupKind()]
+	informer := informerutil.NewTransformingDynamicInformer(
 		r.dynamicClient,
 		schema.GroupVersionResource{Group: gvk.Group, Version: gvk.Version, Resource: apiResource.Name},
 		r.namespace,
 		r.informerResyncDuration,
-		cache.Indexers{},
-		nil)
+		informerutil.NewWorkloadTransform(info.TemplatePath, info.SelectorPath))
 	informer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			r.updateRolloutsReferenceAnnotation(obj, gvk)
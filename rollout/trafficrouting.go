@@ -16,6 +16,7 @@ import (
 	"github.com/argoproj/argo-rollouts/rollout/trafficrouting/traefik"
 	"github.com/argoproj/argo-rollouts/utils/conditions"
 	"github.com/argoproj/argo-rollouts/utils/defaults"
+	ingressutil "github.com/argoproj/argo-rollouts/utils/ingress"
 	"github.com/argoproj/argo-rollouts/utils/record"
 	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
 	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
@@ -31,29 +32,46 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 	if rollout.Spec.Strategy.Canary.TrafficRouting == nil {
 		return nil, nil
 	}
+	// the traffic routing objects are mutated with the clients impersonating the ServiceAccount of
+	// the rollout, if impersonation is enabled
+	clients, err := c.writeClients(rollout)
+	if err != nil {
+		return nil, err
+	}
+	ingressWrapper := c.ingressWrapper
+	if wrap, ok := c.ingressWrapper.(*ingressutil.IngressWrap); ok && clients.ServiceAccount != "" {
+		ingressWrapper = wrap.WithClient(clients.KubeClientSet)
+	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Istio != nil {
+		istioClient := c.IstioController.DynamicClientSet
+		if clients.ServiceAccount != "" {
+			if istioClient != c.dynamicclientset {
+				return nil, fmt.Errorf("impersonation is not supported with an Istio primary cluster")
+			}
+			istioClient = clients.DynamicClientSet
+		}
 		if c.IstioController.VirtualServiceInformer.HasSynced() {
-			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, c.IstioController.DynamicClientSet, c.recorder, c.IstioController.VirtualServiceLister, c.IstioController.DestinationRuleLister))
+			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, istioClient, c.recorder, c.IstioController.VirtualServiceLister, c.IstioController.DestinationRuleLister))
 		} else {
-			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, c.IstioController.DynamicClientSet, c.recorder, nil, nil))
+			trafficReconcilers = append(trafficReconcilers, istio.NewReconciler(rollout, istioClient, c.recorder, nil, nil))
 		}
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Nginx != nil {
 		trafficReconcilers = append(trafficReconcilers, nginx.NewReconciler(nginx.ReconcilerConfig{
 			Rollout:        rollout,
-			Client:         c.kubeclientset,
+			Client:         clients.KubeClientSet,
 			Recorder:       c.recorder,
 			ControllerKind: controllerKind,
-			IngressWrapper: c.ingressWrapper,
+			IngressWrapper: ingressWrapper,
 		}))
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.ALB != nil {
 		alb_reconcilier, err := alb.NewReconciler(alb.ReconcilerConfig{
 			Rollout:        rollout,
-			Client:         c.kubeclientset,
+			Client:         clients.KubeClientSet,
 			Recorder:       c.recorder,
 			ControllerKind: controllerKind,
-			IngressWrapper: c.ingressWrapper,
+			IngressWrapper: ingressWrapper,
 			Status:         &roCtx.newStatus,
 		})
 		if err != nil {
@@ -64,7 +82,7 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 	if rollout.Spec.Strategy.Canary.TrafficRouting.SMI != nil {
 		smi_reconcilier, err := smi.NewReconciler(smi.ReconcilerConfig{
 			Rollout:        rollout,
-			Client:         c.smiclientset,
+			Client:         clients.SmiClientSet,
 			Recorder:       c.recorder,
 			ControllerKind: controllerKind,
 		})
@@ -74,18 +92,18 @@ func (c *Controller) NewTrafficRoutingReconciler(roCtx *rolloutContext) ([]traff
 		trafficReconcilers = append(trafficReconcilers, smi_reconcilier)
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Ambassador != nil {
-		ac := ambassador.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		ac := ambassador.NewDynamicClient(clients.DynamicClientSet, rollout.GetNamespace())
 		trafficReconcilers = append(trafficReconcilers, ambassador.NewReconciler(rollout, ac, c.recorder))
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.AppMesh != nil {
 		trafficReconcilers = append(trafficReconcilers, appmesh.NewReconciler(appmesh.ReconcilerConfig{
 			Rollout:  rollout,
-			Client:   c.dynamicclientset,
+			Client:   clients.DynamicClientSet,
 			Recorder: c.recorder,
 		}))
 	}
 	if rollout.Spec.Strategy.Canary.TrafficRouting.Traefik != nil {
-		dynamicClient := traefik.NewDynamicClient(c.dynamicclientset, rollout.GetNamespace())
+		dynamicClient := traefik.NewDynamicClient(clients.DynamicClientSet, rollout.GetNamespace())
 		trafficReconcilers = append(trafficReconcilers, traefik.NewReconciler(&traefik.ReconcilerConfig{
 			Rollout:  rollout,
 			Client:   dynamicClient,
@@ -3,6 +3,7 @@ package istio
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	log "github.com/sirupsen/logrus"
@@ -155,6 +156,57 @@ func (c *IstioController) EnqueueDestinationRule(obj interface{}) {
 	controllerutil.EnqueueRateLimited(obj, c.destinationRuleWorkqueue)
 }
 
+// CacheState describes the VirtualServices and DestinationRules cached by the Istio controller
+type CacheState struct {
+	Synced                   bool           `json:"synced"`
+	VirtualServices          []CachedObject `json:"virtualServices"`
+	DestinationRules         []CachedObject `json:"destinationRules"`
+	DestinationRuleWorkqueue int            `json:"destinationRuleWorkqueue"`
+}
+
+// CachedObject describes a cached Istio object, and the rollouts referencing it
+type CachedObject struct {
+	Key             string   `json:"key"`
+	ResourceVersion string   `json:"resourceVersion"`
+	Rollouts        []string `json:"rollouts,omitempty"`
+}
+
+// CacheState returns the state of the caches of the Istio controller
+func (c *IstioController) CacheState() CacheState {
+	return CacheState{
+		Synced:                   c.VirtualServiceInformer.HasSynced() && c.DestinationRuleInformer.HasSynced(),
+		VirtualServices:          c.cachedObjects(c.VirtualServiceInformer, virtualServiceIndexName),
+		DestinationRules:         c.cachedObjects(c.DestinationRuleInformer, destinationRuleIndexName),
+		DestinationRuleWorkqueue: c.destinationRuleWorkqueue.Len(),
+	}
+}
+
+func (c *IstioController) cachedObjects(informer cache.SharedIndexInformer, indexName string) []CachedObject {
+	objects := []CachedObject{}
+	for _, obj := range informer.GetStore().List() {
+		acc, err := meta.Accessor(obj)
+		if err != nil {
+			continue
+		}
+		key := fmt.Sprintf("%s/%s", acc.GetNamespace(), acc.GetName())
+		cached := CachedObject{Key: key, ResourceVersion: acc.GetResourceVersion()}
+		rollouts, err := c.RolloutsInformer.Informer().GetIndexer().ByIndex(indexName, key)
+		if err == nil {
+			for _, ro := range rollouts {
+				if roKey, err := cache.MetaNamespaceKeyFunc(ro); err == nil {
+					cached.Rollouts = append(cached.Rollouts, roKey)
+				}
+			}
+			sort.Strings(cached.Rollouts)
+		}
+		objects = append(objects, cached)
+	}
+	sort.Slice(objects, func(i, j int) bool {
+		return objects[i].Key < objects[j].Key
+	})
+	return objects
+}
+
 // EnqueueRolloutFromIstioVirtualService examines a VirtualService, finds the Rollout referencing
 // that VirtualService, and enqueues the corresponding Rollout for reconciliation
 func (c *IstioController) EnqueueRolloutFromIstioVirtualService(vsvc interface{}) {
@@ -252,6 +304,7 @@ func (c *IstioController) syncDestinationRule(key string) error {
 		logCtx.Infof("cleaning destinationrule: rollout does not exist")
 		cleanDestRule = true
 	} else {
+		logCtx = logutil.WithRollout(ro).WithField("destinationrule", name)
 		if !slice.ContainsString(istioutil.GetRolloutDesinationRuleKeys(ro), key, nil) {
 			logCtx.Infof("cleaning destinationrule: rollout no longer references rule")
 			cleanDestRule = true
@@ -274,7 +327,7 @@ func (c *IstioController) syncDestinationRule(key string) error {
 			}
 		}
 		dRuleClient := c.DynamicClientSet.Resource(istioutil.GetIstioDestinationRuleGVR()).Namespace(dRule.Namespace)
-		modified, err := updateDestinationRule(context.TODO(), dRuleClient, origBytes, dRule, dRuleNew)
+		modified, err := updateDestinationRule(context.TODO(), logCtx, dRuleClient, origBytes, dRule, dRuleNew)
 		if err != nil {
 			return err
 		}
@@ -232,6 +232,44 @@ spec:
 	}
 }
 
+func TestCacheState(t *testing.T) {
+	ro := &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "istio-rollout",
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{
+					TrafficRouting: &v1alpha1.RolloutTrafficRouting{
+						Istio: &v1alpha1.IstioTrafficRouting{
+							VirtualService: &v1alpha1.IstioVirtualService{
+								Name: "istio-vsvc",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	vsvc := unstructuredutil.StrToUnstructuredUnsafe(`
+apiVersion: networking.istio.io/v1alpha3
+kind: VirtualService
+metadata:
+  name: istio-vsvc
+  namespace: default
+  resourceVersion: "12"
+`)
+	c := NewFakeIstioController(ro, vsvc)
+	assert.NoError(t, c.RolloutsInformer.Informer().GetIndexer().Add(ro))
+	assert.NoError(t, c.VirtualServiceInformer.GetIndexer().Add(vsvc))
+
+	state := c.CacheState()
+	assert.False(t, state.Synced)
+	assert.Equal(t, []CachedObject{{Key: "default/istio-vsvc", ResourceVersion: "12", Rollouts: []string{"default/istio-rollout"}}}, state.VirtualServices)
+	assert.Empty(t, state.DestinationRules)
+}
+
 func TestRun(t *testing.T) {
 	// make sure we can start and top the controller
 	c := NewFakeIstioController()
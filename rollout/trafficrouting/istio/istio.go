@@ -338,7 +338,7 @@ func (r *Reconciler) UpdateHash(canaryHash, stableHash string, additionalDestina
 			Labels: map[string]string{v1alpha1.DefaultRolloutUniqueLabelKey: dest.PodTemplateHash},
 		})
 	}
-	modified, err := updateDestinationRule(ctx, client, origBytes, dRule, dRuleNew)
+	modified, err := updateDestinationRule(ctx, r.log, client, origBytes, dRule, dRuleNew)
 	if err != nil {
 		return err
 	}
@@ -384,13 +384,13 @@ func destinationRuleReplaceExtraMarshal(dRule *DestinationRule) []byte {
 	return dRuleNewBytes
 }
 
-func updateDestinationRule(ctx context.Context, client dynamic.ResourceInterface, orig []byte, dRule, dRuleNew *DestinationRule) (bool, error) {
+func updateDestinationRule(ctx context.Context, logCtx *log.Entry, client dynamic.ResourceInterface, orig []byte, dRule, dRuleNew *DestinationRule) (bool, error) {
 	dRuleBytes, err := json.Marshal(dRule)
 	if err != nil {
 		return false, err
 	}
 	dRuleNewBytes := destinationRuleReplaceExtraMarshal(dRuleNew)
-	log.Debugf("dRuleNewBytes: %s", string(dRuleNewBytes))
+	logCtx.Debugf("dRuleNewBytes: %s", string(dRuleNewBytes))
 
 	patch, err := jsonpatch.CreateMergePatch(dRuleBytes, dRuleNewBytes)
 	if err != nil {
@@ -412,7 +412,7 @@ func updateDestinationRule(ctx context.Context, client dynamic.ResourceInterface
 	if err != nil {
 		return false, err
 	}
-	log.Infof("updating destinationrule: %s", string(patch))
+	logCtx.Infof("updating destinationrule: %s", string(patch))
 	return true, nil
 }
 
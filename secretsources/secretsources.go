@@ -0,0 +1,92 @@
+package secretsources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/secretsources/vault"
+)
+
+const (
+	// CacheTTL is how long the value of a secret is reused before it is read again from the secret source
+	CacheTTL = time.Minute
+	// cacheSize is the maximum number of secret values which are cached
+	cacheSize = 1024
+)
+
+// SecretSource reads secrets from an external secret store
+type SecretSource interface {
+	// GetSecret returns the value of the secret referenced by ref
+	GetSecret(ctx context.Context, ref v1alpha1.SecretSourceRef) (string, error)
+	// Type gets the secret source type
+	Type() string
+}
+
+// SecretSourceFactory creates the secret sources referenced by analysis arguments, and caches the
+// secret values read from them
+type SecretSourceFactory struct {
+	KubeClient kubernetes.Interface
+	// NewSecretSourceFunc creates the secret source of a reference. Defaults to NewSecretSource.
+	NewSecretSourceFunc func(ref v1alpha1.SecretSourceRef, namespace string) (SecretSource, error)
+	cache               *cache.LRUExpireCache
+}
+
+// NewSecretSourceFactory returns a new SecretSourceFactory
+func NewSecretSourceFactory(kubeClient kubernetes.Interface) *SecretSourceFactory {
+	f := &SecretSourceFactory{
+		KubeClient: kubeClient,
+		cache:      cache.NewLRUExpireCache(cacheSize),
+	}
+	f.NewSecretSourceFunc = f.NewSecretSource
+	return f
+}
+
+// NewSecretSource creates the correct secret source based on the store of the reference, for an
+// AnalysisRun of the given namespace
+func (f *SecretSourceFactory) NewSecretSource(ref v1alpha1.SecretSourceRef, namespace string) (SecretSource, error) {
+	switch source := Type(ref); source {
+	case vault.SourceType:
+		return vault.NewVaultSecretSource(*ref.Vault, f.KubeClient, namespace)
+	default:
+		return nil, fmt.Errorf("no valid secret source in secretSourceRef")
+	}
+}
+
+// GetSecret returns the value of the secret referenced by ref for an AnalysisRun of the given
+// namespace. Values are cached for CacheTTL so that frequent measurements do not read the secret
+// source every time.
+func (f *SecretSourceFactory) GetSecret(ctx context.Context, ref v1alpha1.SecretSourceRef, namespace string) (string, error) {
+	refBytes, err := json.Marshal(ref)
+	if err != nil {
+		return "", err
+	}
+	// the namespace is part of the key since secret sources may restrict the namespaces they serve
+	key := namespace + "/" + string(refBytes)
+	if value, ok := f.cache.Get(key); ok {
+		return value.(string), nil
+	}
+	source, err := f.NewSecretSourceFunc(ref, namespace)
+	if err != nil {
+		return "", err
+	}
+	value, err := source.GetSecret(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	f.cache.Add(key, value, CacheTTL)
+	return value, nil
+}
+
+// Type returns the type of the secret source referenced by ref
+func Type(ref v1alpha1.SecretSourceRef) string {
+	if ref.Vault != nil {
+		return vault.SourceType
+	}
+	return "Unknown Secret Source"
+}
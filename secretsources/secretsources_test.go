@@ -0,0 +1,87 @@
+package secretsources
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/secretsources/vault"
+)
+
+type fakeSecretSource struct {
+	reads  int
+	values map[string]string
+}
+
+func (s *fakeSecretSource) Type() string {
+	return "Fake"
+}
+
+func (s *fakeSecretSource) GetSecret(ctx context.Context, ref v1alpha1.SecretSourceRef) (string, error) {
+	s.reads++
+	value, ok := s.values[ref.Vault.Path]
+	if !ok {
+		return "", fmt.Errorf("secret '%s' does not exist", ref.Vault.Path)
+	}
+	return value, nil
+}
+
+func vaultRef(path string) v1alpha1.SecretSourceRef {
+	return v1alpha1.SecretSourceRef{Vault: &v1alpha1.VaultSecretRef{Path: path, Key: "token"}}
+}
+
+func TestGetSecretCaches(t *testing.T) {
+	source := &fakeSecretSource{values: map[string]string{"secret/data/prometheus": "my-token"}}
+	f := NewSecretSourceFactory(k8sfake.NewSimpleClientset())
+	var namespaces []string
+	f.NewSecretSourceFunc = func(ref v1alpha1.SecretSourceRef, namespace string) (SecretSource, error) {
+		namespaces = append(namespaces, namespace)
+		return source, nil
+	}
+
+	for i := 0; i < 3; i++ {
+		value, err := f.GetSecret(context.TODO(), vaultRef("secret/data/prometheus"), "default")
+		assert.NoError(t, err)
+		assert.Equal(t, "my-token", value)
+	}
+	assert.Equal(t, 1, source.reads)
+
+	// every namespace gets its own secret source, which may deny access to the secret
+	_, err := f.GetSecret(context.TODO(), vaultRef("secret/data/prometheus"), "other")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, source.reads)
+	assert.Equal(t, []string{"default", "other"}, namespaces)
+}
+
+func TestGetSecretDoesNotCacheErrors(t *testing.T) {
+	source := &fakeSecretSource{values: map[string]string{}}
+	f := NewSecretSourceFactory(k8sfake.NewSimpleClientset())
+	f.NewSecretSourceFunc = func(ref v1alpha1.SecretSourceRef, namespace string) (SecretSource, error) {
+		return source, nil
+	}
+
+	_, err := f.GetSecret(context.TODO(), vaultRef("secret/data/prometheus"), "default")
+	assert.EqualError(t, err, "secret 'secret/data/prometheus' does not exist")
+
+	source.values["secret/data/prometheus"] = "my-token"
+	value, err := f.GetSecret(context.TODO(), vaultRef("secret/data/prometheus"), "default")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-token", value)
+	assert.Equal(t, 2, source.reads)
+}
+
+func TestNewSecretSource(t *testing.T) {
+	f := NewSecretSourceFactory(k8sfake.NewSimpleClientset())
+	_, err := f.NewSecretSource(v1alpha1.SecretSourceRef{}, "default")
+	assert.EqualError(t, err, "no valid secret source in secretSourceRef")
+
+	// the Vault profile does not exist
+	_, err = f.NewSecretSource(vaultRef("secret/data/prometheus"), "default")
+	assert.Error(t, err)
+
+	assert.Equal(t, vault.SourceType, Type(vaultRef("secret/data/prometheus")))
+}
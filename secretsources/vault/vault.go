@@ -0,0 +1,159 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+const (
+	// SourceType indicates the secret source is Vault
+	SourceType = "Vault"
+	// DefaultVaultProfileSecretName is the name of the secret holding the Vault address and token
+	DefaultVaultProfileSecretName = "vault"
+	// VaultAddress is the key of the profile secret holding the address of Vault
+	VaultAddress = "address"
+	// VaultToken is the key of the profile secret holding the token used to read secrets
+	VaultToken = "token"
+	// VaultNamespace is the key of the profile secret holding the Vault Enterprise namespace, if any
+	VaultNamespace = "namespace"
+	// VaultAllowedNamespaces is the key of the profile secret holding the comma separated list of
+	// the namespaces whose AnalysisRuns may use the profile, or the wildcard to allow all of them. No
+	// namespace may use the profile when it is not set.
+	VaultAllowedNamespaces = "allowed-namespaces"
+	// allNamespaces is the wildcard of the allowed namespaces of a profile allowing all namespaces
+	allNamespaces = "*"
+
+	requestTimeout = 10 * time.Second
+)
+
+// SecretSource reads secrets through the Vault HTTP API
+// Implements the SecretSource interface
+type SecretSource struct {
+	client    *http.Client
+	address   string
+	token     string
+	namespace string
+}
+
+// vaultResponse is the response to a read of a secret. The data of a KV version 2 secret is
+// nested in data.data, next to data.metadata.
+type vaultResponse struct {
+	Data   map[string]interface{} `json:"data"`
+	Errors []string               `json:"errors"`
+}
+
+// Type indicates the secret source is Vault
+func (s *SecretSource) Type() string {
+	return SourceType
+}
+
+// GetSecret reads the secret at the path of the reference and returns the value of its key
+func (s *SecretSource) GetSecret(ctx context.Context, ref v1alpha1.SecretSourceRef) (string, error) {
+	if ref.Vault == nil {
+		return "", fmt.Errorf("secretSourceRef is not a Vault secret")
+	}
+	path, key := strings.Trim(ref.Vault.Path, "/"), ref.Vault.Key
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, s.address+"/v1/"+path, nil)
+	if err != nil {
+		return "", err
+	}
+	request.Header.Set("X-Vault-Token", s.token)
+	if s.namespace != "" {
+		request.Header.Set("X-Vault-Namespace", s.namespace)
+	}
+	response, err := s.client.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	bodyBytes, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if response.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("secret '%s' does not exist in Vault", path)
+	}
+	var body vaultResponse
+	// the body of an error is not necessarily JSON, e.g. when returned by a proxy
+	_ = json.Unmarshal(bodyBytes, &body)
+	if response.StatusCode != http.StatusOK {
+		err := fmt.Errorf("failed to read secret '%s' from Vault (status %d)", path, response.StatusCode)
+		if len(body.Errors) > 0 {
+			err = fmt.Errorf("%v: %s", err, strings.Join(body.Errors, ", "))
+		}
+		return "", err
+	}
+	if body.Data == nil {
+		return "", fmt.Errorf("could not parse response of Vault for secret '%s'", path)
+	}
+
+	data := body.Data
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, ok := data["metadata"]; ok {
+			data = nested
+		}
+	}
+	value, ok := data[key]
+	if !ok || value == nil {
+		return "", fmt.Errorf("key '%s' does not exist in secret '%s'", key, path)
+	}
+	if stringValue, ok := value.(string); ok {
+		return stringValue, nil
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(valueBytes), nil
+}
+
+// NewVaultSecretSource creates a Vault secret source from the profile of the reference, for an
+// AnalysisRun of the given namespace
+func NewVaultSecretSource(ref v1alpha1.VaultSecretRef, kubeclientset kubernetes.Interface, namespace string) (*SecretSource, error) {
+	profileSecret := DefaultVaultProfileSecretName
+	if ref.Profile != "" {
+		profileSecret = ref.Profile
+	}
+	secret, err := kubeclientset.CoreV1().Secrets(defaults.Namespace()).Get(context.TODO(), profileSecret, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if !containsNamespace(string(secret.Data[VaultAllowedNamespaces]), namespace) {
+		return nil, fmt.Errorf("Vault profile '%s' may not be used by namespace '%s'", profileSecret, namespace)
+	}
+	address := strings.TrimSuffix(string(secret.Data[VaultAddress]), "/")
+	token := string(secret.Data[VaultToken])
+	if address == "" || token == "" {
+		return nil, fmt.Errorf("Vault profile '%s' requires both '%s' and '%s'", profileSecret, VaultAddress, VaultToken)
+	}
+	return &SecretSource{
+		client:    &http.Client{Timeout: requestTimeout},
+		address:   address,
+		token:     token,
+		namespace: string(secret.Data[VaultNamespace]),
+	}, nil
+}
+
+func containsNamespace(namespaces string, namespace string) bool {
+	for _, ns := range strings.Split(namespaces, ",") {
+		ns = strings.TrimSpace(ns)
+		if ns == allNamespaces || ns == namespace {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,196 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+)
+
+const testToken = "s.test-token"
+
+// newVaultStandIn returns a server implementing the read of secrets of the Vault HTTP API, with a
+// KV version 2 secrets engine mounted at secret and a KV version 1 secrets engine mounted at kv
+func newVaultStandIn(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Header.Get("X-Vault-Token") != testToken {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"errors":["permission denied"]}`))
+			return
+		}
+		var data map[string]interface{}
+		switch r.URL.Path {
+		case "/v1/secret/data/prometheus":
+			data = map[string]interface{}{
+				"data":     map[string]interface{}{"token": "kv2-secret", "port": 9090},
+				"metadata": map[string]interface{}{"version": 3},
+			}
+		case "/v1/kv/prometheus":
+			data = map[string]interface{}{"token": "kv1-secret"}
+		case "/v1/secret/data/enterprise":
+			if r.Header.Get("X-Vault-Namespace") != "team-a" {
+				w.WriteHeader(http.StatusNotFound)
+				w.Write([]byte(`{"errors":[]}`))
+				return
+			}
+			data = map[string]interface{}{
+				"data":     map[string]interface{}{"token": "enterprise-secret"},
+				"metadata": map[string]interface{}{"version": 1},
+			}
+		case "/v1/sys/unavailable":
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`<html>Bad Gateway</html>`))
+			return
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"errors":[]}`))
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"data": data}))
+	}))
+}
+
+func newProfile(name string, data map[string]string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaults.Namespace()},
+		Data:       map[string][]byte{},
+	}
+	for k, v := range data {
+		secret.Data[k] = []byte(v)
+	}
+	return secret
+}
+
+func vaultRef(path, key string) v1alpha1.SecretSourceRef {
+	return v1alpha1.SecretSourceRef{Vault: &v1alpha1.VaultSecretRef{Path: path, Key: key}}
+}
+
+func TestGetSecret(t *testing.T) {
+	server := newVaultStandIn(t)
+	defer server.Close()
+	client := k8sfake.NewSimpleClientset(newProfile(DefaultVaultProfileSecretName, map[string]string{
+		VaultAddress:           server.URL + "/",
+		VaultToken:             testToken,
+		VaultAllowedNamespaces: "default",
+	}))
+	source, err := NewVaultSecretSource(v1alpha1.VaultSecretRef{}, client, "default")
+	assert.NoError(t, err)
+	assert.Equal(t, SourceType, source.Type())
+
+	tests := []struct {
+		name          string
+		ref           v1alpha1.SecretSourceRef
+		expectedValue string
+		expectedError string
+	}{
+		{name: "KV version 2", ref: vaultRef("secret/data/prometheus", "token"), expectedValue: "kv2-secret"},
+		{name: "KV version 2 non string value", ref: vaultRef("/secret/data/prometheus/", "port"), expectedValue: "9090"},
+		{name: "KV version 1", ref: vaultRef("kv/prometheus", "token"), expectedValue: "kv1-secret"},
+		{name: "missing key", ref: vaultRef("secret/data/prometheus", "password"), expectedError: "key 'password' does not exist in secret 'secret/data/prometheus'"},
+		{name: "missing secret", ref: vaultRef("secret/data/grafana", "token"), expectedError: "secret 'secret/data/grafana' does not exist in Vault"},
+		{name: "non JSON error", ref: vaultRef("sys/unavailable", "token"), expectedError: "failed to read secret 'sys/unavailable' from Vault (status 502)"},
+		{name: "not a Vault reference", ref: v1alpha1.SecretSourceRef{}, expectedError: "secretSourceRef is not a Vault secret"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			value, err := source.GetSecret(context.TODO(), test.ref)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expectedValue, value)
+		})
+	}
+}
+
+func TestGetSecretPermissionDenied(t *testing.T) {
+	server := newVaultStandIn(t)
+	defer server.Close()
+	client := k8sfake.NewSimpleClientset(newProfile(DefaultVaultProfileSecretName, map[string]string{
+		VaultAddress:           server.URL,
+		VaultToken:             "s.wrong-token",
+		VaultAllowedNamespaces: "default",
+	}))
+	source, err := NewVaultSecretSource(v1alpha1.VaultSecretRef{}, client, "default")
+	assert.NoError(t, err)
+
+	_, err = source.GetSecret(context.TODO(), vaultRef("secret/data/prometheus", "token"))
+	assert.EqualError(t, err, "failed to read secret 'secret/data/prometheus' from Vault (status 403): permission denied")
+	assert.NotContains(t, err.Error(), "s.wrong-token")
+}
+
+func TestGetSecretVaultNamespace(t *testing.T) {
+	server := newVaultStandIn(t)
+	defer server.Close()
+	client := k8sfake.NewSimpleClientset(newProfile("vault-enterprise", map[string]string{
+		VaultAddress:           server.URL,
+		VaultToken:             testToken,
+		VaultNamespace:         "team-a",
+		VaultAllowedNamespaces: "*",
+	}))
+	ref := vaultRef("secret/data/enterprise", "token")
+	ref.Vault.Profile = "vault-enterprise"
+	source, err := NewVaultSecretSource(*ref.Vault, client, "default")
+	assert.NoError(t, err)
+
+	value, err := source.GetSecret(context.TODO(), ref)
+	assert.NoError(t, err)
+	assert.Equal(t, "enterprise-secret", value)
+}
+
+func TestNewVaultSecretSourceAllowedNamespaces(t *testing.T) {
+	client := k8sfake.NewSimpleClientset(newProfile(DefaultVaultProfileSecretName, map[string]string{
+		VaultAddress:           "http://vault:8200",
+		VaultToken:             testToken,
+		VaultAllowedNamespaces: "team-a, team-b",
+	}))
+	_, err := NewVaultSecretSource(v1alpha1.VaultSecretRef{}, client, "team-b")
+	assert.NoError(t, err)
+
+	_, err = NewVaultSecretSource(v1alpha1.VaultSecretRef{}, client, "team-c")
+	assert.EqualError(t, err, "Vault profile 'vault' may not be used by namespace 'team-c'")
+}
+
+func TestNewVaultSecretSourceAllowedNamespacesWildcard(t *testing.T) {
+	client := k8sfake.NewSimpleClientset(newProfile(DefaultVaultProfileSecretName, map[string]string{
+		VaultAddress:           "http://vault:8200",
+		VaultToken:             testToken,
+		VaultAllowedNamespaces: "*",
+	}))
+	_, err := NewVaultSecretSource(v1alpha1.VaultSecretRef{}, client, "team-c")
+	assert.NoError(t, err)
+}
+
+func TestNewVaultSecretSourceAllowedNamespacesUnset(t *testing.T) {
+	client := k8sfake.NewSimpleClientset(newProfile(DefaultVaultProfileSecretName, map[string]string{
+		VaultAddress: "http://vault:8200",
+		VaultToken:   testToken,
+	}))
+	_, err := NewVaultSecretSource(v1alpha1.VaultSecretRef{}, client, "default")
+	assert.EqualError(t, err, "Vault profile 'vault' may not be used by namespace 'default'")
+}
+
+func TestNewVaultSecretSourceInvalidProfile(t *testing.T) {
+	client := k8sfake.NewSimpleClientset(newProfile(DefaultVaultProfileSecretName, map[string]string{
+		VaultAddress:           "http://vault:8200",
+		VaultAllowedNamespaces: "default",
+	}))
+	_, err := NewVaultSecretSource(v1alpha1.VaultSecretRef{}, client, "default")
+	assert.EqualError(t, err, "Vault profile 'vault' requires both 'address' and 'token'")
+
+	_, err = NewVaultSecretSource(v1alpha1.VaultSecretRef{Profile: "missing"}, client, "default")
+	assert.Error(t, err)
+	assert.True(t, strings.Contains(err.Error(), "not found"))
+}
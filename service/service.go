@@ -24,6 +24,7 @@ import (
 	clientset "github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions/rollouts/v1alpha1"
 	controllerutil "github.com/argoproj/argo-rollouts/utils/controller"
+	"github.com/argoproj/argo-rollouts/utils/impersonation"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
 	serviceutil "github.com/argoproj/argo-rollouts/utils/service"
 	unstructuredutil "github.com/argoproj/argo-rollouts/utils/unstructured"
@@ -66,6 +67,15 @@ type ControllerConfig struct {
 	ResyncPeriod time.Duration
 
 	MetricsServer *metrics.MetricsServer
+
+	// Impersonator, when set, provides the clients used to clean up the services on behalf of the
+	// ServiceAccount configured for their namespace
+	Impersonator Impersonator
+}
+
+// Impersonator provides clients which impersonate the ServiceAccount configured for an object
+type Impersonator interface {
+	ClientsFor(namespace string, annotations map[string]string) (*impersonation.Clients, error)
 }
 
 // Controller describes a service controller
@@ -81,6 +91,7 @@ type Controller struct {
 	resyncPeriod      time.Duration
 
 	metricServer   *metrics.MetricsServer
+	impersonator   Impersonator
 	enqueueRollout func(obj interface{})
 }
 
@@ -99,6 +110,7 @@ func NewController(cfg ControllerConfig) *Controller {
 		serviceWorkqueue: cfg.ServiceWorkqueue,
 		resyncPeriod:     cfg.ResyncPeriod,
 		metricServer:     cfg.MetricsServer,
+		impersonator:     cfg.Impersonator,
 	}
 
 	util.CheckErr(cfg.RolloutsInformer.Informer().AddIndexers(cache.Indexers{
@@ -199,12 +211,22 @@ func (c *Controller) syncService(key string) error {
 
 	patch := generateRemovePatch(svc)
 	if patch != "" {
-		_, err = c.kubeclientset.CoreV1().Services(svc.Namespace).Patch(ctx, svc.Name, patchtypes.MergePatchType, []byte(patch), metav1.PatchOptions{})
+		client := c.kubeclientset
+		if c.impersonator != nil {
+			// the rollout of the service is gone, so only the ServiceAccount of the namespace applies
+			clients, err := c.impersonator.ClientsFor(svc.Namespace, nil)
+			if err != nil {
+				// the service is requeued, so that it is cleaned once the ServiceAccount is configured
+				return fmt.Errorf("failed to clean service: %w", err)
+			}
+			client = clients.KubeClientSet
+		}
+		_, err = client.CoreV1().Services(svc.Namespace).Patch(ctx, svc.Name, patchtypes.MergePatchType, []byte(patch), metav1.PatchOptions{})
 		if err != nil {
 			if k8serrors.IsNotFound(err) {
 				return nil
 			}
-			return err
+			return fmt.Errorf("failed to clean service: %w", err)
 		}
 		logCtx.Infof("cleaned service")
 	}
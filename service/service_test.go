@@ -1,13 +1,17 @@
 package service
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/argoproj/argo-rollouts/utils/queue"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	kubeinformers "k8s.io/client-go/informers"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -18,6 +22,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/pkg/client/clientset/versioned/fake"
 	informers "github.com/argoproj/argo-rollouts/pkg/client/informers/externalversions"
+	"github.com/argoproj/argo-rollouts/utils/impersonation"
 	"k8s.io/client-go/tools/cache"
 )
 
@@ -142,6 +147,61 @@ func TestSyncServiceNotReferencedByRollout(t *testing.T) {
 	assert.Equal(t, string(patch.GetPatch()), removeSelectorPatch)
 }
 
+type fakeImpersonator struct {
+	clients *impersonation.Clients
+	err     error
+}
+
+func (i *fakeImpersonator) ClientsFor(namespace string, annotations map[string]string) (*impersonation.Clients, error) {
+	return i.clients, i.err
+}
+
+// TestSyncServiceNotReferencedByRolloutImpersonated ensures the service controller cleans a service
+// while impersonating the ServiceAccount of its namespace, and returns an error to retry when it is
+// not allowed to
+func TestSyncServiceNotReferencedByRolloutImpersonated(t *testing.T) {
+	svc := newService("test-service", 80, map[string]string{
+		v1alpha1.DefaultRolloutUniqueLabelKey: "abc",
+	})
+
+	t.Run("Patched", func(t *testing.T) {
+		ctrl, kubeclient, _, _ := newFakeServiceController(svc, nil)
+		impersonatedClient := k8sfake.NewSimpleClientset(svc)
+		ctrl.impersonator = &fakeImpersonator{clients: &impersonation.Clients{KubeClientSet: impersonatedClient}}
+
+		err := ctrl.syncService("default/test-service")
+		assert.NoError(t, err)
+		assert.Len(t, kubeclient.Actions(), 0)
+		actions := impersonatedClient.Actions()
+		assert.Len(t, actions, 1)
+		patch, ok := actions[0].(k8stesting.PatchAction)
+		assert.True(t, ok)
+		assert.Equal(t, string(patch.GetPatch()), removeSelectorPatch)
+	})
+
+	t.Run("NoServiceAccount", func(t *testing.T) {
+		ctrl, kubeclient, _, _ := newFakeServiceController(svc, nil)
+		ctrl.impersonator = &fakeImpersonator{err: &impersonation.ServiceAccountError{}}
+
+		err := ctrl.syncService("default/test-service")
+		assert.Error(t, err)
+		assert.Len(t, kubeclient.Actions(), 0)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		ctrl, _, _, _ := newFakeServiceController(svc, nil)
+		impersonatedClient := k8sfake.NewSimpleClientset(svc)
+		impersonatedClient.PrependReactor("patch", "services", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, nil, k8serrors.NewForbidden(schema.GroupResource{Resource: "services"}, "test-service", errors.New("not allowed"))
+		})
+		ctrl.impersonator = &fakeImpersonator{clients: &impersonation.Clients{KubeClientSet: impersonatedClient}}
+
+		err := ctrl.syncService("default/test-service")
+		assert.True(t, k8serrors.IsForbidden(err))
+		assert.Len(t, impersonatedClient.Actions(), 1)
+	})
+}
+
 // TestSyncServiceWithNoManagedBy ensures a Rollout without a managed-by but has a Rollout referencing it
 // does not have the controller delete the hash selector
 func TestSyncServiceWithNoManagedBy(t *testing.T) {
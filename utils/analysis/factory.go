@@ -11,6 +11,7 @@ import (
 	templateutil "github.com/argoproj/argo-rollouts/utils/template"
 
 	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/kubernetes/pkg/fieldpath"
 )
 
@@ -194,6 +195,11 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	if metric.ConsecutiveErrorLimit != nil && metric.ConsecutiveErrorLimit.IntValue() < 0 {
 		return fmt.Errorf("consecutiveErrorLimit must be >= 0")
 	}
+	if metric.Provider.Prometheus != nil && metric.Provider.Prometheus.PerSeries != nil {
+		if err := validatePerSeries(metric.Provider.Prometheus.PerSeries); err != nil {
+			return err
+		}
+	}
 	numProviders := 0
 	if metric.Provider.Prometheus != nil {
 		numProviders++
@@ -228,6 +234,23 @@ func ValidateMetric(metric v1alpha1.Metric) error {
 	return nil
 }
 
+func validatePerSeries(perSeries *v1alpha1.PerSeriesEvaluation) error {
+	if perSeries.Quorum == nil {
+		return nil
+	}
+	if perSeries.Policy != v1alpha1.PerSeriesPolicyQuorum {
+		return fmt.Errorf("perSeries.quorum is only valid with the %s policy", v1alpha1.PerSeriesPolicyQuorum)
+	}
+	quorum, err := intstr.GetScaledValueFromIntOrPercent(perSeries.Quorum, 100, true)
+	if err != nil {
+		return fmt.Errorf("invalid perSeries.quorum: %v", err)
+	}
+	if quorum <= 0 {
+		return fmt.Errorf("perSeries.quorum must be > 0")
+	}
+	return nil
+}
+
 func extractValueFromRollout(r *v1alpha1.Rollout, path string) (string, error) {
 	j, _ := json.Marshal(r)
 	m := interface{}(nil)
@@ -405,6 +405,24 @@ func TestValidateMetrics(t *testing.T) {
 		err := ValidateMetrics(spec.Metrics)
 		assert.EqualError(t, err, "metrics[0]: multiple providers specified")
 	})
+	t.Run("Validate per-series quorum", func(t *testing.T) {
+		quorum := intstr.FromString("50%")
+		metric := v1alpha1.Metric{
+			Name: "error-rate",
+			Provider: v1alpha1.MetricProvider{
+				Prometheus: &v1alpha1.PrometheusMetric{
+					PerSeries: &v1alpha1.PerSeriesEvaluation{Policy: v1alpha1.PerSeriesPolicyAny, Quorum: &quorum},
+				},
+			},
+		}
+		assert.EqualError(t, ValidateMetric(metric), "perSeries.quorum is only valid with the Quorum policy")
+		metric.Provider.Prometheus.PerSeries.Policy = v1alpha1.PerSeriesPolicyQuorum
+		assert.NoError(t, ValidateMetric(metric))
+		quorum = intstr.FromInt(0)
+		assert.EqualError(t, ValidateMetric(metric), "perSeries.quorum must be > 0")
+		quorum = intstr.FromString("half")
+		assert.Error(t, ValidateMetric(metric))
+	})
 }
 
 // TestResolveMetricArgs verifies that metric arguments are resolved
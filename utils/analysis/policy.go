@@ -0,0 +1,63 @@
+package analysis
+
+import (
+	"errors"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// ClusterAnalysisTemplateAccessDeniedError is returned when the namespace policy of a
+// ClusterAnalysisTemplate does not allow a namespace to use it
+type ClusterAnalysisTemplateAccessDeniedError struct {
+	TemplateName string
+	Namespace    string
+}
+
+func (e *ClusterAnalysisTemplateAccessDeniedError) Error() string {
+	return fmt.Sprintf("ClusterAnalysisTemplate '%s' is not allowed in namespace '%s' by its namespace policy", e.TemplateName, e.Namespace)
+}
+
+// IsClusterAnalysisTemplateAccessDenied returns whether the error is a ClusterAnalysisTemplateAccessDeniedError
+func IsClusterAnalysisTemplateAccessDenied(err error) bool {
+	var accessDeniedErr *ClusterAnalysisTemplateAccessDeniedError
+	return errors.As(err, &accessDeniedErr)
+}
+
+// VerifyClusterAnalysisTemplateAccess returns a ClusterAnalysisTemplateAccessDeniedError if the
+// namespace policy of the template does not allow the namespace to use it. The namespace is only
+// read from the lister, to match its labels, if the policy has a namespace selector and does not
+// list it by name. A nil lister, as used by namespaced controllers which cannot watch namespaces,
+// never matches a namespace selector.
+func VerifyClusterAnalysisTemplateAccess(namespaceLister corev1listers.NamespaceLister, template *v1alpha1.ClusterAnalysisTemplate, namespace string) error {
+	policy := template.Spec.NamespacePolicy
+	if policy == nil {
+		return nil
+	}
+	for _, ns := range policy.Namespaces {
+		if ns == namespace {
+			return nil
+		}
+	}
+	if policy.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(policy.NamespaceSelector)
+		if err != nil {
+			return fmt.Errorf("invalid namespace selector of ClusterAnalysisTemplate '%s': %w", template.Name, err)
+		}
+		if namespaceLister == nil {
+			return &ClusterAnalysisTemplateAccessDeniedError{TemplateName: template.Name, Namespace: namespace}
+		}
+		ns, err := namespaceLister.Get(namespace)
+		if err != nil {
+			return err
+		}
+		if selector.Matches(labels.Set(ns.Labels)) {
+			return nil
+		}
+	}
+	return &ClusterAnalysisTemplateAccessDeniedError{TemplateName: template.Name, Namespace: namespace}
+}
@@ -0,0 +1,87 @@
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestVerifyClusterAnalysisTemplateAccess(t *testing.T) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	assert.NoError(t, indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"tier": "trusted"}}}))
+	assert.NoError(t, indexer.Add(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}}))
+	namespaceLister := corev1listers.NewNamespaceLister(indexer)
+	newTemplate := func(policy *v1alpha1.NamespacePolicy) *v1alpha1.ClusterAnalysisTemplate {
+		return &v1alpha1.ClusterAnalysisTemplate{
+			ObjectMeta: metav1.ObjectMeta{Name: "success-rate"},
+			Spec:       v1alpha1.AnalysisTemplateSpec{NamespacePolicy: policy},
+		}
+	}
+	trustedSelector := &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "trusted"}}
+
+	tests := []struct {
+		name          string
+		policy        *v1alpha1.NamespacePolicy
+		namespace     string
+		expectedError string
+	}{
+		{name: "no policy", namespace: "team-b"},
+		{name: "listed namespace", policy: &v1alpha1.NamespacePolicy{Namespaces: []string{"team-b"}}, namespace: "team-b"},
+		{name: "matching labels", policy: &v1alpha1.NamespacePolicy{NamespaceSelector: trustedSelector}, namespace: "team-a"},
+		{name: "listed namespace without labels", policy: &v1alpha1.NamespacePolicy{Namespaces: []string{"team-c"}, NamespaceSelector: trustedSelector}, namespace: "team-c"},
+		{
+			name:          "empty policy",
+			policy:        &v1alpha1.NamespacePolicy{},
+			namespace:     "team-a",
+			expectedError: "ClusterAnalysisTemplate 'success-rate' is not allowed in namespace 'team-a' by its namespace policy",
+		},
+		{
+			name:          "unlisted namespace",
+			policy:        &v1alpha1.NamespacePolicy{Namespaces: []string{"team-a"}},
+			namespace:     "team-b",
+			expectedError: "ClusterAnalysisTemplate 'success-rate' is not allowed in namespace 'team-b' by its namespace policy",
+		},
+		{
+			name:          "labels do not match",
+			policy:        &v1alpha1.NamespacePolicy{NamespaceSelector: trustedSelector},
+			namespace:     "team-b",
+			expectedError: "ClusterAnalysisTemplate 'success-rate' is not allowed in namespace 'team-b' by its namespace policy",
+		},
+		{
+			name: "invalid selector",
+			policy: &v1alpha1.NamespacePolicy{NamespaceSelector: &metav1.LabelSelector{
+				MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Unknown"}},
+			}},
+			namespace:     "team-a",
+			expectedError: "invalid namespace selector of ClusterAnalysisTemplate 'success-rate': \"Unknown\" is not a valid pod selector operator",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := VerifyClusterAnalysisTemplateAccess(namespaceLister, newTemplate(test.policy), test.namespace)
+			if test.expectedError == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, test.expectedError)
+		})
+	}
+
+	err := VerifyClusterAnalysisTemplateAccess(namespaceLister, newTemplate(&v1alpha1.NamespacePolicy{}), "team-a")
+	assert.True(t, IsClusterAnalysisTemplateAccessDenied(err))
+	err = VerifyClusterAnalysisTemplateAccess(namespaceLister, newTemplate(&v1alpha1.NamespacePolicy{NamespaceSelector: trustedSelector}), "missing")
+	assert.Error(t, err)
+	assert.False(t, IsClusterAnalysisTemplateAccessDenied(err))
+
+	// without a namespace lister, only the listed namespaces are allowed
+	err = VerifyClusterAnalysisTemplateAccess(nil, newTemplate(&v1alpha1.NamespacePolicy{Namespaces: []string{"team-b"}, NamespaceSelector: trustedSelector}), "team-b")
+	assert.NoError(t, err)
+	err = VerifyClusterAnalysisTemplateAccess(nil, newTemplate(&v1alpha1.NamespacePolicy{NamespaceSelector: trustedSelector}), "team-a")
+	assert.True(t, IsClusterAnalysisTemplateAccessDenied(err))
+}
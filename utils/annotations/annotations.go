@@ -27,6 +27,12 @@ const (
 	DesiredReplicasAnnotation = RolloutLabel + "/desired-replicas"
 	// WorkloadGenerationAnnotation is the generation of the referenced workload
 	WorkloadGenerationAnnotation = RolloutLabel + "/workload-generation"
+	// ImpersonateServiceAccountAnnotation names the ServiceAccount the controller impersonates to mutate
+	// the traffic routing objects and services of a rollout. It is set on a rollout or its namespace.
+	ImpersonateServiceAccountAnnotation = RolloutLabel + "/impersonate-service-account"
+	// InconclusiveRerunsAnnotation is the number of times an inconclusive analysis was rerun before
+	// the AnalysisRun it is set on
+	InconclusiveRerunsAnnotation = RolloutLabel + "/inconclusive-reruns"
 )
 
 // GetDesiredReplicasAnnotation returns the number of desired replicas
@@ -5,11 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 
 	"github.com/argoproj/argo-rollouts/utils/defaults"
-	"github.com/aws/aws-sdk-go-v2/config"
 	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 	log "github.com/sirupsen/logrus"
@@ -60,8 +60,18 @@ type ELBv2APIClient interface {
 type ClientAdapter struct {
 	ELBV2 ELBv2APIClient
 
-	// loadBalancerDNStoARN is a cache that maps a LoadBalancer DNSName to an ARN
-	loadBalancerDNStoARN map[string]string
+	// loadBalancers caches the LoadBalancers by DNSName
+	loadBalancers *expiringCache
+	// targetGroups caches the TargetGroups and listeners of the LoadBalancers by ARN
+	targetGroups *expiringCache
+}
+
+// loadBalancerTargetGroups are the TargetGroups of a LoadBalancer along with their tags, and the
+// listeners of the LoadBalancer. They rarely change so they are cached, unlike the weights of the
+// TargetGroups which are always read from the rules of the listeners.
+type loadBalancerTargetGroups struct {
+	targetGroups []TargetGroupMeta
+	listenerARNs []*string
 }
 
 // TargetGroupMeta is a data type which combines the AWS TargetGroup information along with its
@@ -107,29 +117,40 @@ type ServiceReference struct {
 // NewClient instantiates a new AWS Client. It is declared as a variable to allow mocking
 var NewClient = DefaultNewClientFunc
 
+// DefaultNewClientFunc returns the AWS Client shared by the controller, so that its cache is reused
+// across reconciliations
 func DefaultNewClientFunc() (Client, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO())
+	cfg, err := LoadConfig(context.TODO())
 	if err != nil {
 		return nil, err
 	}
-	c := ClientAdapter{
-		ELBV2:                elbv2.NewFromConfig(cfg),
-		loadBalancerDNStoARN: make(map[string]string),
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+	if sharedClient == nil {
+		sharedClient = newClientAdapter(elbv2.NewFromConfig(cfg), sharedLayer.opts.CacheTTL)
 	}
-	return &c, nil
+	return sharedClient, nil
 }
 
 func FakeNewClientFunc(elbClient ELBv2APIClient) func() (Client, error) {
 	return func() (Client, error) {
-		c := ClientAdapter{
-			ELBV2:                elbClient,
-			loadBalancerDNStoARN: make(map[string]string),
-		}
-		return &c, nil
+		return newClientAdapter(elbClient, currentLayer().opts.CacheTTL), nil
+	}
+}
+
+func newClientAdapter(elbClient ELBv2APIClient, cacheTTL time.Duration) *ClientAdapter {
+	return &ClientAdapter{
+		ELBV2:         &layeredELBv2Client{api: elbClient},
+		loadBalancers: newExpiringCache(cacheTTL),
+		targetGroups:  newExpiringCache(cacheTTL),
 	}
 }
 
 func (c *ClientAdapter) FindLoadBalancerByDNSName(ctx context.Context, dnsName string) (*elbv2types.LoadBalancer, error) {
+	if lb, ok := c.loadBalancers.Get(dnsName); ok {
+		lbCopy := lb.(elbv2types.LoadBalancer)
+		return &lbCopy, nil
+	}
 	paginator := elbv2.NewDescribeLoadBalancersPaginator(c.ELBV2, &elbv2.DescribeLoadBalancersInput{
 		PageSize: aws.Int32(defaults.DefaultAwsLoadBalancerPageSize),
 	})
@@ -140,6 +161,7 @@ func (c *ClientAdapter) FindLoadBalancerByDNSName(ctx context.Context, dnsName s
 		}
 		for _, lb := range output.LoadBalancers {
 			if lb.DNSName != nil && *lb.DNSName == dnsName {
+				c.loadBalancers.Set(dnsName, lb)
 				return &lb, nil
 			}
 		}
@@ -150,13 +172,46 @@ func (c *ClientAdapter) FindLoadBalancerByDNSName(ctx context.Context, dnsName s
 // GetTargetGroupMetadata is a convenience to retrieve the target groups of a load balancer along
 // with relevant metadata (tags, and traffic weights).
 func (c *ClientAdapter) GetTargetGroupMetadata(ctx context.Context, loadBalancerARN string) ([]TargetGroupMeta, error) {
+	lbTargetGroups, cached, err := c.getLoadBalancerTargetGroups(ctx, loadBalancerARN)
+	if err != nil {
+		return nil, err
+	}
+	tgMeta, unknownTargetGroups, err := c.addTargetGroupWeights(ctx, lbTargetGroups)
+	if err != nil {
+		return nil, err
+	}
+	if len(unknownTargetGroups) > 0 && cached {
+		// a target group may have been created since the target groups were cached
+		c.targetGroups.Delete(loadBalancerARN)
+		lbTargetGroups, _, err = c.getLoadBalancerTargetGroups(ctx, loadBalancerARN)
+		if err != nil {
+			return nil, err
+		}
+		tgMeta, unknownTargetGroups, err = c.addTargetGroupWeights(ctx, lbTargetGroups)
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, tgARN := range unknownTargetGroups {
+		log.Warnf("Found ForwardConfig to TargetGroup for unknown target group: %s", tgARN)
+	}
+	return tgMeta, nil
+}
+
+// getLoadBalancerTargetGroups returns the target groups of a load balancer along with their tags,
+// and the listeners of the load balancer, from the cache if they are cached
+func (c *ClientAdapter) getLoadBalancerTargetGroups(ctx context.Context, loadBalancerARN string) (*loadBalancerTargetGroups, bool, error) {
+	if lbTargetGroups, ok := c.targetGroups.Get(loadBalancerARN); ok {
+		return lbTargetGroups.(*loadBalancerTargetGroups), true, nil
+	}
+
 	// Get target groups associated with LoadBalancer
 	tgIn := elbv2.DescribeTargetGroupsInput{
 		LoadBalancerArn: &loadBalancerARN,
 	}
 	tgOut, err := c.ELBV2.DescribeTargetGroups(ctx, &tgIn)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	var tgARNs []string
 	// tgMetaMap is a map from TargetGroup ARN, to TargetGroupMeta objects we want to return
@@ -175,7 +230,7 @@ func (c *ClientAdapter) GetTargetGroupMetadata(ctx context.Context, loadBalancer
 	}
 	tagsOut, err := c.ELBV2.DescribeTags(ctx, &tagsIn)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	for _, tagDesc := range tagsOut.TagDescriptions {
 		for _, tag := range tagDesc.Tags {
@@ -185,23 +240,45 @@ func (c *ClientAdapter) GetTargetGroupMetadata(ctx context.Context, loadBalancer
 		}
 	}
 
-	// Add Weight information to TargetGroups
 	listIn := elbv2.DescribeListenersInput{
 		LoadBalancerArn: &loadBalancerARN,
 	}
 	listOut, err := c.ELBV2.DescribeListeners(ctx, &listIn)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 
+	lbTargetGroups := loadBalancerTargetGroups{}
+	for _, tgARN := range tgARNs {
+		lbTargetGroups.targetGroups = append(lbTargetGroups.targetGroups, *tgMetaMap[tgARN])
+	}
 	// NOTE: listeners is typically a single element array
 	for _, list := range listOut.Listeners {
+		lbTargetGroups.listenerARNs = append(lbTargetGroups.listenerARNs, list.ListenerArn)
+	}
+	c.targetGroups.Set(loadBalancerARN, &lbTargetGroups)
+	return &lbTargetGroups, false, nil
+}
+
+// addTargetGroupWeights returns a copy of the target groups of a load balancer with the weights
+// set by the rules of its listeners, along with the ARNs of the target groups referenced by the
+// rules which are not part of the target groups
+func (c *ClientAdapter) addTargetGroupWeights(ctx context.Context, lbTargetGroups *loadBalancerTargetGroups) ([]TargetGroupMeta, []string, error) {
+	var tgMeta []TargetGroupMeta
+	tgIndexes := make(map[string]int)
+	for i, tg := range lbTargetGroups.targetGroups {
+		tgMeta = append(tgMeta, tg)
+		tgIndexes[*tg.TargetGroupArn] = i
+	}
+
+	var unknownTargetGroups []string
+	for _, listenerARN := range lbTargetGroups.listenerARNs {
 		rulesIn := elbv2.DescribeRulesInput{
-			ListenerArn: list.ListenerArn,
+			ListenerArn: listenerARN,
 		}
 		rulesOut, err := c.ELBV2.DescribeRules(ctx, &rulesIn)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		// NOTE: rules is typically a two element array containing:
 		// 1. a forwarder rule which splits traffic between canary/stable target groups
@@ -210,22 +287,18 @@ func (c *ClientAdapter) GetTargetGroupMetadata(ctx context.Context, loadBalancer
 			for _, action := range rule.Actions {
 				if action.ForwardConfig != nil {
 					for _, tgTuple := range action.ForwardConfig.TargetGroups {
-						tgMeta, ok := tgMetaMap[*tgTuple.TargetGroupArn]
+						i, ok := tgIndexes[*tgTuple.TargetGroupArn]
 						if !ok {
-							log.Warnf("Found ForwardConfig to TargetGroup for unknown target group: %s", *tgTuple.TargetGroupArn)
+							unknownTargetGroups = append(unknownTargetGroups, *tgTuple.TargetGroupArn)
 							continue
 						}
-						tgMeta.Weight = tgTuple.Weight
+						tgMeta[i].Weight = tgTuple.Weight
 					}
 				}
 			}
 		}
 	}
-	var tgMeta []TargetGroupMeta
-	for _, tgARN := range tgARNs {
-		tgMeta = append(tgMeta, *tgMetaMap[tgARN])
-	}
-	return tgMeta, nil
+	return tgMeta, unknownTargetGroups, nil
 }
 
 // GetTargetGroupHealth returns health descriptions of registered targets in a target group.
@@ -0,0 +1,351 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/config"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/smithy-go"
+	"github.com/prometheus/client_golang/prometheus"
+	"golang.org/x/time/rate"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/argoproj/argo-rollouts/utils/defaults"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+const (
+	// ELBv2Service is the name of the Elastic Load Balancing v2 service in the metrics
+	ELBv2Service = "elasticloadbalancing"
+	// CloudWatchService is the name of the CloudWatch service in the metrics
+	CloudWatchService = "cloudwatch"
+
+	// RequestStatusSuccess is the status of the successful calls in the metrics
+	RequestStatusSuccess = "success"
+	// RequestStatusThrottled is the status of the throttled calls in the metrics
+	RequestStatusThrottled = "throttled"
+	// RequestStatusError is the status of the failed calls in the metrics
+	RequestStatusError = "error"
+
+	retryBaseDelay = 200 * time.Millisecond
+	retryMaxDelay  = 10 * time.Second
+)
+
+// throttleErrorCodes are the error codes returned by the AWS APIs when a call is throttled
+var throttleErrorCodes = map[string]bool{
+	"Throttling":                             true,
+	"ThrottlingException":                    true,
+	"ThrottledException":                     true,
+	"RequestThrottledException":              true,
+	"TooManyRequestsException":               true,
+	"ProvisionedThroughputExceededException": true,
+	"RequestLimitExceeded":                   true,
+	"BandwidthLimitExceeded":                 true,
+	"LimitExceededException":                 true,
+	"RequestThrottled":                       true,
+	"SlowDown":                               true,
+	"EC2ThrottledException":                  true,
+}
+
+// IsThrottlingError returns whether an error returned by an AWS API means that the call was throttled
+func IsThrottlingError(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && throttleErrorCodes[apiErr.ErrorCode()]
+}
+
+// ClientOptions configures the layer shared by the AWS clients of the controller
+type ClientOptions struct {
+	// QPS is the maximum number of calls per second to each AWS service. Zero disables the rate limiting.
+	QPS float64
+	// Burst is the maximum burst of calls to each AWS service
+	Burst int
+	// CacheTTL is the duration during which the load balancers and target groups are cached. Zero
+	// disables the caching.
+	CacheTTL time.Duration
+	// MaxRetries is the maximum number of times a throttled call is retried
+	MaxRetries int
+	// RequestsCounter counts the calls to the AWS APIs by service, operation and status
+	RequestsCounter *prometheus.CounterVec
+}
+
+// DefaultClientOptions returns the default options of the layer shared by the AWS clients
+func DefaultClientOptions() ClientOptions {
+	return ClientOptions{
+		QPS:        defaults.DefaultAwsQPS,
+		Burst:      defaults.DefaultAwsBurst,
+		CacheTTL:   defaults.DefaultAwsCacheTTL,
+		MaxRetries: defaults.DefaultAwsMaxRetries,
+	}
+}
+
+// apiLayer rate limits the calls to the AWS APIs per service, retries them with backoff when they
+// are throttled, and counts them
+type apiLayer struct {
+	opts ClientOptions
+
+	lock     sync.Mutex
+	limiters map[string]*rate.Limiter
+	// sleep waits before retrying a throttled call. It is replaced in tests.
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+func newAPILayer(opts ClientOptions) *apiLayer {
+	return &apiLayer{
+		opts:     opts,
+		limiters: map[string]*rate.Limiter{},
+		sleep:    sleepWithContext,
+	}
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (l *apiLayer) limiter(service string) *rate.Limiter {
+	if l.opts.QPS <= 0 {
+		return nil
+	}
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	limiter, ok := l.limiters[service]
+	if !ok {
+		burst := l.opts.Burst
+		if burst < 1 {
+			burst = 1
+		}
+		limiter = rate.NewLimiter(rate.Limit(l.opts.QPS), burst)
+		l.limiters[service] = limiter
+	}
+	return limiter
+}
+
+func (l *apiLayer) call(ctx context.Context, service, operation string, fn func(ctx context.Context) error) error {
+	limiter := l.limiter(service)
+	delay := retryBaseDelay
+	for attempt := 0; ; attempt++ {
+		if limiter != nil {
+			if err := limiter.Wait(ctx); err != nil {
+				return err
+			}
+		}
+		err := fn(ctx)
+		throttled := IsThrottlingError(err)
+		l.countRequest(service, operation, err, throttled)
+		if !throttled || attempt >= l.opts.MaxRetries {
+			return err
+		}
+		if sleepErr := l.sleep(ctx, wait.Jitter(delay, 0.5)); sleepErr != nil {
+			return err
+		}
+		delay *= 2
+		if delay > retryMaxDelay {
+			delay = retryMaxDelay
+		}
+	}
+}
+
+func (l *apiLayer) countRequest(service, operation string, err error, throttled bool) {
+	if l.opts.RequestsCounter == nil {
+		return
+	}
+	status := RequestStatusSuccess
+	if throttled {
+		status = RequestStatusThrottled
+	} else if err != nil {
+		status = RequestStatusError
+	}
+	l.opts.RequestsCounter.WithLabelValues(service, operation, status).Inc()
+}
+
+var (
+	sharedLock   sync.Mutex
+	sharedLayer  = newAPILayer(DefaultClientOptions())
+	sharedConfig *aws.Config
+	sharedClient Client
+)
+
+// ConfigureClients sets the options of the layer shared by the AWS clients. It is meant to be
+// called once on startup, before any AWS client is created.
+func ConfigureClients(opts ClientOptions) {
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+	sharedLayer = newAPILayer(opts)
+	sharedClient = nil
+}
+
+func currentLayer() *apiLayer {
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+	return sharedLayer
+}
+
+// Call calls an operation of an AWS service through the layer shared by the AWS clients, which
+// rate limits the calls, retries them with backoff when they are throttled and counts them
+func Call(ctx context.Context, service, operation string, fn func(ctx context.Context) error) error {
+	return currentLayer().call(ctx, service, operation, fn)
+}
+
+// LoadConfig returns the AWS configuration of the controller. It is loaded once, with a retryer
+// which leaves the retries of the throttled calls to the shared layer, so that they are rate
+// limited and counted.
+func LoadConfig(ctx context.Context) (aws.Config, error) {
+	sharedLock.Lock()
+	defer sharedLock.Unlock()
+	if sharedConfig != nil {
+		return *sharedConfig, nil
+	}
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRetryer(func() aws.Retryer {
+		return retry.NewStandard(func(o *retry.StandardOptions) {
+			o.Retryables = sdkRetryables()
+		})
+	}))
+	if err != nil {
+		return aws.Config{}, err
+	}
+	sharedConfig = &cfg
+	return cfg, nil
+}
+
+// sdkRetryables are the default retryables of the AWS SDK, without the throttling error codes
+func sdkRetryables() []retry.IsErrorRetryable {
+	codes := map[string]struct{}{}
+	for code := range retry.DefaultRetryableErrorCodes {
+		if !throttleErrorCodes[code] {
+			codes[code] = struct{}{}
+		}
+	}
+	retryables := make([]retry.IsErrorRetryable, 0, len(retry.DefaultRetryables))
+	for _, retryable := range retry.DefaultRetryables {
+		if _, ok := retryable.(retry.RetryableErrorCode); ok {
+			retryable = retry.RetryableErrorCode{Codes: codes}
+		}
+		retryables = append(retryables, retryable)
+	}
+	return retryables
+}
+
+// expiringCache is a cache whose entries expire after a TTL
+type expiringCache struct {
+	ttl time.Duration
+
+	lock    sync.Mutex
+	entries map[string]expiringCacheEntry
+}
+
+type expiringCacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+func newExpiringCache(ttl time.Duration) *expiringCache {
+	return &expiringCache{
+		ttl:     ttl,
+		entries: map[string]expiringCacheEntry{},
+	}
+}
+
+func (c *expiringCache) Get(key string) (interface{}, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !timeutil.Now().Before(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *expiringCache) Set(key string, value interface{}) {
+	if c.ttl <= 0 {
+		return
+	}
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.entries[key] = expiringCacheEntry{value: value, expires: timeutil.Now().Add(c.ttl)}
+}
+
+func (c *expiringCache) Delete(key string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.entries, key)
+}
+
+// layeredELBv2Client calls the ELBv2 API through the layer shared by the AWS clients
+type layeredELBv2Client struct {
+	api ELBv2APIClient
+}
+
+func (c *layeredELBv2Client) DescribeTargetGroups(ctx context.Context, params *elbv2.DescribeTargetGroupsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetGroupsOutput, error) {
+	var out *elbv2.DescribeTargetGroupsOutput
+	err := Call(ctx, ELBv2Service, "DescribeTargetGroups", func(ctx context.Context) error {
+		var err error
+		out, err = c.api.DescribeTargetGroups(ctx, params, optFns...)
+		return err
+	})
+	return out, err
+}
+
+func (c *layeredELBv2Client) DescribeLoadBalancers(ctx context.Context, params *elbv2.DescribeLoadBalancersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeLoadBalancersOutput, error) {
+	var out *elbv2.DescribeLoadBalancersOutput
+	err := Call(ctx, ELBv2Service, "DescribeLoadBalancers", func(ctx context.Context) error {
+		var err error
+		out, err = c.api.DescribeLoadBalancers(ctx, params, optFns...)
+		return err
+	})
+	return out, err
+}
+
+func (c *layeredELBv2Client) DescribeListeners(ctx context.Context, params *elbv2.DescribeListenersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeListenersOutput, error) {
+	var out *elbv2.DescribeListenersOutput
+	err := Call(ctx, ELBv2Service, "DescribeListeners", func(ctx context.Context) error {
+		var err error
+		out, err = c.api.DescribeListeners(ctx, params, optFns...)
+		return err
+	})
+	return out, err
+}
+
+func (c *layeredELBv2Client) DescribeTargetHealth(ctx context.Context, params *elbv2.DescribeTargetHealthInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetHealthOutput, error) {
+	var out *elbv2.DescribeTargetHealthOutput
+	err := Call(ctx, ELBv2Service, "DescribeTargetHealth", func(ctx context.Context) error {
+		var err error
+		out, err = c.api.DescribeTargetHealth(ctx, params, optFns...)
+		return err
+	})
+	return out, err
+}
+
+func (c *layeredELBv2Client) DescribeRules(ctx context.Context, params *elbv2.DescribeRulesInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeRulesOutput, error) {
+	var out *elbv2.DescribeRulesOutput
+	err := Call(ctx, ELBv2Service, "DescribeRules", func(ctx context.Context) error {
+		var err error
+		out, err = c.api.DescribeRules(ctx, params, optFns...)
+		return err
+	})
+	return out, err
+}
+
+func (c *layeredELBv2Client) DescribeTags(ctx context.Context, params *elbv2.DescribeTagsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTagsOutput, error) {
+	var out *elbv2.DescribeTagsOutput
+	err := Call(ctx, ELBv2Service, "DescribeTags", func(ctx context.Context) error {
+		var err error
+		out, err = c.api.DescribeTags(ctx, params, optFns...)
+		return err
+	})
+	return out, err
+}
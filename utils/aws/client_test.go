@@ -0,0 +1,344 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/smithy-go"
+	"github.com/prometheus/client_golang/prometheus"
+	promtestutil "github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/utils/aws/mocks"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
+)
+
+var throttlingErr = &smithy.GenericAPIError{Code: "Throttling", Message: "Rate exceeded"}
+
+func newRequestsCounter() *prometheus.CounterVec {
+	return prometheus.NewCounterVec(prometheus.CounterOpts{Name: "aws_request_total"}, []string{"service", "operation", "status"})
+}
+
+// configureTestClients configures the shared layer of the AWS clients for a test, and restores the
+// default layer at the end of the test. It returns the delays waited between the retries, which
+// are not actually waited.
+func configureTestClients(t *testing.T, opts ClientOptions) *[]time.Duration {
+	t.Helper()
+	ConfigureClients(opts)
+	var delays []time.Duration
+	currentLayer().sleep = func(ctx context.Context, d time.Duration) error {
+		delays = append(delays, d)
+		return nil
+	}
+	t.Cleanup(func() {
+		ConfigureClients(DefaultClientOptions())
+	})
+	return &delays
+}
+
+func TestIsThrottlingError(t *testing.T) {
+	assert.True(t, IsThrottlingError(throttlingErr))
+	assert.True(t, IsThrottlingError(&smithy.OperationError{ServiceID: "CloudWatch", OperationName: "GetMetricData", Err: &smithy.GenericAPIError{Code: "ThrottlingException"}}))
+	assert.False(t, IsThrottlingError(&smithy.GenericAPIError{Code: "LoadBalancerNotFound"}))
+	assert.False(t, IsThrottlingError(errors.New("boom")))
+	assert.False(t, IsThrottlingError(nil))
+}
+
+func TestCallRetriesThrottledCalls(t *testing.T) {
+	counter := newRequestsCounter()
+	opts := DefaultClientOptions()
+	opts.QPS = 0
+	opts.MaxRetries = 3
+	opts.RequestsCounter = counter
+	configureTestClients(t, opts)
+
+	calls := 0
+	err := Call(context.TODO(), ELBv2Service, "DescribeRules", func(ctx context.Context) error {
+		calls++
+		if calls < 3 {
+			return throttlingErr
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, float64(2), promtestutil.ToFloat64(counter.WithLabelValues(ELBv2Service, "DescribeRules", RequestStatusThrottled)))
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(counter.WithLabelValues(ELBv2Service, "DescribeRules", RequestStatusSuccess)))
+}
+
+func TestCallBacksOffExponentially(t *testing.T) {
+	opts := DefaultClientOptions()
+	opts.QPS = 0
+	opts.MaxRetries = 10
+	delays := configureTestClients(t, opts)
+
+	err := Call(context.TODO(), ELBv2Service, "DescribeRules", func(ctx context.Context) error {
+		return throttlingErr
+	})
+	assert.Equal(t, throttlingErr, err)
+	assert.Len(t, *delays, 10)
+	for i, delay := range *delays {
+		expected := retryBaseDelay << i
+		if expected > retryMaxDelay {
+			expected = retryMaxDelay
+		}
+		// the delays are jittered by up to 50%
+		assert.GreaterOrEqual(t, int64(delay), int64(expected), "retry %d", i)
+		assert.LessOrEqual(t, int64(delay), int64(expected+expected/2), "retry %d", i)
+	}
+}
+
+func TestCallDoesNotRetryOtherErrors(t *testing.T) {
+	counter := newRequestsCounter()
+	opts := DefaultClientOptions()
+	opts.RequestsCounter = counter
+	configureTestClients(t, opts)
+
+	calls := 0
+	otherErr := &smithy.GenericAPIError{Code: "AccessDenied"}
+	err := Call(context.TODO(), CloudWatchService, "GetMetricData", func(ctx context.Context) error {
+		calls++
+		return otherErr
+	})
+	assert.Equal(t, otherErr, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(counter.WithLabelValues(CloudWatchService, "GetMetricData", RequestStatusError)))
+}
+
+func TestCallStopsRetryingWhenContextIsDone(t *testing.T) {
+	opts := DefaultClientOptions()
+	opts.QPS = 0
+	ConfigureClients(opts)
+	t.Cleanup(func() {
+		ConfigureClients(DefaultClientOptions())
+	})
+	// the retry waits for the real delay, which is interrupted by the cancellation of the context
+	ctx, cancel := context.WithCancel(context.TODO())
+	calls := 0
+	err := Call(ctx, ELBv2Service, "DescribeRules", func(ctx context.Context) error {
+		calls++
+		cancel()
+		return throttlingErr
+	})
+	assert.Equal(t, throttlingErr, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestCallRateLimits(t *testing.T) {
+	opts := DefaultClientOptions()
+	opts.QPS = 0.001
+	opts.Burst = 1
+	configureTestClients(t, opts)
+
+	noop := func(ctx context.Context) error { return nil }
+	assert.NoError(t, Call(context.TODO(), ELBv2Service, "DescribeRules", noop))
+	// the burst is exhausted and the next token is not available before the deadline
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, Call(ctx, ELBv2Service, "DescribeRules", noop))
+	// each service has its own limiter
+	assert.NoError(t, Call(context.TODO(), CloudWatchService, "GetMetricData", noop))
+}
+
+func TestFindLoadBalancerByDNSNameCached(t *testing.T) {
+	configureTestClients(t, DefaultClientOptions())
+	now := time.Now()
+	timeutil.Now = func() time.Time { return now }
+	defer func() { timeutil.Now = time.Now }()
+
+	fakeELB, c := newFakeClient()
+	dnsName := "find-loadbalancer-test-abc-123.us-west-2.elb.amazonaws.com"
+	lbOut := elbv2.DescribeLoadBalancersOutput{
+		LoadBalancers: []elbv2types.LoadBalancer{{
+			LoadBalancerArn: pointer.StringPtr("lb-abc123"),
+			DNSName:         pointer.StringPtr(dnsName),
+		}},
+	}
+	fakeELB.On("DescribeLoadBalancers", mock.Anything, mock.Anything).Return(&lbOut, nil)
+
+	for i := 0; i < 2; i++ {
+		lb, err := c.FindLoadBalancerByDNSName(context.TODO(), dnsName)
+		assert.NoError(t, err)
+		assert.Equal(t, "lb-abc123", *lb.LoadBalancerArn)
+	}
+	fakeELB.AssertNumberOfCalls(t, "DescribeLoadBalancers", 1)
+
+	// load balancers which are not found are not cached
+	for i := 0; i < 2; i++ {
+		lb, err := c.FindLoadBalancerByDNSName(context.TODO(), "doesnt-exist")
+		assert.NoError(t, err)
+		assert.Nil(t, lb)
+	}
+	fakeELB.AssertNumberOfCalls(t, "DescribeLoadBalancers", 3)
+
+	// the cache expires
+	now = now.Add(DefaultClientOptions().CacheTTL)
+	_, err := c.FindLoadBalancerByDNSName(context.TODO(), dnsName)
+	assert.NoError(t, err)
+	fakeELB.AssertNumberOfCalls(t, "DescribeLoadBalancers", 4)
+}
+
+func TestFindLoadBalancerByDNSNameCacheDisabled(t *testing.T) {
+	opts := DefaultClientOptions()
+	opts.CacheTTL = 0
+	configureTestClients(t, opts)
+
+	fakeELB, c := newFakeClient()
+	dnsName := "find-loadbalancer-test-abc-123.us-west-2.elb.amazonaws.com"
+	lbOut := elbv2.DescribeLoadBalancersOutput{
+		LoadBalancers: []elbv2types.LoadBalancer{{
+			LoadBalancerArn: pointer.StringPtr("lb-abc123"),
+			DNSName:         pointer.StringPtr(dnsName),
+		}},
+	}
+	fakeELB.On("DescribeLoadBalancers", mock.Anything, mock.Anything).Return(&lbOut, nil)
+
+	for i := 0; i < 2; i++ {
+		_, err := c.FindLoadBalancerByDNSName(context.TODO(), dnsName)
+		assert.NoError(t, err)
+	}
+	fakeELB.AssertNumberOfCalls(t, "DescribeLoadBalancers", 2)
+}
+
+func newTargetGroupTuple(arn string, weight int32) elbv2types.TargetGroupTuple {
+	return elbv2types.TargetGroupTuple{TargetGroupArn: pointer.StringPtr(arn), Weight: pointer.Int32Ptr(weight)}
+}
+
+func newRulesOutput(tgTuples ...elbv2types.TargetGroupTuple) *elbv2.DescribeRulesOutput {
+	return &elbv2.DescribeRulesOutput{
+		Rules: []elbv2types.Rule{{
+			Actions: []elbv2types.Action{{
+				ForwardConfig: &elbv2types.ForwardActionConfig{TargetGroups: tgTuples},
+			}},
+		}},
+	}
+}
+
+func TestGetTargetGroupMetadataCached(t *testing.T) {
+	counter := newRequestsCounter()
+	opts := DefaultClientOptions()
+	opts.RequestsCounter = counter
+	configureTestClients(t, opts)
+
+	fakeELB, c := newFakeClient()
+	tgOut := elbv2.DescribeTargetGroupsOutput{
+		TargetGroups: []elbv2types.TargetGroup{
+			{TargetGroupArn: pointer.StringPtr("tg-stable")},
+			{TargetGroupArn: pointer.StringPtr("tg-canary")},
+		},
+	}
+	fakeELB.On("DescribeTargetGroups", mock.Anything, mock.Anything).Return(&tgOut, nil)
+	fakeELB.On("DescribeTags", mock.Anything, mock.Anything).Return(&elbv2.DescribeTagsOutput{}, nil)
+	listenersOut := elbv2.DescribeListenersOutput{
+		Listeners: []elbv2types.Listener{{ListenerArn: pointer.StringPtr("lst-abc123")}},
+	}
+	fakeELB.On("DescribeListeners", mock.Anything, mock.Anything).Return(&listenersOut, nil)
+	fakeELB.On("DescribeRules", mock.Anything, mock.Anything).Return(newRulesOutput(newTargetGroupTuple("tg-stable", 90), newTargetGroupTuple("tg-canary", 10)), nil).Once()
+	fakeELB.On("DescribeRules", mock.Anything, mock.Anything).Return(newRulesOutput(newTargetGroupTuple("tg-stable", 80), newTargetGroupTuple("tg-canary", 20)), nil).Once()
+
+	tgMeta, err := c.GetTargetGroupMetadata(context.TODO(), "lb-abc123")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(10), *tgMeta[1].Weight)
+
+	// the weights are always read from the rules, while the target groups come from the cache
+	tgMeta, err = c.GetTargetGroupMetadata(context.TODO(), "lb-abc123")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(80), *tgMeta[0].Weight)
+	assert.Equal(t, int32(20), *tgMeta[1].Weight)
+	fakeELB.AssertNumberOfCalls(t, "DescribeTargetGroups", 1)
+	fakeELB.AssertNumberOfCalls(t, "DescribeTags", 1)
+	fakeELB.AssertNumberOfCalls(t, "DescribeListeners", 1)
+	fakeELB.AssertNumberOfCalls(t, "DescribeRules", 2)
+	assert.Equal(t, float64(2), promtestutil.ToFloat64(counter.WithLabelValues(ELBv2Service, "DescribeRules", RequestStatusSuccess)))
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(counter.WithLabelValues(ELBv2Service, "DescribeTargetGroups", RequestStatusSuccess)))
+}
+
+func TestGetTargetGroupMetadataRefreshesUnknownTargetGroups(t *testing.T) {
+	configureTestClients(t, DefaultClientOptions())
+
+	fakeELB, c := newFakeClient()
+	fakeELB.On("DescribeTargetGroups", mock.Anything, mock.Anything).Return(&elbv2.DescribeTargetGroupsOutput{
+		TargetGroups: []elbv2types.TargetGroup{{TargetGroupArn: pointer.StringPtr("tg-stable")}},
+	}, nil).Once()
+	fakeELB.On("DescribeTargetGroups", mock.Anything, mock.Anything).Return(&elbv2.DescribeTargetGroupsOutput{
+		TargetGroups: []elbv2types.TargetGroup{
+			{TargetGroupArn: pointer.StringPtr("tg-stable")},
+			{TargetGroupArn: pointer.StringPtr("tg-canary")},
+		},
+	}, nil)
+	fakeELB.On("DescribeTags", mock.Anything, mock.Anything).Return(&elbv2.DescribeTagsOutput{}, nil)
+	fakeELB.On("DescribeListeners", mock.Anything, mock.Anything).Return(&elbv2.DescribeListenersOutput{
+		Listeners: []elbv2types.Listener{{ListenerArn: pointer.StringPtr("lst-abc123")}},
+	}, nil)
+	fakeELB.On("DescribeRules", mock.Anything, mock.Anything).Return(newRulesOutput(newTargetGroupTuple("tg-stable", 100)), nil).Once()
+	fakeELB.On("DescribeRules", mock.Anything, mock.Anything).Return(newRulesOutput(newTargetGroupTuple("tg-stable", 90), newTargetGroupTuple("tg-canary", 10)), nil)
+
+	tgMeta, err := c.GetTargetGroupMetadata(context.TODO(), "lb-abc123")
+	assert.NoError(t, err)
+	assert.Len(t, tgMeta, 1)
+
+	// the canary target group was created after the target groups were cached
+	tgMeta, err = c.GetTargetGroupMetadata(context.TODO(), "lb-abc123")
+	assert.NoError(t, err)
+	if assert.Len(t, tgMeta, 2) {
+		assert.Equal(t, "tg-canary", *tgMeta[1].TargetGroupArn)
+		assert.Equal(t, int32(10), *tgMeta[1].Weight)
+	}
+	fakeELB.AssertNumberOfCalls(t, "DescribeTargetGroups", 2)
+}
+
+func TestLayeredELBv2ClientRetriesThrottledCalls(t *testing.T) {
+	counter := newRequestsCounter()
+	opts := DefaultClientOptions()
+	opts.RequestsCounter = counter
+	delays := configureTestClients(t, opts)
+
+	fakeELB := &mocks.ELBv2APIClient{}
+	fakeELB.On("DescribeTargetHealth", mock.Anything, mock.Anything).Return(nil, throttlingErr).Once()
+	fakeELB.On("DescribeTargetHealth", mock.Anything, mock.Anything).Return(&elbv2.DescribeTargetHealthOutput{
+		TargetHealthDescriptions: []elbv2types.TargetHealthDescription{{}},
+	}, nil)
+	c, err := FakeNewClientFunc(fakeELB)()
+	assert.NoError(t, err)
+
+	targets, err := c.GetTargetGroupHealth(context.TODO(), "tg-abc123")
+	assert.NoError(t, err)
+	assert.Len(t, targets, 1)
+	assert.Len(t, *delays, 1)
+	fakeELB.AssertNumberOfCalls(t, "DescribeTargetHealth", 2)
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(counter.WithLabelValues(ELBv2Service, "DescribeTargetHealth", RequestStatusThrottled)))
+	assert.Equal(t, float64(1), promtestutil.ToFloat64(counter.WithLabelValues(ELBv2Service, "DescribeTargetHealth", RequestStatusSuccess)))
+}
+
+func TestSDKRetryablesExcludeThrottling(t *testing.T) {
+	for _, retryable := range sdkRetryables() {
+		assert.False(t, retryable.IsErrorRetryable(throttlingErr).Bool())
+	}
+}
+
+func TestExpiringCache(t *testing.T) {
+	now := time.Now()
+	timeutil.Now = func() time.Time { return now }
+	defer func() { timeutil.Now = time.Now }()
+
+	cache := newExpiringCache(time.Minute)
+	cache.Set("foo", "bar")
+	value, ok := cache.Get("foo")
+	assert.True(t, ok)
+	assert.Equal(t, "bar", value)
+
+	now = now.Add(time.Minute)
+	_, ok = cache.Get("foo")
+	assert.False(t, ok)
+
+	cache.Set("foo", "bar")
+	cache.Delete("foo")
+	_, ok = cache.Get("foo")
+	assert.False(t, ok)
+}
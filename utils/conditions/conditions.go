@@ -103,6 +103,47 @@ const (
 	RolloutStepCompletedReason  = "RolloutStepCompleted"
 	RolloutStepCompletedMessage = "Rollout step %d/%d completed (%s)"
 
+	// RolloutStepDeadlineExceeded indicates when a canary step did not complete before its deadline
+	RolloutStepDeadlineExceededReason  = "RolloutStepDeadlineExceeded"
+	RolloutStepDeadlineExceededMessage = "Rollout step %d/%d did not complete within its deadline of %s"
+
+	// RolloutQueued is emitted when a rollout is queued by the concurrency budget of the controller
+	RolloutQueuedReason  = "RolloutQueued"
+	RolloutQueuedMessage = "Rollout queued at position %d, as %d rollouts are already progressing"
+
+	// RolloutDequeued is emitted when a queued rollout gets a slot of the concurrency budget
+	RolloutDequeuedReason  = "RolloutDequeued"
+	RolloutDequeuedMessage = "Rollout dequeued after waiting %s"
+
+	// RolloutPausedByAlert is emitted when a firing alert selected by the alert rules of a rollout pauses it
+	RolloutPausedByAlertReason  = "RolloutPausedByAlert"
+	RolloutPausedByAlertMessage = "Rollout paused: %s"
+	// AlertFiringMessage describes the firing alert which paused or aborted a rollout
+	AlertFiringMessage = "alert '%s' is firing"
+
+	// RolloutFrozenReason is added in a rollout when an emergency stop holds it at its current step
+	RolloutFrozenReason = "RolloutFrozen"
+	// RolloutUnfrozenReason is added in a rollout when the emergency stop which held it is lifted
+	RolloutUnfrozenReason = "RolloutUnfrozen"
+	// RolloutUnfrozenMessage is added in a rollout when the emergency stop which held it is lifted
+	RolloutUnfrozenMessage = "Emergency stop lifted"
+
+	// InconclusiveAnalysisRerun is emitted when an inconclusive analysis is rerun by its inconclusive policy
+	InconclusiveAnalysisRerunReason  = "InconclusiveAnalysisRerun"
+	InconclusiveAnalysisRerunMessage = "Rerunning inconclusive AnalysisRun '%s' (rerun %d/%d)"
+
+	// InconclusiveAnalysisSucceeded is emitted when an inconclusive analysis is treated as successful by its inconclusive policy
+	InconclusiveAnalysisSucceededReason  = "InconclusiveAnalysisSucceeded"
+	InconclusiveAnalysisSucceededMessage = "Inconclusive AnalysisRun '%s' treated as successful after %s"
+
+	// InconclusiveAnalysisFailed is emitted when an inconclusive analysis is treated as failed by its inconclusive policy
+	InconclusiveAnalysisFailedReason  = "InconclusiveAnalysisFailed"
+	InconclusiveAnalysisFailedMessage = "Inconclusive AnalysisRun '%s' treated as failed after %s"
+
+	// InconclusiveAnalysisEscalated is emitted when an inconclusive analysis is escalated by its inconclusive policy
+	InconclusiveAnalysisEscalatedReason  = "InconclusiveAnalysisEscalated"
+	InconclusiveAnalysisEscalatedMessage = "Inconclusive AnalysisRun '%s' escalated after %s"
+
 	// TrafficWeightUpdated is emitted any time traffic weight is modified
 	TrafficWeightUpdatedReason  = "TrafficWeightUpdated"
 	TrafficWeightUpdatedMessage = "Traffic weight updated %s"
@@ -143,6 +184,11 @@ const (
 	// ServiceReferencingManagedService is added in a rollout when the multiple rollouts reference a Rollout
 	ServiceReferencingManagedService = "Service %q is managed by another Rollout"
 
+	// ImpersonationPermissionDeniedReason is added to a Rollout when the ServiceAccount impersonated by
+	// the controller is missing, or was denied a change to a traffic routing object or service
+	ImpersonationPermissionDeniedReason  = "ImpersonationPermissionDenied"
+	ImpersonationPermissionDeniedMessage = "Permission denied to the impersonated ServiceAccount: %s"
+
 	// TargetGroupHealthyReason is emitted when target group has been verified
 	TargetGroupVerifiedReason              = "TargetGroupVerified"
 	TargetGroupVerifiedRegistrationMessage = "Service %s (TargetGroup %s) verified: %d endpoints registered"
@@ -160,6 +206,16 @@ const (
 	// LoadBalancerNotFoundReason is emitted when load balancer can not be found
 	LoadBalancerNotFoundReason  = "LoadBalancerNotFound"
 	LoadBalancerNotFoundMessage = "Failed to find load balancer: %s"
+
+	// ReplicaSetAdoptedReason is emitted when the rollout adopts the ReplicaSet of an existing Deployment
+	ReplicaSetAdoptedReason  = "ReplicaSetAdopted"
+	ReplicaSetAdoptedMessage = "Adopted ReplicaSet %s of Deployment %s as stable revision"
+	// DeploymentPausedReason is emitted when the rollout pauses a Deployment before adopting its ReplicaSet
+	DeploymentPausedReason  = "DeploymentPaused"
+	DeploymentPausedMessage = "Paused Deployment %s to adopt its ReplicaSet"
+	// AdoptionSkippedReason is emitted when the rollout cannot adopt the ReplicaSet of a Deployment
+	AdoptionSkippedReason  = "AdoptionSkipped"
+	AdoptionSkippedMessage = "Not adopting a ReplicaSet of Deployment %s: %s"
 )
 
 // NewRolloutCondition creates a new rollout condition.
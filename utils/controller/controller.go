@@ -22,6 +22,7 @@ import (
 	"github.com/argoproj/argo-rollouts/controller/metrics"
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	"github.com/argoproj/argo-rollouts/utils/queue"
 )
 
 // processNextWatchObj will process a single object from the watch by seeing if
@@ -216,6 +217,42 @@ func EnqueueRateLimited(obj interface{}, q workqueue.RateLimitingInterface) {
 	q.AddRateLimited(key)
 }
 
+// EnqueueWithPriority adds the object to the queue in the given priority class. Queues which are
+// not priority aware receive a plain Add.
+func EnqueueWithPriority(obj interface{}, priority queue.Priority, q workqueue.RateLimitingInterface) {
+	var key string
+	var err error
+	if key, err = metaNamespaceKeyFunc(obj); err != nil {
+		runtime.HandleError(err)
+		return
+	}
+	if pq, ok := q.(queue.PriorityRateLimitingInterface); ok {
+		pq.AddWithPriority(key, priority)
+		return
+	}
+	q.Add(key)
+}
+
+// UpdatePriority returns the queue priority of an informer update event. Periodic resyncs, which
+// redeliver an unchanged object, are low priority while spec changes are high priority.
+func UpdatePriority(old, new interface{}) queue.Priority {
+	oldAcc, err := meta.Accessor(old)
+	if err != nil {
+		return queue.PriorityNormal
+	}
+	newAcc, err := meta.Accessor(new)
+	if err != nil {
+		return queue.PriorityNormal
+	}
+	if oldAcc.GetResourceVersion() == newAcc.GetResourceVersion() {
+		return queue.PriorityLow
+	}
+	if oldAcc.GetGeneration() != newAcc.GetGeneration() {
+		return queue.PriorityHigh
+	}
+	return queue.PriorityNormal
+}
+
 // EnqueueParentObject will take any resource implementing metav1.Object and attempt
 // to find the ownerType resource that 'owns' it. It does this by looking at the
 // objects metadata.ownerReferences field for an appropriate OwnerReference.
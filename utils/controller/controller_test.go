@@ -162,6 +162,35 @@ func TestEnqueueRateLimitedInvalidObject(t *testing.T) {
 	assert.Equal(t, 0, q.Len())
 }
 
+func TestEnqueueWithPriority(t *testing.T) {
+	q := queue.NewPriorityRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts", nil)
+	EnqueueWithPriority("default/low", queue.PriorityLow, q)
+	EnqueueWithPriority("default/high", queue.PriorityHigh, q)
+	assert.Equal(t, 2, q.Len())
+	item, _ := q.Get()
+	assert.Equal(t, "default/high", item)
+
+	plain := workqueue.NewNamedRateLimitingQueue(queue.DefaultArgoRolloutsRateLimiter(), "Rollouts")
+	EnqueueWithPriority("default/foo", queue.PriorityHigh, plain)
+	assert.Equal(t, 1, plain.Len())
+}
+
+func TestUpdatePriority(t *testing.T) {
+	old := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{ResourceVersion: "1", Generation: 1}}
+	resync := old.DeepCopy()
+	assert.Equal(t, queue.PriorityLow, UpdatePriority(old, resync))
+
+	statusChange := old.DeepCopy()
+	statusChange.ResourceVersion = "2"
+	assert.Equal(t, queue.PriorityNormal, UpdatePriority(old, statusChange))
+
+	specChange := statusChange.DeepCopy()
+	specChange.Generation = 2
+	assert.Equal(t, queue.PriorityHigh, UpdatePriority(old, specChange))
+
+	assert.Equal(t, queue.PriorityNormal, UpdatePriority(struct{}{}, specChange))
+}
+
 func TestEnqueueParentObjectInvalidObject(t *testing.T) {
 	errorMessages := make([]error, 0)
 	utilruntime.ErrorHandlers = append(utilruntime.ErrorHandlers, func(err error) {
@@ -17,6 +17,8 @@ const (
 	DefaultReplicas = int32(1)
 	// DefaultRevisionHistoryLimit default number of revisions to keep if .Spec.RevisionHistoryLimit is nil
 	DefaultRevisionHistoryLimit = int32(10)
+	// DefaultRevisionSnapshotLimit default number of revision snapshots to keep if .Spec.RevisionSnapshotLimit is nil
+	DefaultRevisionSnapshotLimit = int32(0)
 	// DefaultAnalysisRunSuccessfulHistoryLimit default number of successful AnalysisRuns to keep if .Spec.Analysis.SuccessfulRunHistoryLimit is nil
 	DefaultAnalysisRunSuccessfulHistoryLimit = int32(5)
 	// DefaultAnalysisRunUnsuccessfulHistoryLimit default number of unsuccessful AnalysisRuns to keep if .Spec.Analysis.UnsuccessfulRunHistoryLimit is nil
@@ -36,12 +38,23 @@ const (
 	// DefaultConsecutiveErrorLimit is the default number times a metric can error in sequence before
 	// erroring the entire metric.
 	DefaultConsecutiveErrorLimit int32 = 4
+	// DefaultCrashGuardMaxRestarts is the default number of restarts of a container of the new revision
+	// tolerated by the crash guard of a rollout
+	DefaultCrashGuardMaxRestarts int32 = 3
 	// DefaultQPS is the default Queries Per Second (QPS) for client side throttling to the K8s API server
 	DefaultQPS float32 = 40.0
 	// DefaultBurst is the default value for Burst for client side throttling to the K8s API server
 	DefaultBurst int = 80
 	// DefaultAwsLoadBalancerPageSize is the default page size used when calling aws to get load balancers by DNS name
 	DefaultAwsLoadBalancerPageSize = int32(300)
+	// DefaultAwsQPS is the default Queries Per Second (QPS) for client side throttling of the calls to each AWS service
+	DefaultAwsQPS float64 = 10.0
+	// DefaultAwsBurst is the default value for Burst for client side throttling of the calls to each AWS service
+	DefaultAwsBurst int = 20
+	// DefaultAwsCacheTTL is the default duration during which the AWS load balancers and target groups are cached
+	DefaultAwsCacheTTL = 5 * time.Minute
+	// DefaultAwsMaxRetries is the default number of times a throttled call to an AWS API is retried
+	DefaultAwsMaxRetries int = 5
 )
 
 const (
@@ -62,6 +75,8 @@ var (
 	smiAPIVersion                = DefaultSMITrafficSplitVersion
 	targetGroupBindingAPIVersion = DefaultTargetGroupBindingAPIVersion
 	appmeshCRDVersion            = DefaultAppMeshCRDVersion
+	maxProgressingRollouts       int32
+	progressingBudgetLabel       string
 )
 
 const (
@@ -97,6 +112,14 @@ func GetRevisionHistoryLimitOrDefault(rollout *v1alpha1.Rollout) int32 {
 	return *rollout.Spec.RevisionHistoryLimit
 }
 
+// GetRevisionSnapshotLimitOrDefault returns the specified number of revision snapshots to keep or the default number
+func GetRevisionSnapshotLimitOrDefault(rollout *v1alpha1.Rollout) int32 {
+	if rollout.Spec.RevisionSnapshotLimit == nil {
+		return DefaultRevisionSnapshotLimit
+	}
+	return *rollout.Spec.RevisionSnapshotLimit
+}
+
 // GetAnalysisRunSuccessfulHistoryLimitOrDefault returns the specified number of succeed AnalysisRuns to keep or the default number
 func GetAnalysisRunSuccessfulHistoryLimitOrDefault(rollout *v1alpha1.Rollout) int32 {
 	if rollout.Spec.Analysis == nil || rollout.Spec.Analysis.SuccessfulRunHistoryLimit == nil {
@@ -228,6 +251,13 @@ func GetConsecutiveErrorLimitOrDefault(metric *v1alpha1.Metric) int32 {
 	return DefaultConsecutiveErrorLimit
 }
 
+func GetCrashGuardMaxRestartsOrDefault(guard *v1alpha1.RolloutCrashGuard) int32 {
+	if guard.MaxRestarts != nil {
+		return *guard.MaxRestarts
+	}
+	return DefaultCrashGuardMaxRestarts
+}
+
 func Namespace() string {
 	// This way assumes you've set the POD_NAMESPACE environment variable using the downward API.
 	// This check has to be done first for backwards compatibility with the way InClusterConfig was originally set up
@@ -253,6 +283,29 @@ func VerifyTargetGroup() bool {
 	return defaultVerifyTargetGroup
 }
 
+// SetMaxProgressingRollouts sets the number of canary rollouts which may progress past their first
+// step at the same time. Zero disables the limit.
+func SetMaxProgressingRollouts(max int32) {
+	maxProgressingRollouts = max
+}
+
+// GetMaxProgressingRollouts returns the number of canary rollouts which may progress past their
+// first step at the same time, or zero when the number is not limited
+func GetMaxProgressingRollouts() int32 {
+	return maxProgressingRollouts
+}
+
+// SetProgressingBudgetLabel sets the label whose values scope the max progressing rollouts
+func SetProgressingBudgetLabel(label string) {
+	progressingBudgetLabel = label
+}
+
+// GetProgressingBudgetLabel returns the label whose values scope the max progressing rollouts, or an
+// empty string when the limit applies to all rollouts
+func GetProgressingBudgetLabel() string {
+	return progressingBudgetLabel
+}
+
 func SetIstioAPIVersion(apiVersion string) {
 	istioAPIVersion = apiVersion
 }
@@ -45,6 +45,19 @@ func TestGetRevisionHistoryOrDefault(t *testing.T) {
 	assert.Equal(t, DefaultRevisionHistoryLimit, GetRevisionHistoryLimitOrDefault(rolloutDefaultValue))
 }
 
+func TestGetRevisionSnapshotLimitOrDefault(t *testing.T) {
+	revisionSnapshotLimit := int32(20)
+	rolloutNonDefaultValue := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			RevisionSnapshotLimit: &revisionSnapshotLimit,
+		},
+	}
+
+	assert.Equal(t, revisionSnapshotLimit, GetRevisionSnapshotLimitOrDefault(rolloutNonDefaultValue))
+	rolloutDefaultValue := &v1alpha1.Rollout{}
+	assert.Equal(t, DefaultRevisionSnapshotLimit, GetRevisionSnapshotLimitOrDefault(rolloutDefaultValue))
+}
+
 func TestGetAnalysisRunSuccessfulHistoryLimitOrDefault(t *testing.T) {
 	succeedHistoryLimit := int32(2)
 	rolloutNonDefaultValue := &v1alpha1.Rollout{
@@ -372,6 +385,11 @@ func TestGetConsecutiveErrorLimitOrDefault(t *testing.T) {
 	assert.Equal(t, DefaultConsecutiveErrorLimit, GetConsecutiveErrorLimitOrDefault(metricDefaultValue))
 }
 
+func TestGetCrashGuardMaxRestartsOrDefault(t *testing.T) {
+	assert.Equal(t, DefaultCrashGuardMaxRestarts, GetCrashGuardMaxRestartsOrDefault(&v1alpha1.RolloutCrashGuard{}))
+	assert.Equal(t, int32(0), GetCrashGuardMaxRestartsOrDefault(&v1alpha1.RolloutCrashGuard{MaxRestarts: pointer.Int32Ptr(0)}))
+}
+
 func TestSetDefaults(t *testing.T) {
 	SetVerifyTargetGroup(true)
 	assert.True(t, VerifyTargetGroup())
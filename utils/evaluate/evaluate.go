@@ -15,18 +15,31 @@ import (
 )
 
 func EvaluateResult(result interface{}, metric v1alpha1.Metric, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
+	return evaluateResult(result, nil, metric)
+}
+
+// EvaluateSeriesResult evaluates the result of a single series of a vector result, whose labels are
+// available to the conditions as the `labels` variable
+func EvaluateSeriesResult(result interface{}, labels map[string]string, metric v1alpha1.Metric, logCtx logrus.Entry) (v1alpha1.AnalysisPhase, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	return evaluateResult(result, map[string]interface{}{"labels": labels}, metric)
+}
+
+func evaluateResult(result interface{}, vars map[string]interface{}, metric v1alpha1.Metric) (v1alpha1.AnalysisPhase, error) {
 	successCondition := false
 	failCondition := false
 	var err error
 
 	if metric.SuccessCondition != "" {
-		successCondition, err = EvalCondition(result, metric.SuccessCondition)
+		successCondition, err = evalCondition(result, vars, metric.SuccessCondition)
 		if err != nil {
 			return v1alpha1.AnalysisPhaseError, err
 		}
 	}
 	if metric.FailureCondition != "" {
-		failCondition, err = EvalCondition(result, metric.FailureCondition)
+		failCondition, err = evalCondition(result, vars, metric.FailureCondition)
 		if err != nil {
 			return v1alpha1.AnalysisPhaseError, err
 		}
@@ -58,6 +71,11 @@ func EvaluateResult(result interface{}, metric v1alpha1.Metric, logCtx logrus.En
 
 // EvalCondition evaluates the condition with the resultValue as an input
 func EvalCondition(resultValue interface{}, condition string) (bool, error) {
+	return evalCondition(resultValue, nil, condition)
+}
+
+// evalCondition evaluates the condition with the resultValue and the additional variables as an input
+func evalCondition(resultValue interface{}, vars map[string]interface{}, condition string) (bool, error) {
 	var err error
 
 	env := map[string]interface{}{
@@ -69,6 +87,9 @@ func EvalCondition(resultValue interface{}, condition string) (bool, error) {
 		"isNil":   isNilFunc(resultValue),
 		"default": defaultFunc(resultValue),
 	}
+	for name, value := range vars {
+		env[name] = value
+	}
 
 	unwrapFileErr := func(e error) error {
 		if fileErr, ok := err.(*file.Error); ok {
@@ -101,6 +101,24 @@ func TestEvaluateResultWithErrorOnFailureCondition(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestEvaluateSeriesResult(t *testing.T) {
+	metric := v1alpha1.Metric{
+		SuccessCondition: `result < 0.05 || labels.pod == "canary-0"`,
+	}
+	logCtx := logrus.WithField("test", "test")
+	status, err := EvaluateSeriesResult(0.1, map[string]string{"pod": "canary-1"}, metric, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseFailed, status)
+
+	status, err = EvaluateSeriesResult(0.1, map[string]string{"pod": "canary-0"}, metric, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+
+	status, err = EvaluateSeriesResult(0.01, nil, metric, *logCtx)
+	assert.NoError(t, err)
+	assert.Equal(t, v1alpha1.AnalysisPhaseSuccessful, status)
+}
+
 func TestEvaluateConditionWithSuccess(t *testing.T) {
 	b, err := EvalCondition(true, "result == true")
 	assert.Nil(t, err)
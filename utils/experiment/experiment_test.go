@@ -102,14 +102,14 @@ func TestReplicaSetNameFromExperiment(t *testing.T) {
 			Name: "foo",
 		},
 	}
-	assert.Equal(t, "foo-template-76bbb58f74", ReplicasetNameFromExperiment(e, template))
+	assert.Equal(t, "foo-template-5859f96865", ReplicasetNameFromExperiment(e, template))
 
 	newTemplateStatus := v1alpha1.TemplateStatus{
 		Name:           templateName,
 		CollisionCount: pointer.Int32Ptr(1),
 	}
 	e.Status.TemplateStatuses = append(e.Status.TemplateStatuses, newTemplateStatus)
-	assert.Equal(t, "foo-template-688c48b575", ReplicasetNameFromExperiment(e, template))
+	assert.Equal(t, "foo-template-648d6bd896", ReplicasetNameFromExperiment(e, template))
 }
 
 func TestExperimentByCreationTimestamp(t *testing.T) {
@@ -0,0 +1,135 @@
+package freeze
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap in the controller namespace holding the freezes
+	ConfigMapName = "argo-rollouts-config"
+	// ConfigMapKey is the key of the ConfigMap holding the freezes
+	ConfigMapKey = "freeze"
+)
+
+// Freeze is an emergency stop holding the updating rollouts in its scope at their current step
+type Freeze struct {
+	// Namespace restricts the freeze to the rollouts of a namespace. Empty freezes all namespaces
+	Namespace string `json:"namespace,omitempty"`
+	// Selector restricts the freeze to the rollouts with matching labels. Nil freezes all rollouts
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Reason explains the freeze
+	Reason string `json:"reason,omitempty"`
+	// FrozenAt is when the freeze started
+	FrozenAt metav1.Time `json:"frozenAt,omitempty"`
+}
+
+// Matches returns whether the rollout is in the scope of the freeze
+func (f *Freeze) Matches(ro *v1alpha1.Rollout) bool {
+	if f.Namespace != "" && f.Namespace != ro.Namespace {
+		return false
+	}
+	if f.Selector == nil {
+		return true
+	}
+	selector, err := metav1.LabelSelectorAsSelector(f.Selector)
+	if err != nil {
+		return false
+	}
+	return selector.Matches(labels.Set(ro.Labels))
+}
+
+// SameScope returns whether both freezes hold the same rollouts
+func (f *Freeze) SameScope(other Freeze) bool {
+	return f.Namespace == other.Namespace && selectorString(f.Selector) == selectorString(other.Selector)
+}
+
+// Scope returns a description of the rollouts held by the freeze
+func (f *Freeze) Scope() string {
+	scope := "all namespaces"
+	if f.Namespace != "" {
+		scope = fmt.Sprintf("namespace '%s'", f.Namespace)
+	}
+	if selector := selectorString(f.Selector); selector != "" {
+		scope = fmt.Sprintf("%s with labels '%s'", scope, selector)
+	}
+	return scope
+}
+
+// Message returns the message explaining why a rollout is held by the freeze
+func (f *Freeze) Message() string {
+	message := fmt.Sprintf("emergency stop of %s", f.Scope())
+	if f.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, f.Reason)
+	}
+	return message
+}
+
+func selectorString(selector *metav1.LabelSelector) string {
+	if selector == nil {
+		return ""
+	}
+	s, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		return metav1.FormatLabelSelector(selector)
+	}
+	return s.String()
+}
+
+// GetFreezes returns the freezes of the ConfigMap
+func GetFreezes(cm *corev1.ConfigMap) ([]Freeze, error) {
+	if cm == nil {
+		return nil, nil
+	}
+	data := strings.TrimSpace(cm.Data[ConfigMapKey])
+	if data == "" {
+		return nil, nil
+	}
+	var freezes []Freeze
+	if err := yaml.Unmarshal([]byte(data), &freezes); err != nil {
+		return nil, fmt.Errorf("invalid '%s' key of ConfigMap '%s': %w", ConfigMapKey, cm.Name, err)
+	}
+	for i := range freezes {
+		if freezes[i].Selector == nil {
+			continue
+		}
+		if _, err := metav1.LabelSelectorAsSelector(freezes[i].Selector); err != nil {
+			return nil, fmt.Errorf("invalid selector of freeze of %s: %w", freezes[i].Scope(), err)
+		}
+	}
+	return freezes, nil
+}
+
+// SetFreezes stores the freezes in the ConfigMap, removing its key when there are none
+func SetFreezes(cm *corev1.ConfigMap, freezes []Freeze) error {
+	if len(freezes) == 0 {
+		delete(cm.Data, ConfigMapKey)
+		return nil
+	}
+	data, err := yaml.Marshal(freezes)
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[ConfigMapKey] = string(data)
+	return nil
+}
+
+// MatchingFreeze returns the first freeze holding the rollout, or nil when it is not frozen
+func MatchingFreeze(freezes []Freeze, ro *v1alpha1.Rollout) *Freeze {
+	for i := range freezes {
+		if freezes[i].Matches(ro) {
+			return &freezes[i]
+		}
+	}
+	return nil
+}
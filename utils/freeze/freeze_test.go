@@ -0,0 +1,76 @@
+package freeze
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func newRollout(namespace string, labels map[string]string) *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "guestbook",
+			Namespace: namespace,
+			Labels:    labels,
+		},
+	}
+}
+
+func TestMatches(t *testing.T) {
+	frontend := &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}
+	ro := newRollout("payments", map[string]string{"tier": "frontend"})
+
+	assert.True(t, (&Freeze{}).Matches(ro))
+	assert.True(t, (&Freeze{Namespace: "payments"}).Matches(ro))
+	assert.False(t, (&Freeze{Namespace: "other"}).Matches(ro))
+	assert.True(t, (&Freeze{Namespace: "payments", Selector: frontend}).Matches(ro))
+	assert.False(t, (&Freeze{Selector: frontend}).Matches(newRollout("payments", nil)))
+}
+
+func TestScope(t *testing.T) {
+	frontend := &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}
+	assert.Equal(t, "all namespaces", (&Freeze{}).Scope())
+	assert.Equal(t, "namespace 'payments' with labels 'tier=frontend'", (&Freeze{Namespace: "payments", Selector: frontend}).Scope())
+	assert.Equal(t, "emergency stop of all namespaces with labels 'tier=frontend': INC-42", (&Freeze{Selector: frontend, Reason: "INC-42"}).Message())
+
+	f := Freeze{Namespace: "payments", Selector: frontend}
+	assert.True(t, f.SameScope(Freeze{Namespace: "payments", Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "frontend"}}, Reason: "other"}))
+	assert.False(t, f.SameScope(Freeze{Namespace: "payments"}))
+}
+
+func TestGetSetFreezes(t *testing.T) {
+	cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName}}
+	freezes, err := GetFreezes(cm)
+	assert.NoError(t, err)
+	assert.Empty(t, freezes)
+
+	assert.NoError(t, SetFreezes(cm, []Freeze{{Namespace: "payments", Reason: "INC-42"}, {}}))
+	freezes, err = GetFreezes(cm)
+	assert.NoError(t, err)
+	if assert.Len(t, freezes, 2) {
+		assert.Equal(t, "payments", freezes[0].Namespace)
+		assert.Equal(t, "INC-42", freezes[0].Reason)
+		assert.Equal(t, &freezes[1], MatchingFreeze(freezes, newRollout("default", nil)))
+	}
+	assert.Nil(t, MatchingFreeze(freezes[:1], newRollout("default", nil)))
+
+	assert.NoError(t, SetFreezes(cm, nil))
+	assert.NotContains(t, cm.Data, ConfigMapKey)
+}
+
+func TestGetFreezesInvalid(t *testing.T) {
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ConfigMapName},
+		Data:       map[string]string{ConfigMapKey: "namespace: payments"},
+	}
+	_, err := GetFreezes(cm)
+	assert.Error(t, err)
+
+	cm.Data[ConfigMapKey] = `- selector: {matchExpressions: [{key: tier, operator: Bogus}]}`
+	_, err = GetFreezes(cm)
+	assert.Error(t, err)
+}
@@ -6,15 +6,44 @@ import (
 	"fmt"
 	"hash/fnv"
 
+	log "github.com/sirupsen/logrus"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
 )
 
-// ComputePodTemplateHash returns a hash value calculated from pod template.
+// normalizePodTemplate normalizes the pod templates to hash. It is a variable so that tests can
+// make the normalization fail.
+var normalizePodTemplate = NormalizePodTemplate
+
+// ComputePodTemplateHash returns a hash value calculated from the normalized pod template, which
+// does not change when the API server defaults fields of the pod template (see NormalizePodTemplate).
+// If the pod template cannot be normalized, the legacy hash of the pod template is returned instead.
 // The hash will be safe encoded to avoid bad words.
 func ComputePodTemplateHash(template *corev1.PodTemplateSpec, collisionCount *int32) string {
+	normalized, err := normalizePodTemplate(template)
+	if err != nil {
+		log.Warnf("Failed to normalize pod template, falling back to the legacy pod template hash: %v", err)
+		return ComputeLegacyPodTemplateHash(template, collisionCount)
+	}
+	return computeHash(normalized, collisionCount)
+}
+
+// ComputeLegacyPodTemplateHash returns the hash value calculated from the pod template as is. It
+// was used before pod templates were normalized, and is only kept to recognize the ReplicaSets
+// created with it.
+func ComputeLegacyPodTemplateHash(template *corev1.PodTemplateSpec, collisionCount *int32) string {
+	return computeHash(template, collisionCount)
+}
+
+// PodTemplateHashMatches returns whether the given hash is the hash of the pod template, with
+// either the current or the legacy hashing scheme
+func PodTemplateHashMatches(template *corev1.PodTemplateSpec, collisionCount *int32, podHash string) bool {
+	return podHash == ComputePodTemplateHash(template, collisionCount) || podHash == ComputeLegacyPodTemplateHash(template, collisionCount)
+}
+
+func computeHash(obj interface{}, collisionCount *int32) string {
 	podTemplateSpecHasher := fnv.New32a()
-	stepsBytes, err := json.Marshal(template)
+	stepsBytes, err := json.Marshal(obj)
 	if err != nil {
 		panic(err)
 	}
@@ -32,3 +61,9 @@ func ComputePodTemplateHash(template *corev1.PodTemplateSpec, collisionCount *in
 	}
 	return rand.SafeEncodeString(fmt.Sprint(podTemplateSpecHasher.Sum32()))
 }
+
+// ComputePodSpecPatchHash returns a hash value calculated from a pod spec patch, which does not
+// change with the formatting of the patch. The hash will be safe encoded to avoid bad words.
+func ComputePodSpecPatchHash(patch []byte) string {
+	return computeHash(json.RawMessage(patch), nil)
+}
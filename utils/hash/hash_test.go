@@ -1,8 +1,13 @@
 package hash
 
 import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/ghodss/yaml"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -24,6 +29,133 @@ func TestHashUtils(t *testing.T) {
 	})
 }
 
+func TestLegacyPodTemplateHash(t *testing.T) {
+	// The legacy hash must never change, since it identifies ReplicaSets created before pod
+	// templates were normalized
+	assert.Equal(t, "76bbb58f74", ComputeLegacyPodTemplateHash(&corev1.PodTemplateSpec{}, nil))
+	assert.Equal(t, "688c48b575", ComputeLegacyPodTemplateHash(&corev1.PodTemplateSpec{}, pointer.Int32(1)))
+}
+
+func TestPodTemplateHashMatches(t *testing.T) {
+	template := generatePodTemplate("red")
+	assert.True(t, PodTemplateHashMatches(&template, nil, ComputePodTemplateHash(&template, nil)))
+	assert.True(t, PodTemplateHashMatches(&template, nil, ComputeLegacyPodTemplateHash(&template, nil)))
+	assert.False(t, PodTemplateHashMatches(&template, pointer.Int32(1), ComputePodTemplateHash(&template, nil)))
+
+	blue := generatePodTemplate("blue")
+	assert.False(t, PodTemplateHashMatches(&blue, nil, ComputePodTemplateHash(&template, nil)))
+}
+
+func TestPodTemplateHashNormalizationFailure(t *testing.T) {
+	normalizePodTemplate = func(template *corev1.PodTemplateSpec) (map[string]interface{}, error) {
+		return nil, errors.New("intentional error")
+	}
+	defer func() { normalizePodTemplate = NormalizePodTemplate }()
+
+	template := generatePodTemplate("red")
+	assert.NotPanics(t, func() {
+		assert.Equal(t, ComputeLegacyPodTemplateHash(&template, nil), ComputePodTemplateHash(&template, nil))
+	})
+}
+
+func TestComputePodSpecPatchHash(t *testing.T) {
+	patch := ComputePodSpecPatchHash([]byte(`{"nodeSelector":{"pool":"canary"}}`))
+	assert.Equal(t, patch, ComputePodSpecPatchHash([]byte(`{ "nodeSelector": { "pool": "canary" } }`)))
+	assert.NotEqual(t, patch, ComputePodSpecPatchHash([]byte(`{"nodeSelector":{"pool":"debug"}}`)))
+}
+
+func TestNormalizePodTemplate(t *testing.T) {
+	template := generatePodTemplate("red:v1")
+	template.Spec.Containers[0].Ports = []corev1.ContainerPort{{ContainerPort: 80, Protocol: corev1.ProtocolTCP}}
+	template.Spec.TerminationGracePeriodSeconds = pointer.Int64(30)
+
+	normalized, err := NormalizePodTemplate(&template)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"name": "red:v1"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":  "red:v1",
+					"image": "red:v1",
+					// not the default for an image with a tag
+					"imagePullPolicy": "Always",
+					"ports": []interface{}{
+						map[string]interface{}{"containerPort": int64(80)},
+					},
+				},
+			},
+		},
+	}, normalized)
+
+	// explicit defaults do not change the hash
+	template.Spec.Containers[0].TerminationMessagePolicy = corev1.TerminationMessageReadFile
+	template.Spec.SchedulerName = corev1.DefaultSchedulerName
+	normalizedWithDefaults, err := NormalizePodTemplate(&template)
+	assert.NoError(t, err)
+	assert.Equal(t, normalized, normalizedWithDefaults)
+
+	// the result can be modified without affecting later calls
+	normalizedWithDefaults["spec"] = nil
+	normalizedAgain, err := NormalizePodTemplate(&template)
+	assert.NoError(t, err)
+	assert.Equal(t, normalized, normalizedAgain)
+
+	// a pod template with only defaults normalizes to nothing, as the metadata and spec are structs
+	normalizedEmpty, err := NormalizePodTemplate(&corev1.PodTemplateSpec{Spec: corev1.PodSpec{RestartPolicy: corev1.RestartPolicyAlways}})
+	assert.NoError(t, err)
+	assert.Empty(t, normalizedEmpty)
+}
+
+// TestPodTemplateHashCorpus verifies that pod templates serialized under different API defaults
+// have the same hash, and that the hash does not change with library upgrades
+func TestPodTemplateHashCorpus(t *testing.T) {
+	dirs, err := os.ReadDir("testdata")
+	assert.NoError(t, err)
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+		t.Run(dir.Name(), func(t *testing.T) {
+			expected, err := os.ReadFile(filepath.Join("testdata", dir.Name(), "hash"))
+			assert.NoError(t, err)
+			files, err := filepath.Glob(filepath.Join("testdata", dir.Name(), "*.yaml"))
+			assert.NoError(t, err)
+			assert.True(t, len(files) > 1)
+			for _, file := range files {
+				data, err := os.ReadFile(file)
+				assert.NoError(t, err)
+				var template corev1.PodTemplateSpec
+				assert.NoError(t, yaml.UnmarshalStrict(data, &template))
+				assert.Equal(t, strings.TrimSpace(string(expected)), ComputePodTemplateHash(&template, nil), file)
+			}
+		})
+	}
+}
+
+func BenchmarkComputePodTemplateHash(b *testing.B) {
+	data, err := os.ReadFile(filepath.Join("testdata", "probes-and-volumes", "authored.yaml"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	var template corev1.PodTemplateSpec
+	if err := yaml.UnmarshalStrict(data, &template); err != nil {
+		b.Fatal(err)
+	}
+	b.Run("normalized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ComputePodTemplateHash(&template, nil)
+		}
+	})
+	b.Run("legacy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ComputeLegacyPodTemplateHash(&template, nil)
+		}
+	})
+}
+
 func generatePodTemplate(image string) corev1.PodTemplateSpec {
 	podLabels := map[string]string{"name": image}
 
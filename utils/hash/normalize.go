@@ -0,0 +1,230 @@
+package hash
+
+import (
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kubernetes/pkg/util/parsers"
+)
+
+// NormalizePodTemplate returns the content of the pod template without the fields which hold the
+// value the API server defaults them to. A pod template as written by a user and the same pod
+// template as returned by the API server normalize to the same content, and so does a pod template
+// which a newer Kubernetes version populates with additional defaults.
+//
+// The defaulted fields are the ones of the core/v1 PodTemplate defaulting functions, which are
+// stripped from the unstructured content of the pod template when they hold their default value.
+// Unset and empty fields are stripped as well.
+func NormalizePodTemplate(template *corev1.PodTemplateSpec) (map[string]interface{}, error) {
+	template = template.DeepCopy()
+	roundUpResources(&template.Spec)
+	normalized, err := runtime.DefaultUnstructuredConverter.ToUnstructured(template)
+	if err != nil {
+		return nil, err
+	}
+	if spec, ok := normalized["spec"].(map[string]interface{}); ok {
+		stripPodSpecDefaults(spec)
+	}
+	pruneEmpty(normalized)
+	return normalized, nil
+}
+
+// roundUpResources rounds up resource quantities to milli scale, as the API server does
+func roundUpResources(spec *corev1.PodSpec) {
+	roundUp := func(list corev1.ResourceList) {
+		for name, quantity := range list {
+			quantity.RoundUp(resource.Milli)
+			list[name] = quantity
+		}
+	}
+	for i := range spec.InitContainers {
+		roundUp(spec.InitContainers[i].Resources.Limits)
+		roundUp(spec.InitContainers[i].Resources.Requests)
+	}
+	for i := range spec.Containers {
+		roundUp(spec.Containers[i].Resources.Limits)
+		roundUp(spec.Containers[i].Resources.Requests)
+	}
+	for i := range spec.EphemeralContainers {
+		roundUp(spec.EphemeralContainers[i].Resources.Limits)
+		roundUp(spec.EphemeralContainers[i].Resources.Requests)
+	}
+	for i := range spec.Volumes {
+		if ephemeral := spec.Volumes[i].Ephemeral; ephemeral != nil && ephemeral.VolumeClaimTemplate != nil {
+			roundUp(ephemeral.VolumeClaimTemplate.Spec.Resources.Limits)
+			roundUp(ephemeral.VolumeClaimTemplate.Spec.Resources.Requests)
+		}
+	}
+}
+
+func stripPodSpecDefaults(spec map[string]interface{}) {
+	stripDefault(spec, "dnsPolicy", string(corev1.DNSClusterFirst))
+	stripDefault(spec, "restartPolicy", string(corev1.RestartPolicyAlways))
+	stripDefault(spec, "terminationGracePeriodSeconds", int64(corev1.DefaultTerminationGracePeriodSeconds))
+	stripDefault(spec, "schedulerName", corev1.DefaultSchedulerName)
+	stripDefault(spec, "securityContext", map[string]interface{}{})
+
+	// The API server populates the deprecated serviceAccount and serviceAccountName from each
+	// other, so only serviceAccountName is kept when they are the same
+	serviceAccountName, _ := spec["serviceAccountName"].(string)
+	if serviceAccountName == "" {
+		serviceAccountName, _ = spec["serviceAccount"].(string)
+	}
+	if serviceAccountName != "" {
+		spec["serviceAccountName"] = serviceAccountName
+		stripDefault(spec, "serviceAccount", serviceAccountName)
+	}
+
+	hostNetwork, _ := spec["hostNetwork"].(bool)
+	for _, key := range []string{"initContainers", "containers", "ephemeralContainers"} {
+		for _, container := range objects(spec[key]) {
+			stripContainerDefaults(container, hostNetwork && key != "ephemeralContainers")
+		}
+	}
+	for _, volume := range objects(spec["volumes"]) {
+		stripVolumeDefaults(volume)
+	}
+}
+
+func stripContainerDefaults(container map[string]interface{}, hostNetwork bool) {
+	image, _ := container["image"].(string)
+	// the error is ignored as the API server does, which assumes the image was validated
+	_, tag, _, _ := parsers.ParseImageName(image)
+	if tag == "latest" {
+		stripDefault(container, "imagePullPolicy", string(corev1.PullAlways))
+	} else {
+		stripDefault(container, "imagePullPolicy", string(corev1.PullIfNotPresent))
+	}
+	stripDefault(container, "terminationMessagePath", corev1.TerminationMessagePathDefault)
+	stripDefault(container, "terminationMessagePolicy", string(corev1.TerminationMessageReadFile))
+	for _, port := range objects(container["ports"]) {
+		stripDefault(port, "protocol", string(corev1.ProtocolTCP))
+		if hostNetwork {
+			// the host port of a pod on the host network defaults to the container port
+			stripDefault(port, "hostPort", port["containerPort"])
+		}
+	}
+	for _, env := range objects(container["env"]) {
+		stripFieldRefDefaults(object(env, "valueFrom", "fieldRef"))
+	}
+	for _, key := range []string{"livenessProbe", "readinessProbe", "startupProbe"} {
+		probe := object(container, key)
+		stripDefault(probe, "timeoutSeconds", int64(1))
+		stripDefault(probe, "periodSeconds", int64(10))
+		stripDefault(probe, "successThreshold", int64(1))
+		stripDefault(probe, "failureThreshold", int64(3))
+		stripHTTPGetDefaults(object(probe, "httpGet"))
+		stripDefault(object(probe, "grpc"), "service", "")
+	}
+	for _, key := range []string{"postStart", "preStop"} {
+		stripHTTPGetDefaults(object(container, "lifecycle", key, "httpGet"))
+	}
+}
+
+func stripVolumeDefaults(volume map[string]interface{}) {
+	stripDefault(object(volume, "hostPath"), "type", string(corev1.HostPathUnset))
+	stripDefault(object(volume, "secret"), "defaultMode", int64(corev1.SecretVolumeSourceDefaultMode))
+	stripDefault(object(volume, "configMap"), "defaultMode", int64(corev1.ConfigMapVolumeSourceDefaultMode))
+	stripDefault(object(volume, "downwardAPI"), "defaultMode", int64(corev1.DownwardAPIVolumeSourceDefaultMode))
+	for _, item := range objects(object(volume, "downwardAPI")["items"]) {
+		stripFieldRefDefaults(object(item, "fieldRef"))
+	}
+	projected := object(volume, "projected")
+	stripDefault(projected, "defaultMode", int64(corev1.ProjectedVolumeSourceDefaultMode))
+	for _, source := range objects(projected["sources"]) {
+		for _, item := range objects(object(source, "downwardAPI")["items"]) {
+			stripFieldRefDefaults(object(item, "fieldRef"))
+		}
+		stripDefault(object(source, "serviceAccountToken"), "expirationSeconds", int64(3600))
+	}
+	stripDefault(object(volume, "iscsi"), "iscsiInterface", "default")
+	rbd := object(volume, "rbd")
+	stripDefault(rbd, "pool", "rbd")
+	stripDefault(rbd, "user", "admin")
+	stripDefault(rbd, "keyring", "/etc/ceph/keyring")
+	scaleIO := object(volume, "scaleIO")
+	stripDefault(scaleIO, "storageMode", "ThinProvisioned")
+	stripDefault(scaleIO, "fsType", "xfs")
+	azureDisk := object(volume, "azureDisk")
+	stripDefault(azureDisk, "cachingMode", string(corev1.AzureDataDiskCachingReadWrite))
+	stripDefault(azureDisk, "kind", string(corev1.AzureSharedBlobDisk))
+	stripDefault(azureDisk, "fsType", "ext4")
+	stripDefault(azureDisk, "readOnly", false)
+	stripDefault(object(volume, "ephemeral", "volumeClaimTemplate", "spec"), "volumeMode", string(corev1.PersistentVolumeFilesystem))
+	// a volume without a source defaults to an empty dir. Other empty sources are kept, since
+	// they do not default to an empty dir.
+	if len(volume) == 2 {
+		stripDefault(volume, "emptyDir", map[string]interface{}{})
+	}
+}
+
+func stripHTTPGetDefaults(httpGet map[string]interface{}) {
+	stripDefault(httpGet, "path", "/")
+	stripDefault(httpGet, "scheme", string(corev1.URISchemeHTTP))
+}
+
+func stripFieldRefDefaults(fieldRef map[string]interface{}) {
+	stripDefault(fieldRef, "apiVersion", "v1")
+}
+
+// stripDefault removes the field from obj if it holds the default value. obj may be nil.
+func stripDefault(obj map[string]interface{}, field string, defaultValue interface{}) {
+	if value, ok := obj[field]; ok && reflect.DeepEqual(value, defaultValue) {
+		delete(obj, field)
+	}
+}
+
+// object returns the object at the path of fields in obj, or nil if there is none
+func object(obj map[string]interface{}, fields ...string) map[string]interface{} {
+	for _, field := range fields {
+		obj, _ = obj[field].(map[string]interface{})
+	}
+	return obj
+}
+
+// objects returns the objects of a list
+func objects(value interface{}) []map[string]interface{} {
+	list, _ := value.([]interface{})
+	var objs []map[string]interface{}
+	for _, item := range list {
+		if obj, ok := item.(map[string]interface{}); ok {
+			objs = append(objs, obj)
+		}
+	}
+	return objs
+}
+
+// structFields are the fields of a pod template which are structs rather than pointers to
+// structs, and so are serialized as empty objects when they are not set
+var structFields = map[string]bool{
+	"metadata":  true,
+	"spec":      true,
+	"resources": true,
+}
+
+// pruneEmpty removes the null fields, empty lists and unset struct fields of obj and of the
+// objects it contains
+func pruneEmpty(obj map[string]interface{}) {
+	for field, value := range obj {
+		switch typedValue := value.(type) {
+		case nil:
+			delete(obj, field)
+		case map[string]interface{}:
+			pruneEmpty(typedValue)
+			if len(typedValue) == 0 && structFields[field] {
+				delete(obj, field)
+			}
+		case []interface{}:
+			if len(typedValue) == 0 {
+				delete(obj, field)
+			}
+			for _, item := range typedValue {
+				if itemObj, ok := item.(map[string]interface{}); ok {
+					pruneEmpty(itemObj)
+				}
+			}
+		}
+	}
+}
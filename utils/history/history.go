@@ -0,0 +1,186 @@
+package history
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+	replicasetutil "github.com/argoproj/argo-rollouts/utils/replicaset"
+)
+
+// Snapshot is the content of a revision snapshot. It holds everything needed to recreate the
+// ReplicaSet of a revision after the ReplicaSet itself was deleted.
+type Snapshot struct {
+	// PodTemplateHash is the pod template hash of the revision
+	PodTemplateHash string `json:"podTemplateHash"`
+	// Labels are the labels of the ReplicaSet
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are the annotations of the ReplicaSet
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// MinReadySeconds of the ReplicaSet
+	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
+	// Selector is the selector of the ReplicaSet. The selector of a rollout referencing a workload
+	// is the workload's selector, which is only resolved by the controller, so it is snapshotted.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+	// Template is the pod template of the ReplicaSet
+	Template corev1.PodTemplateSpec `json:"template"`
+}
+
+var rolloutKind = v1alpha1.SchemeGroupVersion.WithKind("Rollout")
+
+// annotationsToSkip are ReplicaSet annotations which only describe the current state of a
+// ReplicaSet and are not part of a revision
+var annotationsToSkip = map[string]bool{
+	v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey: true,
+}
+
+// SnapshotName returns the name of the revision snapshot of the given pod template hash
+func SnapshotName(ro *v1alpha1.Rollout, podHash string) string {
+	return fmt.Sprintf("%s-%s", ro.Name, podHash)
+}
+
+// NewSnapshot returns a ControllerRevision owned by the rollout which snapshots the given ReplicaSet
+func NewSnapshot(ro *v1alpha1.Rollout, rs *appsv1.ReplicaSet) (*appsv1.ControllerRevision, error) {
+	podHash := replicasetutil.GetPodTemplateHash(rs)
+	if podHash == "" {
+		return nil, fmt.Errorf("ReplicaSet %s has no %s label", rs.Name, v1alpha1.DefaultRolloutUniqueLabelKey)
+	}
+	revision, err := replicasetutil.Revision(rs)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := Snapshot{
+		PodTemplateHash: podHash,
+		Labels:          rs.Labels,
+		MinReadySeconds: rs.Spec.MinReadySeconds,
+		Selector:        rs.Spec.Selector,
+		Template:        rs.Spec.Template,
+	}
+	for k, v := range rs.Annotations {
+		if annotationsToSkip[k] {
+			continue
+		}
+		if snapshot.Annotations == nil {
+			snapshot.Annotations = map[string]string{}
+		}
+		snapshot.Annotations[k] = v
+	}
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      SnapshotName(ro, podHash),
+			Namespace: ro.Namespace,
+			Labels: map[string]string{
+				v1alpha1.RevisionSnapshotLabelKey:     ro.Name,
+				v1alpha1.DefaultRolloutUniqueLabelKey: podHash,
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, rolloutKind)},
+		},
+		Data:     runtime.RawExtension{Raw: data},
+		Revision: revision,
+	}, nil
+}
+
+// GetSnapshot decodes the content of a revision snapshot
+func GetSnapshot(cr *appsv1.ControllerRevision) (*Snapshot, error) {
+	var snapshot Snapshot
+	if err := json.Unmarshal(cr.Data.Raw, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode revision snapshot %s: %w", cr.Name, err)
+	}
+	if snapshot.PodTemplateHash == "" {
+		return nil, fmt.Errorf("revision snapshot %s has no pod template hash", cr.Name)
+	}
+	return &snapshot, nil
+}
+
+// GetPodTemplateHash returns the pod template hash of the revision snapshot
+func GetPodTemplateHash(cr *appsv1.ControllerRevision) string {
+	if cr.Labels == nil {
+		return ""
+	}
+	return cr.Labels[v1alpha1.DefaultRolloutUniqueLabelKey]
+}
+
+// FilterSnapshots returns the revision snapshots controlled by the rollout
+func FilterSnapshots(ro *v1alpha1.Rollout, crs []*appsv1.ControllerRevision) []*appsv1.ControllerRevision {
+	var snapshots []*appsv1.ControllerRevision
+	for _, cr := range crs {
+		if cr.Labels[v1alpha1.RevisionSnapshotLabelKey] == ro.Name && metav1.IsControlledBy(cr, ro) {
+			snapshots = append(snapshots, cr)
+		}
+	}
+	return snapshots
+}
+
+// SortByRevision sorts the revision snapshots from the oldest to the latest revision
+func SortByRevision(snapshots []*appsv1.ControllerRevision) {
+	sort.SliceStable(snapshots, func(i, j int) bool {
+		if snapshots[i].Revision == snapshots[j].Revision {
+			return snapshots[i].Name < snapshots[j].Name
+		}
+		return snapshots[i].Revision < snapshots[j].Revision
+	})
+}
+
+// NewReplicaSetFromSnapshot returns a scaled down ReplicaSet of the rollout built from a revision
+// snapshot. The ReplicaSet has the same name, labels and pod template as the snapshotted one, so
+// the rollout controller picks it up as soon as the rollout's pod template matches it again.
+func NewReplicaSetFromSnapshot(ro *v1alpha1.Rollout, cr *appsv1.ControllerRevision) (*appsv1.ReplicaSet, error) {
+	snapshot, err := GetSnapshot(cr)
+	if err != nil {
+		return nil, err
+	}
+	rsLabels := map[string]string{}
+	for k, v := range snapshot.Labels {
+		rsLabels[k] = v
+	}
+	rsLabels[v1alpha1.DefaultRolloutUniqueLabelKey] = snapshot.PodTemplateHash
+	template := *snapshot.Template.DeepCopy()
+	if template.Labels == nil {
+		template.Labels = map[string]string{}
+	}
+	template.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = snapshot.PodTemplateHash
+	// snapshots taken before the selector was snapshotted fall back to the rollout's selector
+	selector := snapshot.Selector.DeepCopy()
+	if selector == nil {
+		selector = ro.Spec.Selector.DeepCopy()
+	}
+	if selector == nil {
+		selector = &metav1.LabelSelector{}
+	}
+	if selector.MatchLabels == nil {
+		selector.MatchLabels = map[string]string{}
+	}
+	selector.MatchLabels[v1alpha1.DefaultRolloutUniqueLabelKey] = snapshot.PodTemplateHash
+	rsAnnotations := map[string]string{}
+	for k, v := range snapshot.Annotations {
+		rsAnnotations[k] = v
+	}
+	rsAnnotations[annotations.RevisionAnnotation] = fmt.Sprintf("%d", cr.Revision)
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            SnapshotName(ro, snapshot.PodTemplateHash),
+			Namespace:       ro.Namespace,
+			Labels:          rsLabels,
+			Annotations:     rsAnnotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, rolloutKind)},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas:        pointer.Int32Ptr(0),
+			MinReadySeconds: snapshot.MinReadySeconds,
+			Selector:        selector,
+			Template:        template,
+		},
+	}, nil
+}
@@ -0,0 +1,143 @@
+package history
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+func newRollout() *v1alpha1.Rollout {
+	return &v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "foo",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "foo-uid",
+		},
+		Spec: v1alpha1.RolloutSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+		},
+	}
+}
+
+func newReplicaSet(ro *v1alpha1.Rollout, podHash string, revision string) *appsv1.ReplicaSet {
+	rsLabels := map[string]string{"app": "foo", v1alpha1.DefaultRolloutUniqueLabelKey: podHash}
+	return &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ro.Name + "-" + podHash,
+			Namespace: ro.Namespace,
+			Labels:    rsLabels,
+			Annotations: map[string]string{
+				annotations.RevisionAnnotation:                           revision,
+				annotations.DesiredReplicasAnnotation:                    "3",
+				v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey: "2021-01-01T00:00:00Z",
+			},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, rolloutKind)},
+		},
+		Spec: appsv1.ReplicaSetSpec{
+			Replicas:        pointer.Int32Ptr(3),
+			MinReadySeconds: 10,
+			Selector:        metav1.SetAsLabelSelector(rsLabels),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: rsLabels},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "foo", Image: "foo:" + revision}},
+				},
+			},
+		},
+	}
+}
+
+func TestNewSnapshot(t *testing.T) {
+	ro := newRollout()
+	rs := newReplicaSet(ro, "abc123", "4")
+
+	cr, err := NewSnapshot(ro, rs)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo-abc123", cr.Name)
+	assert.Equal(t, int64(4), cr.Revision)
+	assert.Equal(t, "foo", cr.Labels[v1alpha1.RevisionSnapshotLabelKey])
+	assert.Equal(t, "abc123", GetPodTemplateHash(cr))
+	assert.True(t, metav1.IsControlledBy(cr, ro))
+
+	snapshot, err := GetSnapshot(cr)
+	assert.NoError(t, err)
+	assert.Equal(t, "abc123", snapshot.PodTemplateHash)
+	assert.Equal(t, rs.Spec.Template, snapshot.Template)
+	assert.Equal(t, int32(10), snapshot.MinReadySeconds)
+	assert.Equal(t, "3", snapshot.Annotations[annotations.DesiredReplicasAnnotation])
+	assert.NotContains(t, snapshot.Annotations, v1alpha1.DefaultReplicaSetScaleDownDeadlineAnnotationKey)
+}
+
+func TestNewSnapshotWithoutPodTemplateHash(t *testing.T) {
+	ro := newRollout()
+	rs := newReplicaSet(ro, "abc123", "4")
+	delete(rs.Labels, v1alpha1.DefaultRolloutUniqueLabelKey)
+
+	_, err := NewSnapshot(ro, rs)
+	assert.Error(t, err)
+}
+
+func TestGetSnapshotInvalid(t *testing.T) {
+	_, err := GetSnapshot(&appsv1.ControllerRevision{Data: runtime.RawExtension{Raw: []byte(`not json`)}})
+	assert.Error(t, err)
+
+	_, err = GetSnapshot(&appsv1.ControllerRevision{Data: runtime.RawExtension{Raw: []byte(`{}`)}})
+	assert.Error(t, err)
+}
+
+func TestNewReplicaSetFromSnapshot(t *testing.T) {
+	ro := newRollout()
+	rs := newReplicaSet(ro, "abc123", "4")
+	cr, err := NewSnapshot(ro, rs)
+	assert.NoError(t, err)
+
+	restored, err := NewReplicaSetFromSnapshot(ro, cr)
+	assert.NoError(t, err)
+	assert.Equal(t, rs.Name, restored.Name)
+	assert.Equal(t, rs.Labels, restored.Labels)
+	assert.Equal(t, rs.Spec.Selector, restored.Spec.Selector)
+	assert.Equal(t, rs.Spec.Template, restored.Spec.Template)
+	assert.Equal(t, rs.Spec.MinReadySeconds, restored.Spec.MinReadySeconds)
+	assert.Equal(t, int32(0), *restored.Spec.Replicas)
+	assert.Equal(t, "4", restored.Annotations[annotations.RevisionAnnotation])
+	assert.True(t, metav1.IsControlledBy(restored, ro))
+}
+
+func TestNewReplicaSetFromSnapshotWorkloadRef(t *testing.T) {
+	ro := newRollout()
+	rs := newReplicaSet(ro, "abc123", "4")
+	cr, err := NewSnapshot(ro, rs)
+	assert.NoError(t, err)
+
+	// the selector of a rollout referencing a workload is only resolved by the controller
+	ro.Spec.Selector = nil
+	ro.Spec.WorkloadRef = &v1alpha1.ObjectRef{APIVersion: "apps/v1", Kind: "Deployment", Name: "foo"}
+	restored, err := NewReplicaSetFromSnapshot(ro, cr)
+	assert.NoError(t, err)
+	assert.Equal(t, rs.Spec.Selector, restored.Spec.Selector)
+	assert.Equal(t, rs.Spec.Template, restored.Spec.Template)
+}
+
+func TestFilterAndSortSnapshots(t *testing.T) {
+	ro := newRollout()
+	other := newRollout()
+	other.UID = "other-uid"
+
+	cr3, _ := NewSnapshot(ro, newReplicaSet(ro, "ccc", "3"))
+	cr1, _ := NewSnapshot(ro, newReplicaSet(ro, "aaa", "1"))
+	cr2, _ := NewSnapshot(ro, newReplicaSet(ro, "bbb", "2"))
+	orphan, _ := NewSnapshot(other, newReplicaSet(other, "ddd", "4"))
+
+	snapshots := FilterSnapshots(ro, []*appsv1.ControllerRevision{cr3, cr1, orphan, cr2})
+	assert.Len(t, snapshots, 3)
+	SortByRevision(snapshots)
+	assert.Equal(t, []*appsv1.ControllerRevision{cr1, cr2, cr3}, snapshots)
+}
@@ -0,0 +1,133 @@
+package impersonation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	smiclientset "github.com/servicemeshinterface/smi-sdk-go/pkg/gen/client/split/clientset/versioned"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apiserver/pkg/authentication/serviceaccount"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/kubernetes"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+// Clients are the clients the controller uses to mutate the traffic routing objects and services of
+// a rollout
+type Clients struct {
+	// ServiceAccount is the username of the impersonated ServiceAccount. It is empty when the
+	// clients use the identity of the controller.
+	ServiceAccount   string
+	KubeClientSet    kubernetes.Interface
+	DynamicClientSet dynamic.Interface
+	SmiClientSet     smiclientset.Interface
+}
+
+// ServiceAccountError is returned when the ServiceAccount to impersonate for an object is not
+// configured, or is invalid
+type ServiceAccountError struct {
+	msg string
+}
+
+func (e *ServiceAccountError) Error() string {
+	return e.msg
+}
+
+// IsServiceAccountError returns whether an error is, or wraps, a ServiceAccountError
+func IsServiceAccountError(err error) bool {
+	var saErr *ServiceAccountError
+	return errors.As(err, &saErr)
+}
+
+// IsPermissionDenied returns whether an error means that the impersonated ServiceAccount was not
+// permitted to perform a request, or that there was no ServiceAccount to impersonate
+func IsPermissionDenied(err error) bool {
+	return k8serrors.IsForbidden(err) || IsServiceAccountError(err)
+}
+
+// Impersonator builds clients which impersonate a ServiceAccount of the namespace of a rollout, so
+// that the mutations of the controller are limited to the objects the ServiceAccount may modify
+type Impersonator struct {
+	config *rest.Config
+	// namespaceLister is used to read the annotation of the namespaces. When nil, the ServiceAccount
+	// can only be configured on the rollouts.
+	namespaceLister corev1listers.NamespaceLister
+
+	lock    sync.Mutex
+	clients map[string]*Clients
+}
+
+// NewImpersonator returns an Impersonator building its clients from the config of the controller
+func NewImpersonator(config *rest.Config, namespaceLister corev1listers.NamespaceLister) *Impersonator {
+	return &Impersonator{
+		config:          config,
+		namespaceLister: namespaceLister,
+		clients:         map[string]*Clients{},
+	}
+}
+
+// ServiceAccountName returns the name of the ServiceAccount to impersonate for an object with the
+// given annotations. The annotation of the object takes precedence over the one of its namespace.
+func (i *Impersonator) ServiceAccountName(namespace string, objAnnotations map[string]string) (string, error) {
+	name := objAnnotations[annotations.ImpersonateServiceAccountAnnotation]
+	if name == "" && i.namespaceLister != nil {
+		ns, err := i.namespaceLister.Get(namespace)
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return "", err
+		}
+		if ns != nil {
+			name = ns.Annotations[annotations.ImpersonateServiceAccountAnnotation]
+		}
+	}
+	if name == "" {
+		return "", &ServiceAccountError{msg: fmt.Sprintf("no ServiceAccount to impersonate in namespace %s: the %s annotation must be set on the rollout or its namespace", namespace, annotations.ImpersonateServiceAccountAnnotation)}
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", &ServiceAccountError{msg: fmt.Sprintf("invalid ServiceAccount to impersonate '%s': %s", name, strings.Join(errs, ", "))}
+	}
+	return name, nil
+}
+
+// ClientsFor returns the clients impersonating the ServiceAccount configured for an object of a
+// namespace with the given annotations
+func (i *Impersonator) ClientsFor(namespace string, objAnnotations map[string]string) (*Clients, error) {
+	name, err := i.ServiceAccountName(namespace, objAnnotations)
+	if err != nil {
+		return nil, err
+	}
+	username := serviceaccount.MakeUsername(namespace, name)
+
+	i.lock.Lock()
+	defer i.lock.Unlock()
+	if clients, ok := i.clients[username]; ok {
+		return clients, nil
+	}
+	config := rest.CopyConfig(i.config)
+	config.Impersonate = rest.ImpersonationConfig{UserName: username}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	smiClient, err := smiclientset.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	clients := &Clients{
+		ServiceAccount:   username,
+		KubeClientSet:    kubeClient,
+		DynamicClientSet: dynamicClient,
+		SmiClientSet:     smiClient,
+	}
+	i.clients[username] = clients
+	return clients, nil
+}
@@ -0,0 +1,102 @@
+package impersonation
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/argoproj/argo-rollouts/utils/annotations"
+)
+
+func newNamespaceLister(t *testing.T, namespaces ...*corev1.Namespace) corev1listers.NamespaceLister {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, ns := range namespaces {
+		assert.NoError(t, indexer.Add(ns))
+	}
+	return corev1listers.NewNamespaceLister(indexer)
+}
+
+func newNamespace(name, serviceAccount string) *corev1.Namespace {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if serviceAccount != "" {
+		ns.Annotations = map[string]string{annotations.ImpersonateServiceAccountAnnotation: serviceAccount}
+	}
+	return ns
+}
+
+func TestServiceAccountName(t *testing.T) {
+	lister := newNamespaceLister(t, newNamespace("tenant-a", "ns-sa"), newNamespace("tenant-b", ""))
+	impersonator := NewImpersonator(&rest.Config{}, lister)
+
+	t.Run("ObjectAnnotationTakesPrecedence", func(t *testing.T) {
+		name, err := impersonator.ServiceAccountName("tenant-a", map[string]string{annotations.ImpersonateServiceAccountAnnotation: "rollout-sa"})
+		assert.NoError(t, err)
+		assert.Equal(t, "rollout-sa", name)
+	})
+
+	t.Run("NamespaceAnnotation", func(t *testing.T) {
+		name, err := impersonator.ServiceAccountName("tenant-a", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "ns-sa", name)
+	})
+
+	t.Run("NotConfigured", func(t *testing.T) {
+		for _, namespace := range []string{"tenant-b", "does-not-exist"} {
+			_, err := impersonator.ServiceAccountName(namespace, nil)
+			assert.True(t, IsServiceAccountError(err))
+			assert.EqualError(t, err, fmt.Sprintf("no ServiceAccount to impersonate in namespace %s: the %s annotation must be set on the rollout or its namespace", namespace, annotations.ImpersonateServiceAccountAnnotation))
+		}
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := impersonator.ServiceAccountName("tenant-a", map[string]string{annotations.ImpersonateServiceAccountAnnotation: "Not_Valid"})
+		assert.True(t, IsServiceAccountError(err))
+		assert.Contains(t, err.Error(), "invalid ServiceAccount to impersonate 'Not_Valid'")
+	})
+
+	t.Run("WithoutNamespaceLister", func(t *testing.T) {
+		impersonator := NewImpersonator(&rest.Config{}, nil)
+		_, err := impersonator.ServiceAccountName("tenant-a", nil)
+		assert.True(t, IsServiceAccountError(err))
+	})
+}
+
+func TestClientsFor(t *testing.T) {
+	impersonator := NewImpersonator(&rest.Config{Host: "https://localhost:6443"}, nil)
+	objAnnotations := map[string]string{annotations.ImpersonateServiceAccountAnnotation: "tenant"}
+
+	clients, err := impersonator.ClientsFor("tenant-a", objAnnotations)
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:tenant-a:tenant", clients.ServiceAccount)
+	assert.NotNil(t, clients.KubeClientSet)
+	assert.NotNil(t, clients.DynamicClientSet)
+	assert.NotNil(t, clients.SmiClientSet)
+
+	cached, err := impersonator.ClientsFor("tenant-a", objAnnotations)
+	assert.NoError(t, err)
+	assert.Same(t, clients, cached)
+
+	other, err := impersonator.ClientsFor("tenant-b", objAnnotations)
+	assert.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:tenant-b:tenant", other.ServiceAccount)
+	assert.NotSame(t, clients, other)
+
+	_, err = impersonator.ClientsFor("tenant-a", nil)
+	assert.True(t, IsServiceAccountError(err))
+}
+
+func TestIsPermissionDenied(t *testing.T) {
+	assert.True(t, IsPermissionDenied(k8serrors.NewForbidden(schema.GroupResource{Resource: "services"}, "active", errors.New("not allowed"))))
+	assert.True(t, IsPermissionDenied(fmt.Errorf("wrapped: %w", &ServiceAccountError{msg: "missing"})))
+	assert.False(t, IsPermissionDenied(k8serrors.NewNotFound(schema.GroupResource{Resource: "services"}, "active")))
+	assert.False(t, IsPermissionDenied(errors.New("boom")))
+}
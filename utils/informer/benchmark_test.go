@@ -0,0 +1,160 @@
+package informer
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+)
+
+// cacheObjects is the number of synthetic objects stored in the cache by every benchmark iteration
+const cacheObjects = 1000
+
+// syntheticObjectMeta returns object metadata resembling what the API server returns for objects
+// which were applied with kubectl and are modified by several field managers
+func syntheticObjectMeta(i int) metav1.ObjectMeta {
+	var entries []metav1.ManagedFieldsEntry
+	for _, manager := range []string{"kubectl-client-side-apply", "kube-controller-manager", "argo-rollouts"} {
+		entries = append(entries, metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationUpdate,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:annotations":{},"f:labels":{}},"f:spec":{"f:template":{"f:spec":{"f:containers":{}}}}}` + strings.Repeat(" ", 512))},
+		})
+	}
+	return metav1.ObjectMeta{
+		Name:          fmt.Sprintf("object-%d", i),
+		Namespace:     fmt.Sprintf("namespace-%d", i%50),
+		Labels:        map[string]string{"app": "guestbook", "rollouts-pod-template-hash": "abcdef"},
+		ManagedFields: entries,
+		Annotations: map[string]string{
+			corev1.LastAppliedConfigAnnotation: strings.Repeat("x", 2048),
+		},
+	}
+}
+
+func syntheticPodTemplate() corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "guestbook"}},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:    "guestbook",
+				Image:   "argoproj/rollouts-demo:blue",
+				Command: []string{"/bin/sh", "-c", strings.Repeat("echo hello; ", 50)},
+				Env:     []corev1.EnvVar{{Name: "FOO", Value: "bar"}, {Name: "BAZ", Value: "qux"}},
+			}},
+		},
+	}
+}
+
+// benchmarkCacheMemory stores cacheObjects synthetic objects in an informer store after passing
+// them through the transform, and reports the heap retained by the store per object
+func benchmarkCacheMemory(b *testing.B, newObject func(i int) k8sruntime.Object, transform TransformFunc) {
+	var retained uint64
+	for n := 0; n < b.N; n++ {
+		store := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		var before, after runtime.MemStats
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		for i := 0; i < cacheObjects; i++ {
+			obj := newObject(i)
+			if transform != nil {
+				transform(obj)
+			}
+			if err := store.Add(obj); err != nil {
+				b.Fatal(err)
+			}
+		}
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+		if after.HeapAlloc > before.HeapAlloc {
+			retained += after.HeapAlloc - before.HeapAlloc
+		}
+		runtime.KeepAlive(store)
+	}
+	b.ReportMetric(float64(retained)/float64(b.N*cacheObjects), "cache-B/object")
+}
+
+func newSyntheticReplicaSet(i int) k8sruntime.Object {
+	return &appsv1.ReplicaSet{
+		ObjectMeta: syntheticObjectMeta(i),
+		Spec:       appsv1.ReplicaSetSpec{Template: syntheticPodTemplate()},
+		Status:     appsv1.ReplicaSetStatus{Replicas: 3, AvailableReplicas: 3, ReadyReplicas: 3},
+	}
+}
+
+func newSyntheticService(i int) k8sruntime.Object {
+	return &corev1.Service{
+		ObjectMeta: syntheticObjectMeta(i),
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "guestbook"},
+			Ports:    []corev1.ServicePort{{Name: "http", Port: 80}},
+		},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{Hostname: "elb.amazonaws.com"}}},
+		},
+	}
+}
+
+func newSyntheticJob(i int) k8sruntime.Object {
+	return &batchv1.Job{
+		ObjectMeta: syntheticObjectMeta(i),
+		Spec:       batchv1.JobSpec{Template: syntheticPodTemplate()},
+		Status:     batchv1.JobStatus{Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete}}},
+	}
+}
+
+// newSyntheticJobMetadata returns the synthetic job as stored by a metadata informer
+func newSyntheticJobMetadata(i int) k8sruntime.Object {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: syntheticObjectMeta(i),
+	}
+}
+
+func newSyntheticDeployment(i int) k8sruntime.Object {
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: syntheticObjectMeta(i),
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "guestbook"}},
+			Template: syntheticPodTemplate(),
+		},
+		Status: appsv1.DeploymentStatus{Replicas: 3, AvailableReplicas: 3, ReadyReplicas: 3},
+	}
+	obj, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(deploy)
+	if err != nil {
+		panic(err)
+	}
+	return &unstructured.Unstructured{Object: obj}
+}
+
+func BenchmarkReplicaSetCacheMemory(b *testing.B) {
+	b.Run("full", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticReplicaSet, nil) })
+	b.Run("transformed", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticReplicaSet, TransformReplicaSet) })
+}
+
+func BenchmarkServiceCacheMemory(b *testing.B) {
+	b.Run("full", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticService, nil) })
+	b.Run("transformed", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticService, TransformService) })
+}
+
+func BenchmarkJobCacheMemory(b *testing.B) {
+	b.Run("full", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticJob, nil) })
+	b.Run("metadata", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticJobMetadata, stripMetadata) })
+}
+
+func BenchmarkWorkloadCacheMemory(b *testing.B) {
+	transform := NewWorkloadTransform([]string{"spec", "template"}, []string{"spec", "selector"})
+	b.Run("full", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticDeployment, nil) })
+	b.Run("transformed", func(b *testing.B) { benchmarkCacheMemory(b, newSyntheticDeployment, transform) })
+}
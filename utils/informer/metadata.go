@@ -0,0 +1,71 @@
+package informer
+
+import (
+	"context"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/informers/internalinterfaces"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatalister"
+	"k8s.io/client-go/tools/cache"
+)
+
+// JobsResource is the resource of the Jobs created by the job metric provider
+var JobsResource = batchv1.SchemeGroupVersion.WithResource("jobs")
+
+// MetadataInformer provides access to a shared informer and lister which only cache the metadata
+// of objects (as metav1.PartialObjectMetadata)
+type MetadataInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() metadatalister.Lister
+}
+
+type metadataInformer struct {
+	informer cache.SharedIndexInformer
+	resource schema.GroupVersionResource
+}
+
+func (i *metadataInformer) Informer() cache.SharedIndexInformer {
+	return i.informer
+}
+
+func (i *metadataInformer) Lister() metadatalister.Lister {
+	return metadatalister.New(i.informer.GetIndexer(), i.resource)
+}
+
+// NewMetadataInformer returns an informer which only caches the metadata of the resource's objects,
+// without their managedFields and last-applied-configuration annotation. It is meant for objects of
+// which the controller never reads the spec or status from the cache, and which it fetches from the
+// API server when they are needed.
+func NewMetadataInformer(
+	client metadata.Interface,
+	resource schema.GroupVersionResource,
+	namespace string,
+	resyncPeriod time.Duration,
+	tweakListOptions internalinterfaces.TweakListOptionsFunc,
+) MetadataInformer {
+	informer := newTransformingInformer(
+		&metav1.PartialObjectMetadata{},
+		func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return client.Resource(resource).Namespace(namespace).List(ctx, options)
+		},
+		func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+			return client.Resource(resource).Namespace(namespace).Watch(ctx, options)
+		},
+		tweakListOptions, stripMetadata, resyncPeriod)
+	return &metadataInformer{
+		informer: informer,
+		resource: resource,
+	}
+}
+
+// stripMetadata strips the metadata of objects which are never updated from the cache
+func stripMetadata(obj runtime.Object) {
+	stripObjectMeta(obj)
+	stripAnnotations(obj)
+}
@@ -0,0 +1,245 @@
+package informer
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/informers/internalinterfaces"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// TransformFunc strips an object of fields the controller never reads before it is stored in an
+// informer cache. The object is modified in place.
+type TransformFunc func(obj runtime.Object)
+
+// NewTransformingListWatch wraps a ListerWatcher so that every object returned by a list or
+// delivered by a watch is passed through the transform before it reaches the informer
+func NewTransformingListWatch(lw cache.ListerWatcher, transform TransformFunc) cache.ListerWatcher {
+	return &transformingListWatch{
+		delegate:  lw,
+		transform: transform,
+	}
+}
+
+type transformingListWatch struct {
+	delegate  cache.ListerWatcher
+	transform TransformFunc
+}
+
+func (t *transformingListWatch) List(options metav1.ListOptions) (runtime.Object, error) {
+	list, err := t.delegate.List(options)
+	if err != nil {
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		t.transform(items[i])
+	}
+	if err := meta.SetList(list, items); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (t *transformingListWatch) Watch(options metav1.ListOptions) (watch.Interface, error) {
+	w, err := t.delegate.Watch(options)
+	if err != nil {
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		switch event.Type {
+		case watch.Added, watch.Modified, watch.Deleted:
+			t.transform(event.Object)
+		}
+		return event, true
+	}), nil
+}
+
+// stripObjectMeta removes managedFields, which are never read by the controller and frequently
+// make up a large part of an object. A nil managedFields is ignored by the API server on update,
+// so objects from the cache can still be used as the base of an update.
+func stripObjectMeta(obj runtime.Object) {
+	if acc, err := meta.Accessor(obj); err == nil {
+		acc.SetManagedFields(nil)
+	}
+}
+
+// stripAnnotations removes the kubectl last-applied-configuration annotation, which contains a
+// full copy of the object. This must only be used for objects the controller never updates from
+// the cache.
+func stripAnnotations(obj runtime.Object) {
+	acc, err := meta.Accessor(obj)
+	if err != nil {
+		return
+	}
+	annotations := acc.GetAnnotations()
+	if _, ok := annotations[corev1.LastAppliedConfigAnnotation]; !ok {
+		return
+	}
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	acc.SetAnnotations(annotations)
+}
+
+// TransformReplicaSet strips a ReplicaSet of the fields the controller never reads. ReplicaSets
+// are updated from the cache, so annotations and the pod template are kept as is.
+func TransformReplicaSet(obj runtime.Object) {
+	rs, ok := obj.(*appsv1.ReplicaSet)
+	if !ok {
+		return
+	}
+	stripObjectMeta(rs)
+}
+
+// TransformService strips a Service of the fields the controller never reads. Services are only
+// ever patched by the controller, so the last-applied-configuration annotation and the load
+// balancer status can be dropped.
+func TransformService(obj runtime.Object) {
+	svc, ok := obj.(*corev1.Service)
+	if !ok {
+		return
+	}
+	stripObjectMeta(svc)
+	stripAnnotations(svc)
+	svc.Status = corev1.ServiceStatus{}
+}
+
+// NewWorkloadTransform returns a transform for the unstructured workloads referenced by Rollouts.
+// It keeps the metadata and the fields at the given paths (the pod template and selector the
+// controller resolves), and drops the rest of the spec and the status. Workloads are never updated
+// from the cache.
+func NewWorkloadTransform(paths ...[]string) TransformFunc {
+	return func(obj runtime.Object) {
+		un, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return
+		}
+		stripObjectMeta(un)
+		stripAnnotations(un)
+		stripped := map[string]interface{}{}
+		for _, key := range []string{"apiVersion", "kind", "metadata"} {
+			if value, ok := un.Object[key]; ok {
+				stripped[key] = value
+			}
+		}
+		for _, path := range paths {
+			if len(path) == 0 {
+				continue
+			}
+			if value, ok, _ := unstructured.NestedFieldNoCopy(un.Object, path...); ok {
+				_ = unstructured.SetNestedField(stripped, value, path...)
+			}
+		}
+		un.Object = stripped
+	}
+}
+
+// newTransformingInformer returns a SharedIndexInformer for the typed object which uses the
+// supplied list and watch functions, passing every object through the transform
+func newTransformingInformer(
+	objType runtime.Object,
+	list func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error),
+	watchFunc func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error),
+	tweakListOptions internalinterfaces.TweakListOptionsFunc,
+	transform TransformFunc,
+	resyncPeriod time.Duration,
+) cache.SharedIndexInformer {
+	lw := &cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			if tweakListOptions != nil {
+				tweakListOptions(&options)
+			}
+			return list(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			if tweakListOptions != nil {
+				tweakListOptions(&options)
+			}
+			return watchFunc(context.TODO(), options)
+		},
+	}
+	return cache.NewSharedIndexInformer(
+		NewTransformingListWatch(lw, transform),
+		objType,
+		resyncPeriod,
+		cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc},
+	)
+}
+
+// RegisterTransformingInformers registers memory reduced ReplicaSet and Service informers with the
+// factory. It must be called before the factory's ReplicaSet or Service informers are first
+// requested, after which the factory hands out the transforming informers instead of the defaults.
+func RegisterTransformingInformers(factory informers.SharedInformerFactory, namespace string) {
+	factory.InformerFor(&appsv1.ReplicaSet{}, func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return newTransformingInformer(
+			&appsv1.ReplicaSet{},
+			func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return client.AppsV1().ReplicaSets(namespace).List(ctx, options)
+			},
+			func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				return client.AppsV1().ReplicaSets(namespace).Watch(ctx, options)
+			},
+			nil, TransformReplicaSet, resyncPeriod)
+	})
+	factory.InformerFor(&corev1.Service{}, func(client kubernetes.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+		return newTransformingInformer(
+			&corev1.Service{},
+			func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+				return client.CoreV1().Services(namespace).List(ctx, options)
+			},
+			func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+				return client.CoreV1().Services(namespace).Watch(ctx, options)
+			},
+			nil, TransformService, resyncPeriod)
+	})
+}
+
+// NewTransformingDynamicInformer returns an informer for the unstructured objects of the resource
+// which passes every object through the transform
+func NewTransformingDynamicInformer(
+	client dynamic.Interface,
+	resource schema.GroupVersionResource,
+	namespace string,
+	resyncPeriod time.Duration,
+	transform TransformFunc,
+) informers.GenericInformer {
+	informer := newTransformingInformer(
+		&unstructured.Unstructured{},
+		func(ctx context.Context, options metav1.ListOptions) (runtime.Object, error) {
+			return client.Resource(resource).Namespace(namespace).List(ctx, options)
+		},
+		func(ctx context.Context, options metav1.ListOptions) (watch.Interface, error) {
+			return client.Resource(resource).Namespace(namespace).Watch(ctx, options)
+		},
+		nil, transform, resyncPeriod)
+	return &genericInformer{
+		informer: informer,
+		resource: resource.GroupResource(),
+	}
+}
+
+type genericInformer struct {
+	informer cache.SharedIndexInformer
+	resource schema.GroupResource
+}
+
+func (i *genericInformer) Informer() cache.SharedIndexInformer {
+	return i.informer
+}
+
+func (i *genericInformer) Lister() cache.GenericLister {
+	return cache.NewGenericLister(i.informer.GetIndexer(), i.resource)
+}
@@ -0,0 +1,223 @@
+package informer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/tools/cache"
+)
+
+var managedFields = []metav1.ManagedFieldsEntry{{
+	Manager:    "kubectl",
+	Operation:  metav1.ManagedFieldsOperationApply,
+	APIVersion: "v1",
+	FieldsType: "FieldsV1",
+	FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{}}}`)},
+}}
+
+func newObjectMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:          name,
+		Namespace:     metav1.NamespaceDefault,
+		ManagedFields: managedFields,
+		Labels:        map[string]string{"app": name},
+		Annotations: map[string]string{
+			corev1.LastAppliedConfigAnnotation: `{"apiVersion":"v1"}`,
+			"foo":                              "bar",
+		},
+	}
+}
+
+func TestTransformReplicaSet(t *testing.T) {
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: newObjectMeta("rs"),
+		Spec: appsv1.ReplicaSetSpec{
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "app"}}}},
+		},
+		Status: appsv1.ReplicaSetStatus{AvailableReplicas: 1},
+	}
+	TransformReplicaSet(rs)
+	assert.Nil(t, rs.ManagedFields)
+	// ReplicaSets are updated from the cache, so annotations must be preserved
+	assert.Len(t, rs.Annotations, 2)
+	assert.Len(t, rs.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, int32(1), rs.Status.AvailableReplicas)
+}
+
+func TestTransformService(t *testing.T) {
+	svc := &corev1.Service{
+		ObjectMeta: newObjectMeta("svc"),
+		Spec:       corev1.ServiceSpec{Selector: map[string]string{"app": "svc"}},
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{Ingress: []corev1.LoadBalancerIngress{{IP: "1.2.3.4"}}},
+		},
+	}
+	TransformService(svc)
+	assert.Nil(t, svc.ManagedFields)
+	assert.Equal(t, map[string]string{"foo": "bar"}, svc.Annotations)
+	assert.Equal(t, map[string]string{"app": "svc"}, svc.Spec.Selector)
+	assert.Empty(t, svc.Status.LoadBalancer.Ingress)
+}
+
+func newDeployment() *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":          "deploy",
+			"namespace":     metav1.NamespaceDefault,
+			"generation":    int64(2),
+			"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+			"annotations": map[string]interface{}{
+				corev1.LastAppliedConfigAnnotation: `{"apiVersion":"apps/v1"}`,
+			},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(0),
+			"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "deploy"}},
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "app"}}},
+			},
+		},
+		"status": map[string]interface{}{"replicas": int64(1)},
+	}}
+}
+
+func TestNewWorkloadTransform(t *testing.T) {
+	deploy := newDeployment()
+	NewWorkloadTransform([]string{"spec", "template"}, []string{"spec", "selector"})(deploy)
+	assert.Equal(t, "Deployment", deploy.GetKind())
+	assert.Equal(t, int64(2), deploy.GetGeneration())
+	assert.Nil(t, deploy.GetManagedFields())
+	assert.Empty(t, deploy.GetAnnotations())
+	assert.Equal(t, map[string]interface{}{
+		"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "deploy"}},
+		"template": map[string]interface{}{
+			"spec": map[string]interface{}{"containers": []interface{}{map[string]interface{}{"name": "app"}}},
+		},
+	}, deploy.Object["spec"])
+	assert.NotContains(t, deploy.Object, "status")
+
+	// a kind without a selector, such as a PodTemplate
+	podTemplate := newDeployment()
+	NewWorkloadTransform([]string{"spec", "template"}, nil)(podTemplate)
+	_, ok, _ := unstructured.NestedMap(podTemplate.Object, "spec", "template")
+	assert.True(t, ok)
+	_, ok, _ = unstructured.NestedMap(podTemplate.Object, "spec", "selector")
+	assert.False(t, ok)
+}
+
+func TestTransformIgnoresOtherTypes(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: newObjectMeta("pod")}
+	TransformReplicaSet(pod)
+	TransformService(pod)
+	NewWorkloadTransform([]string{"spec"})(pod)
+	assert.Equal(t, managedFields, pod.ManagedFields)
+}
+
+func TestTransformingListWatch(t *testing.T) {
+	client := fake.NewSimpleClientset(&corev1.Service{ObjectMeta: newObjectMeta("existing")})
+	lw := NewTransformingListWatch(&cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			return client.CoreV1().Services(metav1.NamespaceDefault).List(context.TODO(), options)
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			return client.CoreV1().Services(metav1.NamespaceDefault).Watch(context.TODO(), options)
+		},
+	}, TransformService)
+
+	list, err := lw.List(metav1.ListOptions{})
+	assert.NoError(t, err)
+	svcList := list.(*corev1.ServiceList)
+	assert.Len(t, svcList.Items, 1)
+	assert.Nil(t, svcList.Items[0].ManagedFields)
+
+	w, err := lw.Watch(metav1.ListOptions{})
+	assert.NoError(t, err)
+	defer w.Stop()
+	_, err = client.CoreV1().Services(metav1.NamespaceDefault).Create(context.TODO(), &corev1.Service{ObjectMeta: newObjectMeta("new")}, metav1.CreateOptions{})
+	assert.NoError(t, err)
+	select {
+	case event := <-w.ResultChan():
+		assert.Equal(t, watch.Added, event.Type)
+		assert.Nil(t, event.Object.(*corev1.Service).ManagedFields)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for watch event")
+	}
+}
+
+func TestRegisterTransformingInformers(t *testing.T) {
+	client := fake.NewSimpleClientset(
+		&appsv1.ReplicaSet{ObjectMeta: newObjectMeta("rs")},
+		&corev1.Service{ObjectMeta: newObjectMeta("svc")},
+	)
+	factory := kubeinformers.NewSharedInformerFactory(client, 0)
+	RegisterTransformingInformers(factory, metav1.NamespaceAll)
+
+	rsInformer := factory.Apps().V1().ReplicaSets()
+	svcInformer := factory.Core().V1().Services()
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	factory.Start(stopCh)
+	cache.WaitForCacheSync(stopCh, rsInformer.Informer().HasSynced, svcInformer.Informer().HasSynced)
+
+	rs, err := rsInformer.Lister().ReplicaSets(metav1.NamespaceDefault).Get("rs")
+	assert.NoError(t, err)
+	assert.Nil(t, rs.ManagedFields)
+	svc, err := svcInformer.Lister().Services(metav1.NamespaceDefault).Get("svc")
+	assert.NoError(t, err)
+	assert.NotContains(t, svc.Annotations, corev1.LastAppliedConfigAnnotation)
+}
+
+func TestNewMetadataInformer(t *testing.T) {
+	scheme := runtime.NewScheme()
+	metav1.AddMetaToScheme(scheme)
+	client := metadatafake.NewSimpleMetadataClient(scheme, &metav1.PartialObjectMetadata{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: newObjectMeta("job"),
+	})
+	jobInformer := NewMetadataInformer(client, JobsResource, metav1.NamespaceAll, 0, nil)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go jobInformer.Informer().Run(stopCh)
+	cache.WaitForCacheSync(stopCh, jobInformer.Informer().HasSynced)
+
+	job, err := jobInformer.Lister().Namespace(metav1.NamespaceDefault).Get("job")
+	assert.NoError(t, err)
+	assert.Nil(t, job.ManagedFields)
+	assert.Equal(t, map[string]string{"foo": "bar"}, job.Annotations)
+	assert.Equal(t, map[string]string{"app": "job"}, job.Labels)
+}
+
+func TestNewTransformingDynamicInformer(t *testing.T) {
+	deploymentsResource := appsv1.SchemeGroupVersion.WithResource("deployments")
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		deploymentsResource: "DeploymentList",
+	}, newDeployment())
+	deployInformer := NewTransformingDynamicInformer(client, deploymentsResource, metav1.NamespaceAll, 0, NewWorkloadTransform([]string{"spec", "template"}))
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+	go deployInformer.Informer().Run(stopCh)
+	cache.WaitForCacheSync(stopCh, deployInformer.Informer().HasSynced)
+
+	obj, err := deployInformer.Lister().ByNamespace(metav1.NamespaceDefault).Get("deploy")
+	assert.NoError(t, err)
+	deploy := obj.(*unstructured.Unstructured)
+	assert.Nil(t, deploy.GetManagedFields())
+	assert.NotContains(t, deploy.Object, "status")
+	_, ok, _ := unstructured.NestedMap(deploy.Object, "spec", "template")
+	assert.True(t, ok)
+}
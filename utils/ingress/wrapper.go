@@ -214,6 +214,14 @@ func NewIngressWrapper(mode IngressMode, client kubernetes.Interface, informerFa
 	}, nil
 }
 
+// WithClient returns a wrapper reading from the same informers which uses the given client for its
+// requests to the API server
+func (w *IngressWrap) WithClient(client kubernetes.Interface) *IngressWrap {
+	newWrap := *w
+	newWrap.client = client
+	return &newWrap
+}
+
 func (w *IngressWrap) Informer() cache.SharedIndexInformer {
 	switch w.mode {
 	case IngressModeNetworking:
@@ -4,9 +4,11 @@ import (
 	"flag"
 	"strconv"
 	"strings"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/klog/v2"
 
@@ -26,6 +28,20 @@ const (
 	IngressKey = "ingress"
 	// NamespaceKey defines the key for the namespace field
 	NamespaceKey = "namespace"
+	// RevisionKey defines the key for the revision field
+	RevisionKey = "revision"
+	// StepKey defines the key for the canary step field
+	StepKey = "step"
+	// MetricKey defines the key for the metric field
+	MetricKey = "metric"
+	// MeasurementKey defines the key for the measurement field
+	MeasurementKey = "measurement"
+
+	// LogLevelAnnotation overrides the log level of the controller for the annotated Rollout,
+	// AnalysisRun or Experiment, and the objects it owns
+	LogLevelAnnotation = "rollout.argoproj.io/log-level"
+	// revisionAnnotation mirrors annotations.RevisionAnnotation, which imports this package
+	revisionAnnotation = "rollout.argoproj.io/revision"
 )
 
 // SetKLogLevel set the klog level for the k8s go-client
@@ -57,6 +73,7 @@ func WithObject(obj runtime.Object) *log.Entry {
 	}
 	objectMeta, err := meta.Accessor(obj)
 	if err == nil {
+		logCtx = log.NewEntry(loggerFor(objectMeta.GetAnnotations()))
 		logCtx = logCtx.WithField("namespace", objectMeta.GetNamespace())
 		logCtx = logCtx.WithField(strings.ToLower(kind), objectMeta.GetName())
 	}
@@ -85,19 +102,120 @@ func KindNamespaceName(logCtx *log.Entry) (string, string, string) {
 	return kind, namespace, name
 }
 
-// WithRollout returns a logging context for Rollouts
+// WithRollout returns a logging context for Rollouts, which includes the revision and the canary step
+// of the rollout
 func WithRollout(rollout *v1alpha1.Rollout) *log.Entry {
-	return log.WithField(RolloutKey, rollout.Name).WithField(NamespaceKey, rollout.Namespace)
+	logCtx := log.NewEntry(loggerFor(rollout.Annotations)).WithField(RolloutKey, rollout.Name).WithField(NamespaceKey, rollout.Namespace)
+	if revision, ok := rollout.Annotations[revisionAnnotation]; ok {
+		logCtx = logCtx.WithField(RevisionKey, revision)
+	}
+	if rollout.Status.CurrentStepIndex != nil {
+		logCtx = logCtx.WithField(StepKey, *rollout.Status.CurrentStepIndex)
+	}
+	return logCtx
 }
 
 // WithExperiment returns a logging context for Experiments
 func WithExperiment(experiment *v1alpha1.Experiment) *log.Entry {
-	return log.WithField(ExperimentKey, experiment.Name).WithField(NamespaceKey, experiment.Namespace)
+	logCtx := log.NewEntry(loggerFor(experiment.Annotations)).WithField(ExperimentKey, experiment.Name).WithField(NamespaceKey, experiment.Namespace)
+	return withOwner(logCtx, experiment.ObjectMeta)
 }
 
-// WithAnalysisRun returns a logging context for AnalysisRun
+// WithAnalysisRun returns a logging context for AnalysisRun, which includes the rollout, revision and
+// canary step the AnalysisRun was created for
 func WithAnalysisRun(ar *v1alpha1.AnalysisRun) *log.Entry {
-	return log.WithField(AnalysisRunKey, ar.Name).WithField(NamespaceKey, ar.Namespace)
+	logCtx := log.NewEntry(loggerFor(ar.Annotations)).WithField(AnalysisRunKey, ar.Name).WithField(NamespaceKey, ar.Namespace)
+	logCtx = withOwner(logCtx, ar.ObjectMeta)
+	if step, ok := ar.Labels[v1alpha1.RolloutCanaryStepIndexLabel]; ok {
+		if index, err := strconv.Atoi(step); err == nil {
+			logCtx = logCtx.WithField(StepKey, int32(index))
+		}
+	}
+	return logCtx
+}
+
+// WithMetric returns a logging context for a metric of an AnalysisRun
+func WithMetric(ar *v1alpha1.AnalysisRun, metric string) *log.Entry {
+	return WithAnalysisRun(ar).WithField(MetricKey, metric)
+}
+
+// withOwner adds the fields of the Rollout or Experiment controlling an object to its logging context
+func withOwner(logCtx *log.Entry, objectMeta metav1.ObjectMeta) *log.Entry {
+	ownerRef := metav1.GetControllerOf(&objectMeta)
+	if ownerRef == nil {
+		return logCtx
+	}
+	switch ownerRef.Kind {
+	case "Rollout":
+		logCtx = logCtx.WithField(RolloutKey, ownerRef.Name)
+		if revision, ok := objectMeta.Annotations[revisionAnnotation]; ok {
+			logCtx = logCtx.WithField(RevisionKey, revision)
+		}
+	case "Experiment":
+		logCtx = logCtx.WithField(ExperimentKey, ownerRef.Name)
+	}
+	return logCtx
+}
+
+// InheritLogLevel copies the log level override of an owner to an object it creates, so that the
+// logs of the object are as verbose as the logs of its owner
+func InheritLogLevel(owner, obj metav1.Object) {
+	level, ok := owner.GetAnnotations()[LogLevelAnnotation]
+	if !ok {
+		return
+	}
+	objAnnotations := obj.GetAnnotations()
+	if objAnnotations == nil {
+		objAnnotations = map[string]string{}
+	}
+	if _, ok := objAnnotations[LogLevelAnnotation]; !ok {
+		objAnnotations[LogLevelAnnotation] = level
+		obj.SetAnnotations(objAnnotations)
+	}
+}
+
+// loggerKey identifies the loggers of log level overrides
+type loggerKey struct {
+	level     log.Level
+	formatter log.Formatter
+}
+
+var (
+	// loggers holds the loggers of log level overrides, so that one logger is created per level
+	// rather than per log entry. The formatter is part of the key so that a logger is created again
+	// when the format of the standard logger changes.
+	loggers     = map[loggerKey]*log.Logger{}
+	loggersLock sync.Mutex
+)
+
+// loggerFor returns the logger of an object with the given annotations. It is the standard logger,
+// unless the object overrides the log level with a valid level in its annotations
+func loggerFor(annotations map[string]string) *log.Logger {
+	standardLogger := log.StandardLogger()
+	levelStr, ok := annotations[LogLevelAnnotation]
+	if !ok {
+		return standardLogger
+	}
+	level, err := log.ParseLevel(levelStr)
+	if err != nil || level == standardLogger.GetLevel() {
+		return standardLogger
+	}
+	key := loggerKey{level: level, formatter: standardLogger.Formatter}
+	loggersLock.Lock()
+	defer loggersLock.Unlock()
+	logger, ok := loggers[key]
+	if !ok {
+		logger = &log.Logger{
+			Out:          standardLogger.Out,
+			Hooks:        standardLogger.Hooks,
+			Formatter:    standardLogger.Formatter,
+			ReportCaller: standardLogger.ReportCaller,
+			ExitFunc:     standardLogger.ExitFunc,
+			Level:        level,
+		}
+		loggers[key] = logger
+	}
+	return logger
 }
 
 // WithRedactor returns a log entry with the inputted secret values redacted
@@ -11,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/pointer"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 )
@@ -213,6 +214,119 @@ func TestWithAnalysis(t *testing.T) {
 	assert.True(t, strings.Contains(logMessage, "analysisrun=test-name"))
 }
 
+func TestWithRolloutCorrelationFields(t *testing.T) {
+	ro := v1alpha1.Rollout{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-name",
+			Namespace:   "test-ns",
+			Annotations: map[string]string{"rollout.argoproj.io/revision": "3"},
+		},
+		Status: v1alpha1.RolloutStatus{
+			CurrentStepIndex: pointer.Int32Ptr(2),
+		},
+	}
+	logCtx := WithRollout(&ro)
+	assert.Equal(t, "3", logCtx.Data[RevisionKey])
+	assert.Equal(t, int32(2), logCtx.Data[StepKey])
+}
+
+func TestWithAnalysisRunCorrelationFields(t *testing.T) {
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "test-ns"}}
+	run := v1alpha1.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "test-name",
+			Namespace:       "test-ns",
+			Annotations:     map[string]string{"rollout.argoproj.io/revision": "3"},
+			Labels:          map[string]string{v1alpha1.RolloutCanaryStepIndexLabel: "1"},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ro, v1alpha1.SchemeGroupVersion.WithKind("Rollout"))},
+		},
+	}
+	logCtx := WithMetric(&run, "success-rate")
+	assert.Equal(t, "test-name", logCtx.Data[AnalysisRunKey])
+	assert.Equal(t, "guestbook", logCtx.Data[RolloutKey])
+	assert.Equal(t, "3", logCtx.Data[RevisionKey])
+	assert.Equal(t, int32(1), logCtx.Data[StepKey])
+	assert.Equal(t, "success-rate", logCtx.Data[MetricKey])
+
+	ex := &v1alpha1.Experiment{ObjectMeta: metav1.ObjectMeta{Name: "guestbook-exp", Namespace: "test-ns"}}
+	run.Labels = nil
+	run.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(ex, v1alpha1.SchemeGroupVersion.WithKind("Experiment"))}
+	logCtx = WithAnalysisRun(&run)
+	assert.Equal(t, "guestbook-exp", logCtx.Data[ExperimentKey])
+	assert.NotContains(t, logCtx.Data, RolloutKey)
+	assert.NotContains(t, logCtx.Data, StepKey)
+}
+
+func TestLogLevelAnnotation(t *testing.T) {
+	level := log.GetLevel()
+	defer log.SetLevel(level)
+	log.SetLevel(log.InfoLevel)
+
+	objectMeta := func(logLevel string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:        "test-name",
+			Namespace:   "test-ns",
+			Annotations: map[string]string{LogLevelAnnotation: logLevel},
+		}
+	}
+	for _, logCtx := range []*log.Entry{
+		WithRollout(&v1alpha1.Rollout{ObjectMeta: objectMeta("debug")}),
+		WithAnalysisRun(&v1alpha1.AnalysisRun{ObjectMeta: objectMeta("debug")}),
+		WithExperiment(&v1alpha1.Experiment{ObjectMeta: objectMeta("debug")}),
+		WithObject(&v1alpha1.Rollout{ObjectMeta: objectMeta("debug")}),
+	} {
+		assert.True(t, logCtx.Logger.IsLevelEnabled(log.DebugLevel))
+		assert.NotSame(t, log.StandardLogger(), logCtx.Logger)
+	}
+	assert.False(t, WithRollout(&v1alpha1.Rollout{ObjectMeta: objectMeta("error")}).Logger.IsLevelEnabled(log.InfoLevel))
+
+	// invalid or missing levels fall back to the standard logger
+	assert.Same(t, log.StandardLogger(), WithRollout(&v1alpha1.Rollout{ObjectMeta: objectMeta("verbose")}).Logger)
+	assert.Same(t, log.StandardLogger(), WithRollout(&v1alpha1.Rollout{}).Logger)
+	assert.False(t, log.IsLevelEnabled(log.DebugLevel))
+}
+
+func TestLogLevelAnnotationLoggerCache(t *testing.T) {
+	level := log.GetLevel()
+	defer log.SetLevel(level)
+	log.SetLevel(log.InfoLevel)
+	formatter := log.StandardLogger().Formatter
+	defer log.SetFormatter(formatter)
+
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{LogLevelAnnotation: "debug"}}}
+	logger := WithRollout(ro).Logger
+	assert.Same(t, logger, WithRollout(ro).Logger)
+	assert.Same(t, logger, WithExperiment(&v1alpha1.Experiment{ObjectMeta: ro.ObjectMeta}).Logger)
+
+	// another level has its own logger
+	ro.Annotations[LogLevelAnnotation] = "trace"
+	assert.NotSame(t, logger, WithRollout(ro).Logger)
+
+	// a logger is created again when the format changes
+	ro.Annotations[LogLevelAnnotation] = "debug"
+	jsonFormatter := &log.JSONFormatter{}
+	log.SetFormatter(jsonFormatter)
+	jsonLogger := WithRollout(ro).Logger
+	assert.NotSame(t, logger, jsonLogger)
+	assert.Same(t, jsonFormatter, jsonLogger.Formatter)
+}
+
+func TestInheritLogLevel(t *testing.T) {
+	ro := &v1alpha1.Rollout{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{LogLevelAnnotation: "debug"}}}
+	run := &v1alpha1.AnalysisRun{}
+	InheritLogLevel(ro, run)
+	assert.Equal(t, "debug", run.Annotations[LogLevelAnnotation])
+
+	// the override of the object itself is kept
+	ex := &v1alpha1.Experiment{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{LogLevelAnnotation: "trace"}}}
+	InheritLogLevel(ro, ex)
+	assert.Equal(t, "trace", ex.Annotations[LogLevelAnnotation])
+
+	run = &v1alpha1.AnalysisRun{}
+	InheritLogLevel(&v1alpha1.Rollout{}, run)
+	assert.Nil(t, run.Annotations)
+}
+
 // TestWithRedactor verifies that WithRedactor redacts secrets in logger
 func TestWithRedactor(t *testing.T) {
 	buf := bytes.NewBufferString("")
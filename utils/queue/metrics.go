@@ -0,0 +1,118 @@
+package queue
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// unfinishedWorkUpdatePeriod is how often the unfinished work metrics are updated, as in client-go
+const unfinishedWorkUpdatePeriod = 500 * time.Millisecond
+
+// queueMetrics reports the metrics client-go reports for its workqueues (depth, adds, queue latency,
+// work duration, unfinished work and longest running processor) for a queue which is not a
+// workqueue.Type. It is not safe for concurrent use.
+type queueMetrics struct {
+	depth                   workqueue.GaugeMetric
+	adds                    workqueue.CounterMetric
+	latency                 workqueue.HistogramMetric
+	workDuration            workqueue.HistogramMetric
+	unfinishedWorkSeconds   workqueue.SettableGaugeMetric
+	longestRunningProcessor workqueue.SettableGaugeMetric
+
+	addTimes             map[interface{}]time.Time
+	processingStartTimes map[interface{}]time.Time
+}
+
+func newQueueMetrics(name string, provider workqueue.MetricsProvider) *queueMetrics {
+	if provider == nil {
+		provider = noopMetricsProvider{}
+	}
+	return &queueMetrics{
+		depth:                   provider.NewDepthMetric(name),
+		adds:                    provider.NewAddsMetric(name),
+		latency:                 provider.NewLatencyMetric(name),
+		workDuration:            provider.NewWorkDurationMetric(name),
+		unfinishedWorkSeconds:   provider.NewUnfinishedWorkSecondsMetric(name),
+		longestRunningProcessor: provider.NewLongestRunningProcessorSecondsMetric(name),
+		addTimes:                map[interface{}]time.Time{},
+		processingStartTimes:    map[interface{}]time.Time{},
+	}
+}
+
+// add records an item which was not dirty being added
+func (m *queueMetrics) add(item interface{}, now time.Time) {
+	m.adds.Inc()
+	m.depth.Inc()
+	if _, ok := m.addTimes[item]; !ok {
+		m.addTimes[item] = now
+	}
+}
+
+// get records an item being handed out to a worker
+func (m *queueMetrics) get(item interface{}, now time.Time) {
+	m.depth.Dec()
+	m.processingStartTimes[item] = now
+	if addTime, ok := m.addTimes[item]; ok {
+		m.latency.Observe(now.Sub(addTime).Seconds())
+		delete(m.addTimes, item)
+	}
+}
+
+// done records a worker being done with an item
+func (m *queueMetrics) done(item interface{}, now time.Time) {
+	if startTime, ok := m.processingStartTimes[item]; ok {
+		m.workDuration.Observe(now.Sub(startTime).Seconds())
+		delete(m.processingStartTimes, item)
+	}
+}
+
+func (m *queueMetrics) updateUnfinishedWork(now time.Time) {
+	var total, oldest float64
+	for _, startTime := range m.processingStartTimes {
+		age := now.Sub(startTime).Seconds()
+		total += age
+		if age > oldest {
+			oldest = age
+		}
+	}
+	m.unfinishedWorkSeconds.Set(total)
+	m.longestRunningProcessor.Set(oldest)
+}
+
+type noopMetric struct{}
+
+func (noopMetric) Inc()            {}
+func (noopMetric) Dec()            {}
+func (noopMetric) Set(float64)     {}
+func (noopMetric) Observe(float64) {}
+
+type noopMetricsProvider struct{}
+
+func (noopMetricsProvider) NewDepthMetric(name string) workqueue.GaugeMetric {
+	return noopMetric{}
+}
+
+func (noopMetricsProvider) NewAddsMetric(name string) workqueue.CounterMetric {
+	return noopMetric{}
+}
+
+func (noopMetricsProvider) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return noopMetric{}
+}
+
+func (noopMetricsProvider) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return noopMetric{}
+}
+
+func (noopMetricsProvider) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+
+func (noopMetricsProvider) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+
+func (noopMetricsProvider) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return noopMetric{}
+}
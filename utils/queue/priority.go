@@ -0,0 +1,468 @@
+package queue
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Priority is the class an item is queued in. Items of a higher priority are handed out to workers
+// before items of a lower priority. An item which waits in a class for longer than the aging period
+// is promoted to the next higher class, so lower priorities are never starved.
+type Priority int
+
+const (
+	// PriorityLow is used for periodic resyncs which did not observe any change to the object
+	PriorityLow Priority = iota
+	// PriorityNormal is used for all items which do not specify a priority
+	PriorityNormal
+	// PriorityHigh is used for user-initiated actions (e.g. abort, promote, spec changes)
+	PriorityHigh
+)
+
+// Priorities lists every priority class from highest to lowest
+var Priorities = []Priority{PriorityHigh, PriorityNormal, PriorityLow}
+
+func (p Priority) String() string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityLow:
+		return "low"
+	default:
+		return "normal"
+	}
+}
+
+// defaultPriorityAging is how long an item waits in a priority class before it is promoted to the
+// next higher class
+const defaultPriorityAging = 30 * time.Second
+
+// MetricsProvider provides the metrics client-go reports for its workqueues, and receives queue
+// depth and wait time observations for every priority class
+type MetricsProvider interface {
+	workqueue.MetricsProvider
+	SetWorkqueuePriorityDepth(name string, priority Priority, depth int)
+	ObserveWorkqueuePriorityWait(name string, priority Priority, wait time.Duration)
+}
+
+// PriorityRateLimitingInterface is a rate limiting workqueue which additionally accepts items with
+// an explicit priority
+type PriorityRateLimitingInterface interface {
+	workqueue.RateLimitingInterface
+	// AddWithPriority adds an item to the queue in the given priority class. If the item is already
+	// queued with a lower priority, it is moved up to the given priority.
+	AddWithPriority(item interface{}, priority Priority)
+	// Items returns the items which are queued, in the order they are handed out, followed by the
+	// items being processed. Items waiting for a delay or a rate limited retry are not listed
+	// until they are queued.
+	Items() []Item
+}
+
+// Item describes an item of a workqueue
+type Item struct {
+	Key      string `json:"key"`
+	Priority string `json:"priority"`
+	// Processing is whether a worker is processing the item
+	Processing bool `json:"processing,omitempty"`
+	// Waiting is how long the item has been queued
+	Waiting string `json:"waiting,omitempty"`
+	// Requeues is the number of rate limited retries of the item since it was last processed
+	// successfully
+	Requeues int `json:"requeues"`
+}
+
+type priorityRateLimitingQueue struct {
+	workqueue.DelayingInterface
+	fair        *fairQueue
+	rateLimiter workqueue.RateLimiter
+}
+
+// NewPriorityRateLimitingQueue returns a rate limiting workqueue which hands out items by priority
+// class and, within a class, round-robins between namespaces so that a namespace with many queued
+// items cannot monopolize the workers. Delayed and rate limited items are queued with
+// PriorityNormal once their delay expires. metrics may be nil, otherwise the queue reports the same
+// workqueue metrics as a client-go workqueue with the same name.
+func NewPriorityRateLimitingQueue(rateLimiter workqueue.RateLimiter, name string, metrics MetricsProvider) PriorityRateLimitingInterface {
+	fair := newFairQueue(name, metrics, defaultPriorityAging)
+	return &priorityRateLimitingQueue{
+		DelayingInterface: workqueue.NewDelayingQueueWithCustomQueue(fair, name),
+		fair:              fair,
+		rateLimiter:       rateLimiter,
+	}
+}
+
+func (q *priorityRateLimitingQueue) AddWithPriority(item interface{}, priority Priority) {
+	q.fair.AddWithPriority(item, priority)
+}
+
+func (q *priorityRateLimitingQueue) AddRateLimited(item interface{}) {
+	q.DelayingInterface.AddAfter(item, q.rateLimiter.When(item))
+}
+
+func (q *priorityRateLimitingQueue) NumRequeues(item interface{}) int {
+	return q.rateLimiter.NumRequeues(item)
+}
+
+func (q *priorityRateLimitingQueue) Forget(item interface{}) {
+	q.rateLimiter.Forget(item)
+}
+
+func (q *priorityRateLimitingQueue) Items() []Item {
+	return q.fair.items(q.rateLimiter.NumRequeues)
+}
+
+// namespaceQueue holds the queued items of a single priority class, grouped by namespace
+type namespaceQueue struct {
+	// namespaces is the round-robin order of namespaces which have queued items
+	namespaces []string
+	items      map[string][]interface{}
+	len        int
+}
+
+func (nq *namespaceQueue) push(namespace string, item interface{}) {
+	if len(nq.items[namespace]) == 0 {
+		nq.namespaces = append(nq.namespaces, namespace)
+	}
+	nq.items[namespace] = append(nq.items[namespace], item)
+	nq.len++
+}
+
+// pop removes the first item of the next namespace in turn and moves that namespace to the back
+func (nq *namespaceQueue) pop() interface{} {
+	namespace := nq.namespaces[0]
+	nq.namespaces = nq.namespaces[1:]
+	items := nq.items[namespace]
+	item := items[0]
+	if len(items) == 1 {
+		delete(nq.items, namespace)
+	} else {
+		nq.items[namespace] = items[1:]
+		nq.namespaces = append(nq.namespaces, namespace)
+	}
+	nq.len--
+	return item
+}
+
+func (nq *namespaceQueue) remove(namespace string, item interface{}) {
+	items := nq.items[namespace]
+	for i := range items {
+		if items[i] != item {
+			continue
+		}
+		nq.len--
+		if len(items) > 1 {
+			nq.items[namespace] = append(items[:i:i], items[i+1:]...)
+			return
+		}
+		delete(nq.items, namespace)
+		for j := range nq.namespaces {
+			if nq.namespaces[j] == namespace {
+				nq.namespaces = append(nq.namespaces[:j:j], nq.namespaces[j+1:]...)
+				break
+			}
+		}
+		return
+	}
+}
+
+// queuedItem tracks the bookkeeping of a dirty item
+type queuedItem struct {
+	priority Priority
+	// queuedAt is the time the item was queued. It is zero while the item is dirty but still
+	// being processed.
+	queuedAt time.Time
+	// promoteAt is the time the item is promoted to the next higher priority class
+	promoteAt time.Time
+}
+
+// fairQueue implements workqueue.Interface with the same guarantees as workqueue.Type: an item is
+// never processed by more than one worker at a time, and an item added multiple times before it
+// is processed is only processed once.
+type fairQueue struct {
+	name         string
+	metrics      MetricsProvider
+	queueMetrics *queueMetrics
+	now          func() time.Time
+	// aging is how long an item waits in a priority class before it is promoted
+	aging time.Duration
+
+	cond *sync.Cond
+
+	queues map[Priority]*namespaceQueue
+	// dirty defines all of the items that need to be processed
+	dirty map[interface{}]*queuedItem
+	// processing contains all of the items currently being processed
+	processing map[interface{}]bool
+
+	shuttingDown bool
+	drain        bool
+}
+
+func newFairQueue(name string, metrics MetricsProvider, aging time.Duration) *fairQueue {
+	var provider workqueue.MetricsProvider
+	if metrics != nil {
+		provider = metrics
+	}
+	q := &fairQueue{
+		name:         name,
+		metrics:      metrics,
+		queueMetrics: newQueueMetrics(name, provider),
+		now:          time.Now,
+		aging:        aging,
+		cond:         sync.NewCond(&sync.Mutex{}),
+		queues:       map[Priority]*namespaceQueue{},
+		dirty:        map[interface{}]*queuedItem{},
+		processing:   map[interface{}]bool{},
+	}
+	for _, p := range Priorities {
+		q.queues[p] = &namespaceQueue{items: map[string][]interface{}{}}
+	}
+	if metrics != nil {
+		go q.updateUnfinishedWorkLoop()
+	}
+	return q
+}
+
+// itemNamespace returns the namespace of a namespace/name key, or an empty string for any other item
+func itemNamespace(item interface{}) string {
+	key, ok := item.(string)
+	if !ok {
+		return ""
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return ""
+	}
+	return namespace
+}
+
+// Add adds an item with PriorityNormal
+func (q *fairQueue) Add(item interface{}) {
+	q.AddWithPriority(item, PriorityNormal)
+}
+
+func (q *fairQueue) AddWithPriority(item interface{}, priority Priority) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	if q.shuttingDown {
+		return
+	}
+	if queued, ok := q.dirty[item]; ok {
+		if priority <= queued.priority {
+			return
+		}
+		if !q.processing[item] {
+			// move the item up into the higher priority class, keeping its original queue time
+			q.queues[queued.priority].remove(itemNamespace(item), item)
+			q.updateDepth(queued.priority)
+			queued.priority = priority
+			q.pushToClass(item, queued)
+			return
+		}
+		queued.priority = priority
+		return
+	}
+	queued := &queuedItem{priority: priority}
+	q.dirty[item] = queued
+	q.queueMetrics.add(item, q.now())
+	if q.processing[item] {
+		return
+	}
+	q.push(item, queued)
+	q.cond.Signal()
+}
+
+func (q *fairQueue) push(item interface{}, queued *queuedItem) {
+	queued.queuedAt = q.now()
+	q.pushToClass(item, queued)
+}
+
+// pushToClass queues the item at the back of its priority class
+func (q *fairQueue) pushToClass(item interface{}, queued *queuedItem) {
+	queued.promoteAt = q.now().Add(q.aging)
+	q.queues[queued.priority].push(itemNamespace(item), item)
+	q.updateDepth(queued.priority)
+}
+
+// promote moves the items which waited in their priority class for longer than the aging period up
+// to the next higher class. Items are queued in a class in the order they entered it, so only the
+// first items of every namespace need to be checked.
+func (q *fairQueue) promote() {
+	now := q.now()
+	// promote into the higher classes first, so an item moves up at most one class at a time
+	for i := 1; i < len(Priorities); i++ {
+		higher, nq := Priorities[i-1], q.queues[Priorities[i]]
+		promoted := false
+		for _, namespace := range append([]string(nil), nq.namespaces...) {
+			for len(nq.items[namespace]) > 0 {
+				item := nq.items[namespace][0]
+				queued := q.dirty[item]
+				if now.Before(queued.promoteAt) {
+					break
+				}
+				nq.remove(namespace, item)
+				queued.priority = higher
+				q.pushToClass(item, queued)
+				promoted = true
+			}
+		}
+		if promoted {
+			q.updateDepth(Priorities[i])
+		}
+	}
+}
+
+func (q *fairQueue) Len() int {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.len()
+}
+
+func (q *fairQueue) len() int {
+	total := 0
+	for _, nq := range q.queues {
+		total += nq.len
+	}
+	return total
+}
+
+// Get blocks until it can return an item to be processed. Items are returned from the highest
+// non-empty priority class, round-robin across namespaces.
+func (q *fairQueue) Get() (interface{}, bool) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	for q.len() == 0 && !q.shuttingDown {
+		q.cond.Wait()
+	}
+	if q.len() == 0 {
+		// We must be shutting down.
+		return nil, true
+	}
+	q.promote()
+	for _, p := range Priorities {
+		nq := q.queues[p]
+		if nq.len == 0 {
+			continue
+		}
+		item := nq.pop()
+		q.updateDepth(p)
+		if q.metrics != nil {
+			q.metrics.ObserveWorkqueuePriorityWait(q.name, p, q.now().Sub(q.dirty[item].queuedAt))
+		}
+		q.queueMetrics.get(item, q.now())
+		q.processing[item] = true
+		delete(q.dirty, item)
+		return item, false
+	}
+	return nil, true
+}
+
+// Done marks item as done processing, and if it has been marked as dirty again while it was being
+// processed, it will be re-added to the queue for re-processing.
+func (q *fairQueue) Done(item interface{}) {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.queueMetrics.done(item, q.now())
+	delete(q.processing, item)
+	if queued, ok := q.dirty[item]; ok {
+		q.push(item, queued)
+		q.cond.Signal()
+	} else if len(q.processing) == 0 {
+		// wake up a ShutDownWithDrain waiting for in-flight items
+		q.cond.Broadcast()
+	}
+}
+
+// ShutDown will cause q to ignore all new items added to it and immediately instruct the worker
+// goroutines to exit.
+func (q *fairQueue) ShutDown() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = false
+	q.shuttingDown = true
+	q.cond.Broadcast()
+}
+
+// ShutDownWithDrain will cause q to ignore all new items added to it and block until all items
+// currently being processed are marked as Done.
+func (q *fairQueue) ShutDownWithDrain() {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	q.drain = true
+	q.shuttingDown = true
+	q.cond.Broadcast()
+	for len(q.processing) != 0 && q.drain {
+		q.cond.Wait()
+	}
+}
+
+func (q *fairQueue) ShuttingDown() bool {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	return q.shuttingDown
+}
+
+// items lists the queued items by priority class and round-robin order of their namespaces, then
+// the items being processed
+func (q *fairQueue) items(numRequeues func(item interface{}) int) []Item {
+	q.cond.L.Lock()
+	defer q.cond.L.Unlock()
+	now := q.now()
+	var items []Item
+	for _, p := range Priorities {
+		nq := q.queues[p]
+		for _, namespace := range nq.namespaces {
+			for _, item := range nq.items[namespace] {
+				items = append(items, Item{
+					Key:      fmt.Sprint(item),
+					Priority: p.String(),
+					Waiting:  now.Sub(q.dirty[item].queuedAt).Round(time.Millisecond).String(),
+					Requeues: numRequeues(item),
+				})
+			}
+		}
+	}
+	var processing []Item
+	for item := range q.processing {
+		priority := PriorityNormal
+		if queued, ok := q.dirty[item]; ok {
+			priority = queued.priority
+		}
+		processing = append(processing, Item{
+			Key:        fmt.Sprint(item),
+			Priority:   priority.String(),
+			Processing: true,
+			Requeues:   numRequeues(item),
+		})
+	}
+	sort.Slice(processing, func(i, j int) bool {
+		return processing[i].Key < processing[j].Key
+	})
+	return append(items, processing...)
+}
+
+// updateUnfinishedWorkLoop updates the unfinished work metrics until the queue is shut down
+func (q *fairQueue) updateUnfinishedWorkLoop() {
+	ticker := time.NewTicker(unfinishedWorkUpdatePeriod)
+	defer ticker.Stop()
+	for range ticker.C {
+		q.cond.L.Lock()
+		if q.shuttingDown {
+			q.cond.L.Unlock()
+			return
+		}
+		q.queueMetrics.updateUnfinishedWork(q.now())
+		q.cond.L.Unlock()
+	}
+}
+
+func (q *fairQueue) updateDepth(priority Priority) {
+	if q.metrics != nil {
+		q.metrics.SetWorkqueuePriorityDepth(q.name, priority, q.queues[priority].len)
+	}
+}
@@ -0,0 +1,229 @@
+package queue
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/workqueue"
+)
+
+type fakeMetric struct {
+	value        float64
+	observations int
+}
+
+func (m *fakeMetric) Inc()            { m.value++ }
+func (m *fakeMetric) Dec()            { m.value-- }
+func (m *fakeMetric) Set(v float64)   { m.value = v }
+func (m *fakeMetric) Observe(float64) { m.observations++ }
+
+type fakeMetrics struct {
+	mu    sync.Mutex
+	depth map[Priority]int
+	waits map[Priority]int
+
+	queueDepth, adds, latency, workDuration fakeMetric
+}
+
+func (f *fakeMetrics) NewDepthMetric(string) workqueue.GaugeMetric            { return &f.queueDepth }
+func (f *fakeMetrics) NewAddsMetric(string) workqueue.CounterMetric           { return &f.adds }
+func (f *fakeMetrics) NewLatencyMetric(string) workqueue.HistogramMetric      { return &f.latency }
+func (f *fakeMetrics) NewWorkDurationMetric(string) workqueue.HistogramMetric { return &f.workDuration }
+func (f *fakeMetrics) NewUnfinishedWorkSecondsMetric(string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+func (f *fakeMetrics) NewLongestRunningProcessorSecondsMetric(string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+func (f *fakeMetrics) NewRetriesMetric(string) workqueue.CounterMetric { return noopMetric{} }
+
+func newFakeMetrics() *fakeMetrics {
+	return &fakeMetrics{depth: map[Priority]int{}, waits: map[Priority]int{}}
+}
+
+func (f *fakeMetrics) SetWorkqueuePriorityDepth(name string, priority Priority, depth int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.depth[priority] = depth
+}
+
+func (f *fakeMetrics) ObserveWorkqueuePriorityWait(name string, priority Priority, wait time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.waits[priority]++
+}
+
+func getAll(t *testing.T, q PriorityRateLimitingInterface) []interface{} {
+	var items []interface{}
+	for q.Len() > 0 {
+		item, shutdown := q.Get()
+		assert.False(t, shutdown)
+		items = append(items, item)
+		q.Done(item)
+	}
+	return items
+}
+
+func TestPriorityQueueOrdersByPriority(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", nil)
+	q.AddWithPriority("default/resync", PriorityLow)
+	q.Add("default/normal")
+	q.AddWithPriority("default/abort", PriorityHigh)
+	assert.Equal(t, []interface{}{"default/abort", "default/normal", "default/resync"}, getAll(t, q))
+}
+
+func TestPriorityQueueNamespaceFairness(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", nil)
+	q.Add("busy/a")
+	q.Add("busy/b")
+	q.Add("busy/c")
+	q.Add("quiet/a")
+	q.Add("other/a")
+	assert.Equal(t, []interface{}{"busy/a", "quiet/a", "other/a", "busy/b", "busy/c"}, getAll(t, q))
+}
+
+func TestPriorityQueueUpgradesPriority(t *testing.T) {
+	metrics := newFakeMetrics()
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", metrics)
+	q.AddWithPriority("default/a", PriorityLow)
+	q.AddWithPriority("default/b", PriorityLow)
+	q.Add("default/c")
+	assert.Equal(t, 2, metrics.depth[PriorityLow])
+
+	// a lower priority never downgrades a queued item
+	q.AddWithPriority("default/c", PriorityLow)
+	q.AddWithPriority("default/b", PriorityHigh)
+	assert.Equal(t, 3, q.Len())
+	assert.Equal(t, 1, metrics.depth[PriorityLow])
+	assert.Equal(t, 1, metrics.depth[PriorityHigh])
+	assert.Equal(t, []interface{}{"default/b", "default/c", "default/a"}, getAll(t, q))
+	assert.Equal(t, 1, metrics.waits[PriorityHigh])
+	assert.Equal(t, 1, metrics.waits[PriorityNormal])
+	assert.Equal(t, 1, metrics.waits[PriorityLow])
+	assert.Equal(t, 0, metrics.depth[PriorityHigh])
+}
+
+func TestPriorityQueueWorkqueueMetrics(t *testing.T) {
+	metrics := newFakeMetrics()
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", metrics)
+	defer q.ShutDown()
+	q.Add("default/a")
+	q.Add("default/a")
+	q.AddWithPriority("default/b", PriorityHigh)
+	assert.Equal(t, float64(2), metrics.adds.value)
+	assert.Equal(t, float64(2), metrics.queueDepth.value)
+
+	item, _ := q.Get()
+	assert.Equal(t, "default/b", item)
+	assert.Equal(t, float64(1), metrics.queueDepth.value)
+	assert.Equal(t, 1, metrics.latency.observations)
+
+	// an item added while it is processed is counted once it is dirty
+	q.Add(item)
+	assert.Equal(t, float64(3), metrics.adds.value)
+	assert.Equal(t, float64(2), metrics.queueDepth.value)
+	q.Done(item)
+	assert.Equal(t, 1, metrics.workDuration.observations)
+	getAll(t, q)
+	assert.Equal(t, float64(0), metrics.queueDepth.value)
+	assert.Equal(t, 3, metrics.latency.observations)
+	assert.Equal(t, 3, metrics.workDuration.observations)
+}
+
+func TestPriorityQueueAgingPreventsStarvation(t *testing.T) {
+	q := newFairQueue("test", nil, time.Minute)
+	now := time.Now()
+	q.now = func() time.Time { return now }
+	q.AddWithPriority("default/resync", PriorityLow)
+	q.AddWithPriority("default/abort-0", PriorityHigh)
+	for i := 1; ; i++ {
+		item, _ := q.Get()
+		q.Done(item)
+		if item == "default/resync" {
+			break
+		}
+		require.Less(t, i, 20, "the low priority item was starved")
+		// a new high priority item keeps arriving before the previous one is processed
+		q.AddWithPriority(fmt.Sprintf("default/abort-%d", i), PriorityHigh)
+		now = now.Add(10 * time.Second)
+	}
+	assert.Equal(t, 1, q.Len())
+}
+
+func TestPriorityQueueDirtyWhileProcessing(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", nil)
+	q.AddWithPriority("default/a", PriorityLow)
+	q.AddWithPriority("default/b", PriorityLow)
+	item, _ := q.Get()
+	assert.Equal(t, "default/a", item)
+
+	// re-adding an item which is being processed defers it until Done
+	q.AddWithPriority("default/a", PriorityHigh)
+	assert.Equal(t, 1, q.Len())
+	q.Done(item)
+	assert.Equal(t, 2, q.Len())
+	item, _ = q.Get()
+	assert.Equal(t, "default/a", item)
+}
+
+func TestPriorityQueueNonStringItems(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", nil)
+	q.Add(1)
+	q.Add(1)
+	assert.Equal(t, []interface{}{1}, getAll(t, q))
+}
+
+func TestPriorityQueueRateLimited(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", nil)
+	q.AddRateLimited("default/a")
+	assert.Equal(t, 1, q.NumRequeues("default/a"))
+	time.Sleep(100 * time.Millisecond)
+	assert.Equal(t, 1, q.Len())
+	q.Forget("default/a")
+	assert.Equal(t, 0, q.NumRequeues("default/a"))
+}
+
+func TestPriorityQueueShutDown(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", nil)
+	q.Add("default/a")
+	item, _ := q.Get()
+	done := make(chan struct{})
+	go func() {
+		q.ShutDownWithDrain()
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, q.ShuttingDown())
+	q.Add("default/b")
+	assert.Equal(t, 0, q.Len())
+	q.Done(item)
+	<-done
+	_, shutdown := q.Get()
+	assert.True(t, shutdown)
+}
+
+func TestPriorityQueueItems(t *testing.T) {
+	q := NewPriorityRateLimitingQueue(DefaultArgoRolloutsRateLimiter(), "test", nil)
+	q.AddWithPriority("default/resync", PriorityLow)
+	q.Add("default/normal")
+	q.AddWithPriority("default/abort", PriorityHigh)
+	item, _ := q.Get()
+	assert.Equal(t, "default/abort", item)
+	q.AddRateLimited("default/abort")
+	q.AddRateLimited("default/normal")
+
+	items := q.Items()
+	if assert.Len(t, items, 3) {
+		assert.Equal(t, "default/normal", items[0].Key)
+		assert.Equal(t, "normal", items[0].Priority)
+		assert.Equal(t, 1, items[0].Requeues)
+		assert.NotEmpty(t, items[0].Waiting)
+		assert.Equal(t, "default/resync", items[1].Key)
+		assert.Equal(t, "low", items[1].Priority)
+		assert.Equal(t, Item{Key: "default/abort", Priority: "normal", Processing: true, Requeues: 1}, items[2])
+	}
+}
@@ -0,0 +1,115 @@
+package replicaset
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+	"github.com/argoproj/argo-rollouts/utils/hash"
+)
+
+const (
+	// PodSpecPatchAnnotation records on a ReplicaSet the canary/preview pod spec patch applied to its
+	// pod template
+	PodSpecPatchAnnotation = "rollout.argoproj.io/pod-spec-patch"
+	// PodSpecPatchHashAnnotation denotes the hash of the canary/preview pod spec patch applied to the
+	// pod template of a ReplicaSet, and to the pods it created
+	PodSpecPatchHashAnnotation = "rollout.argoproj.io/pod-spec-patch-hash"
+)
+
+// GetPodSpecPatch returns the canary or preview pod spec patch of the rollout, or nil if it has none
+func GetPodSpecPatch(rollout *v1alpha1.Rollout) *runtime.RawExtension {
+	var patch *runtime.RawExtension
+	if rollout.Spec.Strategy.Canary != nil {
+		patch = rollout.Spec.Strategy.Canary.CanaryPodSpecPatch
+	} else if rollout.Spec.Strategy.BlueGreen != nil {
+		patch = rollout.Spec.Strategy.BlueGreen.PreviewPodSpecPatch
+	}
+	if patch == nil || len(patch.Raw) == 0 {
+		return nil
+	}
+	return patch
+}
+
+// PatchPodSpec returns the pod spec with the strategic merge patch applied
+func PatchPodSpec(spec *corev1.PodSpec, patch []byte) (*corev1.PodSpec, error) {
+	specBytes, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	patchedBytes, err := strategicpatch.StrategicMergePatch(specBytes, patch, corev1.PodSpec{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply pod spec patch: %w", err)
+	}
+	var patched corev1.PodSpec
+	if err := json.Unmarshal(patchedBytes, &patched); err != nil {
+		return nil, fmt.Errorf("failed to apply pod spec patch: %w", err)
+	}
+	return &patched, nil
+}
+
+// NewReplicaSetPodSpec returns the pod spec of a ReplicaSet of the current pod template of the
+// rollout, with the injected anti-affinity rule and the given pod spec patch, if any
+func NewReplicaSetPodSpec(rollout *v1alpha1.Rollout, patch []byte) (*corev1.PodSpec, error) {
+	spec := rollout.Spec.Template.Spec.DeepCopy()
+	spec.Affinity = GenerateReplicaSetAffinity(*rollout)
+	if len(patch) == 0 {
+		return spec, nil
+	}
+	return PatchPodSpec(spec, patch)
+}
+
+// SyncReplicaSetPodSpecPatch returns a copy of the ReplicaSet of the current pod template of the
+// rollout, whose pod spec is patched with the given pod spec patch, or is no longer patched when the
+// patch is nil, and whether it was modified. The pods created before the ReplicaSet was modified keep
+// the patch they were created with.
+func SyncReplicaSetPodSpecPatch(rs *appsv1.ReplicaSet, rollout *v1alpha1.Rollout, patch *runtime.RawExtension) (*appsv1.ReplicaSet, bool, error) {
+	var desired []byte
+	if patch != nil && len(patch.Raw) > 0 {
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, patch.Raw); err != nil {
+			return nil, false, fmt.Errorf("failed to apply pod spec patch: %w", err)
+		}
+		desired = compacted.Bytes()
+	}
+	if rs.Annotations[PodSpecPatchAnnotation] == string(desired) {
+		return rs, false, nil
+	}
+	spec, err := NewReplicaSetPodSpec(rollout, desired)
+	if err != nil {
+		return nil, false, err
+	}
+	rs = rs.DeepCopy()
+	rs.Spec.Template.Spec = *spec
+	if len(desired) > 0 {
+		if rs.Annotations == nil {
+			rs.Annotations = make(map[string]string)
+		}
+		rs.Annotations[PodSpecPatchAnnotation] = string(desired)
+		if rs.Spec.Template.Annotations == nil {
+			rs.Spec.Template.Annotations = make(map[string]string)
+		}
+		rs.Spec.Template.Annotations[PodSpecPatchHashAnnotation] = hash.ComputePodSpecPatchHash(desired)
+	} else {
+		delete(rs.Annotations, PodSpecPatchAnnotation)
+		delete(rs.Spec.Template.Annotations, PodSpecPatchHashAnnotation)
+	}
+	return rs, true, nil
+}
+
+// HasStalePodSpecPatch returns whether the pod was created by the ReplicaSet with another pod spec
+// patch than the one currently applied to its pod template, including none
+func HasStalePodSpecPatch(pod *corev1.Pod, rs *appsv1.ReplicaSet) bool {
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil || controllerRef.UID != rs.UID {
+		return false
+	}
+	return pod.Annotations[PodSpecPatchHashAnnotation] != rs.Spec.Template.Annotations[PodSpecPatchHashAnnotation]
+}
@@ -0,0 +1,110 @@
+package replicaset
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+const testPodSpecPatch = `{
+  "nodeSelector": {"pool": "canary"},
+  "containers": [{"name": "app", "env": [{"name": "DEBUG", "value": "true"}]}]
+}`
+
+func newPodSpecPatchRollout(patch string) *v1alpha1.Rollout {
+	ro := &v1alpha1.Rollout{
+		Spec: v1alpha1.RolloutSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "app",
+						Image: "guestbook:v2",
+						Env:   []corev1.EnvVar{{Name: "LOG_LEVEL", Value: "info"}},
+					}},
+				},
+			},
+			Strategy: v1alpha1.RolloutStrategy{
+				Canary: &v1alpha1.CanaryStrategy{},
+			},
+		},
+	}
+	if patch != "" {
+		ro.Spec.Strategy.Canary.CanaryPodSpecPatch = &runtime.RawExtension{Raw: []byte(patch)}
+	}
+	return ro
+}
+
+func TestGetPodSpecPatch(t *testing.T) {
+	assert.Nil(t, GetPodSpecPatch(newPodSpecPatchRollout("")))
+	assert.NotNil(t, GetPodSpecPatch(newPodSpecPatchRollout(testPodSpecPatch)))
+
+	ro := newPodSpecPatchRollout("")
+	ro.Spec.Strategy.Canary = nil
+	ro.Spec.Strategy.BlueGreen = &v1alpha1.BlueGreenStrategy{PreviewPodSpecPatch: &runtime.RawExtension{Raw: []byte(testPodSpecPatch)}}
+	assert.NotNil(t, GetPodSpecPatch(ro))
+}
+
+func TestPatchPodSpec(t *testing.T) {
+	ro := newPodSpecPatchRollout("")
+	spec, err := PatchPodSpec(&ro.Spec.Template.Spec, []byte(testPodSpecPatch))
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"pool": "canary"}, spec.NodeSelector)
+	assert.Equal(t, "guestbook:v2", spec.Containers[0].Image)
+	assert.Equal(t, []corev1.EnvVar{{Name: "DEBUG", Value: "true"}, {Name: "LOG_LEVEL", Value: "info"}}, spec.Containers[0].Env)
+	// the pod spec of the rollout is left untouched
+	assert.Nil(t, ro.Spec.Template.Spec.NodeSelector)
+
+	_, err = PatchPodSpec(&ro.Spec.Template.Spec, []byte(`{"containers":"app"}`))
+	assert.Error(t, err)
+}
+
+func TestSyncReplicaSetPodSpecPatch(t *testing.T) {
+	ro := newPodSpecPatchRollout(testPodSpecPatch)
+	rs := &appsv1.ReplicaSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook-abc", UID: types.UID("rs-uid")},
+		Spec: appsv1.ReplicaSetSpec{
+			Template: *ro.Spec.Template.DeepCopy(),
+		},
+	}
+
+	patchedRS, modified, err := SyncReplicaSetPodSpecPatch(rs, ro, GetPodSpecPatch(ro))
+	assert.NoError(t, err)
+	assert.True(t, modified)
+	assert.Equal(t, map[string]string{"pool": "canary"}, patchedRS.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, `{"nodeSelector":{"pool":"canary"},"containers":[{"name":"app","env":[{"name":"DEBUG","value":"true"}]}]}`, patchedRS.Annotations[PodSpecPatchAnnotation])
+	assert.NotEmpty(t, patchedRS.Spec.Template.Annotations[PodSpecPatchHashAnnotation])
+	assert.Nil(t, rs.Spec.Template.Spec.NodeSelector)
+
+	_, modified, err = SyncReplicaSetPodSpecPatch(patchedRS, ro, GetPodSpecPatch(ro))
+	assert.NoError(t, err)
+	assert.False(t, modified)
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "guestbook-abc-1",
+			Annotations:     patchedRS.Spec.Template.Annotations,
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(patchedRS, appsv1.SchemeGroupVersion.WithKind("ReplicaSet"))},
+		},
+	}
+	assert.False(t, HasStalePodSpecPatch(pod, patchedRS))
+
+	unpatchedRS, modified, err := SyncReplicaSetPodSpecPatch(patchedRS, ro, nil)
+	assert.NoError(t, err)
+	assert.True(t, modified)
+	assert.Nil(t, unpatchedRS.Spec.Template.Spec.NodeSelector)
+	assert.Equal(t, ro.Spec.Template.Spec.Containers, unpatchedRS.Spec.Template.Spec.Containers)
+	assert.NotContains(t, unpatchedRS.Annotations, PodSpecPatchAnnotation)
+	assert.NotContains(t, unpatchedRS.Spec.Template.Annotations, PodSpecPatchHashAnnotation)
+	assert.True(t, HasStalePodSpecPatch(pod, unpatchedRS))
+
+	// the pods of other ReplicaSets are ignored
+	pod.OwnerReferences = nil
+	assert.False(t, HasStalePodSpecPatch(pod, unpatchedRS))
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/argoproj/argo-rollouts/utils/defaults"
 	"github.com/argoproj/argo-rollouts/utils/hash"
 	logutil "github.com/argoproj/argo-rollouts/utils/log"
+	rolloututil "github.com/argoproj/argo-rollouts/utils/rollout"
 	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
@@ -45,7 +46,13 @@ func FindNewReplicaSet(rollout *v1alpha1.Rollout, rsList []*appsv1.ReplicaSet) *
 	if rs := searchRsByHash(rsList, podHash); rs != nil {
 		return rs
 	}
-	// Second, attempt to find the replicaset with old hash implementation
+	// Second, attempt to find the replicaset with the hash of the pod template before it was
+	// normalized
+	legacyHash := hash.ComputeLegacyPodTemplateHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
+	if rs := searchRsByHash(rsList, legacyHash); rs != nil {
+		return rs
+	}
+	// Third, attempt to find the replicaset with old hash implementation
 	oldHash := controller.ComputeHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
 	if rs := searchRsByHash(rsList, oldHash); rs != nil {
 		logCtx := logutil.WithRollout(rollout)
@@ -102,9 +109,8 @@ func GetRolloutAffinity(rollout v1alpha1.Rollout) *v1alpha1.AntiAffinity {
 
 func GenerateReplicaSetAffinity(rollout v1alpha1.Rollout) *corev1.Affinity {
 	antiAffinityStrategy := GetRolloutAffinity(rollout)
-	currentPodHash := hash.ComputePodTemplateHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
 	affinitySpec := rollout.Spec.Template.Spec.Affinity.DeepCopy()
-	if antiAffinityStrategy != nil && rollout.Status.StableRS != "" && rollout.Status.StableRS != currentPodHash {
+	if antiAffinityStrategy != nil && rollout.Status.StableRS != "" && !hash.PodTemplateHashMatches(&rollout.Spec.Template, rollout.Status.CollisionCount, rollout.Status.StableRS) {
 		antiAffinityRule := CreateInjectedAntiAffinityRule(rollout)
 		if affinitySpec == nil {
 			affinitySpec = &corev1.Affinity{}
@@ -208,8 +214,7 @@ func RemoveInjectedAntiAffinityRule(affinity *corev1.Affinity, rollout v1alpha1.
 
 func IfInjectedAntiAffinityRuleNeedsUpdate(affinity *corev1.Affinity, rollout v1alpha1.Rollout) bool {
 	_, podAffinityTerm := HasInjectedAntiAffinityRule(affinity, rollout)
-	currentPodHash := hash.ComputePodTemplateHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
-	if podAffinityTerm != nil && rollout.Status.StableRS != currentPodHash {
+	if podAffinityTerm != nil && !hash.PodTemplateHashMatches(&rollout.Spec.Template, rollout.Status.CollisionCount, rollout.Status.StableRS) {
 		for _, labelSelectorRequirement := range podAffinityTerm.LabelSelector.MatchExpressions {
 			if labelSelectorRequirement.Key == v1alpha1.DefaultRolloutUniqueLabelKey && labelSelectorRequirement.Values[0] != rollout.Status.StableRS {
 				return true
@@ -220,14 +225,25 @@ func IfInjectedAntiAffinityRuleNeedsUpdate(affinity *corev1.Affinity, rollout v1
 }
 
 func NeedsRestart(rollout *v1alpha1.Rollout) bool {
+	return GetRestartAt(rollout, rollout.Status.NextScheduledRestartAt) != nil
+}
+
+// GetRestartAt returns the time the pods of a rollout must have been created after, when a restart
+// is due: either the restartAt of the spec, or the given next scheduled restart, whichever is the
+// latest. Returns nil if no restart is due.
+func GetRestartAt(rollout *v1alpha1.Rollout, nextScheduledRestartAt *metav1.Time) *metav1.Time {
 	now := timeutil.MetaNow().UTC()
-	if rollout.Spec.RestartAt == nil {
-		return false
-	}
-	if rollout.Status.RestartedAt != nil && rollout.Spec.RestartAt.Equal(rollout.Status.RestartedAt) {
-		return false
+	var restartAt *metav1.Time
+	if rolloututil.IsRestartAtPending(rollout) && now.After(rollout.Spec.RestartAt.Time) {
+		restartAt = rollout.Spec.RestartAt
+	}
+	next := nextScheduledRestartAt
+	if rollout.Spec.RestartSchedule != nil && next != nil && !now.Before(next.Time) {
+		if restartAt == nil || restartAt.Before(next) {
+			restartAt = next
+		}
 	}
-	return now.After(rollout.Spec.RestartAt.Time)
+	return restartAt
 }
 
 // FindOldReplicaSets returns the old replica sets targeted by the given Rollout, with the given slice of RSes.
@@ -470,6 +486,10 @@ func CheckPodSpecChange(rollout *v1alpha1.Rollout, newRS *appsv1.ReplicaSet) boo
 	if rollout.Status.CurrentPodHash == "" {
 		return false
 	}
+	if newRS == nil && hash.PodTemplateHashMatches(&rollout.Spec.Template, rollout.Status.CollisionCount, rollout.Status.CurrentPodHash) {
+		// the current pod hash may still be computed with the legacy hashing scheme
+		return false
+	}
 	podHash := hash.ComputePodTemplateHash(&rollout.Spec.Template, rollout.Status.CollisionCount)
 	if newRS != nil {
 		podHash = GetPodTemplateHash(newRS)
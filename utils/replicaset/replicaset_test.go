@@ -91,6 +91,12 @@ func TestFindNewReplicaSet(t *testing.T) {
 		actual := FindNewReplicaSet(&ro, []*appsv1.ReplicaSet{&rs1})
 		assert.Equal(t, &rs1, actual)
 	})
+	t.Run("FindNewReplicaSet by legacy hash", func(t *testing.T) {
+		// rs was created before pod templates were normalized
+		rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = hash.ComputeLegacyPodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+		actual := FindNewReplicaSet(&ro, []*appsv1.ReplicaSet{&rs1})
+		assert.Equal(t, &rs1, actual)
+	})
 	t.Run("FindNewReplicaSet by deprecated hash", func(t *testing.T) {
 		// rs has the deprecated hash
 		rs1.Labels[v1alpha1.DefaultRolloutUniqueLabelKey] = controller.ComputeHash(&ro.Spec.Template, ro.Status.CollisionCount)
@@ -663,6 +669,12 @@ func TestCheckPodSpecChange(t *testing.T) {
 
 	ro.Status.CurrentPodHash = "different-hash"
 	assert.True(t, CheckPodSpecChange(&ro, &rs))
+
+	// a current pod hash computed with the legacy hashing scheme is not a change
+	ro.Status.CurrentPodHash = hash.ComputeLegacyPodTemplateHash(&ro.Spec.Template, ro.Status.CollisionCount)
+	assert.False(t, CheckPodSpecChange(&ro, nil))
+	ro.Status.CurrentPodHash = "different-hash"
+	assert.True(t, CheckPodSpecChange(&ro, nil))
 }
 
 func TestCheckStepHashChange(t *testing.T) {
@@ -1064,6 +1076,62 @@ func TestNeedsRestart(t *testing.T) {
 		}
 		assert.True(t, NeedsRestart(ro))
 	})
+	t.Run("Restart if .spec.RestartAt is before .status.RestartedAt", func(t *testing.T) {
+		inThePast := metav1.NewTime(metav1.Now().Add(-10 * time.Second))
+		now := metav1.Now()
+		ro := &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				RestartAt: &inThePast,
+			},
+			Status: v1alpha1.RolloutStatus{
+				RestartedAt: &now,
+			},
+		}
+		assert.True(t, NeedsRestart(ro))
+	})
+	t.Run("No Restart if .status.RestartedAt is after .spec.RestartAt with a schedule", func(t *testing.T) {
+		inThePast := metav1.NewTime(metav1.Now().Add(-10 * time.Second))
+		now := metav1.Now()
+		ro := &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				RestartAt:       &inThePast,
+				RestartSchedule: &v1alpha1.RestartSchedule{Schedule: "0 3 * * *"},
+			},
+			Status: v1alpha1.RolloutStatus{
+				RestartedAt: &now,
+			},
+		}
+		assert.False(t, NeedsRestart(ro))
+	})
+	t.Run("Scheduled Restart", func(t *testing.T) {
+		inThePast := metav1.NewTime(metav1.Now().Add(-10 * time.Second))
+		inTheFuture := metav1.NewTime(metav1.Now().Add(10 * time.Second))
+		ro := &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				RestartSchedule: &v1alpha1.RestartSchedule{Schedule: "0 3 * * *"},
+			},
+			Status: v1alpha1.RolloutStatus{
+				NextScheduledRestartAt: &inTheFuture,
+			},
+		}
+		assert.False(t, NeedsRestart(ro))
+		ro.Status.NextScheduledRestartAt = &inThePast
+		assert.True(t, NeedsRestart(ro))
+		assert.Equal(t, &inThePast, GetRestartAt(ro, ro.Status.NextScheduledRestartAt))
+	})
+	t.Run("Latest of RestartAt and Scheduled Restart", func(t *testing.T) {
+		longAgo := metav1.NewTime(metav1.Now().Add(-10 * time.Minute))
+		inThePast := metav1.NewTime(metav1.Now().Add(-10 * time.Second))
+		ro := &v1alpha1.Rollout{
+			Spec: v1alpha1.RolloutSpec{
+				RestartAt:       &longAgo,
+				RestartSchedule: &v1alpha1.RestartSchedule{Schedule: "0 3 * * *"},
+			},
+		}
+		assert.Equal(t, &inThePast, GetRestartAt(ro, &inThePast))
+		ro.Spec.RestartAt = &inThePast
+		assert.Equal(t, &inThePast, GetRestartAt(ro, &longAgo))
+	})
 }
 
 func TestIsStillReferenced(t *testing.T) {
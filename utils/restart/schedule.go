@@ -0,0 +1,55 @@
+package restart
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+// ParseSchedule parses the cron expression of a restart schedule, evaluated in the time zone of the
+// schedule. Only cron expressions and the descriptors like @daily are supported: the @every
+// descriptor is rejected, since its restarts would not happen at fixed times.
+func ParseSchedule(schedule v1alpha1.RestartSchedule) (cron.Schedule, error) {
+	location := time.UTC
+	if schedule.TimeZone != "" {
+		var err error
+		location, err = time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone '%s': %v", schedule.TimeZone, err)
+		}
+	}
+	if strings.HasPrefix(schedule.Schedule, "TZ=") || strings.HasPrefix(schedule.Schedule, "CRON_TZ=") {
+		return nil, fmt.Errorf("invalid schedule '%s': the time zone must be set with timeZone", schedule.Schedule)
+	}
+	parsed, err := cron.ParseStandard(schedule.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule '%s': %v", schedule.Schedule, err)
+	}
+	specSchedule, ok := parsed.(*cron.SpecSchedule)
+	if !ok {
+		return nil, fmt.Errorf("invalid schedule '%s': only cron expressions are supported", schedule.Schedule)
+	}
+	specSchedule.Location = location
+	return specSchedule, nil
+}
+
+// NextRestart returns the first restart of the schedule after the given time
+func NextRestart(schedule cron.Schedule, after time.Time) *metav1.Time {
+	next := schedule.Next(after)
+	if next.IsZero() {
+		// the schedule never matches (e.g. February 30th)
+		return nil
+	}
+	return &metav1.Time{Time: next.UTC()}
+}
+
+// IsScheduledAt returns whether the schedule restarts at the given time. It detects the restarts
+// which are no longer scheduled after the schedule changed.
+func IsScheduledAt(schedule cron.Schedule, at metav1.Time) bool {
+	return schedule.Next(at.Add(-time.Second)).Equal(at.Time)
+}
@@ -0,0 +1,54 @@
+package restart
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
+)
+
+func TestParseSchedule(t *testing.T) {
+	after := time.Date(2022, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, test := range []struct {
+		schedule v1alpha1.RestartSchedule
+		next     time.Time
+	}{
+		{v1alpha1.RestartSchedule{Schedule: "0 3 * * *"}, time.Date(2022, 3, 2, 3, 0, 0, 0, time.UTC)},
+		{v1alpha1.RestartSchedule{Schedule: "@weekly"}, time.Date(2022, 3, 6, 0, 0, 0, 0, time.UTC)},
+		{v1alpha1.RestartSchedule{Schedule: "0 3 * * *", TimeZone: "America/New_York"}, time.Date(2022, 3, 2, 8, 0, 0, 0, time.UTC)},
+	} {
+		schedule, err := ParseSchedule(test.schedule)
+		if assert.NoError(t, err) {
+			assert.Equal(t, &metav1.Time{Time: test.next}, NextRestart(schedule, after))
+		}
+	}
+}
+
+func TestParseScheduleInvalid(t *testing.T) {
+	for _, schedule := range []v1alpha1.RestartSchedule{
+		{Schedule: ""},
+		{Schedule: "0 3 * *"},
+		{Schedule: "@every 1h"},
+		{Schedule: "TZ=UTC"},
+		{Schedule: "0 3 * * *", TimeZone: "Mars/Olympus"},
+	} {
+		_, err := ParseSchedule(schedule)
+		assert.Error(t, err, schedule.Schedule)
+	}
+}
+
+func TestNextRestartNever(t *testing.T) {
+	schedule, err := ParseSchedule(v1alpha1.RestartSchedule{Schedule: "0 3 30 2 *"})
+	assert.NoError(t, err)
+	assert.Nil(t, NextRestart(schedule, time.Now()))
+}
+
+func TestIsScheduledAt(t *testing.T) {
+	schedule, err := ParseSchedule(v1alpha1.RestartSchedule{Schedule: "0 3 * * *", TimeZone: "Europe/Paris"})
+	assert.NoError(t, err)
+	assert.True(t, IsScheduledAt(schedule, metav1.NewTime(time.Date(2022, 3, 2, 2, 0, 0, 0, time.UTC))))
+	assert.False(t, IsScheduledAt(schedule, metav1.NewTime(time.Date(2022, 3, 2, 3, 0, 0, 0, time.UTC))))
+}
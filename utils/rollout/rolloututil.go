@@ -2,14 +2,29 @@ package rollout
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
-	"github.com/argoproj/argo-rollouts/utils/annotations"
-	"github.com/argoproj/argo-rollouts/utils/conditions"
-	"github.com/argoproj/argo-rollouts/utils/defaults"
+	"github.com/argoproj/argo-rollouts/pkg/health"
+	timeutil "github.com/argoproj/argo-rollouts/utils/time"
 )
 
+// IsRestartAtPending returns whether the restartAt of the spec of the rollout was not restarted at
+// yet. Without a restart schedule, any restartAt which differs from the last restart is pending, even
+// an earlier one. With a restart schedule, the scheduled restarts are recorded as the last restart,
+// so only a restartAt after the last restart is pending.
+func IsRestartAtPending(ro *v1alpha1.Rollout) bool {
+	if ro.Spec.RestartAt == nil {
+		return false
+	}
+	if ro.Status.RestartedAt == nil {
+		return true
+	}
+	if ro.Spec.RestartSchedule == nil {
+		return !ro.Spec.RestartAt.Equal(ro.Status.RestartedAt)
+	}
+	return ro.Status.RestartedAt.Before(ro.Spec.RestartAt)
+}
+
 // IsFullyPromoted returns whether or not the given rollout is in a fully promoted state.
 // (versus being in the middle of an update). This is determined by checking if stable hash == desired hash
 func IsFullyPromoted(ro *v1alpha1.Rollout) bool {
@@ -20,61 +35,7 @@ func IsFullyPromoted(ro *v1alpha1.Rollout) bool {
 // or not metadata.generation was observed in status.observedGeneration
 // use this instead of CalculateRolloutPhase
 func GetRolloutPhase(ro *v1alpha1.Rollout) (v1alpha1.RolloutPhase, string) {
-	if !isGenerationObserved(ro) {
-		return v1alpha1.RolloutPhaseProgressing, "waiting for rollout spec update to be observed"
-	}
-	if IsUnpausing(ro) {
-		return v1alpha1.RolloutPhaseProgressing, "waiting for rollout to unpause"
-	}
-	if ro.Spec.TemplateResolvedFromRef && !isWorkloadGenerationObserved(ro) {
-		return v1alpha1.RolloutPhaseProgressing, "waiting for rollout spec update to be observed for the reference workload"
-	}
-
-	if ro.Status.Phase != "" {
-		// for 1.0+ phase/message is calculated controller side
-		return ro.Status.Phase, ro.Status.Message
-	}
-	// for v0.10 and below, fall back to client-side calculation
-	return CalculateRolloutPhase(ro.Spec, ro.Status)
-}
-
-// isGenerationObserved determines if the rollout spec has been observed by the controller. This
-// only applies to v0.10 rollout which uses a numeric status.observedGeneration. For v0.9 rollouts
-// and below this function always returns true.
-func isGenerationObserved(ro *v1alpha1.Rollout) bool {
-	observedGen, err := strconv.Atoi(ro.Status.ObservedGeneration)
-	if err != nil {
-		return true
-	}
-	// It's still possible for a v0.9 rollout to have an all numeric hash, this covers that corner case
-	if int64(observedGen) > ro.Generation {
-		return true
-	}
-	return int64(observedGen) == ro.Generation
-}
-
-// IsUnpausing detects if we are in the process of unpausing a rollout. This is determined by seeing
-// if status.controllerPause is true, but the list of pause conditions (status.pauseConditions)
-// is empty. This implies that a user cleared the pause conditions but controller has not yet
-// observed or reacted to it.
-// NOTE: this function is necessary because unlike metadata.generation & status.observedGeneration
-// status.controllerPause & status.pauseConditions are both status fields and does not benefit from
-// the auto-incrementing behavior of metadata.generation.
-func IsUnpausing(ro *v1alpha1.Rollout) bool {
-	return ro.Status.ControllerPause && len(ro.Status.PauseConditions) == 0
-}
-
-func isWorkloadGenerationObserved(ro *v1alpha1.Rollout) bool {
-	if _, ok := annotations.GetWorkloadGenerationAnnotation(ro); !ok {
-		return true
-	}
-	workloadGeneration, _ := annotations.GetWorkloadGenerationAnnotation(ro)
-	observedWorkloadGen, err := strconv.ParseInt(ro.Status.WorkloadObservedGeneration, 10, 32)
-	if err != nil {
-		return true
-	}
-
-	return int32(observedWorkloadGen) == workloadGeneration
+	return health.RolloutPhase(ro, timeutil.Now())
 }
 
 // CalculateRolloutPhase calculates a rollout phase and message for the given rollout based on
@@ -82,72 +43,7 @@ func isWorkloadGenerationObserved(ro *v1alpha1.Rollout) bool {
 // by clients). Clients should instead call GetRolloutPhase, which takes into consideration
 // status.observedGeneration
 func CalculateRolloutPhase(spec v1alpha1.RolloutSpec, status v1alpha1.RolloutStatus) (v1alpha1.RolloutPhase, string) {
-	ro := v1alpha1.Rollout{
-		Spec:   spec,
-		Status: status,
-	}
-	for _, cond := range ro.Status.Conditions {
-		if cond.Type == v1alpha1.InvalidSpec {
-			return v1alpha1.RolloutPhaseDegraded, fmt.Sprintf("%s: %s", v1alpha1.InvalidSpec, cond.Message)
-		}
-		switch cond.Reason {
-		case conditions.RolloutAbortedReason, conditions.TimedOutReason:
-			return v1alpha1.RolloutPhaseDegraded, fmt.Sprintf("%s: %s", cond.Reason, cond.Message)
-		}
-	}
-	if ro.Spec.Paused {
-		return v1alpha1.RolloutPhasePaused, "manually paused"
-	}
-	for _, pauseCond := range ro.Status.PauseConditions {
-		return v1alpha1.RolloutPhasePaused, string(pauseCond.Reason)
-	}
-	if ro.Spec.RestartAt != nil && (ro.Status.RestartedAt == nil || !ro.Spec.RestartAt.Time.Equal(ro.Status.RestartedAt.Time)) {
-		return v1alpha1.RolloutPhaseProgressing, "rollout is restarting"
-	}
-	if ro.Status.UpdatedReplicas < defaults.GetReplicasOrDefault(ro.Spec.Replicas) {
-		return v1alpha1.RolloutPhaseProgressing, "more replicas need to be updated"
-	}
-	if ro.Status.AvailableReplicas < ro.Status.UpdatedReplicas {
-		return v1alpha1.RolloutPhaseProgressing, "updated replicas are still becoming available"
-	}
-	if ro.Spec.Strategy.BlueGreen != nil {
-		if ro.Status.BlueGreen.ActiveSelector == "" || ro.Status.BlueGreen.ActiveSelector != ro.Status.CurrentPodHash {
-			return v1alpha1.RolloutPhaseProgressing, "active service cutover pending"
-		}
-		if ro.Status.StableRS == "" || !IsFullyPromoted(&ro) {
-			// we switched the active selector to the desired ReplicaSet, but we have yet to mark it
-			// as stable. This could be caused by one of two things:
-			// 1. post-promotion analysis has yet to complete successfully
-			// 2. post-promotion verification (i.e. target group verification)
-			if waitingForBlueGreenPostPromotionAnalysis(&ro) {
-				return v1alpha1.RolloutPhaseProgressing, "waiting for analysis to complete"
-			}
-			return v1alpha1.RolloutPhaseProgressing, "waiting for post-promotion verification to complete"
-		}
-	} else if ro.Spec.Strategy.Canary != nil {
-		if ro.Spec.Strategy.Canary.TrafficRouting == nil {
-			if ro.Status.Replicas > ro.Status.UpdatedReplicas {
-				// This check should only be done for basic canary and not blue-green or canary with traffic routing
-				// since the latter two have the scaleDownDelay feature which leaves the old stack of replicas
-				// running for a long time
-				return v1alpha1.RolloutPhaseProgressing, "old replicas are pending termination"
-			}
-		}
-		if ro.Status.StableRS == "" || !IsFullyPromoted(&ro) {
-			return v1alpha1.RolloutPhaseProgressing, "waiting for all steps to complete"
-		}
-	}
-	return v1alpha1.RolloutPhaseHealthy, ""
-}
-
-// waitingForBlueGreenPostPromotionAnalysis returns we are waiting for blue-green post promotion to complete
-func waitingForBlueGreenPostPromotionAnalysis(ro *v1alpha1.Rollout) bool {
-	if ro.Spec.Strategy.BlueGreen.PostPromotionAnalysis != nil {
-		if ro.Status.BlueGreen.PostPromotionAnalysisRunStatus == nil || !ro.Status.BlueGreen.PostPromotionAnalysisRunStatus.Status.Completed() {
-			return true
-		}
-	}
-	return false
+	return health.CalculateRolloutPhase(spec, status, timeutil.Now())
 }
 
 // CanaryStepString returns a string representation of a canary step
@@ -8,6 +8,7 @@ import (
 	"github.com/argoproj/argo-rollouts/pkg/apis/rollouts/v1alpha1"
 	"github.com/argoproj/argo-rollouts/utils/annotations"
 	"github.com/tj/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/pointer"
@@ -112,6 +113,28 @@ func TestRolloutStatusPaused(t *testing.T) {
 	assert.Equal(t, "manually paused", message)
 }
 
+func TestRolloutStatusQueued(t *testing.T) {
+	ro := newCanaryRollout()
+	ro.Status.ControllerPause = true
+	ro.Status.Queue = &v1alpha1.RolloutQueueStatus{Position: 2}
+	status, message := GetRolloutPhase(ro)
+	assert.Equal(t, v1alpha1.RolloutPhaseQueued, status)
+	assert.Equal(t, "waiting for a progressing slot (position 2)", message)
+}
+
+func TestRolloutStatusFrozen(t *testing.T) {
+	ro := newCanaryRollout()
+	ro.Spec.Paused = true
+	ro.Status.Conditions = []v1alpha1.RolloutCondition{{
+		Type:    v1alpha1.RolloutFrozen,
+		Status:  corev1.ConditionTrue,
+		Message: "emergency stop of namespace 'default': INC-42",
+	}}
+	status, message := GetRolloutPhase(ro)
+	assert.Equal(t, v1alpha1.RolloutPhasePaused, status)
+	assert.Equal(t, "emergency stop of namespace 'default': INC-42", message)
+}
+
 func TestRolloutStatusProgressing(t *testing.T) {
 	{
 		ro := newCanaryRollout()
@@ -255,6 +278,44 @@ func TestRolloutStatusProgressing(t *testing.T) {
 		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
 		assert.Equal(t, "rollout is restarting", message)
 	}
+	{
+		// Verify rollout is considered progressing if restartAt is set before the last restart
+		oneMinuteAgo := metav1.Time{Time: time.Now().Add(-1 * time.Minute)}
+		now := metav1.Now()
+		ro := newCanaryRollout()
+		ro.Spec.RestartAt = &oneMinuteAgo
+		ro.Status.RestartedAt = &now
+		ro.Status.Replicas = 5
+		ro.Status.UpdatedReplicas = 5
+		ro.Status.AvailableReplicas = 5
+		ro.Status.ReadyReplicas = 5
+		ro.Status.StableRS = "abc1234"
+		ro.Status.CurrentPodHash = "abc1234"
+		status, message := GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+		assert.Equal(t, "rollout is restarting", message)
+
+		// with a restart schedule, the scheduled restarts are recorded as the last restart
+		ro.Spec.RestartSchedule = &v1alpha1.RestartSchedule{Schedule: "0 3 * * *"}
+		status, _ = GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseHealthy, status)
+	}
+	{
+		// Verify rollout is considered progressing if we did not finish the scheduled restart
+		oneMinuteAgo := metav1.Time{Time: time.Now().Add(-1 * time.Minute)}
+		ro := newCanaryRollout()
+		ro.Spec.RestartSchedule = &v1alpha1.RestartSchedule{Schedule: "0 3 * * *"}
+		ro.Status.NextScheduledRestartAt = &oneMinuteAgo
+		ro.Status.Replicas = 5
+		ro.Status.UpdatedReplicas = 5
+		ro.Status.AvailableReplicas = 5
+		ro.Status.ReadyReplicas = 5
+		ro.Status.StableRS = "abc1234"
+		ro.Status.CurrentPodHash = "abc1234"
+		status, message := GetRolloutPhase(ro)
+		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
+		assert.Equal(t, "rollout is restarting on schedule", message)
+	}
 	{
 		//Rollout observed workload generation is not updated
 		ro := newCanaryRollout()
@@ -267,25 +328,6 @@ func TestRolloutStatusProgressing(t *testing.T) {
 		assert.Equal(t, v1alpha1.RolloutPhaseProgressing, status)
 		assert.Equal(t, "waiting for rollout spec update to be observed for the reference workload", message)
 	}
-	{
-		ro := newCanaryRollout()
-
-		observed := isWorkloadGenerationObserved(ro)
-		assert.True(t, observed)
-
-		annotations.SetRolloutWorkloadRefGeneration(ro, "2")
-		ro.Status.WorkloadObservedGeneration = "222222222222222222"
-		observed = isWorkloadGenerationObserved(ro)
-		assert.True(t, observed)
-
-		ro.Status.WorkloadObservedGeneration = "1"
-		observed = isWorkloadGenerationObserved(ro)
-		assert.False(t, observed)
-
-		ro.Status.WorkloadObservedGeneration = "2"
-		observed = isWorkloadGenerationObserved(ro)
-		assert.True(t, observed)
-	}
 }
 
 func TestRolloutStatusHealthy(t *testing.T) {